@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-gonic/gin"
 )
@@ -20,6 +22,15 @@ const (
 	getKeyPath      = "/:address/key/:key"
 	getESDTTokens   = "/:address/esdt"
 	getESDTBalance  = "/:address/esdt/:tokenIdentifier"
+	getHistoryPath  = "/:address/history"
+	getKeysPath     = "/:address/keys"
+)
+
+const (
+	defaultHistoryPageSize = 20
+	maxHistoryPageSize     = 100
+	defaultKeysPageSize    = 100
+	maxKeysPageSize        = 1000
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -31,6 +42,8 @@ type FacadeHandler interface {
 	GetCode(account state.UserAccountHandler) []byte
 	GetESDTBalance(address string, key string) (string, string, error)
 	GetAllESDTTokens(address string) ([]string, error)
+	GetBalanceHistory(address string, from uint64, size uint64) (*api.BalanceHistory, error)
+	GetKeyValuePairs(address string, prefix string, from uint64, size uint64) (*api.AccountStorage, error)
 	IsInterfaceNil() bool
 }
 
@@ -58,6 +71,8 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getKeyPath, GetValueForKey)
 	router.RegisterHandler(http.MethodGet, getESDTBalance, GetESDTBalance)
 	router.RegisterHandler(http.MethodGet, getESDTTokens, GetESDTTokens)
+	router.RegisterHandler(http.MethodGet, getHistoryPath, GetBalanceHistory)
+	router.RegisterHandler(http.MethodGet, getKeysPath, GetKeyValuePairs)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -368,6 +383,138 @@ func GetESDTTokens(c *gin.Context) {
 	)
 }
 
+// GetBalanceHistory returns a page of the balance changes of an account, from the oldest to the newest. The page is
+// selected with the from and size query parameters
+func GetBalanceHistory(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetBalanceHistory.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	from, size, err := getQueryParamsPage(c, defaultHistoryPageSize, maxHistoryPageSize)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	history, err := facade.GetBalanceHistory(addr, from, size)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetBalanceHistory.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"history": history},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetKeyValuePairs returns a page of the key-value pairs stored by an account, sorted by key. The keys can be filtered
+// with the hex encoded prefix query parameter and the page is selected with the from and size query parameters
+func GetKeyValuePairs(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	from, size, err := getQueryParamsPage(c, defaultKeysPageSize, maxKeysPageSize)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	prefix := c.Request.URL.Query().Get("prefix")
+	accountStorage, err := facade.GetKeyValuePairs(addr, prefix, from, size)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"storage": accountStorage},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getQueryParamsPage(c *gin.Context, defaultPageSize uint64, maxPageSize uint64) (uint64, uint64, error) {
+	from := uint64(0)
+	size := defaultPageSize
+	var err error
+
+	fromStr := c.Request.URL.Query().Get("from")
+	if fromStr != "" {
+		from, err = strconv.ParseUint(fromStr, 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	sizeStr := c.Request.URL.Query().Get("size")
+	if sizeStr != "" {
+		size, err = strconv.ParseUint(sizeStr, 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	if size == 0 || size > maxPageSize {
+		return 0, 0, errors.ErrInvalidQueryParameter
+	}
+
+	return from, size, nil
+}
+
 func accountResponseFromBaseAccount(address string, code []byte, account state.UserAccountHandler) accountResponse {
 	return accountResponse{
 		Address:  address,
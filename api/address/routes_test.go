@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -75,10 +76,30 @@ type esdtTokensResponse struct {
 	Code  string
 }
 
+type balanceHistoryResponseData struct {
+	History api.BalanceHistory `json:"history"`
+}
+
+type balanceHistoryResponse struct {
+	Data  balanceHistoryResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
 type usernameResponseData struct {
 	Username string `json:"username"`
 }
 
+type accountStorageResponseData struct {
+	Storage api.AccountStorage `json:"storage"`
+}
+
+type accountStorageResponse struct {
+	Data  accountStorageResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
 type usernameResponse struct {
 	Data  usernameResponseData `json:"data"`
 	Error string               `json:"error"`
@@ -595,6 +616,217 @@ func TestGetESDTTokens_ShouldWork(t *testing.T) {
 	assert.Equal(t, []string{testValue1, testValue2}, esdtTokenResponseObj.Data.Tokens)
 }
 
+func TestGetBalanceHistory_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/address/some/history", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetBalanceHistory_InvalidQueryParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetBalanceHistoryCalled: func(_ string, _ uint64, _ uint64) (*api.BalanceHistory, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	for _, query := range []string{"from=abc", "size=-1", "size=0", "size=101"} {
+		req, _ := http.NewRequest("GET", "/address/address/history?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()), query)
+	}
+}
+
+func TestGetBalanceHistory_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetBalanceHistoryCalled: func(_ string, _ uint64, _ uint64) (*api.BalanceHistory, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/history", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBalanceHistory.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetBalanceHistory_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedHistory := api.BalanceHistory{
+		Address: testAddress,
+		Total:   30,
+		Changes: []*api.BalanceChange{{Balance: "10", Nonce: 2, BlockNonce: 7, TxHash: "aabb"}},
+	}
+	facade := mock.Facade{
+		GetBalanceHistoryCalled: func(address string, from uint64, size uint64) (*api.BalanceHistory, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, uint64(20), from)
+			assert.Equal(t, uint64(10), size)
+			return &expectedHistory, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/history?from=20&size=10", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := balanceHistoryResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedHistory, response.Data.History)
+}
+
+func TestGetBalanceHistory_DefaultPageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetBalanceHistoryCalled: func(_ string, from uint64, size uint64) (*api.BalanceHistory, error) {
+			assert.Equal(t, uint64(0), from)
+			assert.Equal(t, uint64(20), size)
+			return &api.BalanceHistory{}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/history", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestGetKeyValuePairs_InvalidQueryParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetKeyValuePairsCalled: func(_ string, _ string, _ uint64, _ uint64) (*api.AccountStorage, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	for _, query := range []string{"from=abc", "size=-1", "size=0", "size=1001"} {
+		req, _ := http.NewRequest("GET", "/address/address/keys?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()), query)
+	}
+}
+
+func TestGetKeyValuePairs_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetKeyValuePairsCalled: func(_ string, _ string, _ uint64, _ uint64) (*api.AccountStorage, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetKeyValuePairs.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	expectedStorage := api.AccountStorage{
+		Address: testAddress,
+		Total:   3,
+		Pairs:   []*api.StorageKeyValue{{Key: "aa01", Value: "ff"}},
+	}
+	facade := mock.Facade{
+		GetKeyValuePairsCalled: func(address string, prefix string, from uint64, size uint64) (*api.AccountStorage, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, "aa", prefix)
+			assert.Equal(t, uint64(2), from)
+			assert.Equal(t, uint64(1), size)
+			return &expectedStorage, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/keys?prefix=aa&from=2&size=1", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := accountStorageResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedStorage, response.Data.Storage)
+}
+
+func TestGetKeyValuePairs_DefaultPageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetKeyValuePairsCalled: func(_ string, prefix string, from uint64, size uint64) (*api.AccountStorage, error) {
+			assert.Equal(t, "", prefix)
+			assert.Equal(t, uint64(0), from)
+			assert.Equal(t, uint64(100), size)
+			return &api.AccountStorage{}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -606,6 +838,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/key/:key", Open: true},
 					{Name: "/:address/esdt", Open: true},
 					{Name: "/:address/esdt/:tokenIdentifier", Open: true},
+					{Name: "/:address/history", Open: true},
+					{Name: "/:address/keys", Open: true},
 				},
 			},
 		},
@@ -8,12 +8,16 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/esdt"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
+	"github.com/ElrondNetwork/elrond-go/api/usernames"
 	valStats "github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
@@ -149,6 +153,30 @@ func RegisterRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		block.Routes(wrappedBlockRouter)
 	}
 
+	esdtRoutes := ws.Group("/esdt")
+	wrappedESDTRouter, err := wrapper.NewRouterWrapper("esdt", esdtRoutes, routesConfig)
+	if err == nil {
+		esdt.Routes(wrappedESDTRouter)
+	}
+
+	delegationRoutes := ws.Group("/delegation")
+	wrappedDelegationRouter, err := wrapper.NewRouterWrapper("delegation", delegationRoutes, routesConfig)
+	if err == nil {
+		delegation.Routes(wrappedDelegationRouter)
+	}
+
+	governanceRoutes := ws.Group("/governance")
+	wrappedGovernanceRouter, err := wrapper.NewRouterWrapper("governance", governanceRoutes, routesConfig)
+	if err == nil {
+		governance.Routes(wrappedGovernanceRouter)
+	}
+
+	usernamesRoutes := ws.Group("/usernames")
+	wrappedUsernamesRouter, err := wrapper.NewRouterWrapper("usernames", usernamesRoutes, routesConfig)
+	if err == nil {
+		usernames.Routes(wrappedUsernamesRouter)
+	}
+
 	apiHandler, ok := elrondFacade.(MainApiHandler)
 	if ok && apiHandler.PprofEnabled() {
 		pprof.Register(ws)
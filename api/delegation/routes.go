@@ -0,0 +1,113 @@
+package delegation
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getContractPath  = "/:contract"
+	getDelegatorPath = "/:contract/delegators/:address"
+)
+
+// allContractsParam is the value of the contract parameter that lists all the delegation contracts. A static
+// /contracts route can not be registered next to the /:contract wildcard, but an address can never be equal to it
+const allContractsParam = "contracts"
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetDelegationContracts() ([]string, error)
+	GetDelegationContract(contract string) (*api.DelegationContract, error)
+	GetDelegator(contract string, address string) (*api.DelegatorFunds, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines delegation related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, getContractPath, getContract)
+	router.RegisterHandler(http.MethodGet, getDelegatorPath, getDelegator)
+}
+
+func getContract(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	contract := c.Param("contract")
+	if contract == allContractsParam {
+		getAllContracts(c, facade)
+		return
+	}
+
+	contractData, err := facade.GetDelegationContract(contract)
+	if err != nil {
+		respondWithDelegationError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"contract": contractData}, "", shared.ReturnCodeSuccess)
+}
+
+func getAllContracts(c *gin.Context, facade FacadeHandler) {
+	contracts, err := facade.GetDelegationContracts()
+	if err != nil {
+		respondWithDelegationError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"contracts": contracts}, "", shared.ReturnCodeSuccess)
+}
+
+func getDelegator(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	delegator, err := facade.GetDelegator(c.Param("contract"), c.Param("address"))
+	if err != nil {
+		respondWithDelegationError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"delegator": delegator}, "", shared.ReturnCodeSuccess)
+}
+
+func respondWithDelegationError(c *gin.Context, err error) {
+	shared.RespondWith(
+		c,
+		http.StatusInternalServerError,
+		nil,
+		fmt.Sprintf("%s: %s", errors.ErrGetDelegationData.Error(), err.Error()),
+		shared.ReturnCodeInternalError,
+	)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			errors.ErrNilAppContext.Error(),
+			shared.ReturnCodeInternalError,
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
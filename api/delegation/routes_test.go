@@ -0,0 +1,257 @@
+package delegation_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type contractResponse struct {
+	Data struct {
+		Contract api.DelegationContract `json:"contract"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type contractsResponse struct {
+	Data struct {
+		Contracts []string `json:"contracts"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type delegatorResponse struct {
+	Data struct {
+		Delegator api.DelegatorFunds `json:"delegator"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetContract_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/delegation/erd1contract", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetContract_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/delegation/erd1contract", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := contractResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetContract_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetDelegationContractCalled: func(_ string) (*api.DelegationContract, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/delegation/erd1contract", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := contractResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetDelegationData.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetContract_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedContract := api.DelegationContract{
+		Address:          "erd1contract",
+		Owner:            "erd1owner",
+		ServiceFee:       1000,
+		TotalActiveStake: "5000",
+		StakedNodes:      []string{"bls1"},
+	}
+	facade := mock.Facade{
+		GetDelegationContractCalled: func(contract string) (*api.DelegationContract, error) {
+			assert.Equal(t, expectedContract.Address, contract)
+			return &expectedContract, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/delegation/erd1contract", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := contractResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedContract, response.Data.Contract)
+}
+
+func TestGetAllContracts_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedContracts := []string{"erd1contract1", "erd1contract2"}
+	facade := mock.Facade{
+		GetDelegationContractsCalled: func() ([]string, error) {
+			return expectedContracts, nil
+		},
+		GetDelegationContractCalled: func(_ string) (*api.DelegationContract, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/delegation/contracts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := contractsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedContracts, response.Data.Contracts)
+}
+
+func TestGetDelegator_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetDelegatorCalled: func(_ string, _ string) (*api.DelegatorFunds, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/delegation/erd1contract/delegators/erd1delegator", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := delegatorResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetDelegator_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedDelegator := api.DelegatorFunds{
+		Contract:         "erd1contract",
+		Address:          "erd1delegator",
+		ActiveStake:      "100",
+		ClaimableRewards: "5",
+		UnDelegated:      []*api.UnDelegatedFund{{Value: "10", RemainingNonces: 20}},
+	}
+	facade := mock.Facade{
+		GetDelegatorCalled: func(contract string, address string) (*api.DelegatorFunds, error) {
+			assert.Equal(t, expectedDelegator.Contract, contract)
+			assert.Equal(t, expectedDelegator.Address, address)
+			return &expectedDelegator, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/delegation/erd1contract/delegators/erd1delegator", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := delegatorResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedDelegator, response.Data.Delegator)
+}
+
+func startNodeServer(handler delegation.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	delegationRoutes := ws.Group("/delegation")
+	if handler != nil {
+		delegationRoutes.Use(middleware.WithFacade(handler))
+	}
+	delegationRoute, _ := wrapper.NewRouterWrapper("delegation", delegationRoutes, getRoutesConfig())
+	delegation.Routes(delegationRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginDelegationRoute := ws.Group("/delegation")
+	delegationRoute, _ := wrapper.NewRouterWrapper("delegation", ginDelegationRoute, getRoutesConfig())
+	delegation.Routes(delegationRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"delegation": {
+				Routes: []config.RouteConfig{
+					{Name: "/:contract", Open: true},
+					{Name: "/:contract/delegators/:address", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
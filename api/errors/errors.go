@@ -28,6 +28,24 @@ var ErrGetValueForKey = errors.New("get value for key error")
 // ErrGetESDTTokens signals an error in getting esdt tokens for a given address
 var ErrGetESDTTokens = errors.New("get esdt tokens for account error")
 
+// ErrGetBalanceHistory signals an error in getting the balance history for an account
+var ErrGetBalanceHistory = errors.New("get balance history for account error")
+
+// ErrGetKeyValuePairs signals an error in getting the key-value pairs stored by an account
+var ErrGetKeyValuePairs = errors.New("get key-value pairs for account error")
+
+// ErrGetESDTToken signals an error in getting the issued esdt tokens
+var ErrGetESDTToken = errors.New("get esdt token error")
+
+// ErrGetGovernanceData signals an error in getting the governance proposals or votes
+var ErrGetGovernanceData = errors.New("get governance data error")
+
+// ErrGetDelegationData signals an error in getting the delegation contracts or delegators
+var ErrGetDelegationData = errors.New("get delegation data error")
+
+// ErrGetValidatorData signals an error in getting the staking queue or the status of validators
+var ErrGetValidatorData = errors.New("get validator data error")
+
 // ErrGetESDTBalance signals an error in getting esdt balance for given address
 var ErrGetESDTBalance = errors.New("get esdt balance for account error")
 
@@ -40,6 +58,12 @@ var ErrEmptyKey = errors.New("key is empty")
 // ErrValidation signals an error in validation
 var ErrValidation = errors.New("validation error")
 
+// ErrInvalidSimulationBatch signals that an empty or too large batch of transactions was provided for simulation
+var ErrInvalidSimulationBatch = errors.New("invalid simulation batch")
+
+// ErrInvalidQueriesBatch signals that an empty or too large batch of smart contract queries was provided
+var ErrInvalidQueriesBatch = errors.New("invalid queries batch")
+
 // ErrTxGenerationFailed signals an error generating a transaction
 var ErrTxGenerationFailed = errors.New("transaction generation failed")
 
@@ -58,6 +82,9 @@ var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrTraceTransaction signals an error happening when trying to trace a transaction
+var ErrTraceTransaction = errors.New("tracing transaction failed")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
@@ -69,3 +96,6 @@ var ErrGetPidInfo = errors.New("error getting peer id info")
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrResolveUsername signals an error in resolving the owner of a username
+var ErrResolveUsername = errors.New("resolve username error")
@@ -0,0 +1,101 @@
+package esdt
+
+import (
+	goerrors "errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/node/systemSCAPI"
+	"github.com/gin-gonic/gin"
+)
+
+const getTokenPath = "/:token"
+
+// allTokensParam is the value of the token parameter that lists all the issued tokens. A static /tokens route can
+// not be registered next to the /:token wildcard, but an ESDT identifier can never be equal to this value
+const allTokensParam = "tokens"
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetESDTTokenIdentifiers() ([]string, error)
+	GetESDTToken(identifier string) (*api.ESDTToken, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines ESDT related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, getTokenPath, getToken)
+}
+
+func getToken(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	token := c.Param("token")
+	if token == allTokensParam {
+		getAllTokens(c, facade)
+		return
+	}
+
+	tokenData, err := facade.GetESDTToken(token)
+	if err != nil {
+		status, returnCode := http.StatusInternalServerError, shared.ReturnCodeInternalError
+		if goerrors.Is(err, systemSCAPI.ErrInvalidTokenIdentifier) {
+			status, returnCode = http.StatusBadRequest, shared.ReturnCodeRequestError
+		}
+		shared.RespondWith(
+			c,
+			status,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetESDTToken.Error(), err.Error()),
+			returnCode,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"token": tokenData}, "", shared.ReturnCodeSuccess)
+}
+
+func getAllTokens(c *gin.Context, facade FacadeHandler) {
+	identifiers, err := facade.GetESDTTokenIdentifiers()
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetESDTToken.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"tokens": identifiers}, "", shared.ReturnCodeSuccess)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			errors.ErrNilAppContext.Error(),
+			shared.ReturnCodeInternalError,
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
@@ -0,0 +1,245 @@
+package esdt_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/esdt"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/node/systemSCAPI"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type tokenResponseData struct {
+	Token api.ESDTToken `json:"token"`
+}
+
+type tokenResponse struct {
+	Data  tokenResponseData `json:"data"`
+	Error string            `json:"error"`
+	Code  string            `json:"code"`
+}
+
+type tokensResponseData struct {
+	Tokens []string `json:"tokens"`
+}
+
+type tokensResponse struct {
+	Data  tokensResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+func TestGetToken_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/esdt/AAA-aaaaaa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetToken_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/esdt/AAA-aaaaaa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := tokenResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetToken_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetESDTTokenCalled: func(_ string) (*api.ESDTToken, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/esdt/AAA-aaaaaa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := tokenResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetESDTToken.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetToken_InvalidIdentifierShouldReturnBadRequest(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetESDTTokenCalled: func(identifier string) (*api.ESDTToken, error) {
+			return nil, fmt.Errorf("%w: %s", systemSCAPI.ErrInvalidTokenIdentifier, identifier)
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/esdt/esdtConfig", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := tokenResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, systemSCAPI.ErrInvalidTokenIdentifier.Error()))
+}
+
+func TestGetToken_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedToken := api.ESDTToken{
+		Identifier:        "AAA-aaaaaa",
+		Name:              "TokenA",
+		MintedValue:       "10",
+		BurntValue:        "3",
+		CirculatingSupply: "7",
+		CanFreeze:         true,
+	}
+	facade := mock.Facade{
+		GetESDTTokenCalled: func(identifier string) (*api.ESDTToken, error) {
+			assert.Equal(t, expectedToken.Identifier, identifier)
+			return &expectedToken, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/esdt/AAA-aaaaaa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := tokenResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedToken, response.Data.Token)
+}
+
+func TestGetAllTokens_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetESDTTokenIdentifiersCalled: func() ([]string, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/esdt/tokens", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := tokensResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetAllTokens_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedTokens := []string{"AAA-aaaaaa", "BBB-bbbbbb"}
+	facade := mock.Facade{
+		GetESDTTokenIdentifiersCalled: func() ([]string, error) {
+			return expectedTokens, nil
+		},
+		GetESDTTokenCalled: func(_ string) (*api.ESDTToken, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/esdt/tokens", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := tokensResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedTokens, response.Data.Tokens)
+}
+
+func startNodeServer(handler esdt.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	esdtRoutes := ws.Group("/esdt")
+	if handler != nil {
+		esdtRoutes.Use(middleware.WithFacade(handler))
+	}
+	esdtRoute, _ := wrapper.NewRouterWrapper("esdt", esdtRoutes, getRoutesConfig())
+	esdt.Routes(esdtRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginESDTRoute := ws.Group("/esdt")
+	esdtRoute, _ := wrapper.NewRouterWrapper("esdt", ginESDTRoute, getRoutesConfig())
+	esdt.Routes(esdtRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"esdt": {
+				Routes: []config.RouteConfig{
+					{Name: "/:token", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
@@ -0,0 +1,139 @@
+package governance
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getProposalsPath           = "/proposals"
+	getProposalPath            = "/proposals/:id"
+	getProposalVotesPath       = "/proposals/:id/votes"
+	getVoteTransactionPath     = "/proposals/:id/vote-transaction"
+	voteQueryParameter         = "vote"
+	validatorAddressQueryParam = "validator"
+)
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	GetGovernanceProposals() ([]*api.GovernanceProposal, error)
+	GetGovernanceProposal(id string) (*api.GovernanceProposal, error)
+	GetGovernanceProposalVotes(id string) ([]*api.GovernanceVote, error)
+	GetGovernanceVoteTransaction(id string, vote string, validator string) (*api.GovernanceVoteTransaction, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines governance related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, getProposalsPath, getProposals)
+	router.RegisterHandler(http.MethodGet, getProposalPath, getProposal)
+	router.RegisterHandler(http.MethodGet, getProposalVotesPath, getProposalVotes)
+	router.RegisterHandler(http.MethodGet, getVoteTransactionPath, getVoteTransaction)
+}
+
+func getProposals(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	proposals, err := facade.GetGovernanceProposals()
+	if err != nil {
+		respondWithGovernanceError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"proposals": proposals}, "", shared.ReturnCodeSuccess)
+}
+
+func getProposal(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	proposal, err := facade.GetGovernanceProposal(c.Param("id"))
+	if err != nil {
+		respondWithGovernanceError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"proposal": proposal}, "", shared.ReturnCodeSuccess)
+}
+
+func getProposalVotes(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	votes, err := facade.GetGovernanceProposalVotes(c.Param("id"))
+	if err != nil {
+		respondWithGovernanceError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"votes": votes}, "", shared.ReturnCodeSuccess)
+}
+
+func getVoteTransaction(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	vote := c.Request.URL.Query().Get(voteQueryParameter)
+	if vote == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	validator := c.Request.URL.Query().Get(validatorAddressQueryParam)
+	tx, err := facade.GetGovernanceVoteTransaction(c.Param("id"), vote, validator)
+	if err != nil {
+		respondWithGovernanceError(c, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"transaction": tx}, "", shared.ReturnCodeSuccess)
+}
+
+func respondWithGovernanceError(c *gin.Context, err error) {
+	shared.RespondWith(
+		c,
+		http.StatusInternalServerError,
+		nil,
+		fmt.Sprintf("%s: %s", errors.ErrGetGovernanceData.Error(), err.Error()),
+		shared.ReturnCodeInternalError,
+	)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			errors.ErrNilAppContext.Error(),
+			shared.ReturnCodeInternalError,
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
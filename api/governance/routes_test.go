@@ -0,0 +1,285 @@
+package governance_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type proposalsResponse struct {
+	Data struct {
+		Proposals []*api.GovernanceProposal `json:"proposals"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type proposalResponse struct {
+	Data struct {
+		Proposal api.GovernanceProposal `json:"proposal"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type votesResponse struct {
+	Data struct {
+		Votes []*api.GovernanceVote `json:"votes"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type voteTransactionResponse struct {
+	Data struct {
+		Transaction api.GovernanceVoteTransaction `json:"transaction"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetProposals_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/governance/proposals", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetProposals_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/governance/proposals", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proposalsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetProposals_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetGovernanceProposalsCalled: func() ([]*api.GovernanceProposal, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/governance/proposals", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proposalsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGovernanceData.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetProposals_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedProposals := []*api.GovernanceProposal{
+		{ID: "commit-1", Status: "passed", Yes: 10, Passed: true, Closed: true},
+		{ID: "commit-2", Status: "active", No: 2},
+	}
+	facade := mock.Facade{
+		GetGovernanceProposalsCalled: func() ([]*api.GovernanceProposal, error) {
+			return expectedProposals, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/governance/proposals", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proposalsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedProposals, response.Data.Proposals)
+}
+
+func TestGetProposal_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedProposal := api.GovernanceProposal{ID: "commit-1", StartVoteNonce: 5, EndVoteNonce: 10, Status: "ended"}
+	facade := mock.Facade{
+		GetGovernanceProposalCalled: func(id string) (*api.GovernanceProposal, error) {
+			assert.Equal(t, expectedProposal.ID, id)
+			return &expectedProposal, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/governance/proposals/commit-1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proposalResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedProposal, response.Data.Proposal)
+}
+
+func TestGetProposalVotes_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedVotes := []*api.GovernanceVote{
+		{Voter: "erd1voter1", Vote: "yes", NumVotes: 3},
+		{Voter: "erd1voter2", Vote: "veto", NumVotes: 1},
+	}
+	facade := mock.Facade{
+		GetGovernanceProposalVotesCalled: func(id string) ([]*api.GovernanceVote, error) {
+			assert.Equal(t, "commit-1", id)
+			return expectedVotes, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/governance/proposals/commit-1/votes", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := votesResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedVotes, response.Data.Votes)
+}
+
+func TestGetVoteTransaction_MissingVoteShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetGovernanceVoteTransactionCalled: func(_ string, _ string, _ string) (*api.GovernanceVoteTransaction, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/governance/proposals/commit-1/vote-transaction", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := voteTransactionResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetVoteTransaction_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedTx := api.GovernanceVoteTransaction{
+		Receiver: "erd1governance",
+		Value:    "0",
+		Data:     "vote@636f6d6d69742d31@796573",
+	}
+	facade := mock.Facade{
+		GetGovernanceVoteTransactionCalled: func(id string, vote string, validator string) (*api.GovernanceVoteTransaction, error) {
+			assert.Equal(t, "commit-1", id)
+			assert.Equal(t, "yes", vote)
+			assert.Equal(t, "erd1validator", validator)
+			return &expectedTx, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/governance/proposals/commit-1/vote-transaction?vote=yes&validator=erd1validator", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := voteTransactionResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedTx, response.Data.Transaction)
+}
+
+func startNodeServer(handler governance.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	governanceRoutes := ws.Group("/governance")
+	if handler != nil {
+		governanceRoutes.Use(middleware.WithFacade(handler))
+	}
+	governanceRoute, _ := wrapper.NewRouterWrapper("governance", governanceRoutes, getRoutesConfig())
+	governance.Routes(governanceRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginGovernanceRoute := ws.Group("/governance")
+	governanceRoute, _ := wrapper.NewRouterWrapper("governance", ginGovernanceRoute, getRoutesConfig())
+	governance.Routes(governanceRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"governance": {
+				Routes: []config.RouteConfig{
+					{Name: "/proposals", Open: true},
+					{Name: "/proposals/:id", Open: true},
+					{Name: "/proposals/:id/votes", Open: true},
+					{Name: "/proposals/:id/vote-transaction", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
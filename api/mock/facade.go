@@ -2,6 +2,7 @@ package mock
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -33,6 +34,9 @@ type Facade struct {
 	ValidateTransactionForSimulationHandler func(tx *transaction.Transaction) error
 	SendBulkTransactionsHandler             func(txs []*transaction.Transaction) (uint64, error)
 	ExecuteSCQueryHandler                   func(query *process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueriesHandler                 func(queries []*process.SCQuery) ([]*vm.VMOutputApi, error)
+	ExecuteSCQueryTypedHandler              func(query *process.SCQuery, arguments []json.RawMessage) (*vm.TypedVMOutputApi, error)
+	SetContractAbiHandler                   func(address []byte, abiBytes []byte) error
 	StatusMetricsHandler                    func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler              func() (map[string]*state.ValidatorApiResponse, error)
 	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (uint64, error)
@@ -43,13 +47,30 @@ type Facade struct {
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                       func(address string) (string, error)
 	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	SimulateTransactionsExecutionHandler    func(txs []*transaction.Transaction) ([]*transaction.SimulationResults, error)
 	GetNumCheckpointsFromAccountStateCalled func() uint32
 	GetNumCheckpointsFromPeerStateCalled    func() uint32
 	GetESDTBalanceCalled                    func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                  func(address string) ([]string, error)
+	GetBalanceHistoryCalled                 func(address string, from uint64, size uint64) (*api.BalanceHistory, error)
+	GetKeyValuePairsCalled                  func(address string, prefix string, from uint64, size uint64) (*api.AccountStorage, error)
+	GetESDTTokenIdentifiersCalled           func() ([]string, error)
+	GetESDTTokenCalled                      func(identifier string) (*api.ESDTToken, error)
+	GetGovernanceProposalsCalled            func() ([]*api.GovernanceProposal, error)
+	GetDelegationContractsCalled            func() ([]string, error)
+	GetValidatorQueueCalled                 func() ([]*api.ValidatorQueueEntry, error)
+	GetValidatorKeyStatusCalled             func(blsKey string) (*api.ValidatorKeyStatus, error)
+	GetValidatorOwnerCalled                 func(address string) (*api.ValidatorOwner, error)
+	ResolveUsernameCalled                   func(username string) (*api.UsernameResolution, error)
+	GetDelegationContractCalled             func(contract string) (*api.DelegationContract, error)
+	GetDelegatorCalled                      func(contract string, address string) (*api.DelegatorFunds, error)
+	GetGovernanceProposalCalled             func(id string) (*api.GovernanceProposal, error)
+	GetGovernanceProposalVotesCalled        func(id string) ([]*api.GovernanceVote, error)
+	GetGovernanceVoteTransactionCalled      func(id string, vote string, validator string) (*api.GovernanceVoteTransaction, error)
 	GetBlockByHashCalled                    func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*api.Block, error)
 	GetTotalStakedValueHandler              func() (*big.Int, error)
+	TraceTransactionCalled                  func(hash string) (*transaction.TraceResults, error)
 }
 
 // GetUsername -
@@ -130,6 +151,141 @@ func (f *Facade) GetAllESDTTokens(address string) ([]string, error) {
 	return []string{""}, nil
 }
 
+// GetESDTTokenIdentifiers -
+func (f *Facade) GetESDTTokenIdentifiers() ([]string, error) {
+	if f.GetESDTTokenIdentifiersCalled != nil {
+		return f.GetESDTTokenIdentifiersCalled()
+	}
+
+	return nil, nil
+}
+
+// GetESDTToken -
+func (f *Facade) GetESDTToken(identifier string) (*api.ESDTToken, error) {
+	if f.GetESDTTokenCalled != nil {
+		return f.GetESDTTokenCalled(identifier)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorQueue -
+func (f *Facade) GetValidatorQueue() ([]*api.ValidatorQueueEntry, error) {
+	if f.GetValidatorQueueCalled != nil {
+		return f.GetValidatorQueueCalled()
+	}
+
+	return nil, nil
+}
+
+// GetValidatorKeyStatus -
+func (f *Facade) GetValidatorKeyStatus(blsKey string) (*api.ValidatorKeyStatus, error) {
+	if f.GetValidatorKeyStatusCalled != nil {
+		return f.GetValidatorKeyStatusCalled(blsKey)
+	}
+
+	return nil, nil
+}
+
+// GetValidatorOwner -
+func (f *Facade) GetValidatorOwner(address string) (*api.ValidatorOwner, error) {
+	if f.GetValidatorOwnerCalled != nil {
+		return f.GetValidatorOwnerCalled(address)
+	}
+
+	return nil, nil
+}
+
+// ResolveUsername -
+func (f *Facade) ResolveUsername(username string) (*api.UsernameResolution, error) {
+	if f.ResolveUsernameCalled != nil {
+		return f.ResolveUsernameCalled(username)
+	}
+
+	return nil, nil
+}
+
+// GetDelegationContracts -
+func (f *Facade) GetDelegationContracts() ([]string, error) {
+	if f.GetDelegationContractsCalled != nil {
+		return f.GetDelegationContractsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetDelegationContract -
+func (f *Facade) GetDelegationContract(contract string) (*api.DelegationContract, error) {
+	if f.GetDelegationContractCalled != nil {
+		return f.GetDelegationContractCalled(contract)
+	}
+
+	return nil, nil
+}
+
+// GetDelegator -
+func (f *Facade) GetDelegator(contract string, address string) (*api.DelegatorFunds, error) {
+	if f.GetDelegatorCalled != nil {
+		return f.GetDelegatorCalled(contract, address)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposals -
+func (f *Facade) GetGovernanceProposals() ([]*api.GovernanceProposal, error) {
+	if f.GetGovernanceProposalsCalled != nil {
+		return f.GetGovernanceProposalsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (f *Facade) GetGovernanceProposal(id string) (*api.GovernanceProposal, error) {
+	if f.GetGovernanceProposalCalled != nil {
+		return f.GetGovernanceProposalCalled(id)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposalVotes -
+func (f *Facade) GetGovernanceProposalVotes(id string) ([]*api.GovernanceVote, error) {
+	if f.GetGovernanceProposalVotesCalled != nil {
+		return f.GetGovernanceProposalVotesCalled(id)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVoteTransaction -
+func (f *Facade) GetGovernanceVoteTransaction(id string, vote string, validator string) (*api.GovernanceVoteTransaction, error) {
+	if f.GetGovernanceVoteTransactionCalled != nil {
+		return f.GetGovernanceVoteTransactionCalled(id, vote, validator)
+	}
+
+	return nil, nil
+}
+
+// GetBalanceHistory -
+func (f *Facade) GetBalanceHistory(address string, from uint64, size uint64) (*api.BalanceHistory, error) {
+	if f.GetBalanceHistoryCalled != nil {
+		return f.GetBalanceHistoryCalled(address, from, size)
+	}
+
+	return nil, nil
+}
+
+// GetKeyValuePairs -
+func (f *Facade) GetKeyValuePairs(address string, prefix string, from uint64, size uint64) (*api.AccountStorage, error) {
+	if f.GetKeyValuePairsCalled != nil {
+		return f.GetKeyValuePairsCalled(address, prefix, from, size)
+	}
+
+	return nil, nil
+}
+
 // GetAccount is the mock implementation of a handler's GetAccount method
 func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
 	return f.GetAccountHandler(address)
@@ -173,6 +329,24 @@ func (f *Facade) SimulateTransactionExecution(tx *transaction.Transaction) (*tra
 	return f.SimulateTransactionExecutionHandler(tx)
 }
 
+// TraceTransaction -
+func (f *Facade) TraceTransaction(hash string) (*transaction.TraceResults, error) {
+	if f.TraceTransactionCalled != nil {
+		return f.TraceTransactionCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// SimulateTransactionsExecution is the mock implementation of a handler's SimulateTransactionsExecution method
+func (f *Facade) SimulateTransactionsExecution(txs []*transaction.Transaction) ([]*transaction.SimulationResults, error) {
+	if f.SimulateTransactionsExecutionHandler != nil {
+		return f.SimulateTransactionsExecutionHandler(txs)
+	}
+
+	return nil, nil
+}
+
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
 func (f *Facade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return f.SendBulkTransactionsHandler(txs)
@@ -198,6 +372,21 @@ func (f *Facade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error)
 	return f.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCQueries is a mock implementation.
+func (f *Facade) ExecuteSCQueries(queries []*process.SCQuery) ([]*vm.VMOutputApi, error) {
+	return f.ExecuteSCQueriesHandler(queries)
+}
+
+// ExecuteSCQueryTyped is a mock implementation.
+func (f *Facade) ExecuteSCQueryTyped(query *process.SCQuery, arguments []json.RawMessage) (*vm.TypedVMOutputApi, error) {
+	return f.ExecuteSCQueryTypedHandler(query, arguments)
+}
+
+// SetContractAbi is a mock implementation.
+func (f *Facade) SetContractAbi(address []byte, abiBytes []byte) error {
+	return f.SetContractAbiHandler(address, abiBytes)
+}
+
 // StatusMetrics is the mock implementation for the StatusMetrics
 func (f *Facade) StatusMetrics() external.StatusMetricsHandler {
 	return f.StatusMetricsHandler()
@@ -19,13 +19,19 @@ import (
 const (
 	sendTransactionEndpoint          = "/transaction/send"
 	simulateTransactionEndpoint      = "/transaction/simulate"
+	simulateBatchEndpoint            = "/transaction/simulate-batch"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
+	traceTransactionEndpoint         = "/transaction/:hash/trace"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
+	simulateBatchPath                = "/simulate-batch"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
+	traceTransactionPath             = "/:txhash/trace"
+
+	maxNumOfTxsInSimulationBatch = 100
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -36,7 +42,9 @@ type FacadeHandler interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	SimulateTransactionsExecution(txs []*transaction.Transaction) ([]*transaction.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	TraceTransaction(hash string) (*transaction.TraceResults, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -99,6 +107,12 @@ func Routes(router *wrapper.RouterWrapper) {
 		middleware.CreateEndpointThrottler(simulateTransactionEndpoint),
 		SimulateTransaction,
 	)
+	router.RegisterHandler(
+		http.MethodPost,
+		simulateBatchPath,
+		middleware.CreateEndpointThrottler(simulateBatchEndpoint),
+		SimulateBatchTransactions,
+	)
 	router.RegisterHandler(http.MethodPost, costPath, ComputeTransactionGasLimit)
 	router.RegisterHandler(
 		http.MethodPost,
@@ -112,6 +126,12 @@ func Routes(router *wrapper.RouterWrapper) {
 		middleware.CreateEndpointThrottler(getTransactionEndpoint),
 		GetTransaction,
 	)
+	router.RegisterHandler(
+		http.MethodGet,
+		traceTransactionPath,
+		middleware.CreateEndpointThrottler(traceTransactionEndpoint),
+		TraceTransaction,
+	)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -229,6 +249,105 @@ func SimulateTransaction(c *gin.Context) {
 	)
 }
 
+// SimulateBatchTransactions will receive an ordered list of transactions from the client and will simulate their
+// execution, one after the other, on the same throw-away copy of the state, returning the results of each step
+func SimulateBatchTransactions(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var gtxs []SendTxRequest
+	err := c.ShouldBindJSON(&gtxs)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if len(gtxs) == 0 || len(gtxs) > maxNumOfTxsInSimulationBatch {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data: nil,
+				Error: fmt.Sprintf("%s: the batch should contain between 1 and %d transactions",
+					errors.ErrInvalidSimulationBatch.Error(), maxNumOfTxsInSimulationBatch),
+				Code: shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(gtxs))
+	txHashes := make([]string, 0, len(gtxs))
+	for idx, gtx := range gtxs {
+		tx, txHash, errCreate := facade.CreateTransaction(
+			gtx.Nonce,
+			gtx.Value,
+			gtx.Receiver,
+			gtx.ReceiverUsername,
+			gtx.Sender,
+			gtx.SenderUsername,
+			gtx.GasPrice,
+			gtx.GasLimit,
+			gtx.Data,
+			gtx.Signature,
+			gtx.ChainID,
+			gtx.Version,
+			gtx.Options,
+		)
+		if errCreate == nil {
+			errCreate = facade.ValidateTransactionForSimulation(tx)
+		}
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: transaction %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errCreate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		txs = append(txs, tx)
+		txHashes = append(txHashes, hex.EncodeToString(txHash))
+	}
+
+	executionResults, err := facade.SimulateTransactionsExecution(txs)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	for idx, result := range executionResults {
+		if idx < len(txHashes) {
+			result.Hash = txHashes[idx]
+		}
+	}
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"results": executionResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // SendTransaction will receive a transaction from the client and propagate it for processing
 func SendTransaction(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -453,6 +572,49 @@ func GetTransaction(c *gin.Context) {
 	)
 }
 
+// TraceTransaction re-executes a historical transaction and returns the call tree of its smart contract executions
+func TraceTransaction(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	trace, err := facade.TraceTransaction(txhash)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTraceTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"trace": trace},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // ComputeTransactionGasLimit returns how many gas units a transaction wil consume
 func ComputeTransactionGasLimit(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -54,6 +54,26 @@ type simulateTxResponse struct {
 	Code  string      `json:"code"`
 }
 
+type simulateBatchResponseData struct {
+	Results []*tr.SimulationResults `json:"results"`
+}
+
+type simulateBatchResponse struct {
+	Data  simulateBatchResponseData `json:"data"`
+	Error string                    `json:"error"`
+	Code  string                    `json:"code"`
+}
+
+type traceTxResponseData struct {
+	Trace *tr.TraceResults `json:"trace"`
+}
+
+type traceTxResponse struct {
+	Data  traceTxResponseData `json:"data"`
+	Error string              `json:"error"`
+	Code  string              `json:"code"`
+}
+
 type sendSingleTxResponseData struct {
 	TxHash string `json:"txHash"`
 }
@@ -767,6 +787,197 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestSimulateBatchTransactions_EmptyBatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-batch", bytes.NewBuffer([]byte("[]")))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := simulateBatchResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrInvalidSimulationBatch.Error())
+}
+
+func TestSimulateBatchTransactions_ValidateErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	processWasCalled := false
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		SimulateTransactionsExecutionHandler: func(txs []*tr.Transaction) ([]*tr.SimulationResults, error) {
+			processWasCalled = true
+			return nil, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{Nonce: nonce}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *tr.Transaction) error {
+			if tx.Nonce == 1 {
+				return expectedErr
+			}
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	txs := []*transaction.SendTxRequest{{Nonce: 0}, {Nonce: 1}}
+	jsonBytes, _ := json.Marshal(txs)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-batch", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := simulateBatchResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.False(t, processWasCalled)
+	assert.Contains(t, response.Error, "transaction 1")
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestSimulateBatchTransactions_ProcessErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		SimulateTransactionsExecutionHandler: func(txs []*tr.Transaction) ([]*tr.SimulationResults, error) {
+			return nil, expectedErr
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *tr.Transaction) error {
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal([]*transaction.SendTxRequest{{Nonce: 0}})
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-batch", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := simulateBatchResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestSimulateBatchTransactions(t *testing.T) {
+	t.Parallel()
+
+	var providedTxs []*tr.Transaction
+	facade := mock.Facade{
+		SimulateTransactionsExecutionHandler: func(txs []*tr.Transaction) ([]*tr.SimulationResults, error) {
+			providedTxs = txs
+			return []*tr.SimulationResults{{Status: tr.TxStatusSuccess}, {Status: tr.TxStatusFail}}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*tr.Transaction, []byte, error) {
+			return &tr.Transaction{Nonce: nonce}, []byte{byte(nonce)}, nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *tr.Transaction) error {
+			return nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal([]*transaction.SendTxRequest{{Nonce: 7}, {Nonce: 8}})
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate-batch", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := simulateBatchResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+	assert.Equal(t, 2, len(providedTxs))
+	assert.Equal(t, uint64(7), providedTxs[0].Nonce)
+	assert.Equal(t, uint64(8), providedTxs[1].Nonce)
+	assert.Equal(t, 2, len(response.Data.Results))
+	assert.Equal(t, hex.EncodeToString([]byte{7}), response.Data.Results[0].Hash)
+	assert.Equal(t, tr.TxStatusSuccess, response.Data.Results[0].Status)
+	assert.Equal(t, hex.EncodeToString([]byte{8}), response.Data.Results[1].Hash)
+	assert.Equal(t, tr.TxStatusFail, response.Data.Results[1].Status)
+}
+
+func TestTraceTransaction_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		TraceTransactionCalled: func(hash string) (*tr.TraceResults, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/abcd/trace", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := traceTxResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrTraceTransaction.Error())
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestTraceTransaction(t *testing.T) {
+	t.Parallel()
+
+	providedHash := ""
+	facade := mock.Facade{
+		TraceTransactionCalled: func(hash string) (*tr.TraceResults, error) {
+			providedHash = hash
+			return &tr.TraceResults{
+				Hash:   hash,
+				Status: tr.TxStatusFail,
+				Steps: []*tr.ExecutionStepApi{
+					{
+						Type:       "call",
+						Function:   "claim",
+						ReturnCode: "user error",
+						Steps:      []*tr.ExecutionStepApi{{Type: "builtInFunction", Function: "ESDTTransfer"}},
+					},
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/transaction/abcd/trace", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := traceTxResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+	assert.Equal(t, "abcd", providedHash)
+	assert.Equal(t, "abcd", response.Data.Trace.Hash)
+	assert.Equal(t, tr.TxStatusFail, response.Data.Trace.Status)
+	assert.Equal(t, 1, len(response.Data.Trace.Steps))
+	assert.Equal(t, "claim", response.Data.Trace.Steps[0].Function)
+	assert.Equal(t, "ESDTTransfer", response.Data.Trace.Steps[0].Steps[0].Function)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -816,6 +1027,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-batch", Open: true},
+					{Name: "/:txhash/trace", Open: true},
 				},
 			},
 		},
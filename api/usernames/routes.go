@@ -0,0 +1,88 @@
+package usernames
+
+import (
+	goerrors "errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/node/usernamesAPI"
+	"github.com/gin-gonic/gin"
+)
+
+const resolveUsernamePath = "/:name"
+
+// FacadeHandler interface defines methods that can be used by the gin webserver
+type FacadeHandler interface {
+	ResolveUsername(username string) (*api.UsernameResolution, error)
+	IsInterfaceNil() bool
+}
+
+// Routes defines username related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, resolveUsernamePath, resolveUsername)
+}
+
+// resolveUsername returns the owner of a username. A node can only query the DNS smart contract of its own shard, so
+// the usernames handled by a DNS contract from another shard are answered with 421 Misdirected Request: these have
+// to be resolved through a node of the shard given in the error message or through the proxy, which routes the
+// request to the right shard
+func resolveUsername(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	resolution, err := facade.ResolveUsername(c.Param("name"))
+	if err != nil {
+		status, returnCode := getResolveUsernameErrorStatus(err)
+		shared.RespondWith(
+			c,
+			status,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrResolveUsername.Error(), err.Error()),
+			returnCode,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"username": resolution}, "", shared.ReturnCodeSuccess)
+}
+
+func getResolveUsernameErrorStatus(err error) (int, shared.ReturnCode) {
+	switch {
+	case goerrors.Is(err, usernamesAPI.ErrInvalidUsername):
+		return http.StatusBadRequest, shared.ReturnCodeRequestError
+	case goerrors.Is(err, usernamesAPI.ErrUsernameNotFound):
+		return http.StatusNotFound, shared.ReturnCodeRequestError
+	case goerrors.Is(err, usernamesAPI.ErrDNSContractInOtherShard):
+		return http.StatusMisdirectedRequest, shared.ReturnCodeRequestError
+	default:
+		return http.StatusInternalServerError, shared.ReturnCodeInternalError
+	}
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			errors.ErrNilAppContext.Error(),
+			shared.ReturnCodeInternalError,
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
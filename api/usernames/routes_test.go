@@ -0,0 +1,193 @@
+package usernames_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/usernames"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/node/usernamesAPI"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type usernameResponseData struct {
+	Username api.UsernameResolution `json:"username"`
+}
+
+type usernameResponse struct {
+	Data  usernameResponseData `json:"data"`
+	Error string               `json:"error"`
+	Code  string               `json:"code"`
+}
+
+func TestResolveUsername_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/usernames/alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestResolveUsername_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/usernames/alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := usernameResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestResolveUsername_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		ResolveUsernameCalled: func(_ string) (*api.UsernameResolution, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/usernames/alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := usernameResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrResolveUsername.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestResolveUsername_ErrorsShouldReturnMatchingStatus(t *testing.T) {
+	t.Parallel()
+
+	testResolveUsernameErrorStatus(t, fmt.Errorf("%w: from shard 2", usernamesAPI.ErrDNSContractInOtherShard), http.StatusMisdirectedRequest)
+	testResolveUsernameErrorStatus(t, fmt.Errorf("%w: alice.elrond", usernamesAPI.ErrUsernameNotFound), http.StatusNotFound)
+	testResolveUsernameErrorStatus(t, usernamesAPI.ErrInvalidUsername, http.StatusBadRequest)
+}
+
+func testResolveUsernameErrorStatus(t *testing.T, facadeErr error, expectedStatus int) {
+	facade := mock.Facade{
+		ResolveUsernameCalled: func(_ string) (*api.UsernameResolution, error) {
+			return nil, facadeErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/usernames/alice", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := usernameResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, expectedStatus, resp.Code)
+	assert.Equal(t, string(shared.ReturnCodeRequestError), response.Code)
+	assert.True(t, strings.Contains(response.Error, facadeErr.Error()))
+}
+
+func TestResolveUsername_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedResolution := api.UsernameResolution{
+		Username:    "alice.elrond",
+		Address:     "erd1owner",
+		DNSContract: "erd1dns",
+		DNSShard:    1,
+	}
+	facade := mock.Facade{
+		ResolveUsernameCalled: func(username string) (*api.UsernameResolution, error) {
+			assert.Equal(t, "alice.elrond", username)
+			return &expectedResolution, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/usernames/alice.elrond", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := usernameResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, expectedResolution, response.Data.Username)
+}
+
+func startNodeServer(handler usernames.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	usernamesRoutes := ws.Group("/usernames")
+	if handler != nil {
+		usernamesRoutes.Use(middleware.WithFacade(handler))
+	}
+	usernamesRoute, _ := wrapper.NewRouterWrapper("usernames", usernamesRoutes, getRoutesConfig())
+	usernames.Routes(usernamesRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginUsernamesRoute := ws.Group("/usernames")
+	usernamesRoute, _ := wrapper.NewRouterWrapper("usernames", ginUsernamesRoute, getRoutesConfig())
+	usernames.Routes(usernamesRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"usernames": {
+				Routes: []config.RouteConfig{
+					{Name: "/:name", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
@@ -1,26 +1,70 @@
 package validator
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-gonic/gin"
 )
 
-const statisticsPath = "/statistics"
+const (
+	statisticsPath = "/statistics"
+	queuePath      = "/queue"
+	ownerPath      = "/owner/:address"
+	keyStatusPath  = "/:blsKey/status"
+
+	// gin does not allow the /:blsKey/status wildcard next to the static routes, so the routes above, which remain
+	// the names enabled in the config, are served by two wildcard routes that dispatch on the path segments
+	segmentPath    = "/:segment"
+	subSegmentPath = "/:segment/:subSegment"
+
+	statisticsSegment = "statistics"
+	queueSegment      = "queue"
+	ownerSegment      = "owner"
+	statusSubSegment  = "status"
+)
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetValidatorQueue() ([]*api.ValidatorQueueEntry, error)
+	GetValidatorKeyStatus(blsKey string) (*api.ValidatorKeyStatus, error)
+	GetValidatorOwner(address string) (*api.ValidatorOwner, error)
 	IsInterfaceNil() bool
 }
 
 // Routes defines validators' related routes
 func Routes(router *wrapper.RouterWrapper) {
-	router.RegisterHandler(http.MethodGet, statisticsPath, Statistics)
+	isStatisticsActive := router.IsEndpointActive(statisticsPath)
+	isQueueActive := router.IsEndpointActive(queuePath)
+	router.RegisterDispatchHandler(http.MethodGet, segmentPath, []string{statisticsPath, queuePath}, func(c *gin.Context) {
+		switch {
+		case c.Param("segment") == statisticsSegment && isStatisticsActive:
+			Statistics(c)
+		case c.Param("segment") == queueSegment && isQueueActive:
+			Queue(c)
+		default:
+			c.AbortWithStatus(http.StatusNotFound)
+		}
+	})
+
+	isOwnerActive := router.IsEndpointActive(ownerPath)
+	isKeyStatusActive := router.IsEndpointActive(keyStatusPath)
+	router.RegisterDispatchHandler(http.MethodGet, subSegmentPath, []string{ownerPath, keyStatusPath}, func(c *gin.Context) {
+		switch {
+		case c.Param("segment") == ownerSegment && isOwnerActive:
+			Owner(c)
+		case c.Param("subSegment") == statusSubSegment && isKeyStatusActive:
+			KeyStatus(c)
+		default:
+			c.AbortWithStatus(http.StatusNotFound)
+		}
+	})
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -82,3 +126,84 @@ func Statistics(c *gin.Context) {
 		},
 	)
 }
+
+// Queue will return the BLS keys waiting in the staking queue, in the order they will be staked
+func Queue(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	queue, err := facade.GetValidatorQueue()
+	if err != nil {
+		respondWithValidatorDataError(c, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"queue": queue},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// KeyStatus will return the staking status of a BLS key, served on /:blsKey/status
+func KeyStatus(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	keyStatus, err := facade.GetValidatorKeyStatus(c.Param("segment"))
+	if err != nil {
+		respondWithValidatorDataError(c, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"status": keyStatus},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// Owner will return the stake, the top-up and the status of all the BLS keys of a validator owner, served on
+// /owner/:address
+func Owner(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	owner, err := facade.GetValidatorOwner(c.Param("subSegment"))
+	if err != nil {
+		respondWithValidatorDataError(c, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"owner": owner},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func respondWithValidatorDataError(c *gin.Context, err error) {
+	c.JSON(
+		http.StatusInternalServerError,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", errors.ErrGetValidatorData.Error(), err.Error()),
+			Code:  shared.ReturnCodeInternalError,
+		},
+	)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -113,6 +114,173 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, validatorStatistics.Result, mapToReturn)
 }
 
+func TestValidatorQueue_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	errStr := "error in facade"
+	facade := mock.Facade{
+		GetValidatorQueueCalled: func() ([]*api.ValidatorQueueEntry, error) {
+			return nil, errors.New(errStr)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/queue", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, apiErrors.ErrGetValidatorData.Error())
+	assert.Contains(t, response.Error, errStr)
+}
+
+func TestValidatorQueue_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	queue := []*api.ValidatorQueueEntry{
+		{Position: 1, BLSKey: "bls1", Owner: "erd1owner", RegisterNonce: 5},
+		{Position: 2, BLSKey: "bls2", Owner: "erd1owner", RegisterNonce: 6},
+	}
+	facade := mock.Facade{
+		GetValidatorQueueCalled: func() ([]*api.ValidatorQueueEntry, error) {
+			return queue, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/queue", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Queue []*api.ValidatorQueueEntry `json:"queue"`
+		} `json:"data"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, queue, response.Data.Queue)
+}
+
+func TestValidatorKeyStatus_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	keyStatus := &api.ValidatorKeyStatus{BLSKey: "bls1", Status: "queued", QueuePosition: 3, QueueSize: 10}
+	facade := mock.Facade{
+		GetValidatorKeyStatusCalled: func(blsKey string) (*api.ValidatorKeyStatus, error) {
+			assert.Equal(t, "bls1", blsKey)
+			return keyStatus, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/bls1/status", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Status *api.ValidatorKeyStatus `json:"status"`
+		} `json:"data"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, keyStatus, response.Data.Status)
+}
+
+func TestValidatorRoutes_UnknownSegmentShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	for _, path := range []string{"/validator/bls1", "/validator/bls1/owner"} {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	}
+}
+
+func TestValidatorRoutes_DisabledRouteShouldReturnNotFound(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetValidatorQueueCalled: func() ([]*api.ValidatorQueueEntry, error) {
+			return make([]*api.ValidatorQueueEntry, 0), nil
+		},
+	}
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"validator": {
+				Routes: []config.RouteConfig{
+					{Name: "/statistics", Open: false},
+					{Name: "/queue", Open: true},
+				},
+			},
+		},
+	}
+	ws := gin.New()
+	ginValidatorRoute := ws.Group("/validator")
+	ginValidatorRoute.Use(middleware.WithFacade(&facade))
+	validatorRoute, _ := wrapper.NewRouterWrapper("validator", ginValidatorRoute, routesConfig)
+	validator.Routes(validatorRoute)
+
+	req, _ := http.NewRequest("GET", "/validator/statistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+
+	req, _ = http.NewRequest("GET", "/validator/queue", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	req, _ = http.NewRequest("GET", "/validator/bls1/status", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestValidatorOwner_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	owner := &api.ValidatorOwner{
+		Address:        "erd1owner",
+		TotalStaked:    "5000",
+		TopUp:          "500",
+		TopUpPerNode:   "250",
+		NumActiveNodes: 2,
+		Keys:           []*api.ValidatorKeyStatus{{BLSKey: "bls1", Status: "staked"}},
+	}
+	facade := mock.Facade{
+		GetValidatorOwnerCalled: func(address string) (*api.ValidatorOwner, error) {
+			assert.Equal(t, "erd1owner", address)
+			return owner, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/owner/erd1owner", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Owner *api.ValidatorOwner `json:"owner"`
+		} `json:"data"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, owner, response.Data.Owner)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -157,6 +325,9 @@ func getRoutesConfig() config.ApiRoutesConfig {
 			"validator": {
 				Routes: []config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/queue", Open: true},
+					{Name: "/:blsKey/status", Open: true},
+					{Name: "/owner/:address", Open: true},
 				},
 			},
 		},
@@ -2,6 +2,7 @@ package vmValues
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -20,11 +21,23 @@ const (
 	stringPath = "/string"
 	intPath    = "/int"
 	queryPath  = "/query"
+
+	queryMultiplePath = "/query-multiple"
+	queryTypedPath    = "/query-typed"
+	contractAbiPath   = "/abi/:address"
+
+	maxNumOfQueriesInBatch = 100
+
+	// maxContractAbiRequestSize bounds the ABI upload body before the registry checks its configured maximum ABI size
+	maxContractAbiRequestSize = 1 << 20
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueries([]*process.SCQuery) ([]*vm.VMOutputApi, error)
+	ExecuteSCQueryTyped(query *process.SCQuery, arguments []json.RawMessage) (*vm.TypedVMOutputApi, error)
+	SetContractAbi(address []byte, abiBytes []byte) error
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
@@ -38,12 +51,25 @@ type VMValueRequest struct {
 	Args       []string `form:"args"  json:"args"`
 }
 
+// VMTypedValueRequest represents a query having the arguments as JSON values, which will be encoded using the
+// contract ABI
+type VMTypedValueRequest struct {
+	ScAddress  string            `json:"scAddress"`
+	FuncName   string            `json:"funcName"`
+	CallerAddr string            `json:"caller"`
+	CallValue  string            `json:"value"`
+	Args       []json.RawMessage `json:"args"`
+}
+
 // Routes defines address related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodPost, hexPath, getHex)
 	router.RegisterHandler(http.MethodPost, stringPath, getString)
 	router.RegisterHandler(http.MethodPost, intPath, getInt)
 	router.RegisterHandler(http.MethodPost, queryPath, executeQuery)
+	router.RegisterHandler(http.MethodPost, queryMultiplePath, executeQueries)
+	router.RegisterHandler(http.MethodPost, queryTypedPath, executeQueryTyped)
+	router.RegisterHandler(http.MethodPost, contractAbiPath, setContractAbi)
 }
 
 // getHex returns the data as bytes, hex-encoded
@@ -89,19 +115,120 @@ func executeQuery(context *gin.Context) {
 	returnOkResponse(context, vmOutput)
 }
 
-func doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, error) {
-	efObj, ok := context.Get("facade")
-	if !ok {
-		return nil, errors.ErrNilAppContext
+// executeQueries runs all the provided queries against the same state and returns their outputs, in the same order
+func executeQueries(context *gin.Context) {
+	vmOutputs, err := doExecuteQueries(context)
+	if err != nil {
+		returnBadRequest(context, "executeQueries", err)
+		return
 	}
 
-	ef, ok := efObj.(FacadeHandler)
-	if !ok {
-		return nil, errors.ErrInvalidAppContext
+	returnOkResponse(context, vmOutputs)
+}
+
+func doExecuteQueries(context *gin.Context) ([]*vm.VMOutputApi, error) {
+	ef, err := getFacade(context)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []VMValueRequest
+	err = context.ShouldBindJSON(&requests)
+	if err != nil {
+		return nil, errors.ErrInvalidJSONRequest
+	}
+	if len(requests) == 0 || len(requests) > maxNumOfQueriesInBatch {
+		return nil, fmt.Errorf("%w: the batch should contain between 1 and %d queries",
+			errors.ErrInvalidQueriesBatch, maxNumOfQueriesInBatch)
+	}
+
+	commands := make([]*process.SCQuery, 0, len(requests))
+	for idx := range requests {
+		command, errCreate := createSCQuery(ef, &requests[idx])
+		if errCreate != nil {
+			return nil, fmt.Errorf("query %d: %w", idx, errCreate)
+		}
+
+		commands = append(commands, command)
+	}
+
+	return ef.ExecuteSCQueries(commands)
+}
+
+// executeQueryTyped encodes the JSON arguments and decodes the returned data using the ABI of the queried contract
+func executeQueryTyped(context *gin.Context) {
+	ef, err := getFacade(context)
+	if err != nil {
+		returnBadRequest(context, "executeQueryTyped", err)
+		return
+	}
+
+	request := VMTypedValueRequest{}
+	err = context.ShouldBindJSON(&request)
+	if err != nil {
+		returnBadRequest(context, "executeQueryTyped", errors.ErrInvalidJSONRequest)
+		return
+	}
+
+	command, err := createSCQuery(ef, &VMValueRequest{
+		ScAddress:  request.ScAddress,
+		FuncName:   request.FuncName,
+		CallerAddr: request.CallerAddr,
+		CallValue:  request.CallValue,
+	})
+	if err != nil {
+		returnBadRequest(context, "executeQueryTyped", err)
+		return
+	}
+
+	typedOutput, err := ef.ExecuteSCQueryTyped(command, request.Args)
+	if err != nil {
+		returnBadRequest(context, "executeQueryTyped", err)
+		return
+	}
+
+	returnOkResponse(context, typedOutput)
+}
+
+// setContractAbi registers the ABI provided in the request body for the contract in the path
+func setContractAbi(context *gin.Context) {
+	ef, err := getFacade(context)
+	if err != nil {
+		returnBadRequest(context, "setContractAbi", err)
+		return
+	}
+
+	addressParam := context.Param("address")
+	address, err := ef.DecodeAddressPubkey(addressParam)
+	if err != nil {
+		returnBadRequest(context, "setContractAbi", fmt.Errorf("'%s' is not a valid address: %s", addressParam, err.Error()))
+		return
+	}
+
+	context.Request.Body = http.MaxBytesReader(context.Writer, context.Request.Body, maxContractAbiRequestSize)
+	abiBytes, err := context.GetRawData()
+	if err != nil {
+		returnBadRequest(context, "setContractAbi", err)
+		return
+	}
+
+	err = ef.SetContractAbi(address, abiBytes)
+	if err != nil {
+		returnBadRequest(context, "setContractAbi", err)
+		return
+	}
+
+	returnOkResponse(context, "ok")
+}
+
+func doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, error) {
+	ef, err := getFacade(context)
+	if err != nil {
+		return nil, err
 	}
 
 	request := VMValueRequest{}
-	err := context.ShouldBindJSON(&request)
+	err = context.ShouldBindJSON(&request)
 	if err != nil {
 		return nil, errors.ErrInvalidJSONRequest
 	}
@@ -114,6 +241,20 @@ func doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, error) {
 	return ef.ExecuteSCQuery(command)
 }
 
+func getFacade(context *gin.Context) (FacadeHandler, error) {
+	efObj, ok := context.Get("facade")
+	if !ok {
+		return nil, errors.ErrNilAppContext
+	}
+
+	ef, ok := efObj.(FacadeHandler)
+	if !ok {
+		return nil, errors.ErrInvalidAppContext
+	}
+
+	return ef, nil
+}
+
 func createSCQuery(fh FacadeHandler, request *VMValueRequest) (*process.SCQuery, error) {
 	decodedAddress, err := fh.DecodeAddressPubkey(request.ScAddress)
 	if err != nil {
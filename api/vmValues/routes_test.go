@@ -35,6 +35,16 @@ type vmOutputResponse struct {
 	Error string             `json:"error"`
 }
 
+type vmOutputsResponse struct {
+	Data  []*vmcommon.VMOutput `json:"data"`
+	Error string               `json:"error"`
+}
+
+type typedVMOutputResponse struct {
+	Data  *vm.TypedVMOutputApi `json:"data"`
+	Error string               `json:"error"`
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -150,6 +160,206 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQueryMultiple_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*vm.VMOutputApi, error) {
+			vmOutputs := make([]*vm.VMOutputApi, 0, len(queries))
+			for _, query := range queries {
+				vmOutputs = append(vmOutputs, &vm.VMOutputApi{
+					ReturnData: [][]byte{[]byte(query.FuncName)},
+				})
+			}
+
+			return vmOutputs, nil
+		},
+	}
+
+	requests := []VMValueRequest{
+		{ScAddress: DummyScAddress, FuncName: "first"},
+		{ScAddress: DummyScAddress, FuncName: "second", Args: []string{"aa"}},
+	}
+
+	response := vmOutputsResponse{}
+	statusCode := doPost(&facade, "/vm-values/query-multiple", requests, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Len(t, response.Data, 2)
+	require.Equal(t, []byte("first"), response.Data[0].ReturnData[0])
+	require.Equal(t, []byte("second"), response.Data[1].ReturnData[0])
+}
+
+func TestQueryMultiple_InvalidBatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*vm.VMOutputApi, error) {
+			require.Fail(t, "should have not executed the queries")
+			return nil, nil
+		},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(&facade, "/vm-values/query-multiple", []VMValueRequest{}, &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrInvalidQueriesBatch.Error())
+
+	requests := make([]VMValueRequest, maxNumOfQueriesInBatch+1)
+	statusCode = doPost(&facade, "/vm-values/query-multiple", requests, &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrInvalidQueriesBatch.Error())
+
+	requests = []VMValueRequest{
+		{ScAddress: DummyScAddress, FuncName: "first"},
+		{ScAddress: DummyScAddress, FuncName: "second", Args: []string{"ZZ"}},
+	}
+	statusCode = doPost(&facade, "/vm-values/query-multiple", requests, &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, "query 1")
+	require.Contains(t, response.Error, "not a valid hex string")
+
+	statusCode = doPost(&facade, "/vm-values/query-multiple", []byte("dummy"), &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrInvalidJSONRequest.Error())
+}
+
+func TestQueryMultiple_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("some random error")
+	facade := mock.Facade{
+		ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*vm.VMOutputApi, error) {
+			return nil, errExpected
+		},
+	}
+
+	requests := []VMValueRequest{{ScAddress: DummyScAddress, FuncName: "function"}}
+
+	response := simpleResponse{}
+	statusCode := doPost(&facade, "/vm-values/query-multiple", requests, &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, errExpected.Error())
+}
+
+func TestQueryTyped_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		ExecuteSCQueryTypedHandler: func(query *process.SCQuery, arguments []json.RawMessage) (*vm.TypedVMOutputApi, error) {
+			require.Equal(t, "getValue", query.FuncName)
+			require.Equal(t, "10", query.CallValue.String())
+			require.Len(t, arguments, 2)
+			require.Equal(t, `"7"`, string(arguments[0]))
+			require.Equal(t, `true`, string(arguments[1]))
+
+			return &vm.TypedVMOutputApi{
+				ReturnData: []interface{}{"14"},
+				ReturnCode: vmcommon.Ok.String(),
+			}, nil
+		},
+	}
+
+	request := VMTypedValueRequest{
+		ScAddress: DummyScAddress,
+		FuncName:  "getValue",
+		CallValue: "10",
+		Args:      []json.RawMessage{json.RawMessage(`"7"`), json.RawMessage(`true`)},
+	}
+
+	response := typedVMOutputResponse{}
+	statusCode := doPost(&facade, "/vm-values/query-typed", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.Equal(t, []interface{}{"14"}, response.Data.ReturnData)
+	require.Equal(t, vmcommon.Ok.String(), response.Data.ReturnCode)
+}
+
+func TestQueryTyped_ErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("some random error")
+	facade := mock.Facade{
+		ExecuteSCQueryTypedHandler: func(_ *process.SCQuery, _ []json.RawMessage) (*vm.TypedVMOutputApi, error) {
+			return nil, errExpected
+		},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(&facade, "/vm-values/query-typed", VMTypedValueRequest{ScAddress: DummyScAddress}, &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, errExpected.Error())
+
+	statusCode = doPost(&facade, "/vm-values/query-typed", VMTypedValueRequest{ScAddress: "bad address"}, &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, "not a valid address")
+
+	statusCode = doPost(&facade, "/vm-values/query-typed", []byte("dummy"), &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrInvalidJSONRequest.Error())
+}
+
+func TestSetContractAbi_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	abiBytes := []byte(`{"name":"adder","endpoints":[{"name":"getSum","outputs":[{"type":"BigUint"}]}]}`)
+	expectedAddress, _ := hex.DecodeString(DummyScAddress)
+	wasCalled := false
+	facade := mock.Facade{
+		SetContractAbiHandler: func(address []byte, providedAbi []byte) error {
+			wasCalled = true
+			require.Equal(t, expectedAddress, address)
+			require.Equal(t, abiBytes, providedAbi)
+			return nil
+		},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(&facade, "/vm-values/abi/"+DummyScAddress, abiBytes, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "", response.Error)
+	require.True(t, wasCalled)
+}
+
+func TestSetContractAbi_ErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("some random error")
+	facade := mock.Facade{
+		SetContractAbiHandler: func(_ []byte, _ []byte) error {
+			return errExpected
+		},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(&facade, "/vm-values/abi/"+DummyScAddress, []byte("{}"), &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, errExpected.Error())
+
+	statusCode = doPost(&facade, "/vm-values/abi/not-an-address", []byte("{}"), &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, "not a valid address")
+}
+
+func TestSetContractAbi_TooLargeBodyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		SetContractAbiHandler: func(_ []byte, _ []byte) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+
+	response := simpleResponse{}
+	statusCode := doPost(&facade, "/vm-values/abi/"+DummyScAddress, make([]byte, maxContractAbiRequestSize+1), &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, "request body too large")
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := VMValueRequest{
 		ScAddress: DummyScAddress,
@@ -362,6 +572,9 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/string", Open: true},
 					{Name: "/int", Open: true},
 					{Name: "/query", Open: true},
+					{Name: "/query-multiple", Open: true},
+					{Name: "/query-typed", Open: true},
+					{Name: "/abi/:address", Open: true},
 				},
 			},
 		},
@@ -34,12 +34,25 @@ func NewRouterWrapper(packageName string, router *gin.RouterGroup, routesConfig
 
 // RegisterHandler will register the handler for the given method and path
 func (rw *RouterWrapper) RegisterHandler(method string, path string, handlers ...gin.HandlerFunc) {
-	if rw.isEndpointActive(path) {
+	if rw.IsEndpointActive(path) {
 		rw.router.Handle(method, path, handlers...)
 	}
 }
 
-func (rw *RouterWrapper) isEndpointActive(endpointToCheck string) bool {
+// RegisterDispatchHandler will register the handler for the given method and wildcard path if any of the endpoints
+// the handler dispatches to is enabled in the routes config. The handler should check each endpoint with
+// IsEndpointActive before serving it
+func (rw *RouterWrapper) RegisterDispatchHandler(method string, path string, endpoints []string, handlers ...gin.HandlerFunc) {
+	for _, endpoint := range endpoints {
+		if rw.IsEndpointActive(endpoint) {
+			rw.router.Handle(method, path, handlers...)
+			return
+		}
+	}
+}
+
+// IsEndpointActive returns true if the given path is enabled in the routes config
+func (rw *RouterWrapper) IsEndpointActive(endpointToCheck string) bool {
 	rw.mutRoutesConfig.RLock()
 	routesConfig := rw.routesConfig
 	rw.mutRoutesConfig.RUnlock()
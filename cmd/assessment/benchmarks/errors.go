@@ -10,3 +10,27 @@ var ErrNilBenchmark = errors.New("nil benchmark")
 
 // ErrFileDoesNotExist signals that the required file does not exist
 var ErrFileDoesNotExist = errors.New("file does not exist")
+
+// ErrEmptyWorkloadsSlice signals that the provided gas workloads slice was empty
+var ErrEmptyWorkloadsSlice = errors.New("empty gas workloads slice provided")
+
+// ErrNilWorkload signals that a nil gas workload was provided
+var ErrNilWorkload = errors.New("nil gas workload")
+
+// ErrNilGasSchedule signals that a nil gas schedule was provided
+var ErrNilGasSchedule = errors.New("nil gas schedule")
+
+// ErrInvalidTargetNanosecondsPerGas signals that an invalid target CPU time per gas unit was provided
+var ErrInvalidTargetNanosecondsPerGas = errors.New("invalid target nanoseconds per gas unit")
+
+// ErrInvalidGasPrice signals that an invalid gas price was provided
+var ErrInvalidGasPrice = errors.New("invalid gas price")
+
+// ErrInvalidWorkloadResult signals that a gas workload did not execute any operation or did not consume any gas
+var ErrInvalidWorkloadResult = errors.New("invalid gas workload result")
+
+// ErrUnknownGasScheduleEntry signals that a gas workload references an entry missing from the gas schedule
+var ErrUnknownGasScheduleEntry = errors.New("unknown gas schedule entry")
+
+// ErrWorkloadExecutionFailed signals that a gas workload operation did not execute successfully
+var ErrWorkloadExecutionFailed = errors.New("gas workload execution failed")
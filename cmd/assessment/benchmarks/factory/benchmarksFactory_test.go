@@ -11,3 +11,9 @@ func TestCreateBenchmarksList(t *testing.T) {
 
 	assert.Equal(t, 15, len(list))
 }
+
+func TestCreateGasWorkloadsList(t *testing.T) {
+	list := CreateGasWorkloadsList("../testdata")
+
+	assert.Equal(t, 14, len(list))
+}
@@ -0,0 +1,132 @@
+package factory
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/assessment/benchmarks"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const (
+	bigIntAPICostSection = "BigIntAPICost"
+	cryptoAPICostSection = "CryptoAPICost"
+)
+
+// CreateGasWorkloadsList creates the list of gas workloads, covering builtin functions, system smart contracts and
+// smart contracts executed by Arwen
+func CreateGasWorkloadsList(testDataDirectory string) []benchmarks.GasWorkload {
+	list := make([]benchmarks.GasWorkload, 0)
+
+	list = append(list, createSaveKeyValueWorkload())
+	list = append(list, createChangeOwnerAddressWorkload(testDataDirectory))
+	list = append(list, createClaimDeveloperRewardsWorkload(testDataDirectory))
+	list = append(list, createESDTIssueWorkload())
+	// the WASM opcodes costs are loaded by the wasmer library only once per process, so they can not be calibrated by
+	// the profiler. The opcodes workloads are used only as reference for the target CPU time per gas unit
+	list = append(list, createArwenWorkload(testDataDirectory, "fibonacci", "fibonacci.wasm", "_main", 32, 10))
+	list = append(list, createArwenWorkload(testDataDirectory, "cpu calculate", "cpucalculate.wasm", "cpuCalculate", 8000, 200))
+	list = append(list, createArwenWorkload(testDataDirectory, "storage100", "storage100.wasm", "store100", 0, 200,
+		benchmarks.GasScheduleEntry{Section: core.ElrondAPICost, Name: "StorageStore"},
+		benchmarks.GasScheduleEntry{Section: core.BaseOperationCost, Name: "StorePerByte"}))
+	list = append(list, createArwenWorkload(testDataDirectory, "C API big int", "cApiTest.wasm", "bigIntNewTest", 0, 70,
+		benchmarks.GasScheduleEntry{Section: bigIntAPICostSection, Name: "BigIntNew"}))
+	list = append(list, createArwenWorkload(testDataDirectory, "C API sha256", "cryptoTest.wasm", "sha256Test", 0, 30,
+		benchmarks.GasScheduleEntry{Section: cryptoAPICostSection, Name: "SHA256"}))
+	list = append(list, createArwenWorkload(testDataDirectory, "C API keccak256", "cryptoTest.wasm", "keccak256Test", 0, 30,
+		benchmarks.GasScheduleEntry{Section: cryptoAPICostSection, Name: "Keccak256"}))
+	list = append(list, createArwenWorkload(testDataDirectory, "C API ripemd160", "cryptoTest.wasm", "ripemd160Test", 0, 30,
+		benchmarks.GasScheduleEntry{Section: cryptoAPICostSection, Name: "Ripemd160"}))
+	list = append(list, createArwenWorkload(testDataDirectory, "C API verify BLS", "cryptoTest.wasm", "verifyBLSTest", 0, 5,
+		benchmarks.GasScheduleEntry{Section: cryptoAPICostSection, Name: "VerifyBLS"}))
+	list = append(list, createArwenWorkload(testDataDirectory, "C API verify ED25519", "cryptoTest.wasm", "verifyEd25519Test", 0, 50,
+		benchmarks.GasScheduleEntry{Section: cryptoAPICostSection, Name: "VerifyEd25519"}))
+	list = append(list, createArwenWorkload(testDataDirectory, "C API verify secp256k1", "cryptoTest.wasm", "verifySecp256k1UncompressedKeyTest", 0, 200,
+		benchmarks.GasScheduleEntry{Section: cryptoAPICostSection, Name: "VerifySecp256k1"}))
+
+	return list
+}
+
+func createSaveKeyValueWorkload() benchmarks.GasWorkload {
+	arg := benchmarks.ArgTxGasWorkload{
+		Name:    "builtin save key value",
+		Entries: []benchmarks.GasScheduleEntry{{Section: core.BuiltInCost, Name: core.BuiltInFunctionSaveKeyValue}},
+		CreateTxData: func(index int, _ []byte) string {
+			key := []byte(fmt.Sprintf("key%d", index))
+			return core.BuiltInFunctionSaveKeyValue + "@" + hex.EncodeToString(key) + "@" + hex.EncodeToString([]byte("value"))
+		},
+		NumOperations: 1000,
+	}
+
+	return benchmarks.NewTxGasWorkload(arg)
+}
+
+func createChangeOwnerAddressWorkload(testDataDirectory string) benchmarks.GasWorkload {
+	arg := benchmarks.ArgTxGasWorkload{
+		Name:       "builtin change owner address",
+		Entries:    []benchmarks.GasScheduleEntry{{Section: core.BuiltInCost, Name: core.BuiltInFunctionChangeOwnerAddress}},
+		ScFilename: filepath.Join(testDataDirectory, "fibonacci.wasm"),
+		CreateTxData: func(_ int, sender []byte) string {
+			return core.BuiltInFunctionChangeOwnerAddress + "@" + hex.EncodeToString(sender)
+		},
+		NumOperations: 1000,
+	}
+
+	return benchmarks.NewTxGasWorkload(arg)
+}
+
+func createClaimDeveloperRewardsWorkload(testDataDirectory string) benchmarks.GasWorkload {
+	arg := benchmarks.ArgTxGasWorkload{
+		Name:       "builtin claim developer rewards",
+		Entries:    []benchmarks.GasScheduleEntry{{Section: core.BuiltInCost, Name: core.BuiltInFunctionClaimDeveloperRewards}},
+		ScFilename: filepath.Join(testDataDirectory, "fibonacci.wasm"),
+		CreateTxData: func(_ int, _ []byte) string {
+			return core.BuiltInFunctionClaimDeveloperRewards
+		},
+		NumOperations: 1000,
+	}
+
+	return benchmarks.NewTxGasWorkload(arg)
+}
+
+func createESDTIssueWorkload() benchmarks.GasWorkload {
+	arg := benchmarks.ArgSystemSCGasWorkload{
+		Name:      "system SC ESDT",
+		Entries:   []benchmarks.GasScheduleEntry{{Section: core.MetaChainSystemSCsCost, Name: "ESDTIssue"}},
+		ScAddress: vm.ESDTSCAddress,
+		Function:  "issue",
+		CreateArgs: func(_ int) [][]byte {
+			return [][]byte{[]byte("WorkloadToken"), []byte("WLT"), big.NewInt(1000000).Bytes(), {2}}
+		},
+		CallValue:     benchmarks.SystemSCWorkloadBaseIssuingCost,
+		NumOperations: 1000,
+	}
+
+	return benchmarks.NewSystemSCGasWorkload(arg)
+}
+
+func createArwenWorkload(
+	testDataDirectory string,
+	name string,
+	scFilename string,
+	function string,
+	value uint64,
+	numOperations int,
+	entries ...benchmarks.GasScheduleEntry,
+) benchmarks.GasWorkload {
+	arg := benchmarks.ArgTxGasWorkload{
+		Name:       name,
+		Entries:    entries,
+		ScFilename: filepath.Join(testDataDirectory, scFilename),
+		CreateTxData: func(_ int, _ []byte) string {
+			return function
+		},
+		Value:         value,
+		NumOperations: numOperations,
+	}
+
+	return benchmarks.NewTxGasWorkload(arg)
+}
@@ -0,0 +1,88 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/display"
+)
+
+// WorkloadProfile contains the measurements of a gas workload and the gas it consumes with the proposed gas schedule
+type WorkloadProfile struct {
+	Name              string
+	NumOperations     int
+	Duration          time.Duration
+	GasUsed           uint64
+	ProposedGasUsed   uint64
+	CalibrationFactor float64
+}
+
+// GasProfileResults represents the output structure of a gas profiling session
+type GasProfileResults struct {
+	TargetNanosecondsPerGas float64
+	GasPrice                uint64
+	Workloads               []WorkloadProfile
+	ProposedGasSchedule     map[string]map[string]uint64
+	Changes                 []GasScheduleChange
+}
+
+// WorkloadsToDisplayTable will output the workloads measurements and the impact of the proposed gas schedule on the
+// fee of a single operation as an ASCII table
+func (gpr *GasProfileResults) WorkloadsToDisplayTable() string {
+	hdr := []string{"Workload", "Operations", "ns/gas", "Factor", "Gas/op", "Proposed gas/op", "Fee/op", "Proposed fee/op", "Fee change"}
+	lines := make([]*display.LineData, 0, len(gpr.Workloads))
+	for _, wp := range gpr.Workloads {
+		gasPerOperation := wp.GasUsed / uint64(wp.NumOperations)
+		proposedGasPerOperation := wp.ProposedGasUsed / uint64(wp.NumOperations)
+		lines = append(lines, display.NewLineData(
+			false,
+			[]string{
+				wp.Name,
+				fmt.Sprintf("%d", wp.NumOperations),
+				fmt.Sprintf("%0.3f", float64(wp.Duration.Nanoseconds())/float64(wp.GasUsed)),
+				fmt.Sprintf("%0.3f", wp.CalibrationFactor),
+				fmt.Sprintf("%d", gasPerOperation),
+				fmt.Sprintf("%d", proposedGasPerOperation),
+				gpr.computeFee(gasPerOperation).String(),
+				gpr.computeFee(proposedGasPerOperation).String(),
+				relativeChangeAsString(gasPerOperation, proposedGasPerOperation),
+			},
+		))
+	}
+
+	tbl, err := display.CreateTableString(hdr, lines)
+	if err != nil {
+		return fmt.Sprintf("[ERR:%s]", err)
+	}
+
+	return tbl
+}
+
+// ChangesToDisplayTable will output the differences between the current and the proposed gas schedule as an ASCII table
+func (gpr *GasProfileResults) ChangesToDisplayTable() string {
+	return GasScheduleDiffToDisplayTable(gpr.Changes)
+}
+
+func (gpr *GasProfileResults) computeFee(gas uint64) *big.Int {
+	fee := big.NewInt(0).SetUint64(gas)
+
+	return fee.Mul(fee, big.NewInt(0).SetUint64(gpr.GasPrice))
+}
+
+// ToStrings will return the workloads measurements as strings (to be easily written, e.g. in a file)
+func (gpr *GasProfileResults) ToStrings() [][]string {
+	result := make([][]string, 0, len(gpr.Workloads))
+	for _, wp := range gpr.Workloads {
+		result = append(result, []string{
+			wp.Name,
+			fmt.Sprintf("%d", wp.NumOperations),
+			fmt.Sprintf("%d", wp.Duration.Nanoseconds()),
+			fmt.Sprintf("%d", wp.GasUsed),
+			fmt.Sprintf("%d", wp.ProposedGasUsed),
+			fmt.Sprintf("%0.3f", wp.CalibrationFactor),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,62 @@
+package benchmarks
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestGasProfileResults() *GasProfileResults {
+	return &GasProfileResults{
+		TargetNanosecondsPerGas: 1,
+		GasPrice:                10,
+		Workloads: []WorkloadProfile{
+			{
+				Name:              "workload 1",
+				NumOperations:     10,
+				Duration:          time.Microsecond,
+				GasUsed:           500,
+				ProposedGasUsed:   1000,
+				CalibrationFactor: 2,
+			},
+		},
+		Changes: []GasScheduleChange{
+			{GasScheduleEntry: GasScheduleEntry{Section: "section1", Name: "a"}, CurrentValue: 50, ProposedValue: 100},
+		},
+	}
+}
+
+func TestGasProfileResults_WorkloadsToDisplayTable(t *testing.T) {
+	t.Parallel()
+
+	tbl := createTestGasProfileResults().WorkloadsToDisplayTable()
+	fmt.Println(tbl)
+
+	stringsToContain := []string{"workload 1", "2.000", "50", "100", "500", "1000", "+100.00%"}
+	for _, str := range stringsToContain {
+		assert.True(t, strings.Contains(tbl, str), "string %s not contained", str)
+	}
+}
+
+func TestGasProfileResults_ChangesToDisplayTable(t *testing.T) {
+	t.Parallel()
+
+	tbl := createTestGasProfileResults().ChangesToDisplayTable()
+
+	stringsToContain := []string{"section1", "a", "50", "100", "+100.00%"}
+	for _, str := range stringsToContain {
+		assert.True(t, strings.Contains(tbl, str), "string %s not contained", str)
+	}
+}
+
+func TestGasProfileResults_ToStrings(t *testing.T) {
+	t.Parallel()
+
+	expected := [][]string{
+		{"workload 1", "10", "1000", "500", "1000", "2.000"},
+	}
+	assert.Equal(t, expected, createTestGasProfileResults().ToStrings())
+}
@@ -0,0 +1,241 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+// minCalibrationFactor and maxCalibrationFactor bound the change proposed for an entry after a single profiling
+// session, so a noisy measurement can not propose absurd values
+const (
+	minCalibrationFactor = 0.01
+	maxCalibrationFactor = 100.0
+)
+
+// WorkloadResult contains the output data after a gas workload run
+type WorkloadResult struct {
+	Duration      time.Duration
+	GasUsed       uint64
+	NumOperations int
+}
+
+// ArgGasProfiler is the argument used in the gas profiler constructor
+type ArgGasProfiler struct {
+	Workloads   []GasWorkload
+	GasSchedule map[string]map[string]uint64
+	// TargetNanosecondsPerGas is the CPU time a gas unit should pay for. The median of the measured workloads is used
+	// when it is 0
+	TargetNanosecondsPerGas float64
+	// GasPrice is used for computing the impact of the proposed gas schedule on the transaction fees
+	GasPrice uint64
+}
+
+type gasProfiler struct {
+	workloads               []GasWorkload
+	gasSchedule             map[string]map[string]uint64
+	targetNanosecondsPerGas float64
+	gasPrice                uint64
+}
+
+type workloadMeasurement struct {
+	result        *WorkloadResult
+	entries       []GasScheduleEntry
+	calibratedGas uint64
+}
+
+// NewGasProfiler creates the component which runs the gas workloads and proposes a gas schedule calibrated on the
+// CPU time measured on the current host
+func NewGasProfiler(arg ArgGasProfiler) (*gasProfiler, error) {
+	if len(arg.Workloads) == 0 {
+		return nil, ErrEmptyWorkloadsSlice
+	}
+	for index, workload := range arg.Workloads {
+		if check.IfNil(workload) {
+			return nil, fmt.Errorf("%w at index %d", ErrNilWorkload, index)
+		}
+	}
+	if len(arg.GasSchedule) == 0 {
+		return nil, ErrNilGasSchedule
+	}
+	if arg.TargetNanosecondsPerGas < 0 || math.IsNaN(arg.TargetNanosecondsPerGas) {
+		return nil, ErrInvalidTargetNanosecondsPerGas
+	}
+	if arg.GasPrice == 0 {
+		return nil, ErrInvalidGasPrice
+	}
+
+	return &gasProfiler{
+		workloads:               arg.Workloads,
+		gasSchedule:             copyGasSchedule(arg.GasSchedule),
+		targetNanosecondsPerGas: arg.TargetNanosecondsPerGas,
+		gasPrice:                arg.GasPrice,
+	}, nil
+}
+
+// Profile runs all the workloads and returns the proposed gas schedule along with the measurements it is based on.
+// Each workload is run with the current gas schedule for measuring its CPU time, then with its gas schedule entries
+// doubled for separating the gas consumed because of those entries from the rest. The entries are scaled so the
+// whole gas consumed by the workload pays for its CPU time at the target price. Workloads without entries are only
+// used as reference for the target price. Finally, each workload is run with the proposed gas schedule for computing
+// the impact on the transaction fees
+func (gp *gasProfiler) Profile() (*GasProfileResults, error) {
+	measurements := make([]*workloadMeasurement, 0, len(gp.workloads))
+	for i, workload := range gp.workloads {
+		log.Info(fmt.Sprintf("profiling gas workload %d out of %d", i+1, len(gp.workloads)),
+			"name", workload.Name())
+
+		measurement, err := gp.measure(workload)
+		if err != nil {
+			return nil, fmt.Errorf("%w for workload %s", err, workload.Name())
+		}
+		measurements = append(measurements, measurement)
+	}
+
+	targetNanosecondsPerGas := gp.targetNanosecondsPerGas
+	if targetNanosecondsPerGas == 0 {
+		targetNanosecondsPerGas = medianNanosecondsPerGas(measurements)
+	}
+
+	results := &GasProfileResults{
+		TargetNanosecondsPerGas: targetNanosecondsPerGas,
+		GasPrice:                gp.gasPrice,
+		Workloads:               make([]WorkloadProfile, 0, len(measurements)),
+	}
+	factorsPerEntry := make(map[GasScheduleEntry][]float64)
+	factors := make([]float64, len(measurements))
+	for i, measurement := range measurements {
+		factors[i] = computeCalibrationFactor(measurement, targetNanosecondsPerGas)
+		if len(measurement.entries) == 0 {
+			continue
+		}
+		if measurement.calibratedGas == 0 {
+			log.Warn("gas workload does not depend on its gas schedule entries, skipping calibration",
+				"name", gp.workloads[i].Name())
+			continue
+		}
+
+		for _, entry := range measurement.entries {
+			factorsPerEntry[entry] = append(factorsPerEntry[entry], factors[i])
+		}
+	}
+
+	results.ProposedGasSchedule = gp.applyCalibrationFactors(factorsPerEntry)
+	results.Changes = ComputeGasScheduleDiff(gp.gasSchedule, results.ProposedGasSchedule)
+
+	for i, workload := range gp.workloads {
+		proposedResult, err := workload.Run(results.ProposedGasSchedule)
+		if err != nil {
+			return nil, fmt.Errorf("%w for workload %s with the proposed gas schedule", err, workload.Name())
+		}
+
+		results.Workloads = append(results.Workloads, WorkloadProfile{
+			Name:              workload.Name(),
+			NumOperations:     measurements[i].result.NumOperations,
+			Duration:          measurements[i].result.Duration,
+			GasUsed:           measurements[i].result.GasUsed,
+			ProposedGasUsed:   proposedResult.GasUsed,
+			CalibrationFactor: factors[i],
+		})
+	}
+
+	return results, nil
+}
+
+func (gp *gasProfiler) measure(workload GasWorkload) (*workloadMeasurement, error) {
+	entries, err := expandEntries(gp.gasSchedule, workload.GasScheduleEntries())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := workload.Run(gp.gasSchedule)
+	if err != nil {
+		return nil, err
+	}
+	if result.NumOperations <= 0 || result.GasUsed == 0 {
+		return nil, ErrInvalidWorkloadResult
+	}
+
+	if len(entries) == 0 {
+		return &workloadMeasurement{
+			result: result,
+		}, nil
+	}
+
+	doubledGasSchedule := copyGasSchedule(gp.gasSchedule)
+	for _, entry := range entries {
+		doubledGasSchedule[entry.Section][entry.Name] *= 2
+	}
+	doubledResult, err := workload.Run(doubledGasSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	calibratedGas := uint64(0)
+	if doubledResult.GasUsed > result.GasUsed {
+		calibratedGas = doubledResult.GasUsed - result.GasUsed
+	}
+
+	return &workloadMeasurement{
+		result:        result,
+		entries:       entries,
+		calibratedGas: calibratedGas,
+	}, nil
+}
+
+// computeCalibrationFactor returns the factor the workload entries should be multiplied with so the gas consumed by
+// the workload pays for its CPU time at the target price, the gas consumed because of other entries being unchanged
+func computeCalibrationFactor(measurement *workloadMeasurement, targetNanosecondsPerGas float64) float64 {
+	if measurement.calibratedGas == 0 {
+		return 1
+	}
+
+	targetGas := float64(measurement.result.Duration.Nanoseconds()) / targetNanosecondsPerGas
+	otherGas := float64(measurement.result.GasUsed - measurement.calibratedGas)
+	factor := (targetGas - otherGas) / float64(measurement.calibratedGas)
+
+	return math.Min(math.Max(factor, minCalibrationFactor), maxCalibrationFactor)
+}
+
+func (gp *gasProfiler) applyCalibrationFactors(factorsPerEntry map[GasScheduleEntry][]float64) map[string]map[string]uint64 {
+	proposed := copyGasSchedule(gp.gasSchedule)
+	for entry, factors := range factorsPerEntry {
+		sum := 0.0
+		for _, factor := range factors {
+			sum += factor
+		}
+		factor := sum / float64(len(factors))
+
+		value := math.Round(float64(gp.gasSchedule[entry.Section][entry.Name]) * factor)
+		proposed[entry.Section][entry.Name] = uint64(math.Max(value, 1))
+	}
+
+	return proposed
+}
+
+func medianNanosecondsPerGas(measurements []*workloadMeasurement) float64 {
+	values := make([]float64, 0, len(measurements))
+	for _, measurement := range measurements {
+		values = append(values, nanosecondsPerGas(measurement.result))
+	}
+	sort.Float64s(values)
+
+	middle := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[middle]
+	}
+
+	return (values[middle-1] + values[middle]) / 2
+}
+
+func nanosecondsPerGas(result *WorkloadResult) float64 {
+	return float64(result.Duration.Nanoseconds()) / float64(result.GasUsed)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *gasProfiler) IsInterfaceNil() bool {
+	return gp == nil
+}
@@ -0,0 +1,333 @@
+package benchmarks
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type gasWorkloadStub struct {
+	RunCalled                func(gasSchedule map[string]map[string]uint64) (*WorkloadResult, error)
+	GasScheduleEntriesCalled func() []GasScheduleEntry
+	NameCalled               func() string
+}
+
+func (gws *gasWorkloadStub) Run(gasSchedule map[string]map[string]uint64) (*WorkloadResult, error) {
+	if gws.RunCalled != nil {
+		return gws.RunCalled(gasSchedule)
+	}
+
+	return &WorkloadResult{}, nil
+}
+
+func (gws *gasWorkloadStub) GasScheduleEntries() []GasScheduleEntry {
+	if gws.GasScheduleEntriesCalled != nil {
+		return gws.GasScheduleEntriesCalled()
+	}
+
+	return nil
+}
+
+func (gws *gasWorkloadStub) Name() string {
+	if gws.NameCalled != nil {
+		return gws.NameCalled()
+	}
+
+	return ""
+}
+
+func (gws *gasWorkloadStub) IsInterfaceNil() bool {
+	return gws == nil
+}
+
+// createLinearWorkload returns a workload consuming the entry's value for each operation, its duration being fixed
+func createLinearWorkload(entry GasScheduleEntry, duration time.Duration) *gasWorkloadStub {
+	return &gasWorkloadStub{
+		RunCalled: func(gasSchedule map[string]map[string]uint64) (*WorkloadResult, error) {
+			return &WorkloadResult{
+				Duration:      duration,
+				GasUsed:       gasSchedule[entry.Section][entry.Name] * 10,
+				NumOperations: 10,
+			}, nil
+		},
+		GasScheduleEntriesCalled: func() []GasScheduleEntry {
+			return []GasScheduleEntry{entry}
+		},
+		NameCalled: func() string {
+			return entry.String()
+		},
+	}
+}
+
+func createTestGasSchedule() map[string]map[string]uint64 {
+	return map[string]map[string]uint64{
+		"section1": {
+			"a": 100,
+			"b": 200,
+		},
+		"section2": {
+			"c": 1000,
+		},
+	}
+}
+
+func createMockArgGasProfiler() ArgGasProfiler {
+	return ArgGasProfiler{
+		Workloads:               []GasWorkload{&gasWorkloadStub{}},
+		GasSchedule:             createTestGasSchedule(),
+		TargetNanosecondsPerGas: 0,
+		GasPrice:                10,
+	}
+}
+
+func TestNewGasProfiler_EmptyWorkloadsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGasProfiler()
+	arg.Workloads = nil
+	gp, err := NewGasProfiler(arg)
+
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrEmptyWorkloadsSlice, err)
+}
+
+func TestNewGasProfiler_NilWorkloadShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGasProfiler()
+	arg.Workloads = []GasWorkload{&gasWorkloadStub{}, nil}
+	gp, err := NewGasProfiler(arg)
+
+	assert.True(t, check.IfNil(gp))
+	assert.True(t, errors.Is(err, ErrNilWorkload))
+}
+
+func TestNewGasProfiler_NilGasScheduleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGasProfiler()
+	arg.GasSchedule = nil
+	gp, err := NewGasProfiler(arg)
+
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrNilGasSchedule, err)
+}
+
+func TestNewGasProfiler_InvalidTargetNanosecondsPerGasShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGasProfiler()
+	arg.TargetNanosecondsPerGas = -1
+	gp, err := NewGasProfiler(arg)
+
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrInvalidTargetNanosecondsPerGas, err)
+
+	arg.TargetNanosecondsPerGas = math.NaN()
+	gp, err = NewGasProfiler(arg)
+
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrInvalidTargetNanosecondsPerGas, err)
+}
+
+func TestNewGasProfiler_InvalidGasPriceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGasProfiler()
+	arg.GasPrice = 0
+	gp, err := NewGasProfiler(arg)
+
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrInvalidGasPrice, err)
+}
+
+func TestNewGasProfiler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	gp, err := NewGasProfiler(createMockArgGasProfiler())
+
+	assert.False(t, check.IfNil(gp))
+	assert.Nil(t, err)
+}
+
+func TestGasProfiler_ProfileWorkloadErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockArgGasProfiler()
+	arg.Workloads = []GasWorkload{
+		&gasWorkloadStub{
+			RunCalled: func(_ map[string]map[string]uint64) (*WorkloadResult, error) {
+				return nil, expectedErr
+			},
+		},
+	}
+	gp, _ := NewGasProfiler(arg)
+
+	results, err := gp.Profile()
+
+	assert.Nil(t, results)
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestGasProfiler_ProfileUnknownEntryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGasProfiler()
+	arg.Workloads = []GasWorkload{createLinearWorkload(GasScheduleEntry{Section: "section1", Name: "missing"}, time.Second)}
+	gp, _ := NewGasProfiler(arg)
+
+	results, err := gp.Profile()
+
+	assert.Nil(t, results)
+	assert.True(t, errors.Is(err, ErrUnknownGasScheduleEntry))
+}
+
+func TestGasProfiler_ProfileInvalidResultShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGasProfiler()
+	arg.Workloads = []GasWorkload{
+		&gasWorkloadStub{
+			RunCalled: func(_ map[string]map[string]uint64) (*WorkloadResult, error) {
+				return &WorkloadResult{Duration: time.Second, NumOperations: 1}, nil
+			},
+		},
+	}
+	gp, _ := NewGasProfiler(arg)
+
+	results, err := gp.Profile()
+
+	assert.Nil(t, results)
+	assert.True(t, errors.Is(err, ErrInvalidWorkloadResult))
+}
+
+func TestGasProfiler_ProfileWithTargetShouldCalibrateEntries(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGasProfiler()
+	// 1 ns/gas target: entry a is 10 times too cheap, entry c is 2 times too expensive
+	arg.TargetNanosecondsPerGas = 1
+	arg.Workloads = []GasWorkload{
+		createLinearWorkload(GasScheduleEntry{Section: "section1", Name: "a"}, 10000*time.Nanosecond),
+		createLinearWorkload(GasScheduleEntry{Section: "section2", Name: "c"}, 5000*time.Nanosecond),
+	}
+	gp, _ := NewGasProfiler(arg)
+
+	results, err := gp.Profile()
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(1000), results.ProposedGasSchedule["section1"]["a"])
+	assert.Equal(t, uint64(200), results.ProposedGasSchedule["section1"]["b"])
+	assert.Equal(t, uint64(500), results.ProposedGasSchedule["section2"]["c"])
+	expectedChanges := []GasScheduleChange{
+		{GasScheduleEntry: GasScheduleEntry{Section: "section1", Name: "a"}, CurrentValue: 100, ProposedValue: 1000},
+		{GasScheduleEntry: GasScheduleEntry{Section: "section2", Name: "c"}, CurrentValue: 1000, ProposedValue: 500},
+	}
+	assert.Equal(t, expectedChanges, results.Changes)
+
+	require.Equal(t, 2, len(results.Workloads))
+	assert.Equal(t, uint64(1000), results.Workloads[0].GasUsed)
+	assert.Equal(t, uint64(10000), results.Workloads[0].ProposedGasUsed)
+	assert.Equal(t, 10.0, results.Workloads[0].CalibrationFactor)
+	assert.Equal(t, uint64(10000), results.Workloads[1].GasUsed)
+	assert.Equal(t, uint64(5000), results.Workloads[1].ProposedGasUsed)
+	assert.Equal(t, 0.5, results.Workloads[1].CalibrationFactor)
+}
+
+func TestGasProfiler_ProfileWithoutTargetShouldUseMedian(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGasProfiler()
+	reference := &gasWorkloadStub{
+		RunCalled: func(_ map[string]map[string]uint64) (*WorkloadResult, error) {
+			return &WorkloadResult{Duration: 2000 * time.Nanosecond, GasUsed: 1000, NumOperations: 1}, nil
+		},
+	}
+	arg.Workloads = []GasWorkload{
+		createLinearWorkload(GasScheduleEntry{Section: "section1", Name: "a"}, 1000*time.Nanosecond),
+		reference,
+		createLinearWorkload(GasScheduleEntry{Section: "section2", Name: "c"}, 100000*time.Nanosecond),
+	}
+	gp, _ := NewGasProfiler(arg)
+
+	results, err := gp.Profile()
+	require.Nil(t, err)
+
+	assert.Equal(t, 2.0, results.TargetNanosecondsPerGas)
+	assert.Equal(t, uint64(50), results.ProposedGasSchedule["section1"]["a"])
+	assert.Equal(t, uint64(5000), results.ProposedGasSchedule["section2"]["c"])
+	assert.Equal(t, 1.0, results.Workloads[1].CalibrationFactor)
+	assert.Equal(t, uint64(1000), results.Workloads[1].ProposedGasUsed)
+}
+
+func TestGasProfiler_ProfileShouldClampCalibrationFactor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGasProfiler()
+	arg.TargetNanosecondsPerGas = 1
+	arg.Workloads = []GasWorkload{
+		createLinearWorkload(GasScheduleEntry{Section: "section1", Name: "a"}, time.Second),
+		createLinearWorkload(GasScheduleEntry{Section: "section2", Name: "c"}, time.Nanosecond),
+	}
+	gp, _ := NewGasProfiler(arg)
+
+	results, err := gp.Profile()
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(100*maxCalibrationFactor), results.ProposedGasSchedule["section1"]["a"])
+	assert.Equal(t, uint64(1000*minCalibrationFactor), results.ProposedGasSchedule["section2"]["c"])
+}
+
+func TestGasProfiler_ProfileShouldAverageFactorsOfSharedEntries(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGasProfiler()
+	arg.TargetNanosecondsPerGas = 1
+	entry := GasScheduleEntry{Section: "section1", Name: "a"}
+	arg.Workloads = []GasWorkload{
+		createLinearWorkload(entry, 2000*time.Nanosecond),
+		createLinearWorkload(entry, 4000*time.Nanosecond),
+	}
+	gp, _ := NewGasProfiler(arg)
+
+	results, err := gp.Profile()
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(300), results.ProposedGasSchedule["section1"]["a"])
+}
+
+func TestGasProfiler_ProfileShouldNotChangeTheProvidedGasSchedule(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGasProfiler()
+	arg.TargetNanosecondsPerGas = 1
+	arg.Workloads = []GasWorkload{
+		&gasWorkloadStub{
+			RunCalled: func(gasSchedule map[string]map[string]uint64) (*WorkloadResult, error) {
+				return &WorkloadResult{
+					Duration:      time.Millisecond,
+					GasUsed:       gasSchedule["section1"]["a"] + gasSchedule["section1"]["b"],
+					NumOperations: 1,
+				}, nil
+			},
+			GasScheduleEntriesCalled: func() []GasScheduleEntry {
+				return []GasScheduleEntry{{Section: "section1"}}
+			},
+		},
+	}
+	gp, _ := NewGasProfiler(arg)
+
+	results, err := gp.Profile()
+	require.Nil(t, err)
+
+	assert.Equal(t, uint64(100*maxCalibrationFactor), results.ProposedGasSchedule["section1"]["a"])
+	assert.Equal(t, uint64(200*maxCalibrationFactor), results.ProposedGasSchedule["section1"]["b"])
+	assert.Equal(t, createTestGasSchedule(), arg.GasSchedule)
+	assert.NotEqual(t, arg.GasSchedule, results.ProposedGasSchedule)
+}
@@ -0,0 +1,186 @@
+package benchmarks
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/display"
+)
+
+// GasScheduleEntry identifies a gas schedule value by its section and name. An empty name stands for all the values of
+// the section
+type GasScheduleEntry struct {
+	Section string
+	Name    string
+}
+
+// String returns the entry in the section.name format
+func (gse GasScheduleEntry) String() string {
+	if len(gse.Name) == 0 {
+		return gse.Section + ".*"
+	}
+
+	return gse.Section + "." + gse.Name
+}
+
+// GasScheduleChange holds the current and the proposed value of a gas schedule entry
+type GasScheduleChange struct {
+	GasScheduleEntry
+	CurrentValue  uint64
+	ProposedValue uint64
+}
+
+// ComputeGasScheduleDiff returns the values which differ between the two gas schedules, sorted by section and name.
+// A value missing from one of the gas schedules is reported as 0 on that side
+func ComputeGasScheduleDiff(current map[string]map[string]uint64, proposed map[string]map[string]uint64) []GasScheduleChange {
+	changes := make([]GasScheduleChange, 0)
+	for _, entry := range unionOfEntries(current, proposed) {
+		currentValue := current[entry.Section][entry.Name]
+		proposedValue := proposed[entry.Section][entry.Name]
+		if currentValue == proposedValue {
+			continue
+		}
+
+		changes = append(changes, GasScheduleChange{
+			GasScheduleEntry: entry,
+			CurrentValue:     currentValue,
+			ProposedValue:    proposedValue,
+		})
+	}
+
+	return changes
+}
+
+func unionOfEntries(schedules ...map[string]map[string]uint64) []GasScheduleEntry {
+	entriesMap := make(map[GasScheduleEntry]struct{})
+	for _, schedule := range schedules {
+		for section, values := range schedule {
+			for name := range values {
+				entriesMap[GasScheduleEntry{Section: section, Name: name}] = struct{}{}
+			}
+		}
+	}
+
+	entries := make([]GasScheduleEntry, 0, len(entriesMap))
+	for entry := range entriesMap {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Section != entries[j].Section {
+			return entries[i].Section < entries[j].Section
+		}
+		return entries[i].Name < entries[j].Name
+	})
+
+	return entries
+}
+
+// expandEntries replaces the entries standing for whole sections with all the values of those sections
+func expandEntries(gasSchedule map[string]map[string]uint64, entries []GasScheduleEntry) ([]GasScheduleEntry, error) {
+	expanded := make([]GasScheduleEntry, 0, len(entries))
+	for _, entry := range entries {
+		values, ok := gasSchedule[entry.Section]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownGasScheduleEntry, entry)
+		}
+		if len(entry.Name) > 0 {
+			_, ok = values[entry.Name]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownGasScheduleEntry, entry)
+			}
+			expanded = append(expanded, entry)
+			continue
+		}
+
+		for _, sectionEntry := range unionOfEntries(map[string]map[string]uint64{entry.Section: values}) {
+			expanded = append(expanded, sectionEntry)
+		}
+	}
+
+	return expanded, nil
+}
+
+func copyGasSchedule(gasSchedule map[string]map[string]uint64) map[string]map[string]uint64 {
+	newGasSchedule := make(map[string]map[string]uint64, len(gasSchedule))
+	for section, values := range gasSchedule {
+		newGasSchedule[section] = make(map[string]uint64, len(values))
+		for name, value := range values {
+			newGasSchedule[section][name] = value
+		}
+	}
+
+	return newGasSchedule
+}
+
+// GasScheduleDiffToDisplayTable will output the provided gas schedule changes as an ASCII table
+func GasScheduleDiffToDisplayTable(changes []GasScheduleChange) string {
+	hdr := []string{"Section", "Name", "Current", "Proposed", "Change"}
+	lines := make([]*display.LineData, 0, len(changes))
+	for i, change := range changes {
+		lines = append(lines, display.NewLineData(
+			i < len(changes)-1 && change.Section != changes[i+1].Section,
+			[]string{
+				change.Section,
+				change.Name,
+				fmt.Sprintf("%d", change.CurrentValue),
+				fmt.Sprintf("%d", change.ProposedValue),
+				relativeChangeAsString(change.CurrentValue, change.ProposedValue),
+			},
+		))
+	}
+
+	tbl, err := display.CreateTableString(hdr, lines)
+	if err != nil {
+		return fmt.Sprintf("[ERR:%s]", err)
+	}
+
+	return tbl
+}
+
+func relativeChangeAsString(currentValue uint64, proposedValue uint64) string {
+	if currentValue == 0 {
+		if proposedValue == 0 {
+			return "0.00%"
+		}
+		return "new"
+	}
+
+	change := (float64(proposedValue) - float64(currentValue)) * 100 / float64(currentValue)
+
+	return fmt.Sprintf("%+.2f%%", change)
+}
+
+// SaveGasSchedule writes the provided gas schedule in the toml format used by the node configuration files
+func SaveGasSchedule(gasSchedule map[string]map[string]uint64, fileName string) error {
+	buff := bytes.NewBuffer(make([]byte, 0))
+	sections := make([]string, 0, len(gasSchedule))
+	for section := range gasSchedule {
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+
+	for i, section := range sections {
+		if i > 0 {
+			buff.WriteString("\n")
+		}
+		buff.WriteString(fmt.Sprintf("[%s]\n", section))
+
+		entries := unionOfEntries(map[string]map[string]uint64{section: gasSchedule[section]})
+		maxNameLen := 0
+		for _, entry := range entries {
+			if len(entry.Name) > maxNameLen {
+				maxNameLen = len(entry.Name)
+			}
+		}
+		for _, entry := range entries {
+			padding := strings.Repeat(" ", maxNameLen-len(entry.Name))
+			buff.WriteString(fmt.Sprintf("    %s%s = %d\n", entry.Name, padding, gasSchedule[section][entry.Name]))
+		}
+	}
+
+	return ioutil.WriteFile(fileName, buff.Bytes(), os.ModePerm)
+}
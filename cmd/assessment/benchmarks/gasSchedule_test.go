@@ -0,0 +1,93 @@
+package benchmarks
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeGasScheduleDiff(t *testing.T) {
+	t.Parallel()
+
+	current := createTestGasSchedule()
+	proposed := createTestGasSchedule()
+	proposed["section1"]["b"] = 300
+	proposed["section2"]["d"] = 10
+	delete(proposed["section2"], "c")
+
+	changes := ComputeGasScheduleDiff(current, proposed)
+
+	expectedChanges := []GasScheduleChange{
+		{GasScheduleEntry: GasScheduleEntry{Section: "section1", Name: "b"}, CurrentValue: 200, ProposedValue: 300},
+		{GasScheduleEntry: GasScheduleEntry{Section: "section2", Name: "c"}, CurrentValue: 1000, ProposedValue: 0},
+		{GasScheduleEntry: GasScheduleEntry{Section: "section2", Name: "d"}, CurrentValue: 0, ProposedValue: 10},
+	}
+	assert.Equal(t, expectedChanges, changes)
+	assert.Equal(t, 0, len(ComputeGasScheduleDiff(current, createTestGasSchedule())))
+}
+
+func TestExpandEntries(t *testing.T) {
+	t.Parallel()
+
+	gasSchedule := createTestGasSchedule()
+
+	entries, err := expandEntries(gasSchedule, []GasScheduleEntry{{Section: "section1"}, {Section: "section2", Name: "c"}})
+	assert.Nil(t, err)
+	expectedEntries := []GasScheduleEntry{
+		{Section: "section1", Name: "a"},
+		{Section: "section1", Name: "b"},
+		{Section: "section2", Name: "c"},
+	}
+	assert.Equal(t, expectedEntries, entries)
+
+	entries, err = expandEntries(gasSchedule, []GasScheduleEntry{{Section: "missing"}})
+	assert.Nil(t, entries)
+	assert.True(t, errors.Is(err, ErrUnknownGasScheduleEntry))
+
+	entries, err = expandEntries(gasSchedule, []GasScheduleEntry{{Section: "section1", Name: "missing"}})
+	assert.Nil(t, entries)
+	assert.True(t, errors.Is(err, ErrUnknownGasScheduleEntry))
+}
+
+func TestGasScheduleDiffToDisplayTable(t *testing.T) {
+	t.Parallel()
+
+	changes := []GasScheduleChange{
+		{GasScheduleEntry: GasScheduleEntry{Section: "section1", Name: "b"}, CurrentValue: 200, ProposedValue: 300},
+		{GasScheduleEntry: GasScheduleEntry{Section: "section2", Name: "d"}, CurrentValue: 0, ProposedValue: 10},
+	}
+
+	tbl := GasScheduleDiffToDisplayTable(changes)
+	fmt.Println(tbl)
+
+	stringsToContain := []string{"section1", "section2", "200", "300", "+50.00%", "new"}
+	for _, str := range stringsToContain {
+		assert.True(t, strings.Contains(tbl, str), "string %s not contained", str)
+	}
+}
+
+func TestSaveGasSchedule(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "gasSchedule")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	fileName := filepath.Join(dir, "gasSchedule.toml")
+	err = SaveGasSchedule(createTestGasSchedule(), fileName)
+	require.Nil(t, err)
+
+	loaded, err := core.LoadGasScheduleConfig(fileName)
+	assert.Nil(t, err)
+	assert.Equal(t, createTestGasSchedule(), loaded)
+}
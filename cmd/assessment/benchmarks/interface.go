@@ -8,3 +8,12 @@ type BenchmarkRunner interface {
 	Name() string
 	IsInterfaceNil() bool
 }
+
+// GasWorkload defines a workload able to measure the CPU time and the gas consumed by a batch of operations, executed
+// with the provided gas schedule
+type GasWorkload interface {
+	Run(gasSchedule map[string]map[string]uint64) (*WorkloadResult, error)
+	GasScheduleEntries() []GasScheduleEntry
+	Name() string
+	IsInterfaceNil() bool
+}
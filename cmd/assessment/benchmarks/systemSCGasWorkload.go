@@ -0,0 +1,232 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/forking"
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/vm"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts/defaults"
+)
+
+// SystemSCWorkloadBaseIssuingCost is the ESDT issuing cost configured for the system smart contracts run by the
+// system smart contract gas workloads
+const SystemSCWorkloadBaseIssuingCost = 1000
+
+// ArgSystemSCGasWorkload is the argument used in the system smart contract gas workload constructor
+type ArgSystemSCGasWorkload struct {
+	Name          string
+	Entries       []GasScheduleEntry
+	ScAddress     []byte
+	Function      string
+	CreateArgs    func(index int) [][]byte
+	CallValue     uint64
+	NumOperations int
+}
+
+type systemSCGasWorkload struct {
+	name          string
+	entries       []GasScheduleEntry
+	scAddress     []byte
+	function      string
+	createArgs    func(index int) [][]byte
+	callValue     uint64
+	numOperations int
+}
+
+// NewSystemSCGasWorkload creates a gas workload which calls a system smart contract through the system VM. The calls
+// are executed on the same state, their output not being applied on the accounts
+func NewSystemSCGasWorkload(arg ArgSystemSCGasWorkload) *systemSCGasWorkload {
+	return &systemSCGasWorkload{
+		name:          arg.Name,
+		entries:       arg.Entries,
+		scAddress:     arg.ScAddress,
+		function:      arg.Function,
+		createArgs:    arg.CreateArgs,
+		callValue:     arg.CallValue,
+		numOperations: arg.NumOperations,
+	}
+}
+
+// Run executes the workload calls and returns the time needed along with the gas consumed
+func (ssgw *systemSCGasWorkload) Run(gasSchedule map[string]map[string]uint64) (*WorkloadResult, error) {
+	vmContainer, err := createSystemVMContainer(gasSchedule)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = vmContainer.Close()
+	}()
+
+	systemVM, err := vmContainer.Get(factory.SystemVirtualMachine)
+	if err != nil {
+		return nil, err
+	}
+
+	gasUsed := uint64(0)
+	startTime := time.Now()
+	for i := 0; i < ssgw.numOperations; i++ {
+		input := &vmcommon.ContractCallInput{
+			VMInput: vmcommon.VMInput{
+				CallerAddr:  workloadSenderAddress,
+				Arguments:   ssgw.createArgs(i),
+				CallValue:   big.NewInt(0).SetUint64(ssgw.callValue),
+				GasPrice:    1,
+				GasProvided: workloadGasLimit,
+			},
+			RecipientAddr: ssgw.scAddress,
+			Function:      ssgw.function,
+		}
+
+		vmOutput, errRun := systemVM.RunSmartContractCall(input)
+		if errRun != nil {
+			return nil, errRun
+		}
+		if vmOutput.ReturnCode != vmcommon.Ok {
+			return nil, fmt.Errorf("%w: return code %s, message %s",
+				ErrWorkloadExecutionFailed, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+		}
+
+		gasUsed += input.GasProvided - vmOutput.GasRemaining
+	}
+	duration := time.Since(startTime)
+
+	return &WorkloadResult{
+		Duration:      duration,
+		GasUsed:       gasUsed,
+		NumOperations: ssgw.numOperations,
+	}, nil
+}
+
+// fillMissingSystemSCsCosts returns a copy of the provided gas schedule where the system smart contracts costs which
+// are not configured are set to 1, so the system VM can be created with gas schedules lacking some of them
+func fillMissingSystemSCsCosts(gasSchedule map[string]map[string]uint64) map[string]map[string]uint64 {
+	filledGasSchedule := copyGasSchedule(gasSchedule)
+	if filledGasSchedule[core.MetaChainSystemSCsCost] == nil {
+		filledGasSchedule[core.MetaChainSystemSCsCost] = make(map[string]uint64)
+	}
+
+	for name := range defaults.FillGasMapMetaChainSystemSCsCosts(1) {
+		_, ok := filledGasSchedule[core.MetaChainSystemSCsCost][name]
+		if !ok {
+			filledGasSchedule[core.MetaChainSystemSCsCost][name] = 1
+		}
+	}
+
+	return filledGasSchedule
+}
+
+func createSystemVMContainer(gasSchedule map[string]map[string]uint64) (process.VirtualMachinesContainer, error) {
+	pkConverter, err := pubkeyConverter.NewBech32PubkeyConverter(len(workloadSenderAddress))
+	if err != nil {
+		return nil, err
+	}
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(1, core.MetachainShardId)
+	if err != nil {
+		return nil, err
+	}
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	datapool := testscommon.NewPoolsHolderMock()
+	argsHook := hooks.ArgBlockChainHook{
+		Accounts:           vm.CreateInMemoryShardAccountsDB(),
+		PubkeyConv:         pkConverter,
+		StorageService:     &mock.ChainStorerMock{},
+		BlockChain:         &mock.BlockChainMock{},
+		ShardCoordinator:   shardCoordinator,
+		Marshalizer:        marshalizer,
+		Uint64Converter:    uint64ByteSlice.NewBigEndianConverter(),
+		BuiltInFunctions:   builtInFunctions.NewBuiltInFunctionContainer(),
+		DataPool:           datapool,
+		CompiledSCPool:     datapool.SmartContracts(),
+		NilCompiledSCStore: true,
+	}
+
+	argsVMContainerFactory := metachain.ArgsNewVMContainerFactory{
+		ArgBlockChainHook:   argsHook,
+		Economics:           &economicsmocks.EconomicsHandlerStub{},
+		MessageSignVerifier: &mock.MessageSignVerifierMock{},
+		GasSchedule:         mock.NewGasScheduleNotifierMock(fillMissingSystemSCsCosts(gasSchedule)),
+		NodesConfigProvider: &mock.NodesConfigProviderStub{},
+		Hasher:              sha256.Sha256{},
+		Marshalizer:         marshalizer,
+		SystemSCConfig:      createWorkloadSystemSCConfig(),
+		ValidatorAccountsDB: vm.CreateInMemoryShardAccountsDB(),
+		ChanceComputer:      &mock.RaterMock{},
+		EpochNotifier:       forking.NewGenericEpochNotifier(),
+	}
+	vmFactory, err := metachain.NewVMContainerFactory(argsVMContainerFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmFactory.Create()
+}
+
+func createWorkloadSystemSCConfig() *config.SystemSmartContractsConfig {
+	return &config.SystemSmartContractsConfig{
+		ESDTSystemSCConfig: config.ESDTSystemSCConfig{
+			BaseIssuingCost: fmt.Sprintf("%d", SystemSCWorkloadBaseIssuingCost),
+			OwnerAddress:    string(workloadSenderAddress),
+		},
+		GovernanceSystemSCConfig: config.GovernanceSystemSCConfig{
+			ProposalCost:     "500",
+			NumNodes:         100,
+			MinQuorum:        50,
+			MinPassThreshold: 50,
+			MinVetoThreshold: 50,
+		},
+		StakingSystemSCConfig: config.StakingSystemSCConfig{
+			GenesisNodePrice:         "1000",
+			UnJailValue:              "100",
+			MinStepValue:             "100",
+			MinStakeValue:            "1",
+			UnBondPeriod:             1,
+			NumRoundsWithoutBleed:    1,
+			MaximumPercentageToBleed: 1,
+			BleedPercentagePerRound:  1,
+			MaxNumberOfNodesForStake: 100,
+			MinUnstakeTokensValue:    "1",
+		},
+		DelegationManagerSystemSCConfig: config.DelegationManagerSystemSCConfig{
+			BaseIssuingCost:    "100",
+			MinCreationDeposit: "100",
+		},
+		DelegationSystemSCConfig: config.DelegationSystemSCConfig{
+			MinStakeAmount: "100",
+			MaxServiceFee:  100,
+		},
+	}
+}
+
+// GasScheduleEntries returns the gas schedule entries charged for the workload operations
+func (ssgw *systemSCGasWorkload) GasScheduleEntries() []GasScheduleEntry {
+	return ssgw.entries
+}
+
+// Name returns the workload's name
+func (ssgw *systemSCGasWorkload) Name() string {
+	return fmt.Sprintf("%s, function %s, numOperations %d", ssgw.name, ssgw.function, ssgw.numOperations)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ssgw *systemSCGasWorkload) IsInterfaceNil() bool {
+	return ssgw == nil
+}
@@ -0,0 +1,60 @@
+package benchmarks
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFillMissingSystemSCsCosts(t *testing.T) {
+	t.Parallel()
+
+	gasSchedule := createTestGasSchedule()
+	gasSchedule[core.MetaChainSystemSCsCost] = map[string]uint64{"ESDTIssue": 37}
+
+	filled := fillMissingSystemSCsCosts(gasSchedule)
+
+	assert.Equal(t, uint64(37), filled[core.MetaChainSystemSCsCost]["ESDTIssue"])
+	assert.Equal(t, uint64(1), filled[core.MetaChainSystemSCsCost]["UnStakeTokens"])
+	assert.Equal(t, 1, len(gasSchedule[core.MetaChainSystemSCsCost]))
+}
+
+func TestSystemSCGasWorkload_ShouldWork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	gasSchedule, err := core.LoadGasScheduleConfig(testGasScheduleFile)
+	require.Nil(t, err)
+
+	testName := "system SC ESDT"
+	entries := []GasScheduleEntry{{Section: core.MetaChainSystemSCsCost, Name: "ESDTIssue"}}
+	ssgw := NewSystemSCGasWorkload(ArgSystemSCGasWorkload{
+		Name:      testName,
+		Entries:   entries,
+		ScAddress: vm.ESDTSCAddress,
+		Function:  "issue",
+		CreateArgs: func(_ int) [][]byte {
+			return [][]byte{[]byte("WorkloadToken"), []byte("WLT"), big.NewInt(1000).Bytes(), {2}}
+		},
+		CallValue:     SystemSCWorkloadBaseIssuingCost,
+		NumOperations: 10,
+	})
+
+	assert.False(t, check.IfNil(ssgw))
+	assert.Equal(t, entries, ssgw.GasScheduleEntries())
+	assert.True(t, strings.Contains(ssgw.Name(), testName))
+	assert.True(t, strings.Contains(ssgw.Name(), "function"))
+
+	result, err := ssgw.Run(gasSchedule)
+	require.Nil(t, err)
+	assert.True(t, result.Duration > 0)
+	assert.Equal(t, 10*gasSchedule[core.MetaChainSystemSCsCost]["ESDTIssue"], result.GasUsed)
+	assert.Equal(t, 10, result.NumOperations)
+}
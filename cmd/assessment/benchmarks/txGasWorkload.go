@@ -0,0 +1,158 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/vm"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/vm/arwen"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+)
+
+const workloadGasLimit = uint64(0xfffffffffffffff)
+
+var workloadSenderAddress = []byte("12345678901234567890123456789012")
+
+// ArgTxGasWorkload is the argument used in the transaction based gas workload constructor
+type ArgTxGasWorkload struct {
+	Name    string
+	Entries []GasScheduleEntry
+	// ScFilename is the smart contract deployed before running the workload. The transactions are sent to the deployed
+	// smart contract when set, otherwise they are sent by the sender to itself
+	ScFilename string
+	// CreateTxData returns the data field of the transaction with the provided index
+	CreateTxData  func(index int, sender []byte) string
+	Value         uint64
+	NumOperations int
+}
+
+type txGasWorkload struct {
+	name          string
+	entries       []GasScheduleEntry
+	scFilename    string
+	createTxData  func(index int, sender []byte) string
+	value         uint64
+	numOperations int
+}
+
+// NewTxGasWorkload creates a gas workload which processes transactions (builtin function calls or smart contract
+// calls executed by Arwen) through a transaction processor using the profiled gas schedule
+func NewTxGasWorkload(arg ArgTxGasWorkload) *txGasWorkload {
+	return &txGasWorkload{
+		name:          arg.Name,
+		entries:       arg.Entries,
+		scFilename:    arg.ScFilename,
+		createTxData:  arg.CreateTxData,
+		value:         arg.Value,
+		numOperations: arg.NumOperations,
+	}
+}
+
+// Run processes the workload transactions and returns the time needed along with the gas consumed
+func (tgw *txGasWorkload) Run(gasSchedule map[string]map[string]uint64) (*WorkloadResult, error) {
+	if len(tgw.scFilename) > 0 && !core.DoesFileExist(tgw.scFilename) {
+		return nil, fmt.Errorf("%w, file %s", ErrFileDoesNotExist, tgw.scFilename)
+	}
+
+	senderNonce := uint64(0)
+	senderBalance := big.NewInt(0).SetUint64(workloadGasLimit)
+	senderBalance.Mul(senderBalance, senderBalance)
+	testContext, err := vm.CreateTxProcessorArwenVMWithGasSchedule(
+		senderNonce,
+		workloadSenderAddress,
+		senderBalance,
+		gasSchedule,
+		false,
+		vm.ArgEnableEpoch{
+			// the workload transactions provide much more gas than needed, which should not be charged
+			PenalizedTooMuchGasEnableEpoch: math.MaxUint32,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer testContext.Close()
+
+	receiver := workloadSenderAddress
+	if len(tgw.scFilename) > 0 {
+		receiver, err = testContext.BlockchainHook.NewAddress(workloadSenderAddress, senderNonce, factory.ArwenVirtualMachine)
+		if err != nil {
+			return nil, err
+		}
+
+		deployData := arwen.CreateDeployTxData(arwen.GetSCCode(tgw.scFilename))
+		err = processWorkloadTx(testContext, senderNonce, vm.CreateEmptyAddress(), 0, deployData)
+		if err != nil {
+			return nil, fmt.Errorf("%w while deploying %s", err, tgw.scFilename)
+		}
+		senderNonce++
+	}
+
+	_, err = testContext.Accounts.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	// the transactions are processed with a gas price of 1, so the accumulated fees are equal to the consumed gas
+	testContext.CreateBlockStarted()
+	startTime := time.Now()
+	for i := 0; i < tgw.numOperations; i++ {
+		err = processWorkloadTx(testContext, senderNonce, receiver, tgw.value, tgw.createTxData(i, workloadSenderAddress))
+		if err != nil {
+			return nil, err
+		}
+		senderNonce++
+	}
+	duration := time.Since(startTime)
+
+	return &WorkloadResult{
+		Duration:      duration,
+		GasUsed:       testContext.TxFeeHandler.GetAccumulatedFees().Uint64(),
+		NumOperations: tgw.numOperations,
+	}, nil
+}
+
+func processWorkloadTx(testContext *vm.VMTestContext, nonce uint64, receiver []byte, value uint64, data string) error {
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    big.NewInt(0).SetUint64(value),
+		RcvAddr:  receiver,
+		SndAddr:  workloadSenderAddress,
+		GasPrice: 1,
+		GasLimit: workloadGasLimit,
+		Data:     []byte(data),
+	}
+
+	returnCode, err := testContext.TxProcessor.ProcessTransaction(tx)
+	if err != nil {
+		return err
+	}
+	if returnCode != vmcommon.Ok {
+		return fmt.Errorf("%w: return code %s", ErrWorkloadExecutionFailed, returnCode)
+	}
+	if testContext.GetLatestError() != nil {
+		return fmt.Errorf("%w: %v", ErrWorkloadExecutionFailed, testContext.GetLatestError())
+	}
+
+	return nil
+}
+
+// GasScheduleEntries returns the gas schedule entries charged for the workload operations
+func (tgw *txGasWorkload) GasScheduleEntries() []GasScheduleEntry {
+	return tgw.entries
+}
+
+// Name returns the workload's name
+func (tgw *txGasWorkload) Name() string {
+	return fmt.Sprintf("%s, numOperations %d", tgw.name, tgw.numOperations)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tgw *txGasWorkload) IsInterfaceNil() bool {
+	return tgw == nil
+}
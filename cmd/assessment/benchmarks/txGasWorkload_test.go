@@ -0,0 +1,65 @@
+package benchmarks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGasScheduleFile = "../../node/config/gasSchedules/gasScheduleV2.toml"
+
+func TestTxGasWorkload_MissingContractShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tgw := NewTxGasWorkload(ArgTxGasWorkload{
+		Name:          "missing",
+		ScFilename:    "../testdata/missing.wasm",
+		NumOperations: 1,
+	})
+
+	result, err := tgw.Run(createTestGasSchedule())
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, ErrFileDoesNotExist))
+}
+
+func TestTxGasWorkload_ShouldWork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	gasSchedule, err := core.LoadGasScheduleConfig(testGasScheduleFile)
+	require.Nil(t, err)
+
+	testName := "storage100"
+	entries := []GasScheduleEntry{{Section: core.ElrondAPICost, Name: "StorageStore"}}
+	tgw := NewTxGasWorkload(ArgTxGasWorkload{
+		Name:       testName,
+		Entries:    entries,
+		ScFilename: "../testdata/storage100.wasm",
+		CreateTxData: func(_ int, _ []byte) string {
+			return "store100"
+		},
+		NumOperations: 10,
+	})
+
+	assert.False(t, check.IfNil(tgw))
+	assert.Equal(t, entries, tgw.GasScheduleEntries())
+	assert.True(t, strings.Contains(tgw.Name(), testName))
+	assert.True(t, strings.Contains(tgw.Name(), "numOperations"))
+
+	result, err := tgw.Run(gasSchedule)
+	require.Nil(t, err)
+	assert.True(t, result.Duration > 0)
+	assert.True(t, result.GasUsed > 0)
+	assert.Equal(t, 10, result.NumOperations)
+
+	gasSchedule[core.ElrondAPICost]["StorageStore"] *= 2
+	doubledResult, err := tgw.Run(gasSchedule)
+	require.Nil(t, err)
+	assert.True(t, doubledResult.GasUsed > result.GasUsed)
+}
@@ -43,6 +43,51 @@ VERSION:
 		Value: "./output.csv",
 	}
 
+	// gasProfile defines a flag for running the gas schedule profiler instead of the host assessment
+	gasProfile = cli.BoolFlag{
+		Name: "gas-profile",
+		Usage: "Boolean option for running the gas schedule profiler instead of the host assessment. The profiler " +
+			"measures the CPU time of the builtin functions, system smart contracts and Arwen workloads, proposes a " +
+			"calibrated gas schedule and prints its differences from the current one.",
+	}
+
+	// gasScheduleFile defines a flag for the gas schedule file used as the current gas schedule
+	gasScheduleFile = cli.StringFlag{
+		Name:  "gas-schedule",
+		Usage: "The gas schedule file which is profiled and compared against.",
+		Value: "../node/config/gasSchedules/gasScheduleV2.toml",
+	}
+
+	// proposedGasScheduleFile defines a flag for the file where the proposed gas schedule will be written
+	proposedGasScheduleFile = cli.StringFlag{
+		Name:  "proposed-gas-schedule",
+		Usage: "The output file where the gas schedule proposed by the gas profiler will be written.",
+		Value: "./proposedGasSchedule.toml",
+	}
+
+	// compareGasScheduleFile defines a flag for a gas schedule file which will only be compared with the current one
+	compareGasScheduleFile = cli.StringFlag{
+		Name: "compare-gas-schedule",
+		Usage: "A gas schedule file which will be compared with the one provided by the gas-schedule flag. No " +
+			"profiling is done when set, only the differences between the two files being displayed.",
+		Value: "",
+	}
+
+	// gasPrice defines a flag for the gas price used when computing the fees impact of the proposed gas schedule
+	gasPrice = cli.Uint64Flag{
+		Name:  "gas-price",
+		Usage: "The gas price used when computing the impact of the proposed gas schedule on the transaction fees.",
+		Value: 1000000000,
+	}
+
+	// targetNanosecondsPerGas defines a flag for the CPU time a gas unit should pay for
+	targetNanosecondsPerGas = cli.Float64Flag{
+		Name: "target-ns-per-gas",
+		Usage: "The CPU time in nanoseconds a gas unit should pay for when calibrating the gas schedule. The median " +
+			"of the measured workloads is used when set to 0.",
+		Value: 0,
+	}
+
 	log = logger.GetOrCreate("main")
 )
 
@@ -66,6 +111,12 @@ func main() {
 		"produces anonymized host parameters along with a list of benchmarks results. More details can be found in the README.md file."
 	app.Flags = []cli.Flag{
 		outputFile,
+		gasProfile,
+		gasScheduleFile,
+		proposedGasScheduleFile,
+		compareGasScheduleFile,
+		gasPrice,
+		targetNanosecondsPerGas,
 	}
 	app.Authors = []cli.Author{
 		{
@@ -75,6 +126,13 @@ func main() {
 	}
 
 	app.Action = func(c *cli.Context) error {
+		if len(c.GlobalString(compareGasScheduleFile.Name)) > 0 {
+			return compareGasSchedules(c)
+		}
+		if c.GlobalBool(gasProfile.Name) {
+			return startGasProfiling(c)
+		}
+
 		return startAssessment(c, app.Version)
 	}
 
@@ -128,3 +186,82 @@ func saveToFile(hi *hostParameters.HostInfo, results *benchmarks.TestResults, ou
 
 	return ioutil.WriteFile(outputFileName, buff.Bytes(), os.ModePerm)
 }
+
+func startGasProfiling(c *cli.Context) error {
+	gasScheduleFileName := c.GlobalString(gasScheduleFile.Name)
+	gasSchedule, err := core.LoadGasScheduleConfig(gasScheduleFileName)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Starting gas schedule profiling...", "gas schedule", gasScheduleFileName)
+	sw := core.NewStopWatch()
+	sw.Start("whole process")
+	defer func() {
+		sw.Stop("whole process")
+		log.Debug("gas profiling process time measurement", sw.GetMeasurements()...)
+	}()
+	log.Info("Gas profiling in progress. Please wait!")
+
+	profiler, err := benchmarks.NewGasProfiler(benchmarks.ArgGasProfiler{
+		Workloads:               factory.CreateGasWorkloadsList("./testdata"),
+		GasSchedule:             gasSchedule,
+		TargetNanosecondsPerGas: c.GlobalFloat64(targetNanosecondsPerGas.Name),
+		GasPrice:                c.GlobalUint64(gasPrice.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	results, err := profiler.Profile()
+	if err != nil {
+		return err
+	}
+
+	log.Info("Gas workloads profile:\n"+results.WorkloadsToDisplayTable(),
+		"target ns/gas", fmt.Sprintf("%0.3f", results.TargetNanosecondsPerGas))
+	log.Info("Proposed gas schedule changes:\n" + results.ChangesToDisplayTable())
+
+	proposedFileName := c.GlobalString(proposedGasScheduleFile.Name)
+	log.Info("Saving proposed gas schedule", "file", proposedFileName)
+	err = benchmarks.SaveGasSchedule(results.ProposedGasSchedule, proposedFileName)
+	if err != nil {
+		return err
+	}
+
+	outputFileName := c.GlobalString(outputFile.Name)
+	log.Info("Saving gas workloads profile", "file", outputFileName)
+
+	return saveRecordsToFile(results.ToStrings(), outputFileName)
+}
+
+func compareGasSchedules(c *cli.Context) error {
+	currentFileName := c.GlobalString(gasScheduleFile.Name)
+	current, err := core.LoadGasScheduleConfig(currentFileName)
+	if err != nil {
+		return err
+	}
+
+	compareFileName := c.GlobalString(compareGasScheduleFile.Name)
+	compared, err := core.LoadGasScheduleConfig(compareFileName)
+	if err != nil {
+		return err
+	}
+
+	changes := benchmarks.ComputeGasScheduleDiff(current, compared)
+	log.Info("Gas schedule differences:\n"+benchmarks.GasScheduleDiffToDisplayTable(changes),
+		"current", currentFileName, "compared", compareFileName, "num changes", len(changes))
+
+	return nil
+}
+
+func saveRecordsToFile(records [][]string, outputFileName string) error {
+	buff := bytes.NewBuffer(make([]byte, 0))
+	csvWriter := csv.NewWriter(buff)
+	err := csvWriter.WriteAll(records)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(outputFileName, buff.Bytes(), os.ModePerm)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/ed25519"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
@@ -23,10 +24,14 @@ import (
 )
 
 type cfg struct {
-	numKeys    int
-	keyType    string
-	consoleOut bool
-	noSplit    bool
+	numKeys        int
+	keyType        string
+	consoleOut     bool
+	noSplit        bool
+	encrypt        bool
+	kdf            string
+	passphraseFile string
+	passphraseEnv  string
 }
 
 const validatorType = "validator"
@@ -90,6 +95,37 @@ VERSION:
 		Destination: &argsConfig.noSplit,
 	}
 
+	// encrypt is the flag that, if active, will encrypt the generated keys with a passphrase
+	encrypt = cli.BoolFlag{
+		Name:        "encrypt",
+		Usage:       "Boolean option that will encrypt the generated keys with the passphrase read from the passphrase file or environment variable",
+		Destination: &argsConfig.encrypt,
+	}
+	// kdf defines a flag for setting the key derivation function used when encrypting the keys
+	kdf = cli.StringFlag{
+		Name: "kdf",
+		Usage: fmt.Sprintf(
+			"The key derivation function used to derive the encryption key from the passphrase. Available options: %s, %s",
+			keystore.KdfScrypt,
+			keystore.KdfArgon2id),
+		Value:       keystore.KdfScrypt,
+		Destination: &argsConfig.kdf,
+	}
+	// passphraseFile defines a flag for the path to the file containing the passphrase
+	passphraseFile = cli.StringFlag{
+		Name:        "passphrase-file",
+		Usage:       "The `filepath` for the file which contains the passphrase used to encrypt the generated keys",
+		Value:       "",
+		Destination: &argsConfig.passphraseFile,
+	}
+	// passphraseEnv defines a flag for the environment variable containing the passphrase
+	passphraseEnv = cli.StringFlag{
+		Name:        "passphrase-env",
+		Usage:       "The `name` of the environment variable which contains the passphrase used to encrypt the generated keys. Used if the passphrase file is not set",
+		Value:       "ELROND_KEYGENERATOR_PASSPHRASE",
+		Destination: &argsConfig.passphraseEnv,
+	}
+
 	argsConfig = &cfg{}
 
 	walletKeyFilenameTemplate    = "walletKey%s.pem"
@@ -99,6 +135,8 @@ VERSION:
 
 	validatorPubKeyConverter, _ = pubkeyConverter.NewHexPubkeyConverter(blsPubkeyLen)
 	walletPubKeyConverter, _    = pubkeyConverter.NewBech32PubkeyConverter(txSignPubkeyLen)
+
+	passphrase []byte
 )
 
 func main() {
@@ -118,6 +156,10 @@ func main() {
 		keyType,
 		consoleOut,
 		noSplit,
+		encrypt,
+		kdf,
+		passphraseFile,
+		passphraseEnv,
 	}
 
 	app.Action = func(_ *cli.Context) error {
@@ -133,6 +175,11 @@ func main() {
 }
 
 func process() error {
+	err := loadPassphrase()
+	if err != nil {
+		return err
+	}
+
 	validatorKeys, walletKeys, err := generateKeys(argsConfig.keyType, argsConfig.numKeys)
 	if err != nil {
 		return err
@@ -141,6 +188,17 @@ func process() error {
 	return outputKeys(validatorKeys, walletKeys, argsConfig.consoleOut, argsConfig.noSplit)
 }
 
+func loadPassphrase() error {
+	if !argsConfig.encrypt {
+		return nil
+	}
+
+	var err error
+	passphrase, err = keystore.LoadPassphrase(argsConfig.passphraseFile, argsConfig.passphraseEnv)
+
+	return err
+}
+
 func generateKeys(typeKey string, numKeys int) ([]key, []key, error) {
 	if numKeys < 1 {
 		return nil, nil, fmt.Errorf("number of keys should be a number greater or equal to 1")
@@ -265,12 +323,20 @@ func writeKeyToStream(writer io.Writer, key key, pubkeyConverter core.PubkeyConv
 
 	pkString := pubkeyConverter.Encode(key.pkBytes)
 
-	blk := pem.Block{
+	blk := &pem.Block{
 		Type:  "PRIVATE KEY for " + pkString,
 		Bytes: []byte(hex.EncodeToString(key.skBytes)),
 	}
 
-	return pem.Encode(writer, &blk)
+	if len(passphrase) > 0 {
+		var err error
+		blk, err = keystore.EncryptPemBlock(blk, passphrase, argsConfig.kdf)
+		if err != nil {
+			return err
+		}
+	}
+
+	return pem.Encode(writer, blk)
 }
 
 func saveKeys(validatorKeys []key, walletKeys []key, noSplit bool) error {
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
 )
 
 // HeaderSigVerifierHandler is the interface needed to check that a header's signature is correct
@@ -40,3 +41,10 @@ type FileLoggingHandler interface {
 	Close() error
 	IsInterfaceNil() bool
 }
+
+// SCExecutionMetricsHandler defines the smart contract execution metrics component, which publishes the metrics on its
+// own go routine until it is closed
+type SCExecutionMetricsHandler interface {
+	process.SCExecutionMetricsHandler
+	Close() error
+}
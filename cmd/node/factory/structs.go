@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"path/filepath"
 	"time"
@@ -23,6 +24,8 @@ import (
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	factoryState "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	trieFactory "github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
@@ -42,6 +45,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node/txsimulator"
+	"github.com/ElrondNetwork/elrond-go/node/txtracer"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
@@ -50,6 +54,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/postprocess"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
+	processEconomics "github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
@@ -68,6 +73,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/sharding/networksharding"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/scMetrics"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/latestData"
@@ -129,6 +135,8 @@ type Process struct {
 	RequestHandler           process.RequestHandler
 	TxLogsProcessor          process.TransactionLogProcessorDatabase
 	HeaderValidator          epochStart.HeaderValidator
+	// Closers holds the components running their own go routines, which have to be closed when the node stops
+	Closers []io.Closer
 }
 
 type processComponentsFactoryArgs struct {
@@ -175,9 +183,11 @@ type processComponentsFactoryArgs struct {
 	historyRepo               dblookupext.HistoryRepository
 	epochNotifier             process.EpochNotifier
 	txSimulatorProcessorArgs  *txsimulator.ArgsTxSimulator
+	txTracerProcessorArgs     *txtracer.ArgsTxTracer
 	storageReolverImportPath  string
 	chanGracefullyClose       chan endProcess.ArgEndProcess
 	fallbackHeaderValidator   process.FallbackHeaderValidator
+	minGasPriceSettings       config.DynamicMinGasPriceSettings
 }
 
 // NewProcessComponentsFactoryArgs initializes the arguments necessary for creating the process components
@@ -223,9 +233,11 @@ func NewProcessComponentsFactoryArgs(
 	historyRepo dblookupext.HistoryRepository,
 	epochNotifier process.EpochNotifier,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
+	txTracerProcessorArgs *txtracer.ArgsTxTracer,
 	storageReolverImportPath string,
 	chanGracefullyClose chan endProcess.ArgEndProcess,
 	fallbackHeaderValidator process.FallbackHeaderValidator,
+	minGasPriceSettings config.DynamicMinGasPriceSettings,
 ) *processComponentsFactoryArgs {
 	return &processComponentsFactoryArgs{
 		coreComponents:            coreComponents,
@@ -270,9 +282,11 @@ func NewProcessComponentsFactoryArgs(
 		historyRepo:               historyRepo,
 		epochNotifier:             epochNotifier,
 		txSimulatorProcessorArgs:  txSimulatorProcessorArgs,
+		txTracerProcessorArgs:     txTracerProcessorArgs,
 		storageReolverImportPath:  storageReolverImportPath,
 		chanGracefullyClose:       chanGracefullyClose,
 		fallbackHeaderValidator:   fallbackHeaderValidator,
+		minGasPriceSettings:       minGasPriceSettings,
 	}
 }
 
@@ -530,6 +544,19 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
+	closers := make([]io.Closer, 0)
+	scExecutionMetrics, err := createSCExecutionMetrics(
+		args.mainConfig.VirtualMachine.ExecutionMetrics,
+		args.coreData.StatusHandler,
+		args.state.AddressPubkeyConverter,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if scExecutionMetrics != nil {
+		closers = append(closers, scExecutionMetrics)
+	}
+
 	blockProcessor, err := newBlockProcessor(
 		args,
 		requestHandler,
@@ -542,6 +569,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		pendingMiniBlocksHandler,
 		args.txSimulatorProcessorArgs,
 		headerIntegrityVerifier,
+		scExecutionMetrics,
 	)
 	if err != nil {
 		return nil, err
@@ -586,6 +614,7 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		RequestHandler:           requestHandler,
 		TxLogsProcessor:          txLogsProcessor,
 		HeaderValidator:          headerValidator,
+		Closers:                  closers,
 	}, nil
 }
 
@@ -1411,6 +1440,7 @@ func newBlockProcessor(
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
+	scExecutionMetrics SCExecutionMetricsHandler,
 ) (process.BlockProcessor, error) {
 
 	shardCoordinator := processArgs.shardCoordinator
@@ -1445,8 +1475,12 @@ func newBlockProcessor(
 			processArgs.historyRepo,
 			processArgs.epochNotifier,
 			txSimulatorProcessorArgs,
+			processArgs.txTracerProcessorArgs,
+			processArgs.tries,
 			processArgs.mainConfig,
 			workingDir,
+			processArgs.minGasPriceSettings,
+			scExecutionMetrics,
 		)
 	}
 	if shardCoordinator.SelfId() == core.MetachainShardId {
@@ -1481,9 +1515,12 @@ func newBlockProcessor(
 			processArgs.historyRepo,
 			processArgs.epochNotifier,
 			txSimulatorProcessorArgs,
+			processArgs.txTracerProcessorArgs,
+			processArgs.tries,
 			processArgs.mainConfig,
 			workingDir,
 			processArgs.rater,
+			scExecutionMetrics,
 		)
 	}
 
@@ -1518,8 +1555,12 @@ func newShardBlockProcessor(
 	historyRepository dblookupext.HistoryRepository,
 	epochNotifier process.EpochNotifier,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
+	txTracerProcessorArgs *txtracer.ArgsTxTracer,
+	tries *mainFactory.TriesComponents,
 	generalConfig config.Config,
 	workingDir string,
+	minGasPriceSettings config.DynamicMinGasPriceSettings,
+	scExecutionMetrics SCExecutionMetricsHandler,
 ) (process.BlockProcessor, error) {
 	argsParser := smartContract.NewArgumentParser()
 
@@ -1663,6 +1704,8 @@ func newShardBlockProcessor(
 		EpochNotifier:                  epochNotifier,
 		StakingV2EnableEpoch:           stakingV2EnableEpoch,
 	}
+	argsNewScProcessor.ExecutionMetrics = scExecutionMetrics
+
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewScProcessor)
 	if err != nil {
 		return nil, err
@@ -1677,6 +1720,26 @@ func newShardBlockProcessor(
 		return nil, err
 	}
 
+	minGasPriceHandler, ok := economics.(processEconomics.MinGasPriceHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w for the min gas price handler", process.ErrWrongTypeAssertion)
+	}
+	minGasPriceAdjuster, err := processEconomics.NewMinGasPriceAdjuster(processEconomics.ArgsMinGasPriceAdjuster{
+		Settings:       minGasPriceSettings,
+		EnableEpoch:    generalConfig.GeneralSettings.DynamicMinGasPriceEnableEpoch,
+		Economics:      minGasPriceHandler,
+		ShardID:        shardCoordinator.SelfId(),
+		BlockChain:     data.Blkc,
+		DataPool:       data.Datapool,
+		Store:          data.Store,
+		Marshalizer:    core.InternalMarshalizer,
+		RequestHandler: requestHandler,
+		GasHandler:     gasHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	argsNewTxProcessor := transaction.ArgsNewTxProcessor{
 		Accounts:                       stateComponents.AccountsAdapter,
 		Hasher:                         core.Hasher,
@@ -1696,13 +1759,44 @@ func newShardBlockProcessor(
 		PenalizedTooMuchGasEnableEpoch: config.GeneralSettings.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      config.GeneralSettings.MetaProtectionEnableEpoch,
 		EpochNotifier:                  epochNotifier,
+		BlockMinGasPrice:               minGasPriceAdjuster,
 	}
 	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
 		return nil, errors.New("could not create transaction statisticsProcessor: " + err.Error())
 	}
 
-	err = createShardTxSimulatorProcessor(argsNewScProcessor, argsNewTxProcessor, shardCoordinator, data, core, stateComponents, txSimulatorProcessorArgs)
+	err = createShardTxSimulatorProcessor(
+		argsNewScProcessor,
+		argsNewTxProcessor,
+		shardCoordinator,
+		data,
+		core,
+		stateComponents,
+		txSimulatorProcessorArgs,
+		argsBuiltIn,
+		argsHook,
+		config,
+		economics.MaxGasLimitPerBlock(shardCoordinator.SelfId()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = createShardTxTracerProcessor(
+		argsNewScProcessor,
+		argsNewTxProcessor,
+		shardCoordinator,
+		data,
+		core,
+		stateComponents,
+		tries,
+		txTracerProcessorArgs,
+		argsBuiltIn,
+		argsHook,
+		config,
+		economics.MaxGasLimitPerBlock(shardCoordinator.SelfId()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1803,7 +1897,8 @@ func newShardBlockProcessor(
 		HeaderIntegrityVerifier: headerIntegrityVerifier,
 	}
 	arguments := block.ArgShardProcessor{
-		ArgBaseProcessor: argumentsBaseProcessor,
+		ArgBaseProcessor:    argumentsBaseProcessor,
+		MinGasPriceAdjuster: minGasPriceAdjuster,
 	}
 
 	blockProcessor, err := block.NewShardProcessor(arguments)
@@ -1850,9 +1945,12 @@ func newMetaBlockProcessor(
 	historyRepository dblookupext.HistoryRepository,
 	epochNotifier process.EpochNotifier,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
+	txTracerProcessorArgs *txtracer.ArgsTxTracer,
+	tries *mainFactory.TriesComponents,
 	generalConfig config.Config,
 	workingDir string,
 	rater sharding.PeerAccountListAndRatingHandler,
+	scExecutionMetrics SCExecutionMetricsHandler,
 ) (process.BlockProcessor, error) {
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
@@ -1987,6 +2085,8 @@ func newMetaBlockProcessor(
 		EpochNotifier:                  epochNotifier,
 		StakingV2EnableEpoch:           systemSCConfig.StakingSystemSCConfig.StakingV2Epoch,
 	}
+	argsNewScProcessor.ExecutionMetrics = scExecutionMetrics
+
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewScProcessor)
 	if err != nil {
 		return nil, err
@@ -2019,6 +2119,28 @@ func newMetaBlockProcessor(
 		txSimulatorProcessorArgs,
 		epochNotifier,
 		systemSCConfig,
+		argsBuiltIn,
+		argsNewVMContainer,
+		&generalConfig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = createMetaTxTracerProcessor(
+		argsNewScProcessor,
+		shardCoordinator,
+		data,
+		core,
+		stateComponents,
+		tries,
+		txTypeHandler,
+		txTracerProcessorArgs,
+		epochNotifier,
+		systemSCConfig,
+		argsBuiltIn,
+		argsNewVMContainer,
+		&generalConfig,
 	)
 	if err != nil {
 		return nil, err
@@ -2277,6 +2399,17 @@ func newMetaBlockProcessor(
 	return metaProcessor, nil
 }
 
+// replayProcessors holds the processing components able to execute transactions outside the block processing,
+// on accounts adapters which are not the ones used by the block processing
+type replayProcessors struct {
+	txProcessor          process.TransactionProcessor
+	scProcessor          process.SmartContractResultProcessor
+	blockChainHook       process.BlockChainHookHandler
+	interimProcContainer process.IntermediateProcessorContainer
+	gasHandler           process.GasHandler
+	logsCollector        txsimulator.LogsCollector
+}
+
 func createShardTxSimulatorProcessor(
 	scProcArgs smartContract.ArgsNewSmartContractProcessor,
 	txProcArgs transaction.ArgsNewTxProcessor,
@@ -2285,12 +2418,144 @@ func createShardTxSimulatorProcessor(
 	core *mainFactory.CoreComponents,
 	stateComponents *mainFactory.StateComponents,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	argsHook hooks.ArgBlockChainHook,
+	config *config.Config,
+	maxGasLimitPerBlock uint64,
 ) error {
 	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(stateComponents.AccountsAdapter)
 	if err != nil {
 		return err
 	}
 
+	replay, err := createShardReplayProcessors(
+		scProcArgs,
+		txProcArgs,
+		shardCoordinator,
+		data,
+		core,
+		stateComponents,
+		argsBuiltIn,
+		argsHook,
+		config,
+		maxGasLimitPerBlock,
+		readOnlyAccountsDB,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	setTxSimulatorProcessorArgs(txSimulatorProcessorArgs, replay, readOnlyAccountsDB)
+
+	return nil
+}
+
+func createShardTxTracerProcessor(
+	scProcArgs smartContract.ArgsNewSmartContractProcessor,
+	txProcArgs transaction.ArgsNewTxProcessor,
+	shardCoordinator sharding.Coordinator,
+	data *mainFactory.DataComponents,
+	core *mainFactory.CoreComponents,
+	stateComponents *mainFactory.StateComponents,
+	tries *mainFactory.TriesComponents,
+	txTracerProcessorArgs *txtracer.ArgsTxTracer,
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	argsHook hooks.ArgBlockChainHook,
+	config *config.Config,
+	maxGasLimitPerBlock uint64,
+) error {
+	if txTracerProcessorArgs == nil {
+		return nil
+	}
+
+	accounts, err := createTracingAccountsDB(tries, core)
+	if err != nil {
+		return err
+	}
+
+	callTracer, err := txtracer.NewCallTracer(stateComponents.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	replay, err := createShardReplayProcessors(
+		scProcArgs,
+		txProcArgs,
+		shardCoordinator,
+		data,
+		core,
+		stateComponents,
+		argsBuiltIn,
+		argsHook,
+		config,
+		maxGasLimitPerBlock,
+		accounts,
+		callTracer,
+	)
+	if err != nil {
+		return err
+	}
+
+	setTxTracerProcessorArgs(txTracerProcessorArgs, replay, callTracer, accounts, nil)
+
+	return nil
+}
+
+func createShardReplayProcessors(
+	scProcArgs smartContract.ArgsNewSmartContractProcessor,
+	txProcArgs transaction.ArgsNewTxProcessor,
+	shardCoordinator sharding.Coordinator,
+	data *mainFactory.DataComponents,
+	core *mainFactory.CoreComponents,
+	stateComponents *mainFactory.StateComponents,
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	argsHook hooks.ArgBlockChainHook,
+	config *config.Config,
+	maxGasLimitPerBlock uint64,
+	accounts state.AccountsAdapter,
+	executionTracer process.SCExecutionTracer,
+) (*replayProcessors, error) {
+	builtInFuncs, err := createSimulationBuiltInFunctions(argsBuiltIn, accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	argsHook, err = createSimulationBlockChainHookArgs(argsHook, accounts, builtInFuncs, config)
+	if err != nil {
+		return nil, err
+	}
+	argsHook.ExecutionTracer = executionTracer
+
+	queryVirtualMachineConfig := config.VirtualMachine.Querying.VirtualMachineConfig
+	queryVirtualMachineConfig.OutOfProcessEnabled = true
+	vmFactory, err := shard.NewVMContainerFactory(
+		queryVirtualMachineConfig,
+		maxGasLimitPerBlock,
+		argsBuiltIn.GasSchedule,
+		argsHook,
+		config.GeneralSettings.SCDeployEnableEpoch,
+		config.GeneralSettings.AheadOfTimeGasUsageEnableEpoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	vmContainer, err := vmFactory.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	err = builtInFunctions.SetPayableHandler(builtInFuncs, vmFactory.BlockChainHookImpl())
+	if err != nil {
+		return nil, err
+	}
+
+	scProcArgs.VmContainer = vmContainer
+	scProcArgs.BlockChainHook = vmFactory.BlockChainHookImpl()
+	scProcArgs.BuiltInFunctions = builtInFuncs
+	scProcArgs.ExecutionTracer = executionTracer
+
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		shardCoordinator,
 		core.InternalMarshalizer,
@@ -2300,29 +2565,29 @@ func createShardTxSimulatorProcessor(
 		data.Datapool,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	interimProcContainer, err := interimProcFactory.Create()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	scForwarder, err := interimProcContainer.Get(dataBlock.SmartContractResultBlock)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	scProcArgs.ScrForwarder = scForwarder
 
 	receiptTxInterim, err := interimProcContainer.Get(dataBlock.ReceiptBlock)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	txProcArgs.ReceiptForwarder = receiptTxInterim
 
 	badTxInterim, err := interimProcContainer.Get(dataBlock.InvalidBlock)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	scProcArgs.BadTxForwarder = badTxInterim
 	txProcArgs.BadTxForwarder = badTxInterim
@@ -2330,37 +2595,167 @@ func createShardTxSimulatorProcessor(
 	scProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 	txProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 
-	scProcArgs.AccountsDB = readOnlyAccountsDB
+	scProcArgs.AccountsDB = accounts
+
+	gasHandler, logsCollector, err := setReplayCollectors(&scProcArgs)
+	if err != nil {
+		return nil, err
+	}
 
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	txProcArgs.ScProcessor = scProcessor
 
-	txProcArgs.Accounts = readOnlyAccountsDB
+	txProcArgs.Accounts = accounts
+	// the replayed transactions do not belong to the block being processed, so only the configured min gas price applies
+	txProcArgs.BlockMinGasPrice = nil
 
-	txSimulatorProcessorArgs.TransactionProcessor, err = transaction.NewTxProcessor(txProcArgs)
+	txProcessor, err := transaction.NewTxProcessor(txProcArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replayProcessors{
+		txProcessor:          txProcessor,
+		scProcessor:          scProcessor,
+		blockChainHook:       vmFactory.BlockChainHookImpl(),
+		interimProcContainer: interimProcContainer,
+		gasHandler:           gasHandler,
+		logsCollector:        logsCollector,
+	}, nil
+}
+
+func createMetaTxSimulatorProcessor(
+	scProcArgs smartContract.ArgsNewSmartContractProcessor,
+	shardCoordinator sharding.Coordinator,
+	data *mainFactory.DataComponents,
+	core *mainFactory.CoreComponents,
+	stateComponents *mainFactory.StateComponents,
+	txTypeHandler process.TxTypeHandler,
+	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
+	epochNotifier process.EpochNotifier,
+	systemSCConfig *config.SystemSmartContractsConfig,
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	argsNewVMContainer metachain.ArgsNewVMContainerFactory,
+	config *config.Config,
+) error {
+	accountsWrapper, err := txsimulator.NewReadOnlyAccountsDB(stateComponents.AccountsAdapter)
 	if err != nil {
 		return err
 	}
 
-	txSimulatorProcessorArgs.IntermmediateProcContainer = interimProcContainer
+	validatorAccountsWrapper, err := txsimulator.NewReadOnlyAccountsDB(stateComponents.PeerAccounts)
+	if err != nil {
+		return err
+	}
+
+	replay, err := createMetaReplayProcessors(
+		scProcArgs,
+		shardCoordinator,
+		data,
+		core,
+		stateComponents,
+		txTypeHandler,
+		epochNotifier,
+		systemSCConfig,
+		argsBuiltIn,
+		argsNewVMContainer,
+		config,
+		accountsWrapper,
+		validatorAccountsWrapper,
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	setTxSimulatorProcessorArgs(txSimulatorProcessorArgs, replay, accountsWrapper)
 
 	return nil
 }
 
-func createMetaTxSimulatorProcessor(
+func createMetaTxTracerProcessor(
 	scProcArgs smartContract.ArgsNewSmartContractProcessor,
 	shardCoordinator sharding.Coordinator,
 	data *mainFactory.DataComponents,
 	core *mainFactory.CoreComponents,
 	stateComponents *mainFactory.StateComponents,
+	tries *mainFactory.TriesComponents,
 	txTypeHandler process.TxTypeHandler,
-	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
+	txTracerProcessorArgs *txtracer.ArgsTxTracer,
 	epochNotifier process.EpochNotifier,
 	systemSCConfig *config.SystemSmartContractsConfig,
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	argsNewVMContainer metachain.ArgsNewVMContainerFactory,
+	config *config.Config,
 ) error {
+	if txTracerProcessorArgs == nil {
+		return nil
+	}
+
+	accounts, err := createTracingAccountsDB(tries, core)
+	if err != nil {
+		return err
+	}
+
+	validatorAccounts, err := state.NewPeerAccountsDB(
+		tries.TriesContainer.Get([]byte(trieFactory.PeerAccountTrie)),
+		core.Hasher,
+		core.InternalMarshalizer,
+		factoryState.NewPeerAccountCreator(),
+	)
+	if err != nil {
+		return err
+	}
+
+	callTracer, err := txtracer.NewCallTracer(stateComponents.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	replay, err := createMetaReplayProcessors(
+		scProcArgs,
+		shardCoordinator,
+		data,
+		core,
+		stateComponents,
+		txTypeHandler,
+		epochNotifier,
+		systemSCConfig,
+		argsBuiltIn,
+		argsNewVMContainer,
+		config,
+		accounts,
+		validatorAccounts,
+		callTracer,
+	)
+	if err != nil {
+		return err
+	}
+
+	setTxTracerProcessorArgs(txTracerProcessorArgs, replay, callTracer, accounts, validatorAccounts)
+
+	return nil
+}
+
+func createMetaReplayProcessors(
+	scProcArgs smartContract.ArgsNewSmartContractProcessor,
+	shardCoordinator sharding.Coordinator,
+	data *mainFactory.DataComponents,
+	core *mainFactory.CoreComponents,
+	stateComponents *mainFactory.StateComponents,
+	txTypeHandler process.TxTypeHandler,
+	epochNotifier process.EpochNotifier,
+	systemSCConfig *config.SystemSmartContractsConfig,
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	argsNewVMContainer metachain.ArgsNewVMContainerFactory,
+	config *config.Config,
+	accounts state.AccountsAdapter,
+	validatorAccounts state.AccountsAdapter,
+	executionTracer process.SCExecutionTracer,
+) (*replayProcessors, error) {
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		shardCoordinator,
 		core.InternalMarshalizer,
@@ -2370,42 +2765,75 @@ func createMetaTxSimulatorProcessor(
 		data.Datapool,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	interimProcContainer, err := interimProcFactory.Create()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	scForwarder, err := interimProcContainer.Get(dataBlock.SmartContractResultBlock)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	scProcArgs.ScrForwarder = scForwarder
 
 	badTxInterim, err := interimProcContainer.Get(dataBlock.InvalidBlock)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	scProcArgs.BadTxForwarder = badTxInterim
 
 	scProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
+	scProcArgs.AccountsDB = accounts
 
-	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
+	builtInFuncs, err := createSimulationBuiltInFunctions(argsBuiltIn, accounts)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	accountsWrapper, err := txsimulator.NewReadOnlyAccountsDB(stateComponents.AccountsAdapter)
+	argsNewVMContainer.ArgBlockChainHook, err = createSimulationBlockChainHookArgs(
+		argsNewVMContainer.ArgBlockChainHook,
+		accounts,
+		builtInFuncs,
+		config,
+	)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	argsNewVMContainer.ArgBlockChainHook.ExecutionTracer = executionTracer
+	argsNewVMContainer.ValidatorAccountsDB = validatorAccounts
+
+	vmFactory, err := metachain.NewVMContainerFactory(argsNewVMContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	vmContainer, err := vmFactory.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	scProcArgs.VmContainer = vmContainer
+	scProcArgs.BlockChainHook = vmFactory.BlockChainHookImpl()
+	scProcArgs.BuiltInFunctions = builtInFuncs
+	scProcArgs.ExecutionTracer = executionTracer
+
+	gasHandler, logsCollector, err := setReplayCollectors(&scProcArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
+	if err != nil {
+		return nil, err
 	}
 
 	argsNewMetaTx := transaction.ArgsNewMetaTxProcessor{
 		Hasher:           core.Hasher,
 		Marshalizer:      core.InternalMarshalizer,
-		Accounts:         accountsWrapper,
+		Accounts:         accounts,
 		PubkeyConv:       stateComponents.AddressPubkeyConverter,
 		ShardCoordinator: shardCoordinator,
 		ScProcessor:      scProcessor,
@@ -2414,14 +2842,146 @@ func createMetaTxSimulatorProcessor(
 		ESDTEnableEpoch:  systemSCConfig.ESDTSystemSCConfig.EnabledEpoch,
 		EpochNotifier:    epochNotifier,
 	}
-	txSimulatorProcessorArgs.TransactionProcessor, err = transaction.NewMetaTxProcessor(argsNewMetaTx)
+	txProcessor, err := transaction.NewMetaTxProcessor(argsNewMetaTx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	txSimulatorProcessorArgs.IntermmediateProcContainer = interimProcContainer
+	return &replayProcessors{
+		txProcessor:          txProcessor,
+		scProcessor:          scProcessor,
+		blockChainHook:       vmFactory.BlockChainHookImpl(),
+		interimProcContainer: interimProcContainer,
+		gasHandler:           gasHandler,
+		logsCollector:        logsCollector,
+	}, nil
+}
 
-	return nil
+// createTracingAccountsDB creates an accounts adapter, not shared with the block processing, over the user accounts
+// trie storage. The tracer recreates its trie before every trace so the block processing's trie is never changed
+func createTracingAccountsDB(
+	tries *mainFactory.TriesComponents,
+	core *mainFactory.CoreComponents,
+) (state.AccountsAdapter, error) {
+	return state.NewAccountsDB(
+		tries.TriesContainer.Get([]byte(trieFactory.UserAccountTrie)),
+		core.Hasher,
+		core.InternalMarshalizer,
+		factoryState.NewAccountCreator(),
+	)
+}
+
+func setTxSimulatorProcessorArgs(
+	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
+	replay *replayProcessors,
+	accountsJournal txsimulator.AccountsJournal,
+) {
+	txSimulatorProcessorArgs.TransactionProcessor = replay.txProcessor
+	txSimulatorProcessorArgs.IntermmediateProcContainer = replay.interimProcContainer
+	txSimulatorProcessorArgs.AccountsJournal = accountsJournal
+	txSimulatorProcessorArgs.LogsCollector = replay.logsCollector
+	txSimulatorProcessorArgs.GasHandler = replay.gasHandler
+}
+
+func setTxTracerProcessorArgs(
+	txTracerProcessorArgs *txtracer.ArgsTxTracer,
+	replay *replayProcessors,
+	callTracer txtracer.CallTracer,
+	accounts state.AccountsAdapter,
+	validatorAccounts state.AccountsAdapter,
+) {
+	txTracerProcessorArgs.TransactionProcessor = replay.txProcessor
+	txTracerProcessorArgs.SCResultsProcessor = replay.scProcessor
+	txTracerProcessorArgs.Accounts = accounts
+	txTracerProcessorArgs.ValidatorAccounts = validatorAccounts
+	txTracerProcessorArgs.BlockChainHook = replay.blockChainHook
+	txTracerProcessorArgs.CallTracer = callTracer
+	txTracerProcessorArgs.IntermmediateProcContainer = replay.interimProcContainer
+	txTracerProcessorArgs.GasHandler = replay.gasHandler
+	txTracerProcessorArgs.LogsCleaner = replay.logsCollector
+}
+
+// createSimulationBuiltInFunctions creates a built-in functions container which works on the simulation's accounts
+func createSimulationBuiltInFunctions(
+	argsBuiltIn builtInFunctions.ArgsCreateBuiltInFunctionContainer,
+	accounts state.AccountsAdapter,
+) (process.BuiltInFunctionContainer, error) {
+	argsBuiltIn.Accounts = accounts
+	builtInFuncFactory, err := builtInFunctions.NewBuiltInFunctionsFactory(argsBuiltIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return builtInFuncFactory.CreateBuiltInFunctionContainer()
+}
+
+// createSimulationBlockChainHookArgs adapts the block processing's blockchain hook arguments so that the simulation's
+// virtual machines read from and write to the simulation's accounts. The compiled code is kept apart and not persisted
+func createSimulationBlockChainHookArgs(
+	argsHook hooks.ArgBlockChainHook,
+	accounts state.AccountsAdapter,
+	builtInFuncs process.BuiltInFunctionContainer,
+	config *config.Config,
+) (hooks.ArgBlockChainHook, error) {
+	argsHook.Accounts = accounts
+	argsHook.BuiltInFunctions = builtInFuncs
+	argsHook.NilCompiledSCStore = true
+
+	smartContractsCache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(config.SmartContractDataPool))
+	if err != nil {
+		return hooks.ArgBlockChainHook{}, err
+	}
+	argsHook.CompiledSCPool = smartContractsCache
+
+	return argsHook, nil
+}
+
+// setReplayCollectors replaces the logs processor and the gas handler of a replay's smart contract processor with
+// instances that are not shared with the block processing and disables its execution metrics
+func setReplayCollectors(
+	scProcArgs *smartContract.ArgsNewSmartContractProcessor,
+) (process.GasHandler, txsimulator.LogsCollector, error) {
+	gasHandler, err := preprocess.NewGasComputation(
+		scProcArgs.EconomicsFee,
+		scProcArgs.TxTypeHandler,
+		scProcArgs.EpochNotifier,
+		scProcArgs.DeployEnableEpoch,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logsCollector := txsimulator.NewLogsCollector()
+
+	scProcArgs.GasHandler = gasHandler
+	scProcArgs.TxLogsProcessor = logsCollector
+	// the simulated and the traced executions are not part of the shard's load
+	scProcArgs.ExecutionMetrics = nil
+
+	return gasHandler, logsCollector, nil
+}
+
+func createSCExecutionMetrics(
+	metricsConfig config.SCExecutionMetricsConfig,
+	statusHandler core.AppStatusHandler,
+	pubkeyConverter core.PubkeyConverter,
+) (SCExecutionMetricsHandler, error) {
+	if !metricsConfig.Enabled {
+		return nil, nil
+	}
+
+	argsExecutionMetrics := scMetrics.ArgsSCExecutionMetrics{
+		StatusHandler:   statusHandler,
+		PubkeyConverter: pubkeyConverter,
+		MaxNumContracts: metricsConfig.MaxNumContracts,
+		PublishInterval: time.Duration(metricsConfig.PublishIntervalInSeconds) * time.Second,
+	}
+	executionMetrics, err := scMetrics.NewSCExecutionMetrics(argsExecutionMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	return executionMetrics, nil
 }
 
 func newValidatorStatisticsProcessor(
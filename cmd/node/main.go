@@ -33,11 +33,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	indexerFactory "github.com/ElrondNetwork/elrond-go/core/indexer/factory"
 	"github.com/ElrondNetwork/elrond-go/core/logging"
+	"github.com/ElrondNetwork/elrond-go/core/outport"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/core/watchdog"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
@@ -51,15 +53,21 @@ import (
 	"github.com/ElrondNetwork/elrond-go/facade"
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/fallback"
+	"github.com/ElrondNetwork/elrond-go/genesis"
 	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
 	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/abi"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/nodeDebugFactory"
+	"github.com/ElrondNetwork/elrond-go/node/systemSCAPI"
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
 	"github.com/ElrondNetwork/elrond-go/node/txsimulator"
+	"github.com/ElrondNetwork/elrond-go/node/txtracer"
+	"github.com/ElrondNetwork/elrond-go/node/usernamesAPI"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
@@ -74,8 +82,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
+	disabledAntiflood "github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
@@ -291,6 +301,22 @@ VERSION:
 		Usage: "The `filepath` for the PEM file which contains the secret keys for the validator key.",
 		Value: "./config/validatorKey.pem",
 	}
+	// validatorKeyPassphraseFile defines a flag for the path to the file that contains the passphrase used to decrypt
+	// the validator key
+	validatorKeyPassphraseFile = cli.StringFlag{
+		Name: "validator-key-passphrase-file",
+		Usage: "The `filepath` for the file which contains the passphrase used to decrypt the validator key. " +
+			"Only used if the validator key is encrypted. If not set, the passphrase will be read from the environment " +
+			"variable defined by the --validator-key-passphrase-env flag.",
+		Value: "",
+	}
+	// validatorKeyPassphraseEnv defines a flag for the environment variable that contains the passphrase used to
+	// decrypt the validator key
+	validatorKeyPassphraseEnv = cli.StringFlag{
+		Name:  "validator-key-passphrase-env",
+		Usage: "The `name` of the environment variable which contains the passphrase used to decrypt the validator key.",
+		Value: "ELROND_VALIDATOR_KEY_PASSPHRASE",
+	}
 	// elasticSearchTemplates defines a flag for the path to the elasticsearch templates
 	elasticSearchTemplates = cli.StringFlag{
 		Name:  "elasticsearch-templates-path",
@@ -416,6 +442,8 @@ func main() {
 		gasScheduleConfigurationDirectory,
 		validatorKeyIndex,
 		validatorKeyPemFile,
+		validatorKeyPassphraseFile,
+		validatorKeyPassphraseEnv,
 		port,
 		profileMode,
 		useHealthService,
@@ -575,6 +603,13 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	}
 	log.Debug("config", "file", externalConfigurationFileName)
 
+	if externalConfig.SQLIndexerConnector.Enabled {
+		err = indexerFactory.CheckSQLDriver(externalConfig.SQLIndexerConnector.DriverName)
+		if err != nil {
+			return fmt.Errorf("%w for the SQL indexer", err)
+		}
+	}
+
 	if ctx.IsSet(port.Name) {
 		p2pConfig.Node.Port = ctx.GlobalString(port.Name)
 	}
@@ -668,12 +703,19 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	}
 
 	validatorKeyPemFileName := ctx.GlobalString(validatorKeyPemFile.Name)
+	passphraseHandler := func() ([]byte, error) {
+		return keystore.LoadPassphrase(
+			ctx.GlobalString(validatorKeyPassphraseFile.Name),
+			ctx.GlobalString(validatorKeyPassphraseEnv.Name),
+		)
+	}
 	cryptoParamsLoader, err := mainFactory.NewCryptoSigningParamsLoader(
 		validatorPubkeyConverter,
 		ctx.GlobalInt(validatorKeyIndex.Name),
 		validatorKeyPemFileName,
 		suite,
 		isInImportMode,
+		passphraseHandler,
 	)
 	if err != nil {
 		return err
@@ -808,6 +850,11 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	coreComponents.StatusHandler = statusHandlersInfo.StatusHandler
 
+	peerReputationHandler, err := createPeerReputationHandler(generalConfig, pathManager, shardId)
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating network components")
 	networkComponentFactory, err := mainFactory.NewNetworkComponentsFactory(
 		*p2pConfig,
@@ -815,6 +862,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		coreComponents.StatusHandler,
 		coreComponents.InternalMarshalizer,
 		syncer,
+		peerReputationHandler,
 	)
 	if err != nil {
 		return err
@@ -1130,6 +1178,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	metrics.SaveStringMetric(coreComponents.StatusHandler, core.MetricChainId, genesisNodesConfig.ChainID)
 	metrics.SaveUint64Metric(coreComponents.StatusHandler, core.MetricGasPerDataByte, economicsData.GasPerDataByte())
 	metrics.SaveUint64Metric(coreComponents.StatusHandler, core.MetricMinGasPrice, economicsData.MinGasPrice())
+	metrics.SaveUint64Metric(coreComponents.StatusHandler, core.MetricCurrentMinGasPrice, economicsData.CurrentMinGasPrice())
 	metrics.SaveUint64Metric(coreComponents.StatusHandler, core.MetricMinGasLimit, economicsData.MinGasLimit())
 	metrics.SaveStringMetric(coreComponents.StatusHandler, core.MetricRewardsTopUpGradientPoint, economicsData.RewardsTopUpGradientPoint().String())
 	metrics.SaveStringMetric(coreComponents.StatusHandler, core.MetricTopUpFactor, fmt.Sprintf("%g", economicsData.RewardsTopUpFactor()))
@@ -1191,6 +1240,25 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	indexerBlocksProvider, err := indexer.NewStorageBlocksProvider(indexer.ArgsStorageBlocksProvider{
+		Store:           dataComponents.Store,
+		Marshalizer:     coreComponents.InternalMarshalizer,
+		Uint64Converter: coreComponents.Uint64ByteSliceConverter,
+	})
+	if err != nil {
+		return err
+	}
+
+	elasticPendingBlocksStorer, err := createIndexerPendingBlocksStorer(
+		externalConfig.ElasticSearchConnector.PendingBlocksStorage,
+		externalConfig.ElasticSearchConnector.Enabled,
+		pathManager,
+		shardIdString,
+	)
+	if err != nil {
+		return err
+	}
+
 	elasticIndexer, err := createElasticIndexer(
 		externalConfig.ElasticSearchConnector,
 		coreComponents.InternalMarshalizer,
@@ -1205,11 +1273,49 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		economicsData,
 		isInImportMode,
 		ctx.GlobalString(elasticSearchTemplates.Name),
+		indexerBlocksProvider,
+		elasticPendingBlocksStorer,
+		statusHandlersInfo.StatusHandler,
+	)
+	if err != nil {
+		return err
+	}
+
+	sqlPendingBlocksStorer, err := createIndexerPendingBlocksStorer(
+		externalConfig.SQLIndexerConnector.PendingBlocksStorage,
+		externalConfig.SQLIndexerConnector.Enabled,
+		pathManager,
+		shardIdString,
+	)
+	if err != nil {
+		return err
+	}
+
+	sqlIndexer, err := createSQLIndexer(
+		externalConfig.SQLIndexerConnector,
+		coreComponents.InternalMarshalizer,
+		coreComponents.Hasher,
+		nodesCoordinator,
+		epochStartNotifier,
+		addressPubkeyConverter,
+		validatorPubkeyConverter,
+		stateComponents.AccountsAdapter,
+		economicsConfig.GlobalSettings.Denomination,
+		shardCoordinator,
+		economicsData,
+		isInImportMode,
+		indexerBlocksProvider,
+		sqlPendingBlocksStorer,
 	)
 	if err != nil {
 		return err
 	}
 
+	elasticIndexer, err = createOutport(externalConfig.OutportConnector, shardCoordinator.SelfId(), elasticIndexer, sqlIndexer)
+	if err != nil {
+		return err
+	}
+
 	gasScheduleConfigurationFolderName := ctx.GlobalString(gasScheduleConfigurationDirectory.Name)
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
 		GasScheduleConfig: generalConfig.GasSchedule,
@@ -1244,6 +1350,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		Hasher:      coreComponents.Hasher,
 		Marshalizer: coreComponents.InternalMarshalizer,
 		Store:       dataComponents.Store,
+		Accounts:    stateComponents.AccountsAdapter,
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	if err != nil {
@@ -1258,6 +1365,12 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	txSimulatorProcessorArgs := &txsimulator.ArgsTxSimulator{
 		AddressPubKeyConverter: addressPubkeyConverter,
 		ShardCoordinator:       shardCoordinator,
+		EconomicsHandler:       economicsData,
+	}
+
+	var txTracerProcessorArgs *txtracer.ArgsTxTracer
+	if generalConfig.Debug.TransactionTracer.Enabled {
+		txTracerProcessorArgs = &txtracer.ArgsTxTracer{}
 	}
 
 	fallbackHeaderValidator, err := fallback.NewFallbackHeaderValidator(
@@ -1312,9 +1425,11 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		historyRepository,
 		epochNotifier,
 		txSimulatorProcessorArgs,
+		txTracerProcessorArgs,
 		ctx.GlobalString(importDbDirectory.Name),
 		chanStopNodeProcess,
 		fallbackHeaderValidator,
+		economicsConfig.DynamicMinGasPriceSettings,
 	)
 	processComponents, err := factory.ProcessComponentsFactory(processArgs)
 	if err != nil {
@@ -1397,6 +1512,34 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	if txTracerProcessorArgs != nil {
+		transactionTracer, errCreate := txtracer.NewTransactionTracer(*txTracerProcessorArgs)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		err = currentNode.ApplyOptions(node.WithTransactionTracer(transactionTracer))
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Trace("creating contracts ABI registry")
+	abiRegistry, err := abi.NewAbiRegistry(abi.ArgsAbiRegistry{
+		PubkeyConverter:   stateComponents.AddressPubkeyConverter,
+		Directory:         generalConfig.AbiRegistry.Directory,
+		MaxAbiSizeInBytes: generalConfig.AbiRegistry.MaxAbiSizeInBytes,
+		MaxNumAbis:        generalConfig.AbiRegistry.MaxNumAbis,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = currentNode.ApplyOptions(node.WithAbiRegistry(abiRegistry))
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating software checker structure")
 	softwareVersionChecker, err := factory.CreateSoftwareVersionChecker(coreComponents.StatusHandler, generalConfig.SoftwareVersionConfig)
 	if err != nil {
@@ -1412,6 +1555,10 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	log.Trace("creating api resolver structure")
 	apiWorkingDir := filepath.Join(workingDir, factory.TemporaryPath)
+	dnsAddresses, err := smartContractParser.GetDeployedSCAddresses(genesis.DNSType)
+	if err != nil {
+		return err
+	}
 	apiResolver, err := createApiResolver(
 		generalConfig,
 		stateComponents.AccountsAdapter,
@@ -1433,6 +1580,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		rater,
 		epochNotifier,
 		apiWorkingDir,
+		dnsAddresses,
 	)
 	if err != nil {
 		return err
@@ -1464,6 +1612,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		Node:                   currentNode,
 		ApiResolver:            apiResolver,
 		TxSimulatorProcessor:   transactionSimulator,
+		AbiRegistry:            abiRegistry,
 		RestAPIServerDebugMode: restAPIServerDebugMode,
 		WsAntifloodConfig:      generalConfig.Antiflood.WebServer,
 		FacadeConfig: config.FacadeConfig{
@@ -1506,7 +1655,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(log, healthService, dataComponents, triesComponents, networkComponents, chanCloseComponents)
+		closeAllComponents(log, healthService, dataComponents, triesComponents, networkComponents, processComponents, chanCloseComponents)
 	}()
 
 	select {
@@ -1571,12 +1720,19 @@ func closeAllComponents(
 	dataComponents *mainFactory.DataComponents,
 	triesComponents *mainFactory.TriesComponents,
 	networkComponents *mainFactory.NetworkComponents,
+	processComponents *factory.Process,
 	chanCloseComponents chan struct{},
 ) {
 	log.Debug("closing health service...")
 	err := healthService.Close()
 	log.LogIfError(err)
 
+	log.Debug("closing process components...")
+	for _, closer := range processComponents.Closers {
+		err = closer.Close()
+		log.LogIfError(err)
+	}
+
 	log.Debug("closing all store units....")
 	err = dataComponents.Store.CloseAll()
 	log.LogIfError(err)
@@ -1591,6 +1747,10 @@ func closeAllComponents(
 	err = networkComponents.NetMessenger.Close()
 	log.LogIfError(err)
 
+	log.Debug("closing peer reputation handler...")
+	err = networkComponents.PeerReputationHandler.Close()
+	log.LogIfError(err)
+
 	chanCloseComponents <- struct{}{}
 }
 
@@ -2029,6 +2189,9 @@ func createElasticIndexer(
 	economicsHandler process.TransactionFeeCalculator,
 	isInImportDBMode bool,
 	elasticSearchTemplatesPath string,
+	blocksProvider indexer.BlocksProvider,
+	pendingBlocksStorer storage.Storer,
+	statusHandler core.AppStatusHandler,
 ) (indexer.Indexer, error) {
 
 	indexerFactoryArgs := &indexerFactory.ArgsIndexerFactory{
@@ -2052,11 +2215,124 @@ func createElasticIndexer(
 		Options: &indexer.Options{
 			UseKibana: elasticSearchConfig.UseKibana,
 		},
-		IsInImportDBMode: isInImportDBMode,
+		IsInImportDBMode:    isInImportDBMode,
+		BlocksProvider:      blocksProvider,
+		PendingBlocksStorer: pendingBlocksStorer,
+		StatusHandler:       statusHandler,
 	}
 
 	return indexerFactory.NewIndexer(indexerFactoryArgs)
 }
+
+// createSQLIndexer creates a new indexer that writes the data in a sql database
+func createSQLIndexer(
+	sqlIndexerConfig config.SQLIndexerConfig,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	nodesCoordinator sharding.NodesCoordinator,
+	startNotifier notifier.EpochStartNotifier,
+	addressPubkeyConverter core.PubkeyConverter,
+	validatorPubkeyConverter core.PubkeyConverter,
+	accountsDB state.AccountsAdapter,
+	denomination int,
+	shardCoordinator sharding.Coordinator,
+	economicsHandler process.TransactionFeeCalculator,
+	isInImportDBMode bool,
+	blocksProvider indexer.BlocksProvider,
+	pendingBlocksStorer storage.Storer,
+) (indexer.Indexer, error) {
+	sqlIndexerFactoryArgs := &indexerFactory.ArgsSQLIndexerFactory{
+		Enabled:                  sqlIndexerConfig.Enabled,
+		IndexerCacheSize:         sqlIndexerConfig.IndexerCacheSize,
+		DriverName:               sqlIndexerConfig.DriverName,
+		DataSourceName:           sqlIndexerConfig.DataSourceName,
+		ShardCoordinator:         shardCoordinator,
+		Marshalizer:              marshalizer,
+		Hasher:                   hasher,
+		EpochStartNotifier:       startNotifier,
+		NodesCoordinator:         nodesCoordinator,
+		AddressPubkeyConverter:   addressPubkeyConverter,
+		ValidatorPubkeyConverter: validatorPubkeyConverter,
+		EnabledIndexes:           sqlIndexerConfig.EnabledIndexes,
+		Denomination:             denomination,
+		AccountsDB:               accountsDB,
+		TransactionFeeCalculator: economicsHandler,
+		IsInImportDBMode:         isInImportDBMode,
+		BlocksProvider:           blocksProvider,
+		PendingBlocksStorer:      pendingBlocksStorer,
+		// the indexing lag metrics are reported only by the elastic search indexer
+		StatusHandler: statusHandler.NewNilStatusHandler(),
+	}
+
+	return indexerFactory.NewSQLIndexer(sqlIndexerFactoryArgs)
+}
+
+// createIndexerPendingBlocksStorer creates the storer in which an indexer persists the blocks not yet indexed
+func createIndexerPendingBlocksStorer(
+	storageConfig config.StorageConfig,
+	enabled bool,
+	pathManager storage.PathManagerHandler,
+	shardId string,
+) (storage.Storer, error) {
+	if !enabled {
+		return storageUnit.NewNilStorer(), nil
+	}
+
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = pathManager.PathForStatic(shardId, storageConfig.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(storageConfig.Bloom),
+	)
+}
+
+// createOutport wraps the enabled indexers and, if enabled, the stream driver into an outport so the
+// finalized data will be pushed to all of them
+func createOutport(
+	outportConfig config.OutportConfig,
+	selfShardID uint32,
+	indexers ...indexer.Indexer,
+) (indexer.Indexer, error) {
+	drivers := make([]outport.Driver, 0)
+	var lastEnabledIndexer indexer.Indexer = indexer.NewNilIndexer()
+	for _, dataIndexer := range indexers {
+		if dataIndexer.IsNilIndexer() {
+			continue
+		}
+
+		indexerDriver, err := outport.NewIndexerDriver(dataIndexer)
+		if err != nil {
+			return nil, err
+		}
+
+		drivers = append(drivers, indexerDriver)
+		lastEnabledIndexer = dataIndexer
+	}
+
+	if !outportConfig.Enabled {
+		if len(drivers) <= 1 {
+			return lastEnabledIndexer, nil
+		}
+
+		return outport.NewOutport(drivers...)
+	}
+
+	streamDriver, err := outport.NewStreamDriver(outport.ArgsStreamDriver{
+		URL:                outportConfig.URL,
+		Marshalizer:        &marshal.JsonMarshalizer{},
+		SelfShardID:        selfShardID,
+		RetryDuration:      time.Duration(outportConfig.RetryDurationInSec) * time.Second,
+		MaxPendingMessages: outportConfig.MaxPendingMessages,
+	})
+	if err != nil {
+		return nil, err
+	}
+	drivers = append(drivers, streamDriver)
+
+	return outport.NewOutport(drivers...)
+}
 func getConsensusGroupSize(nodesConfig *sharding.NodesSetup, shardCoordinator sharding.Coordinator) (uint32, error) {
 	if shardCoordinator.SelfId() == core.MetachainShardId {
 		return nodesConfig.MetaChainConsensusGroupSize, nil
@@ -2305,6 +2581,7 @@ func createNode(
 		node.WithEpochStartEventNotifier(epochStartRegistrationHandler),
 		node.WithBlockBlackListHandler(process.BlackListHandler),
 		node.WithPeerDenialEvaluator(peerDenialEvaluator),
+		node.WithPeerReputationHandler(network.PeerReputationHandler),
 		node.WithNetworkShardingCollector(networkShardingCollector),
 		node.WithBootStorer(process.BootStorer),
 		node.WithRequestedItemsHandler(requestedItemsHandler),
@@ -2331,6 +2608,7 @@ func createNode(
 		node.WithPeerSignatureHandler(crypto.PeerSignatureHandler),
 		node.WithHistoryRepository(historyRepository),
 		node.WithEnableSignTxWithHashEpoch(config.GeneralSettings.TransactionSignedWithTxHashEnableEpoch),
+		node.WithMaxNumStorageKeys(config.AccountStorageQuery.MaxNumKeys),
 		node.WithTxSignHasher(coreData.TxSignHasher),
 		node.WithTxVersionChecker(txVersionCheckerHandler),
 		node.WithImportMode(isInImportDbMode),
@@ -2389,6 +2667,37 @@ func createPeerHonestyHandler(
 	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, cache)
 }
 
+func createPeerReputationHandler(
+	config *config.Config,
+	pathManager storage.PathManagerHandler,
+	shardId string,
+) (process.PeerReputationHandler, error) {
+	reputationConfig := config.Antiflood.PeerReputation
+	if !config.Antiflood.Enabled || !reputationConfig.Enabled {
+		return &disabledAntiflood.PeerReputationHandler{}, nil
+	}
+
+	dbConfig := storageFactory.GetDBFromConfig(reputationConfig.Storage.DB)
+	dbConfig.FilePath = pathManager.PathForStatic(shardId, reputationConfig.Storage.DB.FilePath)
+	storer, err := storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(reputationConfig.Storage.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(reputationConfig.Storage.Bloom),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return blackList.NewPeerReputationStore(blackList.ArgPeerReputationStore{
+		Storer: storer,
+		// the reputation records are node-local and do not have a protobuf definition
+		Marshalizer:      &marshal.JsonMarshalizer{},
+		DecayCoefficient: reputationConfig.DecayCoefficient,
+		DecayInterval:    time.Duration(reputationConfig.DecayIntervalInSeconds) * time.Second,
+		MaxBanMultiplier: reputationConfig.MaxBanMultiplier,
+	})
+}
+
 func initStatsFileMonitor(
 	config *config.Config,
 	pathManager storage.PathManagerHandler,
@@ -2448,6 +2757,7 @@ func createApiResolver(
 	rater sharding.PeerAccountListAndRatingHandler,
 	epochNotifier process.EpochNotifier,
 	workingDir string,
+	dnsAddresses map[string]struct{},
 ) (facade.ApiResolver, error) {
 	scQueryService, err := createScQueryService(
 		generalConfig,
@@ -2510,7 +2820,61 @@ func createApiResolver(
 		return nil, err
 	}
 
-	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler, totalStakedValueHandler)
+	argsSystemSCAPI := &systemSCAPI.ArgsSystemSCAPIHandlers{
+		ShardID:                shardCoordinator.SelfId(),
+		InternalMarshalizer:    marshalizer,
+		Accounts:               accnts,
+		AddressPubkeyConverter: pubkeyConv,
+		BlockChain:             blockChain,
+		SCQueryService:         scQueryService,
+		ValidatorAccounts:      validatorAccounts,
+		StakingSystemSCConfig:  systemSCConfig.StakingSystemSCConfig,
+	}
+	esdtTokensHandler, err := systemSCAPI.CreateESDTTokensHandler(argsSystemSCAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	governanceHandler, err := systemSCAPI.CreateGovernanceHandler(argsSystemSCAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationHandler, err := systemSCAPI.CreateDelegationHandler(argsSystemSCAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorHandler, err := systemSCAPI.CreateValidatorHandler(argsSystemSCAPI)
+	if err != nil {
+		return nil, err
+	}
+
+	argsUsernameProcessor := usernamesAPI.ArgsUsernameProcessor{
+		DNSAddresses:     dnsAddresses,
+		ShardCoordinator: shardCoordinator,
+		SCQueryService:   scQueryService,
+		Hasher:           keccak.Keccak{},
+		PubkeyConverter:  pubkeyConv,
+	}
+	usernameHandler, err := usernamesAPI.NewUsernameProcessor(argsUsernameProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	argsApiResolver := external.ArgNodeApiResolver{
+		SCQueryService:          scQueryService,
+		StatusMetricsHandler:    statusMetrics,
+		TxCostHandler:           txCostHandler,
+		TotalStakedValueHandler: totalStakedValueHandler,
+		ESDTTokensHandler:       esdtTokensHandler,
+		GovernanceHandler:       governanceHandler,
+		DelegationHandler:       delegationHandler,
+		ValidatorHandler:        validatorHandler,
+		UsernameHandler:         usernameHandler,
+	}
+
+	return external.NewNodeApiResolver(argsApiResolver)
 }
 
 //TODO refactor this code when moving into feat/soft-restart. Maybe use arguments instead of endless parameter lists
@@ -2577,7 +2941,22 @@ func createScQueryService(
 		return nil, err
 	}
 
-	return sqQueryDispatcher, nil
+	resultsCacheConfig := generalConfig.VirtualMachine.Querying.ResultsCache
+	if !resultsCacheConfig.Enabled {
+		return sqQueryDispatcher, nil
+	}
+
+	resultsCacher, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(resultsCacheConfig.Cache))
+	if err != nil {
+		return nil, err
+	}
+
+	sqQueryCache, err := smartContract.NewScQueryServiceCache(sqQueryDispatcher, blockChain, resultsCacher)
+	if err != nil {
+		return nil, err
+	}
+
+	return sqQueryCache, nil
 }
 
 func createScQueryElement(
@@ -89,6 +89,7 @@ func InitMetrics(
 
 	appStatusHandler.SetStringValue(core.MetricP2PNumConnectedPeersClassification, initString)
 	appStatusHandler.SetStringValue(core.MetricP2PPeerInfo, initString)
+	appStatusHandler.SetStringValue(core.MetricP2PReachability, initString)
 	appStatusHandler.SetStringValue(core.MetricP2PIntraShardValidators, initString)
 	appStatusHandler.SetStringValue(core.MetricP2PIntraShardObservers, initString)
 	appStatusHandler.SetStringValue(core.MetricP2PCrossShardValidators, initString)
@@ -247,6 +248,14 @@ func computeConnectedPeers(
 
 	setP2pConnectedPeersMetrics(appStatusHandler, peersInfo)
 	setCurrentP2pNodeAddresses(appStatusHandler, networkComponents)
+	setP2pTopicsBandwidthMetrics(appStatusHandler, networkComponents.NetMessenger.GetTopicsBandwidth())
+}
+
+func setP2pTopicsBandwidthMetrics(appStatusHandler core.AppStatusHandler, topics map[string]p2p.TopicBandwidth) {
+	for topic, bandwidth := range topics {
+		appStatusHandler.SetUInt64Value(core.MetricP2PTopicBytesSentPrefix+topic, bandwidth.BytesSent)
+		appStatusHandler.SetUInt64Value(core.MetricP2PTopicBytesReceivedPrefix+topic, bandwidth.BytesReceived)
+	}
 }
 
 func setP2pConnectedPeersMetrics(appStatusHandler core.AppStatusHandler, info *p2p.ConnectedPeersInfo) {
@@ -284,6 +293,7 @@ func setCurrentP2pNodeAddresses(
 	networkComponents *mainFactory.NetworkComponents,
 ) {
 	appStatusHandler.SetStringValue(core.MetricP2PPeerInfo, sliceToString(networkComponents.NetMessenger.Addresses()))
+	appStatusHandler.SetStringValue(core.MetricP2PReachability, networkComponents.NetMessenger.Reachability())
 }
 
 func registerPollProbableHighestNonce(
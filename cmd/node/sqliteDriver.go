@@ -0,0 +1,8 @@
+// +build sqlite
+
+package main
+
+import (
+	// registers the "sqlite3" sql driver used by the SQL indexer
+	_ "github.com/mattn/go-sqlite3"
+)
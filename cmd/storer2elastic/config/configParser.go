@@ -11,13 +11,16 @@ type GeneralConfig struct {
 	DBPath             string `toml:"dbPath"`
 	NodeConfigFilePath string `toml:"nodeConfigPath"`
 	ChainID            string `toml:"chainID"`
+	NumWorkers         int    `toml:"numWorkers"`
+	ProgressFilePath   string `toml:"progressFilePath"`
 }
 
 // ElasticSearchConfig holds the elastic search configuration
 type ElasticSearchConfig struct {
-	URL      string `toml:"url"`
-	Username string `toml:"username"`
-	Password string `toml:"password"`
+	URL              string `toml:"url"`
+	Username         string `toml:"username"`
+	Password         string `toml:"password"`
+	IndexerCacheSize int    `toml:"indexerCacheSize"`
 }
 
 // DBConfig will map the db configuration
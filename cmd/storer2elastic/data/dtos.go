@@ -9,6 +9,9 @@ import (
 type RoundPersistedData struct {
 	MetaBlockData *HeaderData
 	ShardHeaders  map[uint32][]*HeaderData
+	// SkipIndexing is set for the epoch start rounds that were already indexed or are outside the requested epochs.
+	// They are provided only for rebuilding the validators' state
+	SkipIndexing bool
 }
 
 // HeaderData holds the data for a shard in a round
@@ -17,3 +20,9 @@ type HeaderData struct {
 	Body             *block.Body
 	BodyTransactions map[string]data.TransactionHandler
 }
+
+// Progress holds the last round that was completely indexed
+type Progress struct {
+	Epoch     uint32 `json:"epoch"`
+	MetaNonce uint64 `json:"metaNonce"`
+}
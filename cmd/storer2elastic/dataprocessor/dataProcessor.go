@@ -41,7 +41,9 @@ type ArgsDataProcessor struct {
 	TPSBenchmarkUpdater TPSBenchmarkUpdaterHandler
 	RatingsProcessor    RatingProcessorHandler
 	RatingConfig        config.RatingsConfig
-	StartingEpoch       uint32
+	FromEpoch           uint32
+	ProgressHandler     ProgressHandler
+	IndexerCacheSize    int
 }
 
 type dataProcessor struct {
@@ -56,7 +58,10 @@ type dataProcessor struct {
 	nodesCoordinators   map[uint32]NodesCoordinator
 	tpsBenchmarkUpdater TPSBenchmarkUpdaterHandler
 	ratingsProcessor    RatingProcessorHandler
-	startingEpoch       uint32
+	fromEpoch           uint32
+	progressHandler     ProgressHandler
+	progressLag         int
+	handedRounds        []storer2ElasticData.Progress
 }
 
 // NewDataProcessor returns a new instance of dataProcessor
@@ -85,6 +90,12 @@ func NewDataProcessor(args ArgsDataProcessor) (*dataProcessor, error) {
 	if check.IfNil(args.RatingsProcessor) {
 		return nil, ErrNilRatingProcessor
 	}
+	if check.IfNil(args.ProgressHandler) {
+		return nil, ErrNilProgressHandler
+	}
+	if args.IndexerCacheSize < 0 {
+		return nil, indexer.ErrNegativeCacheSize
+	}
 
 	dp := &dataProcessor{
 		elasticIndexer:      args.ElasticIndexer,
@@ -96,8 +107,13 @@ func NewDataProcessor(args ArgsDataProcessor) (*dataProcessor, error) {
 		ratingsProcessor:    args.RatingsProcessor,
 		tpsBenchmarkUpdater: args.TPSBenchmarkUpdater,
 		ratingConfig:        args.RatingConfig,
-		startingEpoch:       args.StartingEpoch,
-		startTime:           time.Now(),
+		fromEpoch:           args.FromEpoch,
+		progressHandler:     args.ProgressHandler,
+		// the indexer saves the items in order and holds at most IndexerCacheSize items in its queue, besides the one
+		// being saved. As each round adds at least one item, a round is indexed once this many rounds were added after it
+		progressLag:  args.IndexerCacheSize + 1,
+		handedRounds: make([]storer2ElasticData.Progress, 0),
+		startTime:    time.Now(),
 	}
 
 	nodesCoordinators, err := dp.createNodesCoordinators(args.GenesisNodesSetup)
@@ -117,6 +133,12 @@ func (dp *dataProcessor) Index() error {
 
 func (dp *dataProcessor) processData(persistedData storer2ElasticData.RoundPersistedData) bool {
 	metaPersistedData := persistedData.MetaBlockData
+	if persistedData.SkipIndexing {
+		metaBlock, _ := metaPersistedData.Header.(*block.MetaBlock)
+		dp.prepareValidatorsForEpoch(metaBlock, metaPersistedData.Body)
+		return true
+	}
+
 	if metaPersistedData.Header.IsStartOfEpochBlock() || metaPersistedData.Header.GetNonce() == 0 {
 		metaBlock, _ := metaPersistedData.Header.(*block.MetaBlock)
 		dp.processValidatorsForEpoch(metaBlock, metaPersistedData.Body)
@@ -148,9 +170,31 @@ func (dp *dataProcessor) processData(persistedData storer2ElasticData.RoundPersi
 		}
 	}
 
+	dp.saveProgress(storer2ElasticData.Progress{
+		Epoch:     metaPersistedData.Header.GetEpoch(),
+		MetaNonce: metaPersistedData.Header.GetNonce(),
+	})
+
 	return true
 }
 
+// saveProgress will persist the last round that is certainly indexed, as the rounds are indexed asynchronously
+func (dp *dataProcessor) saveProgress(handedRound storer2ElasticData.Progress) {
+	dp.handedRounds = append(dp.handedRounds, handedRound)
+	if len(dp.handedRounds) <= dp.progressLag {
+		return
+	}
+
+	indexedRound := dp.handedRounds[0]
+	dp.handedRounds = dp.handedRounds[1:]
+
+	err := dp.progressHandler.SaveProgress(indexedRound)
+	if err != nil {
+		log.Warn("cannot save the indexing progress", "epoch", indexedRound.Epoch,
+			"meta nonce", indexedRound.MetaNonce, "error", err)
+	}
+}
+
 func (dp *dataProcessor) indexData(data *storer2ElasticData.HeaderData) error {
 	signersIndexes, err := dp.computeSignersIndexes(data.Header)
 	if err != nil {
@@ -223,7 +267,7 @@ func (dp *dataProcessor) createNodesCoordinators(nodesConfig update.GenesisNodes
 		}
 		nodesCoordinatorsMap[shardID] = nodeCoordForShard
 
-		if dp.startingEpoch == 0 {
+		if dp.fromEpoch == 0 {
 			validatorsPubKeys, errGetEligible := nodeCoordForShard.GetAllEligibleValidatorsPublicKeys(0)
 			if errGetEligible != nil || len(validatorsPubKeys) == 0 {
 				log.Warn("cannot get all eligible validatorsPubKeys", "epoch", 0)
@@ -314,6 +358,23 @@ func (dp *dataProcessor) processValidatorsForEpoch(metaBlock *block.MetaBlock, b
 		return
 	}
 
+	dp.prepareValidatorsForEpoch(metaBlock, body)
+
+	validatorsPubKeys, err := dp.nodesCoordinators[core.MetachainShardId].GetAllEligibleValidatorsPublicKeys(metaBlock.Epoch)
+	if err != nil || len(validatorsPubKeys) == 0 {
+		log.Warn("cannot get all eligible validatorsPubKeys", "epoch", metaBlock.Epoch)
+		return
+	}
+
+	dp.elasticIndexer.SaveValidatorsPubKeys(validatorsPubKeys, metaBlock.Epoch)
+}
+
+// prepareValidatorsForEpoch updates the nodes coordinators with the validators of the epoch started by the meta block
+func (dp *dataProcessor) prepareValidatorsForEpoch(metaBlock *block.MetaBlock, body *block.Body) {
+	if metaBlock.Epoch == 0 {
+		return
+	}
+
 	peerMiniBlocks := make([]*block.MiniBlock, 0)
 
 	for _, mb := range body.MiniBlocks {
@@ -345,14 +406,6 @@ func (dp *dataProcessor) processValidatorsForEpoch(metaBlock *block.MetaBlock, b
 	for shardID := range dp.nodesCoordinators {
 		dp.nodesCoordinators[shardID].EpochStartPrepare(metaBlock, peerBlock)
 	}
-
-	validatorsPubKeys, err := dp.nodesCoordinators[core.MetachainShardId].GetAllEligibleValidatorsPublicKeys(metaBlock.Epoch)
-	if err != nil || len(validatorsPubKeys) == 0 {
-		log.Warn("cannot get all eligible validatorsPubKeys", "epoch", metaBlock.Epoch)
-		return
-	}
-
-	dp.elasticIndexer.SaveValidatorsPubKeys(validatorsPubKeys, metaBlock.Epoch)
 }
 
 func (dp *dataProcessor) uniqueMiniBlocksSlice(mbs []*block.MiniBlock) []*block.MiniBlock {
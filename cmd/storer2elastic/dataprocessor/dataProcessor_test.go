@@ -103,6 +103,15 @@ func TestNewDataProcessor(t *testing.T) {
 			},
 			exError: dataprocessor.ErrNilRatingProcessor,
 		},
+		{
+			name: "NilProgressHandler",
+			argsFunc: func() dataprocessor.ArgsDataProcessor {
+				args := getDataProcessorArgs()
+				args.ProgressHandler = nil
+				return args
+			},
+			exError: dataprocessor.ErrNilProgressHandler,
+		},
 		{
 			name: "All arguments ok",
 			argsFunc: func() dataprocessor.ArgsDataProcessor {
@@ -148,6 +157,52 @@ func TestDataProcessor_Index(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDataProcessor_IndexShouldSkipTheStateOnlyRoundsAndSaveTheProgressOfTheIndexedRounds(t *testing.T) {
+	t.Parallel()
+
+	indexedNonces := make([]uint64, 0)
+	savedProgress := make([]storer2ElasticData.Progress, 0)
+	args := getDataProcessorArgs()
+	args.IndexerCacheSize = 1
+	args.ElasticIndexer = &mock.ElasticIndexerStub{
+		SaveBlockCalled: func(_ data.BodyHandler, header data.HeaderHandler, _ map[string]data.TransactionHandler, _ []uint64, _ []string, _ []byte) {
+			indexedNonces = append(indexedNonces, header.GetNonce())
+		},
+	}
+	args.ProgressHandler = &mock.ProgressHandlerStub{
+		SaveProgressCalled: func(progress storer2ElasticData.Progress) error {
+			savedProgress = append(savedProgress, progress)
+			return nil
+		},
+	}
+	args.DataReplayer = &mock.DataReplayerStub{
+		RangeCalled: func(handler func(persistedData storer2ElasticData.RoundPersistedData) bool) error {
+			handler(storer2ElasticData.RoundPersistedData{
+				MetaBlockData: &storer2ElasticData.HeaderData{Header: &block.MetaBlock{Nonce: 0}, Body: &block.Body{}},
+				SkipIndexing:  true,
+			})
+			for nonce := uint64(1); nonce <= 4; nonce++ {
+				handler(storer2ElasticData.RoundPersistedData{
+					MetaBlockData: &storer2ElasticData.HeaderData{
+						Header:           &block.MetaBlock{Nonce: nonce, PrevRandSeed: []byte("seed"), RandSeed: []byte("seed")},
+						Body:             &block.Body{},
+						BodyTransactions: map[string]data.TransactionHandler{},
+					},
+				})
+			}
+			return nil
+		},
+	}
+	dp, _ := dataprocessor.NewDataProcessor(args)
+
+	err := dp.Index()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3, 4}, indexedNonces)
+	// the last IndexerCacheSize+1 rounds might still be in the indexer's queue
+	expectedProgress := []storer2ElasticData.Progress{{Epoch: 0, MetaNonce: 1}, {Epoch: 0, MetaNonce: 2}}
+	require.Equal(t, expectedProgress, savedProgress)
+}
+
 func getDataProcessorArgs() dataprocessor.ArgsDataProcessor {
 	return dataprocessor.ArgsDataProcessor{
 		ElasticIndexer: &mock.ElasticIndexerStub{},
@@ -237,6 +292,8 @@ func getDataProcessorArgs() dataprocessor.ArgsDataProcessor {
 				},
 			},
 		},
-		StartingEpoch: 0,
+		FromEpoch:        0,
+		ProgressHandler:  &mock.ProgressHandlerStub{},
+		IndexerCacheSize: 0,
 	}
 }
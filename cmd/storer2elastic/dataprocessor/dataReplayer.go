@@ -28,15 +28,30 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
+// roundsBufferSize defines how many rounds an epoch worker can read ahead of the rounds' handler
+const roundsBufferSize = 100
+
 type dataReplayer struct {
 	databaseReader    DatabaseReaderHandler
+	persisters        *sharedPersisters
 	generalConfig     config.Config
 	shardCoordinator  sharding.Coordinator
 	marshalizer       marshal.Marshalizer
 	uint64Converter   typeConverters.Uint64ByteSliceConverter
 	headerMarshalizer HeaderMarshalizerHandler
 	emptyReceiptHash  []byte
-	startingEpoch     uint32
+	fromEpoch         uint32
+	toEpoch           uint32
+	numWorkers        int
+	lastProgress      *storer2ElasticData.Progress
+}
+
+// epochReplay holds the rounds read by a worker for an epoch. The error is set before the rounds channel is closed
+type epochReplay struct {
+	record              *databasereader.DatabaseInfo
+	onlyValidatorsState bool
+	rounds              chan storer2ElasticData.RoundPersistedData
+	err                 error
 }
 
 type persistersHolder struct {
@@ -62,7 +77,10 @@ type DataReplayerArgs struct {
 	Hasher                   hashing.Hasher
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
 	HeaderMarshalizer        HeaderMarshalizerHandler
-	StartingEpoch            uint32
+	FromEpoch                uint32
+	ToEpoch                  uint32
+	NumWorkers               int
+	LastProgress             *storer2ElasticData.Progress
 }
 
 // NewDataReplayer returns a new instance of dataReplayer
@@ -85,6 +103,12 @@ func NewDataReplayer(args DataReplayerArgs) (*dataReplayer, error) {
 	if check.IfNil(args.HeaderMarshalizer) {
 		return nil, ErrNilHeaderMarshalizer
 	}
+	if args.NumWorkers < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidNumberOfWorkers, args.NumWorkers)
+	}
+	if args.ToEpoch < args.FromEpoch {
+		return nil, fmt.Errorf("%w: from epoch %d, to epoch %d", ErrInvalidEpochsWindow, args.FromEpoch, args.ToEpoch)
+	}
 
 	emptyReceiptHash, err := core.CalculateHash(args.Marshalizer, args.Hasher, &batch.Batch{Data: [][]byte{}})
 	if err != nil {
@@ -93,13 +117,17 @@ func NewDataReplayer(args DataReplayerArgs) (*dataReplayer, error) {
 
 	return &dataReplayer{
 		databaseReader:    args.DatabaseReader,
+		persisters:        newSharedPersisters(args.DatabaseReader),
 		generalConfig:     args.GeneralConfig,
 		shardCoordinator:  args.ShardCoordinator,
 		marshalizer:       args.Marshalizer,
 		uint64Converter:   args.Uint64ByteSliceConverter,
 		headerMarshalizer: args.HeaderMarshalizer,
 		emptyReceiptHash:  emptyReceiptHash,
-		startingEpoch:     args.StartingEpoch,
+		fromEpoch:         args.FromEpoch,
+		toEpoch:           args.ToEpoch,
+		numWorkers:        args.NumWorkers,
+		lastProgress:      args.LastProgress,
 	}, nil
 }
 
@@ -145,19 +173,155 @@ func (dr *dataReplayer) startIndexing(errChan chan error, persistedDataHandler f
 		return
 	}
 
+	errChan <- dr.replayEpochs(dr.createEpochReplays(metachainRecords), records, persistedDataHandler)
+}
+
+func (dr *dataReplayer) createEpochReplays(metachainRecords []*databasereader.DatabaseInfo) []*epochReplay {
+	firstEpochToIndex := dr.fromEpoch
+	if dr.lastProgress != nil && dr.lastProgress.Epoch > firstEpochToIndex {
+		firstEpochToIndex = dr.lastProgress.Epoch
+	}
+
+	replays := make([]*epochReplay, 0, len(metachainRecords))
 	for _, metaDB := range metachainRecords {
-		if metaDB.Epoch < dr.startingEpoch {
+		if metaDB.Epoch > dr.toEpoch {
 			continue
 		}
 
-		err = dr.processMetaChainDatabase(metaDB, records, persistedDataHandler)
-		if err != nil {
-			errChan <- err
-			return
+		replays = append(replays, &epochReplay{
+			record: metaDB,
+			// the validators of an epoch are computed starting from all the previous epoch start blocks
+			onlyValidatorsState: metaDB.Epoch < firstEpochToIndex,
+			rounds:              make(chan storer2ElasticData.RoundPersistedData, roundsBufferSize),
+		})
+	}
+
+	return replays
+}
+
+// replayEpochs reads the epochs in parallel but calls the handler with the rounds in order, epoch after epoch. A worker
+// can not read more than roundsBufferSize rounds ahead, so the memory usage is bounded
+func (dr *dataReplayer) replayEpochs(
+	replays []*epochReplay,
+	dbsInfo []*databasereader.DatabaseInfo,
+	persistedDataHandler func(persistedData storer2ElasticData.RoundPersistedData) bool,
+) error {
+	stopChan := make(chan struct{})
+	defer close(stopChan)
+
+	startedReplays := make(chan *epochReplay, len(replays))
+	go func() {
+		defer close(startedReplays)
+
+		workerSlots := make(chan struct{}, dr.numWorkers)
+		for _, replay := range replays {
+			select {
+			case workerSlots <- struct{}{}:
+			case <-stopChan:
+				return
+			}
+
+			startedReplays <- replay
+			go func(replay *epochReplay) {
+				dr.replayEpoch(replay, dbsInfo, stopChan)
+				<-workerSlots
+			}(replay)
 		}
+	}()
+
+	for replay := range startedReplays {
+		for roundData := range replay.rounds {
+			if !persistedDataHandler(roundData) {
+				return ErrRangeIsOver
+			}
+		}
+		if replay.err != nil {
+			return replay.err
+		}
+	}
+
+	return nil
+}
+
+func (dr *dataReplayer) replayEpoch(replay *epochReplay, dbsInfo []*databasereader.DatabaseInfo, stopChan chan struct{}) {
+	defer close(replay.rounds)
+
+	handler := func(persistedData storer2ElasticData.RoundPersistedData) bool {
+		select {
+		case replay.rounds <- persistedData:
+			return true
+		case <-stopChan:
+			return false
+		}
+	}
+
+	if replay.onlyValidatorsState {
+		replay.err = dr.processEpochStartForValidatorsState(replay.record, handler)
+		return
 	}
 
-	errChan <- nil
+	replay.err = dr.processMetaChainDatabase(replay.record, dbsInfo, handler)
+}
+
+func (dr *dataReplayer) processEpochStartForValidatorsState(
+	record *databasereader.DatabaseInfo,
+	persistedDataHandler func(persistedData storer2ElasticData.RoundPersistedData) bool,
+) error {
+	metaHeadersPersisters, err := dr.prepareMetaPersistersHolder(record)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		dr.closeMetaPersisters(metaHeadersPersisters)
+	}()
+
+	metachainPersisters, err := dr.preparePersistersHolder(record)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		dr.closePersisters(metachainPersisters)
+	}()
+
+	epochStartMetaBlock, err := dr.getEpochStartMetaBlock(record, metaHeadersPersisters)
+	if err != nil {
+		return err
+	}
+
+	roundData, err := dr.createValidatorsStateRound(epochStartMetaBlock, metachainPersisters)
+	if err != nil {
+		return err
+	}
+	if !persistedDataHandler(*roundData) {
+		return ErrRangeIsOver
+	}
+
+	return nil
+}
+
+func (dr *dataReplayer) createValidatorsStateRound(
+	metaBlock *block.MetaBlock,
+	persisters *persistersHolder,
+) (*storer2ElasticData.RoundPersistedData, error) {
+	metaHdrData, err := dr.processHeader(persisters, metaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storer2ElasticData.RoundPersistedData{
+		MetaBlockData: metaHdrData,
+		ShardHeaders:  make(map[uint32][]*storer2ElasticData.HeaderData),
+		SkipIndexing:  true,
+	}, nil
+}
+
+// getLastIndexedNonce returns the nonce of the last meta block indexed by a previous run in the provided epoch
+func (dr *dataReplayer) getLastIndexedNonce(epoch uint32) (uint64, bool) {
+	if dr.lastProgress == nil || dr.lastProgress.Epoch != epoch {
+		return 0, false
+	}
+
+	return dr.lastProgress.MetaNonce, true
 }
 
 func (dr *dataReplayer) processMetaChainDatabase(
@@ -206,13 +370,21 @@ func (dr *dataReplayer) processMetaChainDatabase(
 		return err
 	}
 
+	var roundData *storer2ElasticData.RoundPersistedData
 	startingNonce := epochStartMetaBlock.Nonce
-	roundData, err := dr.processMetaBlock(
-		epochStartMetaBlock,
-		dbsInfo,
-		metachainPersisters,
-		shardPersistersHolder,
-	)
+	lastIndexedNonce, isResumed := dr.getLastIndexedNonce(record.Epoch)
+	if isResumed && lastIndexedNonce >= startingNonce {
+		log.Info("resuming indexing", "epoch", record.Epoch, "last indexed meta nonce", lastIndexedNonce)
+		roundData, err = dr.createValidatorsStateRound(epochStartMetaBlock, metachainPersisters)
+		startingNonce = lastIndexedNonce
+	} else {
+		roundData, err = dr.processMetaBlock(
+			epochStartMetaBlock,
+			dbsInfo,
+			metachainPersisters,
+			shardPersistersHolder,
+		)
+	}
 	if err != nil {
 		return err
 	}
@@ -250,13 +422,13 @@ func (dr *dataReplayer) processMetaChainDatabase(
 func (dr *dataReplayer) prepareMetaPersistersHolder(record *databasereader.DatabaseInfo) (*metaBlocksPersistersHolder, error) {
 	metaPersHolder := &metaBlocksPersistersHolder{}
 
-	metaBlocksUnit, err := dr.databaseReader.LoadPersister(record, dr.generalConfig.MetaBlockStorage.DB.FilePath)
+	metaBlocksUnit, err := dr.persisters.loadPersister(record, dr.generalConfig.MetaBlockStorage.DB.FilePath)
 	if err != nil {
 		return nil, err
 	}
 	metaPersHolder.metaBlocksPersister = metaBlocksUnit
 
-	headerHashNonceUnit, err := dr.databaseReader.LoadStaticPersister(record, dr.generalConfig.MetaHdrNonceHashStorage.DB.FilePath)
+	headerHashNonceUnit, err := dr.persisters.loadStaticPersister(record, dr.generalConfig.MetaHdrNonceHashStorage.DB.FilePath)
 	if err != nil {
 		return nil, err
 	}
@@ -596,37 +768,37 @@ func (dr *dataReplayer) getReceipt(holder *persistersHolder, txHash []byte) (dat
 func (dr *dataReplayer) preparePersistersHolder(dbInfo *databasereader.DatabaseInfo) (*persistersHolder, error) {
 	persHold := &persistersHolder{}
 
-	shardHeadersPersister, err := dr.databaseReader.LoadPersister(dbInfo, dr.generalConfig.BlockHeaderStorage.DB.FilePath)
+	shardHeadersPersister, err := dr.persisters.loadPersister(dbInfo, dr.generalConfig.BlockHeaderStorage.DB.FilePath)
 	if err != nil {
 		return nil, err
 	}
 	persHold.shardHeadersPersister = shardHeadersPersister
 
-	miniBlocksPersister, err := dr.databaseReader.LoadPersister(dbInfo, dr.generalConfig.MiniBlocksStorage.DB.FilePath)
+	miniBlocksPersister, err := dr.persisters.loadPersister(dbInfo, dr.generalConfig.MiniBlocksStorage.DB.FilePath)
 	if err != nil {
 		return nil, err
 	}
 	persHold.miniBlocksPersister = miniBlocksPersister
 
-	txsPersister, err := dr.databaseReader.LoadPersister(dbInfo, dr.generalConfig.TxStorage.DB.FilePath)
+	txsPersister, err := dr.persisters.loadPersister(dbInfo, dr.generalConfig.TxStorage.DB.FilePath)
 	if err != nil {
 		return nil, err
 	}
 	persHold.transactionPersister = txsPersister
 
-	uTxsPersister, err := dr.databaseReader.LoadPersister(dbInfo, dr.generalConfig.UnsignedTransactionStorage.DB.FilePath)
+	uTxsPersister, err := dr.persisters.loadPersister(dbInfo, dr.generalConfig.UnsignedTransactionStorage.DB.FilePath)
 	if err != nil {
 		return nil, err
 	}
 	persHold.unsignedTransactionsPersister = uTxsPersister
 
-	rTxsPersister, err := dr.databaseReader.LoadPersister(dbInfo, dr.generalConfig.RewardTxStorage.DB.FilePath)
+	rTxsPersister, err := dr.persisters.loadPersister(dbInfo, dr.generalConfig.RewardTxStorage.DB.FilePath)
 	if err != nil {
 		return nil, err
 	}
 	persHold.rewardTransactionsPersister = rTxsPersister
 
-	receiptsPersister, err := dr.databaseReader.LoadPersister(dbInfo, dr.generalConfig.ReceiptsStorage.DB.FilePath)
+	receiptsPersister, err := dr.persisters.loadPersister(dbInfo, dr.generalConfig.ReceiptsStorage.DB.FilePath)
 	if err != nil {
 		return nil, err
 	}
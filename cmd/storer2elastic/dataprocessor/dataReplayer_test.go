@@ -1,6 +1,9 @@
 package dataprocessor_test
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
@@ -79,6 +82,25 @@ func TestNewDataReplayer(t *testing.T) {
 			},
 			exError: dataprocessor.ErrNilHeaderMarshalizer,
 		},
+		{
+			name: "InvalidNumberOfWorkers",
+			argsFunc: func() dataprocessor.DataReplayerArgs {
+				args := getDataReplayArgs()
+				args.NumWorkers = 0
+				return args
+			},
+			exError: dataprocessor.ErrInvalidNumberOfWorkers,
+		},
+		{
+			name: "InvalidEpochsWindow",
+			argsFunc: func() dataprocessor.DataReplayerArgs {
+				args := getDataReplayArgs()
+				args.FromEpoch = 3
+				args.ToEpoch = 2
+				return args
+			},
+			exError: dataprocessor.ErrInvalidEpochsWindow,
+		},
 		{
 			name: "All arguments ok",
 			argsFunc: func() dataprocessor.DataReplayerArgs {
@@ -91,7 +113,7 @@ func TestNewDataReplayer(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			_, err := dataprocessor.NewDataReplayer(tt.argsFunc())
-			require.Equal(t, err, tt.exError)
+			require.True(t, errors.Is(err, tt.exError))
 		})
 	}
 }
@@ -263,6 +285,114 @@ func TestDataReplayer_Range_EpochStartMetaBlockFoundInNonceHashStorageShouldWork
 	_ = dr.Range(handlerFunc)
 }
 
+type replayedRound struct {
+	epoch        uint32
+	nonce        uint64
+	skipIndexing bool
+}
+
+// createThreeEpochsDatabaseReader creates a metachain database with 3 epochs, each epoch having the epoch start meta
+// block with the nonce 10*epoch and the following meta block
+func createThreeEpochsDatabaseReader(marshalizer *mock.MarshalizerMock) *mock.DatabaseReaderStub {
+	uint64ByteSliceConv := &mock.Uint64ByteSliceConverterMock{}
+	hdrHashNoncePersister := mock.NewPersisterMock()
+	metaBlocksPersisters := make(map[uint32]storage.Persister)
+	dbsInfo := make([]*databasereader.DatabaseInfo, 0)
+	for epoch := uint32(0); epoch < 3; epoch++ {
+		metaBlocksPersister := mock.NewPersisterMock()
+		for nonce := uint64(epoch) * 10; nonce <= uint64(epoch)*10+1; nonce++ {
+			metaBlock := &block.MetaBlock{Nonce: nonce, Epoch: epoch}
+			metaBlockBytes, _ := marshalizer.Marshal(metaBlock)
+			metaBlockHash := []byte(fmt.Sprintf("metaBlock%d", nonce))
+			_ = metaBlocksPersister.Put(metaBlockHash, metaBlockBytes)
+			_ = hdrHashNoncePersister.Put(uint64ByteSliceConv.ToByteSlice(nonce), metaBlockHash)
+			if nonce == uint64(epoch)*10 {
+				_ = metaBlocksPersister.Put([]byte(core.EpochStartIdentifier(epoch)), metaBlockBytes)
+			}
+		}
+
+		metaBlocksPersisters[epoch] = metaBlocksPersister
+		dbsInfo = append(dbsInfo,
+			&databasereader.DatabaseInfo{Epoch: epoch, Shard: core.MetachainShardId},
+			&databasereader.DatabaseInfo{Epoch: epoch, Shard: 0},
+		)
+	}
+
+	return &mock.DatabaseReaderStub{
+		GetDatabaseInfoCalled: func() ([]*databasereader.DatabaseInfo, error) {
+			return dbsInfo, nil
+		},
+		LoadPersisterCalled: func(dbInfo *databasereader.DatabaseInfo, unit string) (storage.Persister, error) {
+			if unit == "MetaBlock" {
+				return metaBlocksPersisters[dbInfo.Epoch], nil
+			}
+
+			return mock.NewPersisterMock(), nil
+		},
+		LoadStaticPersisterCalled: func(dbInfo *databasereader.DatabaseInfo, unit string) (storage.Persister, error) {
+			return hdrHashNoncePersister, nil
+		},
+	}
+}
+
+func replayThreeEpochs(t *testing.T, args dataprocessor.DataReplayerArgs) []replayedRound {
+	marshalizer := &mock.MarshalizerMock{}
+	args.DatabaseReader = createThreeEpochsDatabaseReader(marshalizer)
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{ShardID: 0, NumOfShards: 1}
+	realHeaderMarshalizer, _ := databasereader.NewHeaderMarshalizer(marshalizer)
+	args.HeaderMarshalizer = realHeaderMarshalizer
+	dr, err := dataprocessor.NewDataReplayer(args)
+	require.NoError(t, err)
+
+	rounds := make([]replayedRound, 0)
+	err = dr.Range(func(persistedData data.RoundPersistedData) bool {
+		rounds = append(rounds, replayedRound{
+			epoch:        persistedData.MetaBlockData.Header.GetEpoch(),
+			nonce:        persistedData.MetaBlockData.Header.GetNonce(),
+			skipIndexing: persistedData.SkipIndexing,
+		})
+		return true
+	})
+	require.NoError(t, err)
+
+	return rounds
+}
+
+func TestDataReplayer_Range_EpochsWindowShouldReplayOnlyTheValidatorsStateOfThePreviousEpochs(t *testing.T) {
+	t.Parallel()
+
+	args := getDataReplayArgs()
+	args.FromEpoch = 1
+	args.ToEpoch = 1
+	args.NumWorkers = 2
+
+	rounds := replayThreeEpochs(t, args)
+	expectedRounds := []replayedRound{
+		{epoch: 0, nonce: 0, skipIndexing: true},
+		{epoch: 1, nonce: 10},
+		{epoch: 1, nonce: 11},
+	}
+	require.Equal(t, expectedRounds, rounds)
+}
+
+func TestDataReplayer_Range_ParallelWorkersShouldResumeAfterTheLastProgress(t *testing.T) {
+	t.Parallel()
+
+	args := getDataReplayArgs()
+	args.NumWorkers = 3
+	args.LastProgress = &data.Progress{Epoch: 1, MetaNonce: 10}
+
+	rounds := replayThreeEpochs(t, args)
+	expectedRounds := []replayedRound{
+		{epoch: 0, nonce: 0, skipIndexing: true},
+		{epoch: 1, nonce: 10, skipIndexing: true},
+		{epoch: 1, nonce: 11},
+		{epoch: 2, nonce: 20},
+		{epoch: 2, nonce: 21},
+	}
+	require.Equal(t, expectedRounds, rounds)
+}
+
 func getDataReplayArgs() dataprocessor.DataReplayerArgs {
 	return dataprocessor.DataReplayerArgs{
 		GeneralConfig: nodeConfig.Config{
@@ -275,6 +405,9 @@ func getDataReplayArgs() dataprocessor.DataReplayerArgs {
 		Hasher:                   &mock.HasherMock{},
 		Uint64ByteSliceConverter: &mock.Uint64ByteSliceConverterMock{},
 		HeaderMarshalizer:        &mock.HeaderMarshalizerStub{},
+		FromEpoch:                0,
+		ToEpoch:                  math.MaxUint32,
+		NumWorkers:               1,
 	}
 }
 
@@ -0,0 +1,201 @@
+package dataprocessor
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	storer2ElasticData "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+const (
+	blocksIndex       = "blocks"
+	transactionsIndex = "transactions"
+)
+
+// ArgsDataValidator holds the arguments needed for creating a new dataValidator
+type ArgsDataValidator struct {
+	DatabaseClient DatabaseClientHandler
+	DataReplayer   DataReplayerHandler
+	Marshalizer    marshal.Marshalizer
+	Hasher         hashing.Hasher
+}
+
+type dataValidator struct {
+	databaseClient DatabaseClientHandler
+	dataReplayer   DataReplayerHandler
+	marshalizer    marshal.Marshalizer
+	hasher         hashing.Hasher
+	numChecked     map[string]int
+	numMissing     map[string]int
+}
+
+// NewDataValidator returns a new instance of dataValidator
+func NewDataValidator(args ArgsDataValidator) (*dataValidator, error) {
+	if check.IfNil(args.DatabaseClient) {
+		return nil, ErrNilDatabaseClient
+	}
+	if check.IfNil(args.DataReplayer) {
+		return nil, ErrNilDataReplayer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &dataValidator{
+		databaseClient: args.DatabaseClient,
+		dataReplayer:   args.DataReplayer,
+		marshalizer:    args.Marshalizer,
+		hasher:         args.Hasher,
+		numChecked:     make(map[string]int),
+		numMissing:     make(map[string]int),
+	}, nil
+}
+
+// Validate will check that the blocks and the transactions from the database are present in elastic search, without
+// indexing anything
+func (dv *dataValidator) Validate() error {
+	var validationErr error
+	err := dv.dataReplayer.Range(func(persistedData storer2ElasticData.RoundPersistedData) bool {
+		if persistedData.SkipIndexing {
+			return true
+		}
+
+		validationErr = dv.validateRound(persistedData)
+		return validationErr == nil
+	})
+	if err != nil {
+		return err
+	}
+	if validationErr != nil {
+		return validationErr
+	}
+
+	log.Info("validation done",
+		"checked blocks", dv.numChecked[blocksIndex], "missing blocks", dv.numMissing[blocksIndex],
+		"checked transactions", dv.numChecked[transactionsIndex], "missing transactions", dv.numMissing[transactionsIndex])
+	if dv.numMissing[blocksIndex] > 0 || dv.numMissing[transactionsIndex] > 0 {
+		return fmt.Errorf("%w: %d missing blocks, %d missing transactions", ErrValidationFailed,
+			dv.numMissing[blocksIndex], dv.numMissing[transactionsIndex])
+	}
+
+	return nil
+}
+
+func (dv *dataValidator) validateRound(persistedData storer2ElasticData.RoundPersistedData) error {
+	err := dv.validateHeaderData(persistedData.MetaBlockData)
+	if err != nil {
+		return err
+	}
+
+	for _, headersInShard := range persistedData.ShardHeaders {
+		for _, headerData := range headersInShard {
+			err = dv.validateHeaderData(headerData)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (dv *dataValidator) validateHeaderData(headerData *storer2ElasticData.HeaderData) error {
+	headerHash, err := core.CalculateHash(dv.marshalizer, dv.hasher, headerData.Header)
+	if err != nil {
+		return err
+	}
+
+	err = dv.checkDocuments(blocksIndex, []string{hex.EncodeToString(headerHash)}, headerData.Header.GetNonce())
+	if err != nil {
+		return err
+	}
+
+	if headerData.Body == nil {
+		return nil
+	}
+
+	txHashes := make([]string, 0)
+	for _, mb := range headerData.Body.MiniBlocks {
+		isIndexedAsTransaction := mb.Type == block.TxBlock || mb.Type == block.InvalidBlock || mb.Type == block.RewardsBlock
+		if !isIndexedAsTransaction {
+			continue
+		}
+
+		for _, txHash := range mb.TxHashes {
+			txHashes = append(txHashes, hex.EncodeToString(txHash))
+		}
+	}
+	if len(txHashes) == 0 {
+		return nil
+	}
+
+	return dv.checkDocuments(transactionsIndex, txHashes, headerData.Header.GetNonce())
+}
+
+func (dv *dataValidator) checkDocuments(index string, hashes []string, headerNonce uint64) error {
+	response, err := dv.databaseClient.DoMultiGet(getDocumentsByIDsQuery(hashes), index)
+	if err != nil {
+		return err
+	}
+
+	found := getFoundDocuments(response)
+	for _, hash := range hashes {
+		dv.numChecked[index]++
+		if found[hash] {
+			continue
+		}
+
+		dv.numMissing[index]++
+		log.Warn("document missing from elastic search", "index", index, "id", hash, "header nonce", headerNonce)
+	}
+
+	return nil
+}
+
+func getDocumentsByIDsQuery(hashes []string) map[string]interface{} {
+	docs := make([]interface{}, len(hashes))
+	for idx := range hashes {
+		docs[idx] = map[string]interface{}{
+			"_id":     hashes[idx],
+			"_source": false,
+		}
+	}
+
+	return map[string]interface{}{
+		"docs": docs,
+	}
+}
+
+func getFoundDocuments(response map[string]interface{}) map[string]bool {
+	found := make(map[string]bool)
+	docs, ok := response["docs"].([]interface{})
+	if !ok {
+		return found
+	}
+
+	for _, element := range docs {
+		doc, isMap := element.(map[string]interface{})
+		if !isMap {
+			continue
+		}
+
+		id, _ := doc["_id"].(string)
+		isFound, _ := doc["found"].(bool)
+		found[id] = isFound
+	}
+
+	return found
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dv *dataValidator) IsInterfaceNil() bool {
+	return dv == nil
+}
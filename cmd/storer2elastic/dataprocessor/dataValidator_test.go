@@ -0,0 +1,143 @@
+package dataprocessor_test
+
+import (
+	"errors"
+	"testing"
+
+	storer2ElasticData "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
+	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/dataprocessor"
+	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/mock"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/require"
+)
+
+func getDataValidatorArgs() dataprocessor.ArgsDataValidator {
+	return dataprocessor.ArgsDataValidator{
+		DatabaseClient: &mock.DatabaseClientStub{},
+		DataReplayer:   &mock.DataReplayerStub{},
+		Marshalizer:    &mock.MarshalizerMock{},
+		Hasher:         &mock.HasherMock{},
+	}
+}
+
+func createValidationDataReplayer() *mock.DataReplayerStub {
+	return &mock.DataReplayerStub{
+		RangeCalled: func(handler func(persistedData storer2ElasticData.RoundPersistedData) bool) error {
+			handler(storer2ElasticData.RoundPersistedData{
+				MetaBlockData: &storer2ElasticData.HeaderData{Header: &block.MetaBlock{Nonce: 1}},
+				SkipIndexing:  true,
+			})
+			handler(storer2ElasticData.RoundPersistedData{
+				MetaBlockData: &storer2ElasticData.HeaderData{Header: &block.MetaBlock{Nonce: 2}},
+				ShardHeaders: map[uint32][]*storer2ElasticData.HeaderData{
+					0: {
+						{
+							Header: &block.Header{Nonce: 5},
+							Body: &block.Body{MiniBlocks: []*block.MiniBlock{
+								{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx")}},
+								{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr")}},
+							}},
+						},
+					},
+				},
+			})
+			return nil
+		},
+	}
+}
+
+func createMultiGetResponse(query map[string]interface{}, isMissing func(id string) bool) map[string]interface{} {
+	docs := make([]interface{}, 0)
+	for _, doc := range query["docs"].([]interface{}) {
+		id := doc.(map[string]interface{})["_id"].(string)
+		docs = append(docs, map[string]interface{}{
+			"_id":   id,
+			"found": !isMissing(id),
+		})
+	}
+
+	return map[string]interface{}{"docs": docs}
+}
+
+func TestNewDataValidator(t *testing.T) {
+	t.Parallel()
+
+	args := getDataValidatorArgs()
+	args.DatabaseClient = nil
+	dv, err := dataprocessor.NewDataValidator(args)
+	require.Nil(t, dv)
+	require.Equal(t, dataprocessor.ErrNilDatabaseClient, err)
+
+	args = getDataValidatorArgs()
+	args.DataReplayer = nil
+	dv, err = dataprocessor.NewDataValidator(args)
+	require.Nil(t, dv)
+	require.Equal(t, dataprocessor.ErrNilDataReplayer, err)
+
+	args = getDataValidatorArgs()
+	dv, err = dataprocessor.NewDataValidator(args)
+	require.NoError(t, err)
+	require.False(t, dv.IsInterfaceNil())
+}
+
+func TestDataValidator_ValidateAllDocumentsFoundShouldWork(t *testing.T) {
+	t.Parallel()
+
+	checkedIDs := make(map[string][]string)
+	args := getDataValidatorArgs()
+	args.DataReplayer = createValidationDataReplayer()
+	args.DatabaseClient = &mock.DatabaseClientStub{
+		DoMultiGetCalled: func(query map[string]interface{}, index string) (map[string]interface{}, error) {
+			response := createMultiGetResponse(query, func(_ string) bool {
+				return false
+			})
+			for _, doc := range response["docs"].([]interface{}) {
+				checkedIDs[index] = append(checkedIDs[index], doc.(map[string]interface{})["_id"].(string))
+			}
+
+			return response, nil
+		},
+	}
+	dv, _ := dataprocessor.NewDataValidator(args)
+
+	err := dv.Validate()
+	require.NoError(t, err)
+	// the meta block of the skipped round and the smart contract results are not checked
+	require.Equal(t, 2, len(checkedIDs["blocks"]))
+	require.Equal(t, []string{"7478"}, checkedIDs["transactions"])
+}
+
+func TestDataValidator_ValidateMissingDocumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDataValidatorArgs()
+	args.DataReplayer = createValidationDataReplayer()
+	args.DatabaseClient = &mock.DatabaseClientStub{
+		DoMultiGetCalled: func(query map[string]interface{}, index string) (map[string]interface{}, error) {
+			return createMultiGetResponse(query, func(id string) bool {
+				return index == "transactions"
+			}), nil
+		},
+	}
+	dv, _ := dataprocessor.NewDataValidator(args)
+
+	err := dv.Validate()
+	require.True(t, errors.Is(err, dataprocessor.ErrValidationFailed))
+}
+
+func TestDataValidator_ValidateDatabaseClientErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := getDataValidatorArgs()
+	args.DataReplayer = createValidationDataReplayer()
+	args.DatabaseClient = &mock.DatabaseClientStub{
+		DoMultiGetCalled: func(_ map[string]interface{}, _ string) (map[string]interface{}, error) {
+			return nil, expectedErr
+		},
+	}
+	dv, _ := dataprocessor.NewDataValidator(args)
+
+	err := dv.Validate()
+	require.Equal(t, expectedErr, err)
+}
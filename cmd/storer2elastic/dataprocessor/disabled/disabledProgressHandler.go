@@ -0,0 +1,26 @@
+package disabled
+
+import storer2ElasticData "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
+
+type disabledProgressHandler struct {
+}
+
+// NewProgressHandler will return a new instance of disabledProgressHandler
+func NewProgressHandler() *disabledProgressHandler {
+	return &disabledProgressHandler{}
+}
+
+// LoadProgress returns nil as there is no saved progress
+func (d *disabledProgressHandler) LoadProgress() (*storer2ElasticData.Progress, error) {
+	return nil, nil
+}
+
+// SaveProgress won't do anything
+func (d *disabledProgressHandler) SaveProgress(_ storer2ElasticData.Progress) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledProgressHandler) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -52,3 +52,21 @@ var ErrOsSignalIntercepted = errors.New("os signal intercepted")
 
 // ErrNilHandlerFunc signals that a nil handler function for raning has been provided
 var ErrNilHandlerFunc = errors.New("nil handler function for ranging")
+
+// ErrInvalidNumberOfWorkers signals that an invalid number of workers has been provided
+var ErrInvalidNumberOfWorkers = errors.New("invalid number of workers")
+
+// ErrInvalidEpochsWindow signals that the last epoch to be indexed is lower than the first one
+var ErrInvalidEpochsWindow = errors.New("invalid epochs window")
+
+// ErrNilProgressHandler signals that a nil progress handler has been provided
+var ErrNilProgressHandler = errors.New("nil progress handler")
+
+// ErrEmptyProgressFilePath signals that an empty path for the progress file has been provided
+var ErrEmptyProgressFilePath = errors.New("empty progress file path")
+
+// ErrNilDatabaseClient signals that a nil database client has been provided
+var ErrNilDatabaseClient = errors.New("nil database client")
+
+// ErrValidationFailed signals that the elastic search contents do not match the data found in storage
+var ErrValidationFailed = errors.New("validation failed")
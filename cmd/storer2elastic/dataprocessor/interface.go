@@ -49,3 +49,16 @@ type RatingProcessorHandler interface {
 	IndexRatingsForEpochStartMetaBlock(metaBlock *block.MetaBlock) error
 	IsInterfaceNil() bool
 }
+
+// ProgressHandler defines the actions that a component which persists the indexing progress has to do
+type ProgressHandler interface {
+	LoadProgress() (*storer2ElasticData.Progress, error)
+	SaveProgress(progress storer2ElasticData.Progress) error
+	IsInterfaceNil() bool
+}
+
+// DatabaseClientHandler defines the actions that an elastic search client used for validation has to do
+type DatabaseClientHandler interface {
+	DoMultiGet(query map[string]interface{}, index string) (map[string]interface{}, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,64 @@
+package dataprocessor
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	storer2ElasticData "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+const progressFilePermissions = 0644
+
+type fileProgressHandler struct {
+	filePath string
+}
+
+// NewFileProgressHandler returns a progress handler that keeps the indexing progress in a json file
+func NewFileProgressHandler(filePath string) (*fileProgressHandler, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptyProgressFilePath
+	}
+
+	return &fileProgressHandler{
+		filePath: filePath,
+	}, nil
+}
+
+// LoadProgress returns the progress saved by a previous run or nil if there is none
+func (fph *fileProgressHandler) LoadProgress() (*storer2ElasticData.Progress, error) {
+	if !core.DoesFileExist(fph.filePath) {
+		return nil, nil
+	}
+
+	progress := &storer2ElasticData.Progress{}
+	err := core.LoadJsonFile(progress, fph.filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return progress, nil
+}
+
+// SaveProgress will persist the provided progress. The file is replaced atomically so a crash while saving will not
+// corrupt the previous progress
+func (fph *fileProgressHandler) SaveProgress(progress storer2ElasticData.Progress) error {
+	buff, err := json.Marshal(&progress)
+	if err != nil {
+		return err
+	}
+
+	tempFilePath := fph.filePath + ".tmp"
+	err = ioutil.WriteFile(tempFilePath, buff, progressFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, fph.filePath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fph *fileProgressHandler) IsInterfaceNil() bool {
+	return fph == nil
+}
@@ -0,0 +1,57 @@
+package dataprocessor_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	storer2ElasticData "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
+	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/dataprocessor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFileProgressHandler_EmptyPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ph, err := dataprocessor.NewFileProgressHandler("")
+	require.Nil(t, ph)
+	require.Equal(t, dataprocessor.ErrEmptyProgressFilePath, err)
+}
+
+func TestFileProgressHandler_LoadProgressNoFileShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "progress")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	ph, _ := dataprocessor.NewFileProgressHandler(filepath.Join(dir, "progress.json"))
+	progress, err := ph.LoadProgress()
+	require.NoError(t, err)
+	require.Nil(t, progress)
+}
+
+func TestFileProgressHandler_SaveAndLoadProgress(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "progress")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	filePath := filepath.Join(dir, "progress.json")
+	ph, _ := dataprocessor.NewFileProgressHandler(filePath)
+	err = ph.SaveProgress(storer2ElasticData.Progress{Epoch: 2, MetaNonce: 37})
+	require.NoError(t, err)
+	err = ph.SaveProgress(storer2ElasticData.Progress{Epoch: 3, MetaNonce: 45})
+	require.NoError(t, err)
+
+	reloadedHandler, _ := dataprocessor.NewFileProgressHandler(filePath)
+	progress, err := reloadedHandler.LoadProgress()
+	require.NoError(t, err)
+	require.Equal(t, &storer2ElasticData.Progress{Epoch: 3, MetaNonce: 45}, progress)
+}
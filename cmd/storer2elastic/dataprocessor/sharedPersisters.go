@@ -0,0 +1,104 @@
+package dataprocessor
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/databasereader"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+type refCountedPersister struct {
+	persister storage.Persister
+	numUsers  int
+}
+
+// sharedPersisters allows the epochs replayed in parallel to use the same persister, as a database can not be opened
+// twice at the same time. A persister is closed when its last user closes it
+type sharedPersisters struct {
+	databaseReader DatabaseReaderHandler
+	mut            sync.Mutex
+	persisters     map[string]*refCountedPersister
+}
+
+func newSharedPersisters(databaseReader DatabaseReaderHandler) *sharedPersisters {
+	return &sharedPersisters{
+		databaseReader: databaseReader,
+		persisters:     make(map[string]*refCountedPersister),
+	}
+}
+
+func (sp *sharedPersisters) loadPersister(dbInfo *databasereader.DatabaseInfo, unit string) (storage.Persister, error) {
+	key := fmt.Sprintf("epoch_%d_shard_%d_%s", dbInfo.Epoch, dbInfo.Shard, unit)
+	return sp.load(key, func() (storage.Persister, error) {
+		return sp.databaseReader.LoadPersister(dbInfo, unit)
+	})
+}
+
+func (sp *sharedPersisters) loadStaticPersister(dbInfo *databasereader.DatabaseInfo, unit string) (storage.Persister, error) {
+	// the static databases are not split by epoch
+	key := fmt.Sprintf("static_shard_%d_%s", dbInfo.Shard, unit)
+	return sp.load(key, func() (storage.Persister, error) {
+		return sp.databaseReader.LoadStaticPersister(dbInfo, unit)
+	})
+}
+
+func (sp *sharedPersisters) load(key string, loadHandler func() (storage.Persister, error)) (storage.Persister, error) {
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	shared, ok := sp.persisters[key]
+	if !ok {
+		persister, err := loadHandler()
+		if err != nil {
+			return nil, err
+		}
+
+		shared = &refCountedPersister{persister: persister}
+		sp.persisters[key] = shared
+	}
+
+	shared.numUsers++
+
+	return &sharedPersister{
+		Persister: shared.persister,
+		key:       key,
+		owner:     sp,
+	}, nil
+}
+
+func (sp *sharedPersisters) release(key string) error {
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	shared, ok := sp.persisters[key]
+	if !ok {
+		return nil
+	}
+
+	shared.numUsers--
+	if shared.numUsers > 0 {
+		return nil
+	}
+
+	delete(sp.persisters, key)
+
+	return shared.persister.Close()
+}
+
+type sharedPersister struct {
+	storage.Persister
+	key       string
+	owner     *sharedPersisters
+	closeOnce sync.Once
+}
+
+// Close will close the underlying persister only if there is no other user of it
+func (sp *sharedPersister) Close() error {
+	var err error
+	sp.closeOnce.Do(func() {
+		err = sp.owner.release(sp.key)
+	})
+
+	return err
+}
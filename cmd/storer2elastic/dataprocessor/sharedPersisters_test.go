@@ -0,0 +1,51 @@
+package dataprocessor
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/databasereader"
+	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/require"
+)
+
+type closeCounterPersister struct {
+	storage.Persister
+	numCloseCalls int
+}
+
+func (ccp *closeCounterPersister) Close() error {
+	ccp.numCloseCalls++
+	return nil
+}
+
+func TestSharedPersisters_ShouldLoadOnceAndCloseAfterTheLastUser(t *testing.T) {
+	t.Parallel()
+
+	numLoads := 0
+	persister := &closeCounterPersister{Persister: mock.NewPersisterMock()}
+	databaseReader := &mock.DatabaseReaderStub{
+		LoadPersisterCalled: func(dbInfo *databasereader.DatabaseInfo, unit string) (storage.Persister, error) {
+			numLoads++
+			return persister, nil
+		},
+	}
+
+	sp := newSharedPersisters(databaseReader)
+	dbInfo := &databasereader.DatabaseInfo{Epoch: 1, Shard: 0}
+	first, err := sp.loadPersister(dbInfo, "MiniBlocks")
+	require.NoError(t, err)
+	second, err := sp.loadPersister(dbInfo, "MiniBlocks")
+	require.NoError(t, err)
+	require.Equal(t, 1, numLoads)
+
+	_ = first.Close()
+	_ = first.Close()
+	require.Equal(t, 0, persister.numCloseCalls)
+
+	_ = second.Close()
+	require.Equal(t, 1, persister.numCloseCalls)
+
+	_, _ = sp.loadPersister(dbInfo, "MiniBlocks")
+	require.Equal(t, 2, numLoads)
+}
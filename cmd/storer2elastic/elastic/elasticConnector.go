@@ -8,10 +8,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	indexerDisabled "github.com/ElrondNetwork/elrond-go/core/indexer/disabled"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/factory"
 	bootstrapDisabled "github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/elastic/go-elasticsearch/v7"
 )
 
 // ConnectorFactoryArgs holds the data needed for creating a new elastic search connector factory
@@ -59,7 +63,7 @@ func NewConnectorFactory(args ConnectorFactoryArgs) (*elasticSearchConnectorFact
 func (escf *elasticSearchConnectorFactory) Create() (indexer.Indexer, error) {
 	indexerFactoryArgs := &factory.ArgsIndexerFactory{
 		Url:                      escf.elasticConfig.URL,
-		IndexerCacheSize:         100,
+		IndexerCacheSize:         escf.elasticConfig.IndexerCacheSize,
 		UserName:                 escf.elasticConfig.Username,
 		Password:                 escf.elasticConfig.Password,
 		Marshalizer:              escf.marshalizer,
@@ -72,11 +76,24 @@ func (escf *elasticSearchConnectorFactory) Create() (indexer.Indexer, error) {
 			UseKibana: false,
 		},
 		EnabledIndexes: []string{"blocks", "miniblocks", "transactions", "tps", "rounds", "rating", "validators"},
+		// the tool replays the blocks from storage by itself, so the indexer has nothing to back-fill
+		BlocksProvider:      indexerDisabled.NewBlocksProvider(),
+		PendingBlocksStorer: storageUnit.NewNilStorer(),
+		StatusHandler:       statusHandler.NewNilStatusHandler(),
 	}
 
 	return factory.NewIndexer(indexerFactoryArgs)
 }
 
+// CreateDatabaseClient will create and return a new elastic search client, used for reading the indexed data
+func (escf *elasticSearchConnectorFactory) CreateDatabaseClient() (indexer.DatabaseClientHandler, error) {
+	return indexer.NewElasticClient(elasticsearch.Config{
+		Addresses: []string{escf.elasticConfig.URL},
+		Username:  escf.elasticConfig.Username,
+		Password:  escf.elasticConfig.Password,
+	})
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (escf *elasticSearchConnectorFactory) IsInterfaceNil() bool {
 	return escf == nil
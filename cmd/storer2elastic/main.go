@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -10,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/config"
 	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/databasereader"
 	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/dataprocessor"
+	dataProcessorDisabled "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/dataprocessor/disabled"
 	"github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/elastic"
 	nodeConfigPackage "github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -32,7 +34,10 @@ type flags struct {
 	nodeConfigFilePath   string
 	ratingConfigFilePath string
 	nodesSetupFilePath   string
-	startingEpoch        int
+	fromEpoch            int
+	toEpoch              int
+	numWorkers           int
+	dryRun               bool
 }
 
 var (
@@ -91,11 +96,35 @@ VERSION:
 		Destination: &flagsValues.nodesSetupFilePath,
 	}
 
-	startingEpochFlag = cli.IntFlag{
-		Name:        "starting-epoch",
+	// fromEpochFlag defines a flag which holds the first epoch to be indexed
+	fromEpochFlag = cli.IntFlag{
+		Name:        "from-epoch, starting-epoch",
 		Usage:       "This uint flag specifies the epoch to start when indexing",
 		Value:       0,
-		Destination: &flagsValues.startingEpoch,
+		Destination: &flagsValues.fromEpoch,
+	}
+
+	// toEpochFlag defines a flag which holds the last epoch to be indexed
+	toEpochFlag = cli.IntFlag{
+		Name:        "to-epoch",
+		Usage:       "This int flag specifies the last epoch to be indexed. A negative value means all the epochs",
+		Value:       -1,
+		Destination: &flagsValues.toEpoch,
+	}
+
+	// numWorkersFlag defines a flag which holds the number of epochs read in parallel
+	numWorkersFlag = cli.IntFlag{
+		Name:        "num-workers",
+		Usage:       "This int flag specifies the number of epochs read from the database in parallel. Overrides the config value",
+		Value:       0,
+		Destination: &flagsValues.numWorkers,
+	}
+
+	// dryRunFlag defines a flag which enables the validation mode
+	dryRunFlag = cli.BoolFlag{
+		Name:        "dry-run",
+		Usage:       "If set, nothing will be indexed. The blocks and the transactions from the database will be checked against elastic search",
+		Destination: &flagsValues.dryRun,
 	}
 
 	flagsValues = &flags{}
@@ -137,7 +166,10 @@ func initCliFlags() {
 		nodeConfigFilePathFlag,
 		ratingsConfigFilePathFlag,
 		nodesSetupFilePathFlag,
-		startingEpochFlag,
+		fromEpochFlag,
+		toEpochFlag,
+		numWorkersFlag,
+		dryRunFlag,
 	}
 	cliApp.Authors = []cli.Author{
 		{
@@ -164,6 +196,13 @@ func startStorer2Elastic(ctx *cli.Context) error {
 	if ctx.IsSet(nodeConfigFilePathFlag.Name) {
 		configuration.General.NodeConfigFilePath = ctx.GlobalString(nodeConfigFilePathFlag.Name)
 	}
+	if ctx.IsSet(numWorkersFlag.Name) {
+		configuration.General.NumWorkers = flagsValues.numWorkers
+	}
+	toEpoch := uint32(math.MaxUint32)
+	if flagsValues.toEpoch >= 0 {
+		toEpoch = uint32(flagsValues.toEpoch)
+	}
 
 	err = core.LoadTomlFile(&nodeConfig, configuration.General.NodeConfigFilePath)
 	if err != nil {
@@ -227,11 +266,6 @@ func startStorer2Elastic(ctx *cli.Context) error {
 		return err
 	}
 
-	elasticIndexer, err := elasticConnectorFactory.Create()
-	if err != nil {
-		return fmt.Errorf("error connecting to elastic: %w", err)
-	}
-
 	// TODO: maybe use custom configs from node config instead of a general configuration
 	generalDBConfig := config.DBConfig{
 		Type:              string(storageUnit.LvlDBSerial),
@@ -253,27 +287,22 @@ func startStorer2Elastic(ctx *cli.Context) error {
 		return err
 	}
 
-	ratingsProcessor, err := dataprocessor.NewRatingsProcessor(
-		dataprocessor.RatingProcessorArgs{
-			ShardCoordinator:         shardCoordinator,
-			ValidatorPubKeyConverter: validatorPubKeyConverter,
-			DbPathWithChainID:        dbPathWithChainID,
-			GeneralConfig:            nodeConfig,
-			Marshalizer:              marshalizer,
-			Hasher:                   hasher,
-			ElasticIndexer:           elasticIndexer,
-			GenesisNodesConfig:       genesisNodesConfig,
-			RatingsConfig:            ratingsConfig,
-		},
-	)
+	headerMarshalizer, err := databasereader.NewHeaderMarshalizer(marshalizer)
 	if err != nil {
 		return err
 	}
 
-	headerMarshalizer, err := databasereader.NewHeaderMarshalizer(marshalizer)
+	progressHandler, err := createProgressHandler(configuration.General.ProgressFilePath)
 	if err != nil {
 		return err
 	}
+	lastProgress, err := progressHandler.LoadProgress()
+	if err != nil {
+		return err
+	}
+	if lastProgress != nil {
+		log.Info("resuming the indexing", "epoch", lastProgress.Epoch, "meta nonce", lastProgress.MetaNonce)
+	}
 
 	dataReplayerArgs := dataprocessor.DataReplayerArgs{
 		GeneralConfig:            nodeConfig,
@@ -283,7 +312,10 @@ func startStorer2Elastic(ctx *cli.Context) error {
 		Hasher:                   hasher,
 		Uint64ByteSliceConverter: uint64ByteSliceConverter,
 		HeaderMarshalizer:        headerMarshalizer,
-		StartingEpoch:            uint32(flagsValues.startingEpoch),
+		FromEpoch:                uint32(flagsValues.fromEpoch),
+		ToEpoch:                  toEpoch,
+		NumWorkers:               configuration.General.NumWorkers,
+		LastProgress:             lastProgress,
 	}
 
 	dataReplayer, err := dataprocessor.NewDataReplayer(dataReplayerArgs)
@@ -291,6 +323,37 @@ func startStorer2Elastic(ctx *cli.Context) error {
 		return err
 	}
 
+	if flagsValues.dryRun {
+		databaseClient, errCreate := elasticConnectorFactory.CreateDatabaseClient()
+		if errCreate != nil {
+			return fmt.Errorf("error connecting to elastic: %w", errCreate)
+		}
+
+		return validateIndexedData(databaseClient, dataReplayer)
+	}
+
+	elasticIndexer, err := elasticConnectorFactory.Create()
+	if err != nil {
+		return fmt.Errorf("error connecting to elastic: %w", err)
+	}
+
+	ratingsProcessor, err := dataprocessor.NewRatingsProcessor(
+		dataprocessor.RatingProcessorArgs{
+			ShardCoordinator:         shardCoordinator,
+			ValidatorPubKeyConverter: validatorPubKeyConverter,
+			DbPathWithChainID:        dbPathWithChainID,
+			GeneralConfig:            nodeConfig,
+			Marshalizer:              marshalizer,
+			Hasher:                   hasher,
+			ElasticIndexer:           elasticIndexer,
+			GenesisNodesConfig:       genesisNodesConfig,
+			RatingsConfig:            ratingsConfig,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
 	tpsBenchmarkUpdater, err := dataprocessor.NewTPSBenchmarkUpdater(genesisNodesConfig, elasticIndexer)
 	if err != nil {
 		return err
@@ -307,7 +370,9 @@ func startStorer2Elastic(ctx *cli.Context) error {
 			TPSBenchmarkUpdater: tpsBenchmarkUpdater,
 			RatingsProcessor:    ratingsProcessor,
 			RatingConfig:        ratingsConfig,
-			StartingEpoch:       uint32(flagsValues.startingEpoch),
+			FromEpoch:           uint32(flagsValues.fromEpoch),
+			ProgressHandler:     progressHandler,
+			IndexerCacheSize:    configuration.ElasticSearch.IndexerCacheSize,
 		})
 	if err != nil {
 		return err
@@ -321,3 +386,37 @@ func startStorer2Elastic(ctx *cli.Context) error {
 
 	return nil
 }
+
+func createProgressHandler(progressFilePath string) (dataprocessor.ProgressHandler, error) {
+	// a validation run should always check all the requested epochs
+	if flagsValues.dryRun {
+		return dataProcessorDisabled.NewProgressHandler(), nil
+	}
+
+	return dataprocessor.NewFileProgressHandler(progressFilePath)
+}
+
+func validateIndexedData(
+	databaseClient dataprocessor.DatabaseClientHandler,
+	dataReplayer dataprocessor.DataReplayerHandler,
+) error {
+	dataValidator, err := dataprocessor.NewDataValidator(
+		dataprocessor.ArgsDataValidator{
+			DatabaseClient: databaseClient,
+			DataReplayer:   dataReplayer,
+			Marshalizer:    marshalizer,
+			Hasher:         hasher,
+		})
+	if err != nil {
+		return err
+	}
+
+	err = dataValidator.Validate()
+	if err != nil {
+		return err
+	}
+
+	log.Info("finished validating. app will close")
+
+	return nil
+}
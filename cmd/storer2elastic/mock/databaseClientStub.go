@@ -0,0 +1,20 @@
+package mock
+
+// DatabaseClientStub -
+type DatabaseClientStub struct {
+	DoMultiGetCalled func(query map[string]interface{}, index string) (map[string]interface{}, error)
+}
+
+// DoMultiGet -
+func (d *DatabaseClientStub) DoMultiGet(query map[string]interface{}, index string) (map[string]interface{}, error) {
+	if d.DoMultiGetCalled != nil {
+		return d.DoMultiGetCalled(query, index)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (d *DatabaseClientStub) IsInterfaceNil() bool {
+	return d == nil
+}
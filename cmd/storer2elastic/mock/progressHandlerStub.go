@@ -0,0 +1,32 @@
+package mock
+
+import storer2ElasticData "github.com/ElrondNetwork/elrond-go/cmd/storer2elastic/data"
+
+// ProgressHandlerStub -
+type ProgressHandlerStub struct {
+	LoadProgressCalled func() (*storer2ElasticData.Progress, error)
+	SaveProgressCalled func(progress storer2ElasticData.Progress) error
+}
+
+// LoadProgress -
+func (p *ProgressHandlerStub) LoadProgress() (*storer2ElasticData.Progress, error) {
+	if p.LoadProgressCalled != nil {
+		return p.LoadProgressCalled()
+	}
+
+	return nil, nil
+}
+
+// SaveProgress -
+func (p *ProgressHandlerStub) SaveProgress(progress storer2ElasticData.Progress) error {
+	if p.SaveProgressCalled != nil {
+		return p.SaveProgressCalled(progress)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (p *ProgressHandlerStub) IsInterfaceNil() bool {
+	return p == nil
+}
@@ -169,6 +169,8 @@ type Config struct {
 	Versions              VersionsConfig
 	GasSchedule           GasScheduleConfig
 	Logs                  LogsConfig
+	AbiRegistry           AbiRegistryConfig
+	AccountStorageQuery   AccountStorageQueryConfig
 }
 
 // LogsConfig will hold settings related to the logging sub-system
@@ -176,6 +178,18 @@ type LogsConfig struct {
 	LogFileLifeSpanInSec int
 }
 
+// AccountStorageQueryConfig will hold settings related to the api queries listing the storage of an account
+type AccountStorageQueryConfig struct {
+	MaxNumKeys uint32
+}
+
+// AbiRegistryConfig will hold settings related to the contracts ABI registry
+type AbiRegistryConfig struct {
+	Directory         string
+	MaxAbiSizeInBytes uint32
+	MaxNumAbis        uint32
+}
+
 // StoragePruningConfig will hold settings related to storage pruning
 type StoragePruningConfig struct {
 	Enabled             bool
@@ -233,6 +247,7 @@ type GeneralSettingsConfig struct {
 	GenesisString                          string
 	GenesisMaxNumberOfShards               uint32
 	BlockGasAndFeesReCheckEnableEpoch      uint32
+	DynamicMinGasPriceEnableEpoch          uint32
 }
 
 // FacadeConfig will hold different configuration option that will be passed to the main ElrondFacade
@@ -309,6 +324,16 @@ type AntifloodConfig struct {
 	WebServer                 WebServerAntifloodConfig
 	Topic                     TopicAntifloodConfig
 	TxAccumulator             TxAccumulatorConfig
+	PeerReputation            PeerReputationConfig
+}
+
+// PeerReputationConfig will hold the persisted peer reputation parameters
+type PeerReputationConfig struct {
+	Enabled                bool
+	DecayCoefficient       float64
+	DecayIntervalInSeconds uint32
+	MaxBanMultiplier       uint32
+	Storage                StorageConfig
 }
 
 // FloodPreventerConfig will hold all flood preventer parameters
@@ -335,8 +360,16 @@ type IncreaseFactorConfig struct {
 
 // VirtualMachineServicesConfig holds configuration for the Virtual Machine(s): both querying and execution services.
 type VirtualMachineServicesConfig struct {
-	Execution VirtualMachineConfig
-	Querying  QueryVirtualMachineConfig
+	Execution        VirtualMachineConfig
+	Querying         QueryVirtualMachineConfig
+	ExecutionMetrics SCExecutionMetricsConfig
+}
+
+// SCExecutionMetricsConfig holds the configuration of the per contract execution metrics
+type SCExecutionMetricsConfig struct {
+	Enabled                  bool
+	MaxNumContracts          int
+	PublishIntervalInSeconds int
 }
 
 // VirtualMachineConfig holds configuration for a Virtual Machine service
@@ -349,6 +382,13 @@ type VirtualMachineConfig struct {
 type QueryVirtualMachineConfig struct {
 	VirtualMachineConfig
 	NumConcurrentVMs int
+	ResultsCache     QueryResultsCacheConfig
+}
+
+// QueryResultsCacheConfig holds the configuration of the cache storing the smart contract query results
+type QueryResultsCacheConfig struct {
+	Enabled bool
+	Cache   CacheConfig
 }
 
 // VirtualMachineOutOfProcessConfig holds configuration for out-of-process virtual machine(s)
@@ -386,12 +426,20 @@ type DbLookupExtensionsConfig struct {
 	MiniblockHashByTxHashStorageConfig StorageConfig
 	EpochByHashStorageConfig           StorageConfig
 	ResultsHashesByTxHashStorageConfig StorageConfig
+	BalanceHistoryEnabled              bool
+	BalanceHistoryStorageConfig        StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
 type DebugConfig struct {
 	InterceptorResolver InterceptorResolverDebugConfig
 	Antiflood           AntifloodDebugConfig
+	TransactionTracer   TransactionTracerDebugConfig
+}
+
+// TransactionTracerDebugConfig will hold the transaction tracer debug configuration
+type TransactionTracerDebugConfig struct {
+	Enabled bool
 }
 
 // HealthServiceConfig will hold health service (monitoring) configuration
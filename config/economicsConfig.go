@@ -34,9 +34,17 @@ type FeeSettings struct {
 	GasPriceModifier        float64
 }
 
+// DynamicMinGasPriceSettings will hold the settings of the congestion based minimum gas price
+type DynamicMinGasPriceSettings struct {
+	RoundsPerAdjustment      uint64
+	TargetBlockFullness      float64
+	MaxMinGasPriceMultiplier float64
+}
+
 // EconomicsConfig will hold economics config
 type EconomicsConfig struct {
-	GlobalSettings  GlobalSettings
-	RewardsSettings RewardsSettings
-	FeeSettings     FeeSettings
+	GlobalSettings             GlobalSettings
+	RewardsSettings            RewardsSettings
+	FeeSettings                FeeSettings
+	DynamicMinGasPriceSettings DynamicMinGasPriceSettings
 }
@@ -3,15 +3,36 @@ package config
 // ExternalConfig will hold the configurations for external tools, such as Explorer or Elastic Search
 type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
+	SQLIndexerConnector    SQLIndexerConfig
+	OutportConnector       OutportConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
 type ElasticSearchConfig struct {
-	Enabled          bool
-	IndexerCacheSize int
-	URL              string
-	UseKibana        bool
-	Username         string
-	Password         string
-	EnabledIndexes   []string
+	Enabled              bool
+	IndexerCacheSize     int
+	URL                  string
+	UseKibana            bool
+	Username             string
+	Password             string
+	EnabledIndexes       []string
+	PendingBlocksStorage StorageConfig
+}
+
+// SQLIndexerConfig will hold the configuration for the sql indexer
+type SQLIndexerConfig struct {
+	Enabled              bool
+	IndexerCacheSize     int
+	DriverName           string
+	DataSourceName       string
+	EnabledIndexes       []string
+	PendingBlocksStorage StorageConfig
+}
+
+// OutportConfig will hold the configuration for the outport stream driver
+type OutportConfig struct {
+	Enabled            bool
+	URL                string
+	RetryDurationInSec int
+	MaxPendingMessages int
 }
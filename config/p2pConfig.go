@@ -5,6 +5,10 @@ type P2PConfig struct {
 	Node                NodeConfig
 	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
 	Sharding            ShardingConfig
+	TrustedPeers        TrustedPeersConfig
+	PrivateNetwork      PrivateNetworkConfig
+	NATTraversal        NATTraversalConfig
+	OutgoingQoS         OutgoingQoSConfig
 }
 
 // NodeConfig will hold basic p2p settings
@@ -34,3 +38,33 @@ type ShardingConfig struct {
 	MaxCrossShardObservers  uint32
 	Type                    string
 }
+
+// TrustedPeersConfig will hold the peers that the node will always try to keep connected to
+type TrustedPeersConfig struct {
+	PeerList []string
+}
+
+// PrivateNetworkConfig will hold the private network settings
+type PrivateNetworkConfig struct {
+	Enabled        bool
+	AllowedPeerIDs []string
+}
+
+// NATTraversalConfig will hold the settings used by the node when it runs behind a NAT
+type NATTraversalConfig struct {
+	AnnouncedAddresses  []string
+	EnablePortMapping   bool
+	EnableNATService    bool
+	EnableRelayFallback bool
+	RelayPeers          []string
+}
+
+// OutgoingQoSConfig will hold the priorities settings used when broadcasting messages
+type OutgoingQoSConfig struct {
+	Enabled              bool
+	HighPriorityTopics   []string
+	LowPriorityTopics    []string
+	HighPriorityWeight   uint32
+	NormalPriorityWeight uint32
+	LowPriorityWeight    uint32
+}
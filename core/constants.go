@@ -306,6 +306,10 @@ const MetricProcessedProposedBlock = "erd_consensus_processed_proposed_block"
 // MetricMinGasPrice is the metric that specifies min gas price
 const MetricMinGasPrice = "erd_min_gas_price"
 
+// MetricCurrentMinGasPrice is the metric that specifies the min gas price currently accepted by the shard, which can be
+// higher than the configured min gas price when the congestion based min gas price is enabled
+const MetricCurrentMinGasPrice = "erd_current_min_gas_price"
+
 // MetricMinGasLimit is the metric that specifies the minimum gas limit
 const MetricMinGasLimit = "erd_min_gas_limit"
 
@@ -418,6 +422,15 @@ const (
 // MetricP2PPeerInfo is the metric for the node's p2p info
 const MetricP2PPeerInfo = "erd_p2p_peer_info"
 
+// MetricP2PReachability is the metric for the node's reachability as detected by the AutoNAT subsystem
+const MetricP2PReachability = "erd_p2p_reachability"
+
+// MetricP2PTopicBytesSentPrefix is the prefix of the metrics that hold the number of bytes sent on each topic
+const MetricP2PTopicBytesSentPrefix = "erd_p2p_topic_bytes_sent_"
+
+// MetricP2PTopicBytesReceivedPrefix is the prefix of the metrics that hold the number of bytes received on each topic
+const MetricP2PTopicBytesReceivedPrefix = "erd_p2p_topic_bytes_received_"
+
 // MetricP2PIntraShardValidators is the metric that outputs the intra-shard connected validators
 const MetricP2PIntraShardValidators = "erd_p2p_intra_shard_validators"
 
@@ -436,6 +449,35 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricIndexerLastIndexedNonce is the metric for the nonce of the last block saved by the indexer
+const MetricIndexerLastIndexedNonce = "erd_indexer_last_indexed_nonce"
+
+// MetricIndexerLag is the metric for the number of blocks committed by the node but not yet saved by the indexer
+const MetricIndexerLag = "erd_indexer_lag"
+
+// MetricIndexerPendingBlocks is the metric for the number of blocks waiting in the indexer's persisted queue
+const MetricIndexerPendingBlocks = "erd_indexer_pending_blocks"
+
+// MetricSCExecutionPrefix is the prefix of the per contract execution metrics. Each tracked contract is assigned a
+// slot and its metrics are stored under the metric name followed by "_" and the slot index
+const MetricSCExecutionPrefix = "erd_sc_execution_"
+
+// MetricSCExecutionAddress is the metric for the address of the contract tracked in an execution metrics slot
+const MetricSCExecutionAddress = MetricSCExecutionPrefix + "address"
+
+// MetricSCExecutionGasUsed is the metric for the gas consumed by the executions of a tracked contract
+const MetricSCExecutionGasUsed = MetricSCExecutionPrefix + "gas_used"
+
+// MetricSCExecutionNumCalls is the metric for the number of executions of a tracked contract
+const MetricSCExecutionNumCalls = MetricSCExecutionPrefix + "num_calls"
+
+// MetricSCExecutionNumFailures is the metric for the number of failed executions of a tracked contract
+const MetricSCExecutionNumFailures = MetricSCExecutionPrefix + "num_failures"
+
+// MetricSCExecutionTimeMs is the metric for the time in milliseconds spent by the virtual machines executing a
+// tracked contract
+const MetricSCExecutionTimeMs = MetricSCExecutionPrefix + "time_ms"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
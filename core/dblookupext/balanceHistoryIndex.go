@@ -0,0 +1,286 @@
+package dblookupext
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const recordedBlockKeyPrefix = "balanceHistoryBlock_"
+
+// BalanceChange holds the state of an account after the block containing one of its transactions was committed
+type BalanceChange struct {
+	Balance    string `json:"balance"`
+	Nonce      uint64 `json:"nonce"`
+	BlockNonce uint64 `json:"blockNonce"`
+	BlockHash  []byte `json:"blockHash"`
+	TxHash     []byte `json:"txHash"`
+}
+
+// BalanceHistory holds a page of the balance changes of an account, from the oldest to the newest
+type BalanceHistory struct {
+	Changes []*BalanceChange
+	Total   uint64
+}
+
+// recordedBlock holds the addresses whose balance changes were recorded for a block, so they can be removed if the
+// block is reverted
+type recordedBlock struct {
+	BlockHash []byte   `json:"blockHash"`
+	Addresses [][]byte `json:"addresses"`
+}
+
+// balanceHistoryIndex keeps, for each address, the number of recorded changes under the address key and the key of
+// each change under the address concatenated with its big endian index, so a page can be read without loading the
+// whole history. A change is stored under the address, the block nonce and the transaction hash, while the addresses
+// touched by a block are stored under the block nonce: recording a block again replaces its changes and reverting a
+// block removes them
+type balanceHistoryIndex struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+	accounts    state.AccountsAdapter
+}
+
+func newBalanceHistoryIndex(storer storage.Storer, accounts state.AccountsAdapter) *balanceHistoryIndex {
+	return &balanceHistoryIndex{
+		marshalizer: &marshal.JsonMarshalizer{},
+		storer:      storer,
+		accounts:    accounts,
+	}
+}
+
+// saveBalanceChanges records the balance of the senders and receivers of the provided transactions. It should be
+// called after the block was committed, as the balances are read from the accounts state. The changes previously
+// recorded for the same block nonce, either by the same block or by a block from a reverted fork, are replaced
+func (bhi *balanceHistoryIndex) saveBalanceChanges(blockNonce uint64, blockHash []byte, txs map[string]data.TransactionHandler) {
+	bhi.removeBlockChanges(blockNonce)
+
+	txHashesByAddress := groupTransactionHashesByAddress(txs)
+
+	addresses := make([]string, 0, len(txHashesByAddress))
+	for address := range txHashesByAddress {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	block := &recordedBlock{
+		BlockHash: blockHash,
+		Addresses: make([][]byte, 0, len(addresses)),
+	}
+	for _, address := range addresses {
+		accountHandler, err := bhi.accounts.GetExistingAccount([]byte(address))
+		if err != nil {
+			// the account belongs to another shard
+			continue
+		}
+
+		account, ok := accountHandler.(state.UserAccountHandler)
+		if !ok {
+			continue
+		}
+
+		bhi.removeChangesFromBlockNonce([]byte(address), blockNonce)
+		bhi.appendChanges([]byte(address), account, blockNonce, blockHash, txHashesByAddress[address])
+		block.Addresses = append(block.Addresses, []byte(address))
+	}
+
+	if len(block.Addresses) == 0 {
+		return
+	}
+
+	blockBytes, err := bhi.marshalizer.Marshal(block)
+	if err != nil {
+		return
+	}
+
+	err = bhi.storer.Put(recordedBlockKey(blockNonce), blockBytes)
+	if err != nil {
+		log.Warn("saveBalanceChanges() cannot save the addresses of the block", "error", err.Error())
+	}
+}
+
+// removeBlockChanges removes the balance changes recorded for the block with the provided nonce, if any
+func (bhi *balanceHistoryIndex) removeBlockChanges(blockNonce uint64) {
+	blockBytes, err := bhi.storer.Get(recordedBlockKey(blockNonce))
+	if err != nil {
+		return
+	}
+
+	block := &recordedBlock{}
+	err = bhi.marshalizer.Unmarshal(block, blockBytes)
+	if err != nil {
+		return
+	}
+
+	for _, address := range block.Addresses {
+		bhi.removeChangesFromBlockNonce(address, blockNonce)
+	}
+
+	err = bhi.storer.Remove(recordedBlockKey(blockNonce))
+	if err != nil {
+		log.Warn("removeBlockChanges() cannot remove the addresses of the block", "error", err.Error())
+	}
+}
+
+// removeChangesFromBlockNonce removes the newest changes of the address, down to the ones of the provided block nonce
+func (bhi *balanceHistoryIndex) removeChangesFromBlockNonce(address []byte, blockNonce uint64) {
+	numChanges := bhi.getNumChanges(address)
+	remainingChanges := numChanges
+	for remainingChanges > 0 {
+		key, err := bhi.storer.Get(indexKey(address, remainingChanges-1))
+		if err != nil || len(key) < len(address)+8 {
+			break
+		}
+		if binary.BigEndian.Uint64(key[len(address):len(address)+8]) < blockNonce {
+			break
+		}
+
+		_ = bhi.storer.Remove(key)
+		_ = bhi.storer.Remove(indexKey(address, remainingChanges-1))
+		remainingChanges--
+	}
+
+	if remainingChanges == numChanges {
+		return
+	}
+
+	bhi.saveNumChanges(address, remainingChanges)
+}
+
+func (bhi *balanceHistoryIndex) appendChanges(
+	address []byte,
+	account state.UserAccountHandler,
+	blockNonce uint64,
+	blockHash []byte,
+	txHashes []string,
+) {
+	numChanges := bhi.getNumChanges(address)
+	for _, txHash := range txHashes {
+		change := &BalanceChange{
+			Balance:    account.GetBalance().String(),
+			Nonce:      account.GetNonce(),
+			BlockNonce: blockNonce,
+			BlockHash:  blockHash,
+			TxHash:     []byte(txHash),
+		}
+
+		changeBytes, err := bhi.marshalizer.Marshal(change)
+		if err != nil {
+			continue
+		}
+
+		key := changeKey(address, blockNonce, []byte(txHash))
+		err = bhi.storer.Put(key, changeBytes)
+		if err != nil {
+			log.Warn("saveBalanceChanges() cannot save the balance change", "error", err.Error())
+			continue
+		}
+
+		err = bhi.storer.Put(indexKey(address, numChanges), key)
+		if err != nil {
+			log.Warn("saveBalanceChanges() cannot save the balance change index", "error", err.Error())
+			continue
+		}
+
+		numChanges++
+	}
+
+	bhi.saveNumChanges(address, numChanges)
+}
+
+func (bhi *balanceHistoryIndex) getBalanceHistory(address []byte, from uint64, size uint64) (*BalanceHistory, error) {
+	total := bhi.getNumChanges(address)
+	history := &BalanceHistory{
+		Changes: make([]*BalanceChange, 0),
+		Total:   total,
+	}
+
+	for index := from; index < total && index-from < size; index++ {
+		key, err := bhi.storer.Get(indexKey(address, index))
+		if err != nil {
+			return nil, err
+		}
+
+		changeBytes, err := bhi.storer.Get(key)
+		if err != nil {
+			return nil, err
+		}
+
+		change := &BalanceChange{}
+		err = bhi.marshalizer.Unmarshal(change, changeBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		history.Changes = append(history.Changes, change)
+	}
+
+	return history, nil
+}
+
+func (bhi *balanceHistoryIndex) getNumChanges(address []byte) uint64 {
+	numChangesBytes, err := bhi.storer.Get(address)
+	if err != nil || len(numChangesBytes) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(numChangesBytes)
+}
+
+func (bhi *balanceHistoryIndex) saveNumChanges(address []byte, numChanges uint64) {
+	err := bhi.storer.Put(address, uint64ToBytes(numChanges))
+	if err != nil {
+		log.Warn("saveBalanceChanges() cannot save the number of balance changes", "error", err.Error())
+	}
+}
+
+func groupTransactionHashesByAddress(txs map[string]data.TransactionHandler) map[string][]string {
+	txHashes := make([]string, 0, len(txs))
+	for txHash := range txs {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	txHashesByAddress := make(map[string][]string)
+	for _, txHash := range txHashes {
+		tx := txs[txHash]
+		sender := string(tx.GetSndAddr())
+		receiver := string(tx.GetRcvAddr())
+
+		if len(sender) > 0 {
+			txHashesByAddress[sender] = append(txHashesByAddress[sender], txHash)
+		}
+		if len(receiver) > 0 && receiver != sender {
+			txHashesByAddress[receiver] = append(txHashesByAddress[receiver], txHash)
+		}
+	}
+
+	return txHashesByAddress
+}
+
+func indexKey(address []byte, index uint64) []byte {
+	return append(append(make([]byte, 0, len(address)+8), address...), uint64ToBytes(index)...)
+}
+
+func changeKey(address []byte, blockNonce uint64, txHash []byte) []byte {
+	key := make([]byte, 0, len(address)+8+len(txHash))
+	key = append(key, address...)
+	key = append(key, uint64ToBytes(blockNonce)...)
+
+	return append(key, txHash...)
+}
+
+func recordedBlockKey(blockNonce uint64) []byte {
+	return append([]byte(recordedBlockKeyPrefix), uint64ToBytes(blockNonce)...)
+}
+
+func uint64ToBytes(value uint64) []byte {
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint64(buff, value)
+
+	return buff
+}
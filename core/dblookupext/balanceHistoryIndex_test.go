@@ -0,0 +1,174 @@
+package dblookupext
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountsWithBalances(balances map[string]int64) *mock.AccountsStub {
+	return &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			balance, ok := balances[string(address)]
+			if !ok {
+				return nil, errors.New("account not found")
+			}
+
+			account, _ := state.NewUserAccount(address)
+			_ = account.AddToBalance(big.NewInt(balance))
+			account.IncreaseNonce(uint64(balance))
+
+			return account, nil
+		},
+	}
+}
+
+func TestBalanceHistoryIndex_SaveBalanceChangesAndPaginate(t *testing.T) {
+	t.Parallel()
+
+	accounts := createAccountsWithBalances(map[string]int64{"alice": 10, "bob": 20})
+	index := newBalanceHistoryIndex(genericmocks.NewStorerMock("BalanceHistory", 0), accounts)
+
+	index.saveBalanceChanges(5, []byte("block5"), map[string]data.TransactionHandler{
+		"txB": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")},
+	})
+	index.saveBalanceChanges(6, []byte("block6"), map[string]data.TransactionHandler{
+		"txC": &transaction.Transaction{SndAddr: []byte("bob"), RcvAddr: []byte("alice")},
+	})
+
+	history, err := index.getBalanceHistory([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), history.Total)
+	require.Equal(t, 3, len(history.Changes))
+	require.Equal(t, []byte("txA"), history.Changes[0].TxHash)
+	require.Equal(t, []byte("txB"), history.Changes[1].TxHash)
+	require.Equal(t, []byte("txC"), history.Changes[2].TxHash)
+	require.Equal(t, uint64(6), history.Changes[2].BlockNonce)
+	require.Equal(t, []byte("block6"), history.Changes[2].BlockHash)
+	require.Equal(t, "10", history.Changes[2].Balance)
+	require.Equal(t, uint64(10), history.Changes[2].Nonce)
+
+	history, err = index.getBalanceHistory([]byte("alice"), 1, 1)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), history.Total)
+	require.Equal(t, 1, len(history.Changes))
+	require.Equal(t, []byte("txB"), history.Changes[0].TxHash)
+
+	history, err = index.getBalanceHistory([]byte("alice"), 3, 10)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(history.Changes))
+
+	history, err = index.getBalanceHistory([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), history.Total)
+	require.Equal(t, "20", history.Changes[0].Balance)
+}
+
+func TestBalanceHistoryIndex_SaveBalanceChangesShouldSkipAccountsFromOtherShards(t *testing.T) {
+	t.Parallel()
+
+	accounts := createAccountsWithBalances(map[string]int64{"alice": 10})
+	index := newBalanceHistoryIndex(genericmocks.NewStorerMock("BalanceHistory", 0), accounts)
+
+	index.saveBalanceChanges(5, []byte("block5"), map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("carol")},
+	})
+
+	history, err := index.getBalanceHistory([]byte("carol"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), history.Total)
+	require.Equal(t, 0, len(history.Changes))
+
+	history, err = index.getBalanceHistory([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), history.Total)
+}
+
+func TestBalanceHistoryIndex_SaveBalanceChangesTwiceShouldNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	accounts := createAccountsWithBalances(map[string]int64{"alice": 10, "bob": 20})
+	index := newBalanceHistoryIndex(genericmocks.NewStorerMock("BalanceHistory", 0), accounts)
+
+	txs := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	index.saveBalanceChanges(5, []byte("block5"), txs)
+	index.saveBalanceChanges(5, []byte("block5"), txs)
+
+	history, err := index.getBalanceHistory([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), history.Total)
+	require.Equal(t, 1, len(history.Changes))
+	require.Equal(t, []byte("txA"), history.Changes[0].TxHash)
+}
+
+func TestBalanceHistoryIndex_SaveBalanceChangesOfForkShouldReplaceTheOldBlock(t *testing.T) {
+	t.Parallel()
+
+	accounts := createAccountsWithBalances(map[string]int64{"alice": 10, "bob": 20, "carol": 30})
+	index := newBalanceHistoryIndex(genericmocks.NewStorerMock("BalanceHistory", 0), accounts)
+
+	index.saveBalanceChanges(5, []byte("block5"), map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")},
+	})
+	index.saveBalanceChanges(6, []byte("block6"), map[string]data.TransactionHandler{
+		"txB": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	})
+	index.saveBalanceChanges(6, []byte("block6 from fork"), map[string]data.TransactionHandler{
+		"txC": &transaction.Transaction{SndAddr: []byte("carol"), RcvAddr: []byte("carol")},
+	})
+
+	history, err := index.getBalanceHistory([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), history.Total)
+	require.Equal(t, []byte("txA"), history.Changes[0].TxHash)
+
+	history, err = index.getBalanceHistory([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), history.Total)
+
+	history, err = index.getBalanceHistory([]byte("carol"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), history.Total)
+	require.Equal(t, []byte("block6 from fork"), history.Changes[0].BlockHash)
+}
+
+func TestBalanceHistoryIndex_RemoveBlockChangesShouldRemoveOnlyTheRevertedBlock(t *testing.T) {
+	t.Parallel()
+
+	accounts := createAccountsWithBalances(map[string]int64{"alice": 10, "bob": 20})
+	index := newBalanceHistoryIndex(genericmocks.NewStorerMock("BalanceHistory", 0), accounts)
+
+	index.saveBalanceChanges(5, []byte("block5"), map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	})
+	index.saveBalanceChanges(6, []byte("block6"), map[string]data.TransactionHandler{
+		"txB": &transaction.Transaction{SndAddr: []byte("bob"), RcvAddr: []byte("alice")},
+	})
+
+	index.removeBlockChanges(6)
+
+	history, err := index.getBalanceHistory([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), history.Total)
+	require.Equal(t, []byte("txA"), history.Changes[0].TxHash)
+
+	history, err = index.getBalanceHistory([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), history.Total)
+	require.Equal(t, []byte("txA"), history.Changes[0].TxHash)
+
+	index.removeBlockChanges(6)
+	history, err = index.getBalanceHistory([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), history.Total)
+}
@@ -8,6 +8,12 @@ import (
 
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
+// ErrBalanceHistoryNotEnabled signals that the balance history was requested but it is not enabled
+var ErrBalanceHistoryNotEnabled = errors.New("balance history is not enabled")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
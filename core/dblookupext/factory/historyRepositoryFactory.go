@@ -5,6 +5,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
@@ -18,6 +19,7 @@ type ArgsHistoryRepositoryFactory struct {
 	Store       dataRetriever.StorageService
 	Marshalizer marshal.Marshalizer
 	Hasher      hashing.Hasher
+	Accounts    state.AccountsAdapter
 }
 
 type historyRepositoryFactory struct {
@@ -26,6 +28,7 @@ type historyRepositoryFactory struct {
 	store                    dataRetriever.StorageService
 	marshalizer              marshal.Marshalizer
 	hasher                   hashing.Hasher
+	accounts                 state.AccountsAdapter
 }
 
 // NewHistoryRepositoryFactory creates an instance of historyRepositoryFactory
@@ -46,6 +49,7 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 		store:                    args.Store,
 		marshalizer:              args.Marshalizer,
 		hasher:                   args.Hasher,
+		accounts:                 args.Accounts,
 	}, nil
 }
 
@@ -63,6 +67,11 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		EpochByHashStorer:           hpf.store.GetStorer(dataRetriever.EpochByHashUnit),
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
+		BalanceHistoryEnabled:       hpf.dbLookupExtensionsConfig.BalanceHistoryEnabled,
+		Accounts:                    hpf.accounts,
+	}
+	if historyRepArgs.BalanceHistoryEnabled {
+		historyRepArgs.BalanceHistoryStorer = hpf.store.GetStorer(dataRetriever.BalanceHistoryUnit)
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/container"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -31,6 +32,9 @@ type HistoryRepositoryArguments struct {
 	EventsHashesByTxHashStorer  storage.Storer
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
+	BalanceHistoryEnabled       bool
+	BalanceHistoryStorer        storage.Storer
+	Accounts                    state.AccountsAdapter
 }
 
 type historyRepository struct {
@@ -39,6 +43,7 @@ type historyRepository struct {
 	miniblockHashByTxHashIndex storage.Storer
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	balanceHistoryIndex        *balanceHistoryIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 
@@ -81,12 +86,25 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.EventsHashesByTxHashStorer) {
 		return nil, core.ErrNilStore
 	}
+	if arguments.BalanceHistoryEnabled {
+		if check.IfNil(arguments.BalanceHistoryStorer) {
+			return nil, core.ErrNilStore
+		}
+		if check.IfNil(arguments.Accounts) {
+			return nil, ErrNilAccountsAdapter
+		}
+	}
 
 	hashToEpochIndex := newHashToEpochIndex(arguments.EpochByHashStorer, arguments.Marshalizer)
 	deduplicationCacheForInsertMiniblockMetadata, _ := lrucache.NewCache(sizeOfDeduplicationCache)
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
 
+	var balanceHistory *balanceHistoryIndex
+	if arguments.BalanceHistoryEnabled {
+		balanceHistory = newBalanceHistoryIndex(arguments.BalanceHistoryStorer, arguments.Accounts)
+	}
+
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
 		miniblocksMetadataStorer:              arguments.MiniblocksMetadataStorer,
@@ -99,6 +117,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		balanceHistoryIndex:                          balanceHistory,
 	}, nil
 }
 
@@ -422,6 +441,43 @@ func (hr *historyRepository) consumePendingNotificationsNoLock(pendingMap *conta
 	}
 }
 
+// RecordBalanceChanges records the balances of the accounts touched by the provided transactions, if the balance
+// history is enabled. This function should be called synchronously, right after committing the block
+func (hr *historyRepository) RecordBalanceChanges(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	txsFromPool map[string]data.TransactionHandler,
+) {
+	if hr.balanceHistoryIndex == nil {
+		return
+	}
+
+	hr.recordBlockMutex.Lock()
+	hr.balanceHistoryIndex.saveBalanceChanges(blockHeader.GetNonce(), blockHeaderHash, txsFromPool)
+	hr.recordBlockMutex.Unlock()
+}
+
+// RevertBalanceChanges removes the balance changes recorded for the provided block, if the balance history is enabled.
+// This function should be called when the block is rolled back
+func (hr *historyRepository) RevertBalanceChanges(blockHeader data.HeaderHandler) {
+	if hr.balanceHistoryIndex == nil {
+		return
+	}
+
+	hr.recordBlockMutex.Lock()
+	hr.balanceHistoryIndex.removeBlockChanges(blockHeader.GetNonce())
+	hr.recordBlockMutex.Unlock()
+}
+
+// GetBalanceHistory returns at most size balance changes of the provided address, starting with the one at index from
+func (hr *historyRepository) GetBalanceHistory(address []byte, from uint64, size uint64) (*BalanceHistory, error) {
+	if hr.balanceHistoryIndex == nil {
+		return nil, ErrBalanceHistoryNotEnabled
+	}
+
+	return hr.balanceHistoryIndex.getBalanceHistory(address, from, size)
+}
+
 // GetResultsHashesByTxHash will return results hashes by transaction hash
 func (hr *historyRepository) GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error) {
 	return hr.eventsHashesByTxHashIndex.getEventsHashesByTxHash(txHash, epoch)
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
 	"github.com/stretchr/testify/require"
 )
@@ -65,12 +66,66 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, core.ErrNilMarshalizer, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.BalanceHistoryEnabled = true
+	args.Accounts = &mock.AccountsStub{}
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.BalanceHistoryEnabled = true
+	args.BalanceHistoryStorer = genericmocks.NewStorerMock("BalanceHistory", 0)
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, ErrNilAccountsAdapter, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
 	require.NotNil(t, repo)
 }
 
+func TestHistoryRepository_GetBalanceHistoryNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	repo, _ := NewHistoryRepository(createMockHistoryRepoArgs(0))
+	repo.RecordBalanceChanges([]byte("hash"), &block.Header{}, map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice")},
+	})
+
+	history, err := repo.GetBalanceHistory([]byte("alice"), 0, 10)
+	require.Nil(t, history)
+	require.Equal(t, ErrBalanceHistoryNotEnabled, err)
+}
+
+func TestHistoryRepository_RecordAndRevertBalanceChangesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	args.BalanceHistoryEnabled = true
+	args.BalanceHistoryStorer = genericmocks.NewStorerMock("BalanceHistory", 0)
+	args.Accounts = createAccountsWithBalances(map[string]int64{"alice": 7})
+	repo, _ := NewHistoryRepository(args)
+
+	header := &block.Header{Nonce: 3}
+	repo.RecordBalanceChanges([]byte("hash3"), header, map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	})
+
+	history, err := repo.GetBalanceHistory([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), history.Total)
+	expectedChange := &BalanceChange{Balance: "7", Nonce: 7, BlockNonce: 3, BlockHash: []byte("hash3"), TxHash: []byte("txA")}
+	require.Equal(t, expectedChange, history.Changes[0])
+
+	repo.RevertBalanceChanges(header)
+
+	history, err = repo.GetBalanceHistory([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), history.Total)
+}
+
 func TestHistoryRepository_RecordBlock(t *testing.T) {
 	t.Parallel()
 
@@ -19,10 +19,13 @@ type HistoryRepository interface {
 		receiptsFromPool map[string]data.TransactionHandler,
 	) error
 
+	RecordBalanceChanges(blockHeaderHash []byte, blockHeader data.HeaderHandler, txsFromPool map[string]data.TransactionHandler)
+	RevertBalanceChanges(blockHeader data.HeaderHandler)
 	OnNotarizedBlocks(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHash(hash []byte) (*MiniblockMetadata, error)
 	GetEpochByHash(hash []byte) (uint32, error)
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
+	GetBalanceHistory(address []byte, from uint64, size uint64) (*BalanceHistory, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -17,6 +17,14 @@ func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ d
 	return nil
 }
 
+// RecordBalanceChanges does nothing
+func (nhr *nilHistoryRepository) RecordBalanceChanges(_ []byte, _ data.HeaderHandler, _ map[string]data.TransactionHandler) {
+}
+
+// RevertBalanceChanges does nothing
+func (nhr *nilHistoryRepository) RevertBalanceChanges(_ data.HeaderHandler) {
+}
+
 // OnNotarizedBlocks does nothing
 func (nhr *nilHistoryRepository) OnNotarizedBlocks(_ uint32, _ []data.HeaderHandler, _ [][]byte) {
 }
@@ -41,6 +49,11 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// GetBalanceHistory returns the not enabled error
+func (nhr *nilHistoryRepository) GetBalanceHistory(_ []byte, _ uint64, _ uint64) (*BalanceHistory, error) {
+	return nil, ErrBalanceHistoryNotEnabled
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
@@ -135,13 +135,23 @@ func CreateFile(arg ArgCreateFileArgument) (*os.File, error) {
 
 // LoadSkPkFromPemFile loads the secret key and existing public key bytes stored in the file
 func LoadSkPkFromPemFile(relativePath string, skIndex int) ([]byte, string, error) {
+	blkRecovered, err := LoadPemBlockFromFile(relativePath, skIndex)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return GetSkPkFromPemBlock(blkRecovered)
+}
+
+// LoadPemBlockFromFile loads the skIndex-th pem block stored in the file
+func LoadPemBlockFromFile(relativePath string, skIndex int) (*pem.Block, error) {
 	if skIndex < 0 {
-		return nil, "", ErrInvalidIndex
+		return nil, ErrInvalidIndex
 	}
 
 	file, err := OpenFile(relativePath)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	defer func() {
@@ -151,10 +161,10 @@ func LoadSkPkFromPemFile(relativePath string, skIndex int) ([]byte, string, erro
 
 	buff, err := ioutil.ReadAll(file)
 	if err != nil {
-		return nil, "", fmt.Errorf("%w while reading %s file", err, relativePath)
+		return nil, fmt.Errorf("%w while reading %s file", err, relativePath)
 	}
 	if len(buff) == 0 {
-		return nil, "", fmt.Errorf("%w while reading %s file", ErrEmptyFile, relativePath)
+		return nil, fmt.Errorf("%w while reading %s file", ErrEmptyFile, relativePath)
 	}
 
 	var blkRecovered *pem.Block
@@ -162,15 +172,24 @@ func LoadSkPkFromPemFile(relativePath string, skIndex int) ([]byte, string, erro
 	for i := 0; i <= skIndex; i++ {
 		if len(buff) == 0 {
 			//less private keys present in the file than required
-			return nil, "", fmt.Errorf("%w while reading %s file, invalid index %d", ErrInvalidIndex, relativePath, i)
+			return nil, fmt.Errorf("%w while reading %s file, invalid index %d", ErrInvalidIndex, relativePath, i)
 		}
 
 		blkRecovered, buff = pem.Decode(buff)
 		if blkRecovered == nil {
-			return nil, "", fmt.Errorf("%w while reading %s file, error decoding", ErrPemFileIsInvalid, relativePath)
+			return nil, fmt.Errorf("%w while reading %s file, error decoding", ErrPemFileIsInvalid, relativePath)
 		}
 	}
 
+	if blkRecovered == nil {
+		return nil, ErrNilPemBLock
+	}
+
+	return blkRecovered, nil
+}
+
+// GetSkPkFromPemBlock returns the secret key and existing public key bytes stored in the provided plain pem block
+func GetSkPkFromPemBlock(blkRecovered *pem.Block) ([]byte, string, error) {
 	if blkRecovered == nil {
 		return nil, "", ErrNilPemBLock
 	}
@@ -0,0 +1,112 @@
+package indexer
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// backFillItem is the work item that, at the beginning of a session, indexes the blocks left unindexed by the previous
+// sessions. It is executed by the dispatcher as any other item so a failure will be retried with the same back off
+type backFillItem struct {
+	selfShardID      uint32
+	firstNonce       uint64
+	pendingBlocks    []*pendingBlock
+	elasticProcessor ElasticProcessor
+	blocksProvider   BlocksProvider
+	tracker          *pendingBlocksTracker
+	marshalizer      marshal.Marshalizer
+}
+
+// Save will index the pending blocks of the previous session and the blocks committed while the indexer was not
+// running, which are detected comparing the last indexed nonce with the nonce of the first block of this session
+func (bfi *backFillItem) Save() error {
+	err := bfi.savePendingBlocks()
+	if err != nil {
+		return err
+	}
+
+	return bfi.fillGap()
+}
+
+func (bfi *backFillItem) savePendingBlocks() error {
+	for len(bfi.pendingBlocks) > 0 {
+		record := bfi.pendingBlocks[0]
+
+		storedBlock, err := bfi.blocksProvider.GetBlockByHash(record.ShardID, record.HeaderHash)
+		if err != nil {
+			log.Warn("indexer: pending block is not available in storage, will skip",
+				"hash", record.HeaderHash, "nonce", record.Nonce, "error", err.Error())
+			bfi.tracker.remove(record)
+			bfi.pendingBlocks = bfi.pendingBlocks[1:]
+			continue
+		}
+
+		err = bfi.saveBlock(storedBlock)
+		if err != nil {
+			return err
+		}
+
+		bfi.tracker.remove(record)
+		bfi.pendingBlocks = bfi.pendingBlocks[1:]
+	}
+
+	return nil
+}
+
+func (bfi *backFillItem) fillGap() error {
+	lastIndexedNonce, found, err := bfi.elasticProcessor.GetLastIndexedBlockNonce(bfi.selfShardID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		log.Debug("indexer: no block indexed for the current shard, nothing to back-fill")
+		return nil
+	}
+
+	bfi.tracker.setIndexed(lastIndexedNonce)
+	if lastIndexedNonce+1 >= bfi.firstNonce {
+		return nil
+	}
+
+	log.Info("indexer: back-filling blocks missing from the database",
+		"shard", bfi.selfShardID, "from nonce", lastIndexedNonce+1, "to nonce", bfi.firstNonce-1)
+	for nonce := lastIndexedNonce + 1; nonce < bfi.firstNonce; nonce++ {
+		storedBlock, errGet := bfi.blocksProvider.GetBlockByNonce(bfi.selfShardID, nonce)
+		if errGet != nil {
+			log.Warn("indexer: block is not available in storage, back-fill stopped",
+				"nonce", nonce, "error", errGet.Error())
+			return nil
+		}
+
+		err = bfi.saveBlock(storedBlock)
+		if err != nil {
+			return err
+		}
+
+		bfi.tracker.setIndexed(nonce)
+	}
+
+	return nil
+}
+
+func (bfi *backFillItem) saveBlock(storedBlock *workItems.StoredBlock) error {
+	// the signers indexes can not be recovered from storage
+	wi := workItems.NewItemBlock(
+		bfi.elasticProcessor,
+		bfi.marshalizer,
+		storedBlock.Body,
+		storedBlock.Header,
+		storedBlock.TxPool,
+		storedBlock.TxLogs,
+		nil,
+		nil,
+		storedBlock.HeaderHash,
+	)
+
+	return wi.Save()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bfi *backFillItem) IsInterfaceNil() bool {
+	return bfi == nil
+}
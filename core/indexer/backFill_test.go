@@ -0,0 +1,156 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStoredBlock(nonce uint64) *workItems.StoredBlock {
+	return &workItems.StoredBlock{
+		HeaderHash: []byte(fmt.Sprintf("hash%d", nonce)),
+		Header:     &dataBlock.Header{Nonce: nonce, ShardID: 1},
+		Body:       &dataBlock.Body{},
+		TxPool:     make(map[string]data.TransactionHandler),
+		TxLogs:     make(map[string]data.LogHandler),
+	}
+}
+
+func createMockBackFillItem(elasticProcessor ElasticProcessor, blocksProvider BlocksProvider) *backFillItem {
+	_, handler := newMetricsRecorder()
+
+	return &backFillItem{
+		selfShardID:      1,
+		firstNonce:       10,
+		elasticProcessor: elasticProcessor,
+		blocksProvider:   blocksProvider,
+		tracker:          newPendingBlocksTracker(genericmocks.NewStorerMock("PendingBlocks", 0), handler),
+		marshalizer:      &mock.MarshalizerMock{},
+	}
+}
+
+func TestBackFillItem_SaveShouldIndexThePendingBlocksAndTheGap(t *testing.T) {
+	t.Parallel()
+
+	indexedNonces := make([]uint64, 0)
+	elasticProcessor := &mock.ElasticProcessorStub{
+		SaveHeaderCalled: func(header data.HeaderHandler, _ []uint64, _ *dataBlock.Body, _ []string, _ int) error {
+			indexedNonces = append(indexedNonces, header.GetNonce())
+			return nil
+		},
+		GetLastIndexedBlockNonceCalled: func(shardID uint32) (uint64, bool, error) {
+			assert.Equal(t, uint32(1), shardID)
+			return 7, true, nil
+		},
+	}
+	blocksProvider := &mock.BlocksProviderStub{
+		GetBlockByHashCalled: func(shardID uint32, headerHash []byte) (*workItems.StoredBlock, error) {
+			assert.Equal(t, []byte("hash4"), headerHash)
+			return createStoredBlock(4), nil
+		},
+		GetBlockByNonceCalled: func(shardID uint32, nonce uint64) (*workItems.StoredBlock, error) {
+			return createStoredBlock(nonce), nil
+		},
+	}
+
+	item := createMockBackFillItem(elasticProcessor, blocksProvider)
+	item.pendingBlocks = []*pendingBlock{{HeaderHash: []byte("hash4"), ShardID: 1, Nonce: 4}}
+
+	err := item.Save()
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{4, 8, 9}, indexedNonces)
+	assert.Equal(t, 0, len(item.pendingBlocks))
+}
+
+func TestBackFillItem_SaveNothingIndexedShouldNotFillTheGap(t *testing.T) {
+	t.Parallel()
+
+	blocksProvider := &mock.BlocksProviderStub{
+		GetBlockByNonceCalled: func(shardID uint32, nonce uint64) (*workItems.StoredBlock, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	item := createMockBackFillItem(&mock.ElasticProcessorStub{}, blocksProvider)
+	err := item.Save()
+	assert.Nil(t, err)
+}
+
+func TestBackFillItem_SaveMissingBlocksShouldBeSkipped(t *testing.T) {
+	t.Parallel()
+
+	indexedNonces := make([]uint64, 0)
+	elasticProcessor := &mock.ElasticProcessorStub{
+		SaveHeaderCalled: func(header data.HeaderHandler, _ []uint64, _ *dataBlock.Body, _ []string, _ int) error {
+			indexedNonces = append(indexedNonces, header.GetNonce())
+			return nil
+		},
+		GetLastIndexedBlockNonceCalled: func(shardID uint32) (uint64, bool, error) {
+			return 5, true, nil
+		},
+	}
+	blocksProvider := &mock.BlocksProviderStub{
+		GetBlockByNonceCalled: func(shardID uint32, nonce uint64) (*workItems.StoredBlock, error) {
+			if nonce == 7 {
+				return nil, errors.New("block not found")
+			}
+			return createStoredBlock(nonce), nil
+		},
+	}
+
+	item := createMockBackFillItem(elasticProcessor, blocksProvider)
+	item.pendingBlocks = []*pendingBlock{{HeaderHash: []byte("missing"), ShardID: 1, Nonce: 3}}
+
+	err := item.Save()
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(item.pendingBlocks))
+	assert.Equal(t, []uint64{6}, indexedNonces)
+}
+
+func TestBackFillItem_SaveFailureShouldResumeFromTheFailedBlock(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numFailures := 1
+	indexedNonces := make([]uint64, 0)
+	elasticProcessor := &mock.ElasticProcessorStub{
+		SaveHeaderCalled: func(header data.HeaderHandler, _ []uint64, _ *dataBlock.Body, _ []string, _ int) error {
+			if header.GetNonce() == 2 && numFailures > 0 {
+				numFailures--
+				return expectedErr
+			}
+
+			indexedNonces = append(indexedNonces, header.GetNonce())
+			return nil
+		},
+	}
+	blocksProvider := &mock.BlocksProviderStub{
+		GetBlockByHashCalled: func(shardID uint32, headerHash []byte) (*workItems.StoredBlock, error) {
+			return createStoredBlock(uint64(headerHash[0])), nil
+		},
+	}
+
+	item := createMockBackFillItem(elasticProcessor, blocksProvider)
+	item.pendingBlocks = []*pendingBlock{
+		{HeaderHash: []byte{1}, ShardID: 1, Nonce: 1},
+		{HeaderHash: []byte{2}, ShardID: 1, Nonce: 2},
+		{HeaderHash: []byte{3}, ShardID: 1, Nonce: 3},
+	}
+
+	err := item.Save()
+	assert.True(t, errors.Is(err, expectedErr))
+	assert.Equal(t, 2, len(item.pendingBlocks))
+
+	err = item.Save()
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{1, 2, 3}, indexedNonces)
+}
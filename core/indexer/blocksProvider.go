@@ -0,0 +1,224 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ BlocksProvider = (*storageBlocksProvider)(nil)
+
+// ArgsStorageBlocksProvider holds the arguments needed to create a blocks provider that reads from the local storage
+type ArgsStorageBlocksProvider struct {
+	Store           dataRetriever.StorageService
+	Marshalizer     marshal.Marshalizer
+	Uint64Converter typeConverters.Uint64ByteSliceConverter
+}
+
+type storageBlocksProvider struct {
+	store           dataRetriever.StorageService
+	marshalizer     marshal.Marshalizer
+	uint64Converter typeConverters.Uint64ByteSliceConverter
+}
+
+// NewStorageBlocksProvider creates a component able to rebuild the committed blocks from the local storage
+func NewStorageBlocksProvider(args ArgsStorageBlocksProvider) (*storageBlocksProvider, error) {
+	if check.IfNil(args.Store) {
+		return nil, ErrNilStorageService
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, ErrNilUint64Converter
+	}
+
+	return &storageBlocksProvider{
+		store:           args.Store,
+		marshalizer:     args.Marshalizer,
+		uint64Converter: args.Uint64Converter,
+	}, nil
+}
+
+// GetBlockByNonce returns the committed block of the provided shard having the provided nonce
+func (sbp *storageBlocksProvider) GetBlockByNonce(shardID uint32, nonce uint64) (*workItems.StoredBlock, error) {
+	header, headerHash, err := process.GetHeaderFromStorageWithNonce(nonce, shardID, sbp.store, sbp.uint64Converter, sbp.marshalizer)
+	if err != nil {
+		return nil, fmt.Errorf("%w for shard %d, nonce %d", err, shardID, nonce)
+	}
+
+	return sbp.createStoredBlock(header, headerHash)
+}
+
+// GetBlockByHash returns the committed block of the provided shard having the provided hash
+func (sbp *storageBlocksProvider) GetBlockByHash(shardID uint32, headerHash []byte) (*workItems.StoredBlock, error) {
+	var header data.HeaderHandler
+	var err error
+	if shardID == core.MetachainShardId {
+		header, err = process.GetMetaHeaderFromStorage(headerHash, sbp.marshalizer, sbp.store)
+	} else {
+		header, err = process.GetShardHeaderFromStorage(headerHash, sbp.marshalizer, sbp.store)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w for shard %d, hash %s", err, shardID, hex.EncodeToString(headerHash))
+	}
+
+	return sbp.createStoredBlock(header, headerHash)
+}
+
+func (sbp *storageBlocksProvider) createStoredBlock(header data.HeaderHandler, headerHash []byte) (*workItems.StoredBlock, error) {
+	storedBlock := &workItems.StoredBlock{
+		HeaderHash: headerHash,
+		Header:     header,
+		Body:       &block.Body{},
+		TxPool:     make(map[string]data.TransactionHandler),
+		TxLogs:     make(map[string]data.LogHandler),
+	}
+
+	for _, mbHash := range header.GetMiniBlockHeadersHashes() {
+		miniBlock, err := sbp.getMiniBlock(mbHash)
+		if err != nil {
+			return nil, err
+		}
+
+		storedBlock.Body.MiniBlocks = append(storedBlock.Body.MiniBlocks, miniBlock)
+	}
+
+	receiptsMiniBlocks, err := sbp.getReceiptsMiniBlocks(header)
+	if err != nil {
+		return nil, err
+	}
+	storedBlock.Body.MiniBlocks = append(storedBlock.Body.MiniBlocks, receiptsMiniBlocks...)
+
+	for _, miniBlock := range storedBlock.Body.MiniBlocks {
+		err = sbp.addTransactions(miniBlock, storedBlock.TxPool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for txHash := range storedBlock.TxPool {
+		txLog, errGet := sbp.getTxLog([]byte(txHash))
+		if errGet != nil {
+			continue
+		}
+
+		storedBlock.TxLogs[txHash] = txLog
+	}
+
+	return storedBlock, nil
+}
+
+func (sbp *storageBlocksProvider) getMiniBlock(mbHash []byte) (*block.MiniBlock, error) {
+	mbBytes, err := sbp.store.Get(dataRetriever.MiniBlockUnit, mbHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for miniblock %s", err, hex.EncodeToString(mbHash))
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = sbp.marshalizer.Unmarshal(miniBlock, mbBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w when unmarshaling miniblock %s", err, hex.EncodeToString(mbHash))
+	}
+
+	return miniBlock, nil
+}
+
+func (sbp *storageBlocksProvider) getReceiptsMiniBlocks(header data.HeaderHandler) ([]*block.MiniBlock, error) {
+	if len(header.GetReceiptsHash()) == 0 {
+		return nil, nil
+	}
+
+	batchBytes, err := sbp.store.Get(dataRetriever.ReceiptsUnit, header.GetReceiptsHash())
+	if err != nil {
+		// the receipts are saved only if the block generated at least one receipt
+		return nil, nil
+	}
+
+	receiptsBatch := &batch.Batch{}
+	err = sbp.marshalizer.Unmarshal(receiptsBatch, batchBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlocks := make([]*block.MiniBlock, 0, len(receiptsBatch.Data))
+	for _, mbBytes := range receiptsBatch.Data {
+		miniBlock := &block.MiniBlock{}
+		err = sbp.marshalizer.Unmarshal(miniBlock, mbBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		miniBlocks = append(miniBlocks, miniBlock)
+	}
+
+	return miniBlocks, nil
+}
+
+func (sbp *storageBlocksProvider) addTransactions(miniBlock *block.MiniBlock, txPool map[string]data.TransactionHandler) error {
+	for _, txHash := range miniBlock.TxHashes {
+		var unit dataRetriever.UnitType
+		var tx data.TransactionHandler
+		switch miniBlock.Type {
+		case block.TxBlock, block.InvalidBlock:
+			unit, tx = dataRetriever.TransactionUnit, &transaction.Transaction{}
+		case block.SmartContractResultBlock:
+			unit, tx = dataRetriever.UnsignedTransactionUnit, &smartContractResult.SmartContractResult{}
+		case block.RewardsBlock:
+			unit, tx = dataRetriever.RewardTransactionUnit, &rewardTx.RewardTx{}
+		case block.ReceiptBlock:
+			unit, tx = dataRetriever.UnsignedTransactionUnit, &receipt.Receipt{}
+		default:
+			// hashes stored inside peer blocks do not stand for a specific transaction
+			continue
+		}
+
+		txBytes, err := sbp.store.Get(unit, txHash)
+		if err != nil {
+			return fmt.Errorf("%w for transaction %s", err, hex.EncodeToString(txHash))
+		}
+
+		err = sbp.marshalizer.Unmarshal(tx, txBytes)
+		if err != nil {
+			return fmt.Errorf("%w when unmarshaling transaction %s", err, hex.EncodeToString(txHash))
+		}
+
+		txPool[string(txHash)] = tx
+	}
+
+	return nil
+}
+
+func (sbp *storageBlocksProvider) getTxLog(txHash []byte) (data.LogHandler, error) {
+	logBytes, err := sbp.store.Get(dataRetriever.TxLogsUnit, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	txLog := &transaction.Log{}
+	err = sbp.marshalizer.Unmarshal(txLog, logBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return txLog, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sbp *storageBlocksProvider) IsInterfaceNil() bool {
+	return sbp == nil
+}
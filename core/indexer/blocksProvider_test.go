@@ -0,0 +1,152 @@
+package indexer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockStorageService() dataRetriever.StorageService {
+	storers := make(map[dataRetriever.UnitType]storage.Storer)
+	getStorer := func(unitType dataRetriever.UnitType) storage.Storer {
+		storer, ok := storers[unitType]
+		if !ok {
+			storer = genericmocks.NewStorerMock("", 0)
+			storers[unitType] = storer
+		}
+
+		return storer
+	}
+
+	store := &mock.ChainStorerMock{
+		GetStorerCalled: getStorer,
+		GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+			return getStorer(unitType).Get(key)
+		},
+	}
+
+	return store
+}
+
+func createMockArgsStorageBlocksProvider(store dataRetriever.StorageService) ArgsStorageBlocksProvider {
+	return ArgsStorageBlocksProvider{
+		Store:           store,
+		Marshalizer:     &marshal.GogoProtoMarshalizer{},
+		Uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+	}
+}
+
+func putMarshalized(t *testing.T, store dataRetriever.StorageService, unitType dataRetriever.UnitType, key []byte, obj interface{}) {
+	buff, err := (&marshal.GogoProtoMarshalizer{}).Marshal(obj)
+	require.Nil(t, err)
+
+	err = store.GetStorer(unitType).Put(key, buff)
+	require.Nil(t, err)
+}
+
+func TestNewStorageBlocksProvider_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStorageBlocksProvider(nil)
+	bp, err := NewStorageBlocksProvider(args)
+	assert.Nil(t, bp)
+	assert.Equal(t, ErrNilStorageService, err)
+
+	store := createMockStorageService()
+	args = createMockArgsStorageBlocksProvider(store)
+	args.Marshalizer = nil
+	bp, err = NewStorageBlocksProvider(args)
+	assert.Nil(t, bp)
+	assert.Equal(t, core.ErrNilMarshalizer, err)
+
+	args = createMockArgsStorageBlocksProvider(store)
+	args.Uint64Converter = nil
+	bp, err = NewStorageBlocksProvider(args)
+	assert.Nil(t, bp)
+	assert.Equal(t, ErrNilUint64Converter, err)
+}
+
+func TestStorageBlocksProvider_GetBlockByNonceShouldRebuildTheBlock(t *testing.T) {
+	t.Parallel()
+
+	store := createMockStorageService()
+
+	txMiniBlock := &dataBlock.MiniBlock{TxHashes: [][]byte{[]byte("tx")}, Type: dataBlock.TxBlock}
+	scrMiniBlock := &dataBlock.MiniBlock{TxHashes: [][]byte{[]byte("scr")}, Type: dataBlock.SmartContractResultBlock}
+	peerMiniBlock := &dataBlock.MiniBlock{TxHashes: [][]byte{[]byte("peer")}, Type: dataBlock.PeerBlock}
+	receiptMiniBlock := &dataBlock.MiniBlock{TxHashes: [][]byte{[]byte("receipt")}, Type: dataBlock.ReceiptBlock}
+	putMarshalized(t, store, dataRetriever.MiniBlockUnit, []byte("mbTx"), txMiniBlock)
+	putMarshalized(t, store, dataRetriever.MiniBlockUnit, []byte("mbScr"), scrMiniBlock)
+	putMarshalized(t, store, dataRetriever.MiniBlockUnit, []byte("mbPeer"), peerMiniBlock)
+
+	receiptMiniBlockBytes, _ := (&marshal.GogoProtoMarshalizer{}).Marshal(receiptMiniBlock)
+	putMarshalized(t, store, dataRetriever.ReceiptsUnit, []byte("receipts"), &batch.Batch{Data: [][]byte{receiptMiniBlockBytes}})
+
+	putMarshalized(t, store, dataRetriever.TransactionUnit, []byte("tx"), &transaction.Transaction{Nonce: 1, Value: big.NewInt(1)})
+	putMarshalized(t, store, dataRetriever.UnsignedTransactionUnit, []byte("scr"), &smartContractResult.SmartContractResult{Nonce: 2, Value: big.NewInt(2)})
+	putMarshalized(t, store, dataRetriever.UnsignedTransactionUnit, []byte("receipt"), &receipt.Receipt{Value: big.NewInt(3)})
+	putMarshalized(t, store, dataRetriever.TxLogsUnit, []byte("tx"), &transaction.Log{Address: []byte("sc")})
+
+	header := &dataBlock.Header{
+		Nonce:   7,
+		ShardID: 1,
+		MiniBlockHeaders: []dataBlock.MiniBlockHeader{
+			{Hash: []byte("mbTx"), Type: dataBlock.TxBlock},
+			{Hash: []byte("mbScr"), Type: dataBlock.SmartContractResultBlock},
+			{Hash: []byte("mbPeer"), Type: dataBlock.PeerBlock},
+		},
+		ReceiptsHash: []byte("receipts"),
+	}
+	putMarshalized(t, store, dataRetriever.BlockHeaderUnit, []byte("headerHash"), header)
+	nonceBytes := uint64ByteSlice.NewBigEndianConverter().ToByteSlice(7)
+	_ = store.GetStorer(dataRetriever.ShardHdrNonceHashDataUnit+1).Put(nonceBytes, []byte("headerHash"))
+
+	bp, _ := NewStorageBlocksProvider(createMockArgsStorageBlocksProvider(store))
+	storedBlock, err := bp.GetBlockByNonce(1, 7)
+	require.Nil(t, err)
+
+	assert.Equal(t, []byte("headerHash"), storedBlock.HeaderHash)
+	assert.Equal(t, uint64(7), storedBlock.Header.GetNonce())
+	require.Equal(t, 4, len(storedBlock.Body.MiniBlocks))
+	assert.Equal(t, dataBlock.ReceiptBlock, storedBlock.Body.MiniBlocks[3].Type)
+	require.Equal(t, 3, len(storedBlock.TxPool))
+	assert.Equal(t, uint64(1), storedBlock.TxPool["tx"].GetNonce())
+	assert.Equal(t, uint64(2), storedBlock.TxPool["scr"].GetNonce())
+	assert.Equal(t, big.NewInt(3), storedBlock.TxPool["receipt"].GetValue())
+	require.Equal(t, 1, len(storedBlock.TxLogs))
+	assert.Equal(t, []byte("sc"), storedBlock.TxLogs["tx"].GetAddress())
+}
+
+func TestStorageBlocksProvider_GetBlockByHashMissingDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	store := createMockStorageService()
+	bp, _ := NewStorageBlocksProvider(createMockArgsStorageBlocksProvider(store))
+
+	storedBlock, err := bp.GetBlockByHash(core.MetachainShardId, []byte("missing"))
+	assert.Nil(t, storedBlock)
+	assert.NotNil(t, err)
+
+	header := &dataBlock.Header{
+		MiniBlockHeaders: []dataBlock.MiniBlockHeader{{Hash: []byte("mbTx"), Type: dataBlock.TxBlock}},
+	}
+	putMarshalized(t, store, dataRetriever.BlockHeaderUnit, []byte("headerHash"), header)
+
+	storedBlock, err = bp.GetBlockByHash(0, []byte("headerHash"))
+	assert.Nil(t, storedBlock)
+	assert.NotNil(t, err)
+}
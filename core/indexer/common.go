@@ -520,7 +520,7 @@ func GetElasticTemplatesAndPolicies(path string, useKibana bool) (map[string]*by
 	indexPolicies := make(map[string]*bytes.Buffer)
 	var err error
 
-	indexes := []string{"opendistro", txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex}
+	indexes := []string{"opendistro", txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex, logsIndex, scResultsIndex, esdtTransfersIndex}
 	for _, index := range indexes {
 		indexTemplates[index], err = getTemplateByIndex(path, index)
 		if err != nil {
@@ -16,6 +16,9 @@ const (
 	ratingIndex          = "rating"
 	accountsIndex        = "accounts"
 	accountsHistoryIndex = "accountshistory"
+	logsIndex            = "logs"
+	scResultsIndex       = "scresults"
+	esdtTransfersIndex   = "esdttransfers"
 
 	txPolicy              = "transactions_policy"
 	blockPolicy           = "blocks_policy"
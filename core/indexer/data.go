@@ -83,22 +83,44 @@ type Event struct {
 
 // ScResult is a structure containing all the fields that need to be saved for a smart contract result
 type ScResult struct {
-	Hash           string `json:"hash"`
-	Nonce          uint64 `json:"nonce"`
-	GasLimit       uint64 `json:"gasLimit"`
-	GasPrice       uint64 `json:"gasPrice"`
-	Value          string `json:"value"`
-	Sender         string `json:"sender"`
-	Receiver       string `json:"receiver"`
-	RelayerAddr    string `json:"relayerAddr,omitempty"`
-	RelayedValue   string `json:"relayedValue,omitempty"`
-	Code           string `json:"code,omitempty"`
-	Data           []byte `json:"data,omitempty"`
-	PreTxHash      string `json:"prevTxHash"`
-	OriginalTxHash string `json:"originalTxHash"`
-	CallType       string `json:"callType"`
-	CodeMetadata   []byte `json:"codeMetaData,omitempty"`
-	ReturnMessage  string `json:"returnMessage,omitempty"`
+	Hash           string        `json:"hash"`
+	Nonce          uint64        `json:"nonce"`
+	GasLimit       uint64        `json:"gasLimit"`
+	GasPrice       uint64        `json:"gasPrice"`
+	Value          string        `json:"value"`
+	Sender         string        `json:"sender"`
+	Receiver       string        `json:"receiver"`
+	RelayerAddr    string        `json:"relayerAddr,omitempty"`
+	RelayedValue   string        `json:"relayedValue,omitempty"`
+	Code           string        `json:"code,omitempty"`
+	Data           []byte        `json:"data,omitempty"`
+	PreTxHash      string        `json:"prevTxHash"`
+	OriginalTxHash string        `json:"originalTxHash"`
+	CallType       string        `json:"callType"`
+	CodeMetadata   []byte        `json:"codeMetaData,omitempty"`
+	ReturnMessage  string        `json:"returnMessage,omitempty"`
+	Timestamp      time.Duration `json:"timestamp,omitempty"`
+}
+
+// Logs is a structure containing the log generated by a transaction or by a smart contract result. It is saved
+// in its own index, under the hash of the transaction or of the smart contract result
+type Logs struct {
+	TxHash    string        `json:"-"`
+	Address   string        `json:"address"`
+	Events    []Event       `json:"events"`
+	Timestamp time.Duration `json:"timestamp"`
+}
+
+// ESDTTransfer is a structure containing a decoded ESDT transfer, made either by a transaction or by a smart
+// contract result. It is saved under the hash of the transaction or of the smart contract result
+type ESDTTransfer struct {
+	TxHash         string        `json:"-"`
+	OriginalTxHash string        `json:"originalTxHash,omitempty"`
+	Sender         string        `json:"sender"`
+	Receiver       string        `json:"receiver"`
+	Token          string        `json:"token"`
+	Value          string        `json:"value"`
+	Timestamp      time.Duration `json:"timestamp"`
 }
 
 // Block is a structure containing all the fields that need
@@ -1,8 +1,12 @@
 package indexer
 
 import (
+	"io"
+	"sync"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/disabled"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -21,6 +25,14 @@ type dataIndexer struct {
 	elasticProcessor ElasticProcessor
 	options          *Options
 	marshalizer      marshal.Marshalizer
+	mutTxLogsProc    sync.RWMutex
+	txLogsProcessor  process.TransactionLogProcessorDatabase
+	selfShardID      uint32
+	blocksProvider   BlocksProvider
+	pendingTracker   *pendingBlocksTracker
+	mutBackFill      sync.Mutex
+	backFillStarted  bool
+	pendingBlocks    []*pendingBlock
 }
 
 // NewDataIndexer will create a new data indexer
@@ -37,7 +49,12 @@ func NewDataIndexer(arguments ArgDataIndexer) (Indexer, error) {
 		elasticProcessor: arguments.ElasticProcessor,
 		marshalizer:      arguments.Marshalizer,
 		options:          arguments.Options,
+		txLogsProcessor:  disabled.NewNilTxLogsProcessor(),
+		selfShardID:      arguments.ShardCoordinator.SelfId(),
+		blocksProvider:   arguments.BlocksProvider,
+		pendingTracker:   newPendingBlocksTracker(arguments.PendingBlocksStorer, arguments.StatusHandler),
 	}
+	dataIndexerObj.pendingBlocks = dataIndexerObj.pendingTracker.loadPendingBlocks()
 
 	if arguments.ShardCoordinator.SelfId() == core.MetachainShardId {
 		arguments.EpochStartNotifier.RegisterHandler(dataIndexerObj.epochStartEventHandler())
@@ -65,6 +82,15 @@ func checkIndexerArgs(arguments ArgDataIndexer) error {
 	if check.IfNil(arguments.ShardCoordinator) {
 		return ErrNilShardCoordinator
 	}
+	if check.IfNil(arguments.BlocksProvider) {
+		return ErrNilBlocksProvider
+	}
+	if check.IfNil(arguments.PendingBlocksStorer) {
+		return ErrNilPendingBlocksStorer
+	}
+	if check.IfNil(arguments.StatusHandler) {
+		return ErrNilStatusHandler
+	}
 
 	return nil
 }
@@ -101,16 +127,90 @@ func (di *dataIndexer) SaveBlock(
 		bodyHandler,
 		headerHandler,
 		txPool,
+		di.getTransactionsLogs(txPool),
 		signersIndexes,
 		notarizedHeadersHashes,
 		headerHash,
 	)
-	di.dispatcher.Add(wi)
+	if check.IfNil(headerHandler) {
+		// the item will skip the nil header, there is nothing to track
+		di.dispatcher.Add(wi)
+		return
+	}
+
+	di.startBackFillIfNeeded(headerHandler.GetNonce())
+
+	record := &pendingBlock{
+		HeaderHash: headerHash,
+		ShardID:    headerHandler.GetShardID(),
+		Nonce:      headerHandler.GetNonce(),
+	}
+	di.pendingTracker.add(record)
+
+	di.dispatcher.Add(&pendingBlockItem{
+		WorkItemHandler: wi,
+		record:          record,
+		tracker:         di.pendingTracker,
+	})
+}
+
+// startBackFillIfNeeded adds, before the first block of the session, the work item that indexes the blocks that were
+// left unindexed by the previous sessions
+func (di *dataIndexer) startBackFillIfNeeded(firstNonce uint64) {
+	di.mutBackFill.Lock()
+	defer di.mutBackFill.Unlock()
+
+	if di.backFillStarted {
+		return
+	}
+	di.backFillStarted = true
+
+	di.dispatcher.Add(&backFillItem{
+		selfShardID:      di.selfShardID,
+		firstNonce:       firstNonce,
+		pendingBlocks:    di.pendingBlocks,
+		elasticProcessor: di.elasticProcessor,
+		blocksProvider:   di.blocksProvider,
+		tracker:          di.pendingTracker,
+		marshalizer:      di.marshalizer,
+	})
+	di.pendingBlocks = nil
+}
+
+// getTransactionsLogs reads the logs of the provided transactions (and smart contract results) from the logs cache.
+// This has to be done synchronously as the cache is cleaned before the next block is processed
+func (di *dataIndexer) getTransactionsLogs(txPool map[string]data.TransactionHandler) map[string]data.LogHandler {
+	di.mutTxLogsProc.RLock()
+	defer di.mutTxLogsProc.RUnlock()
+
+	txLogs := make(map[string]data.LogHandler)
+	for txHash := range txPool {
+		txLog, ok := di.txLogsProcessor.GetLogFromCache([]byte(txHash))
+		if !ok || check.IfNil(txLog) {
+			continue
+		}
+
+		txLogs[txHash] = txLog
+	}
+
+	di.txLogsProcessor.Clean()
+
+	return txLogs
 }
 
 // Close will stop goroutine that index data in database
 func (di *dataIndexer) Close() error {
-	return di.dispatcher.Close()
+	err := di.dispatcher.Close()
+
+	closableProcessor, ok := di.elasticProcessor.(io.Closer)
+	if ok {
+		errClose := closableProcessor.Close()
+		if errClose != nil {
+			return errClose
+		}
+	}
+
+	return err
 }
 
 // RevertIndexedBlock will remove from database block and miniblocks
@@ -168,7 +268,13 @@ func (di *dataIndexer) SaveAccounts(accounts []state.UserAccountHandler) {
 
 // SetTxLogsProcessor will set tx logs processor
 func (di *dataIndexer) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
-	di.elasticProcessor.SetTxLogsProcessor(txLogsProc)
+	if check.IfNil(txLogsProc) {
+		return
+	}
+
+	di.mutTxLogsProc.Lock()
+	di.txLogsProcessor = txLogsProc
+	di.mutTxLogsProc.Unlock()
 }
 
 // IsNilIndexer will return a bool value that signals if the indexer's implementation is a NilIndexer
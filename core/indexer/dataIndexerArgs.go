@@ -9,17 +9,21 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 //ArgDataIndexer is struct that is used to store all components that are needed to create a indexer
 type ArgDataIndexer struct {
-	ShardCoordinator   sharding.Coordinator
-	Marshalizer        marshal.Marshalizer
-	EpochStartNotifier sharding.EpochStartEventNotifier
-	NodesCoordinator   sharding.NodesCoordinator
-	Options            *Options
-	DataDispatcher     DispatcherHandler
-	ElasticProcessor   ElasticProcessor
+	ShardCoordinator    sharding.Coordinator
+	Marshalizer         marshal.Marshalizer
+	EpochStartNotifier  sharding.EpochStartEventNotifier
+	NodesCoordinator    sharding.NodesCoordinator
+	Options             *Options
+	DataDispatcher      DispatcherHandler
+	ElasticProcessor    ElasticProcessor
+	BlocksProvider      BlocksProvider
+	PendingBlocksStorer storage.Storer
+	StatusHandler       core.AppStatusHandler
 }
 
 //ArgElasticProcessor is struct that is used to store all components that are needed to an elastic indexer
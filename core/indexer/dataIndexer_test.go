@@ -12,7 +12,6 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
-	"github.com/ElrondNetwork/elrond-go/core/indexer/disabled"
 	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -20,8 +19,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 	"github.com/ElrondNetwork/elrond-go/marshal"
-	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -44,13 +44,16 @@ func newTestMetaBlock() *dataBlock.MetaBlock {
 
 func NewDataIndexerArguments() ArgDataIndexer {
 	return ArgDataIndexer{
-		Marshalizer:        &mock.MarshalizerMock{},
-		Options:            &Options{},
-		NodesCoordinator:   &mock.NodesCoordinatorMock{},
-		EpochStartNotifier: &mock.EpochStartNotifierStub{},
-		DataDispatcher:     &mock.DispatcherMock{},
-		ElasticProcessor:   &mock.ElasticProcessorStub{},
-		ShardCoordinator:   &mock.ShardCoordinatorMock{},
+		Marshalizer:         &mock.MarshalizerMock{},
+		Options:             &Options{},
+		NodesCoordinator:    &mock.NodesCoordinatorMock{},
+		EpochStartNotifier:  &mock.EpochStartNotifierStub{},
+		DataDispatcher:      &mock.DispatcherMock{},
+		ElasticProcessor:    &mock.ElasticProcessorStub{},
+		ShardCoordinator:    &mock.ShardCoordinatorMock{},
+		BlocksProvider:      &mock.BlocksProviderStub{},
+		PendingBlocksStorer: genericmocks.NewStorerMock("PendingBlocks", 0),
+		StatusHandler:       statusHandler.NewNilStatusHandler(),
 	}
 }
 
@@ -230,19 +233,49 @@ func TestDataIndexer_RevertIndexedBlock(t *testing.T) {
 	require.True(t, called)
 }
 
-func TestDataIndexer_SetTxLogsProcessor(t *testing.T) {
-	called := false
+func TestDataIndexer_SetTxLogsProcessorShouldPassTheLogsToTheWorkItemAndCleanTheCache(t *testing.T) {
+	t.Parallel()
+
+	txHash := "txHash"
+	txLog := &transaction.Log{Address: []byte("sc address")}
+	numCleanCalls := 0
+	var savedLogs map[string]data.LogHandler
 
 	arguments := NewDataIndexerArguments()
 	arguments.ElasticProcessor = &mock.ElasticProcessorStub{
-		SetTxLogsProcessorCalled: func(txLogsProc process.TransactionLogProcessorDatabase) {
-			called = true
+		SaveLogsCalled: func(header data.HeaderHandler, txLogs map[string]data.LogHandler) error {
+			savedLogs = txLogs
+			return nil
+		},
+	}
+	arguments.DataDispatcher = &mock.DispatcherMock{
+		AddCalled: func(item workItems.WorkItemHandler) {
+			_ = item.Save()
 		},
 	}
 	ei, _ := NewDataIndexer(arguments)
 
-	ei.SetTxLogsProcessor(disabled.NewNilTxLogsProcessor())
-	require.True(t, called)
+	ei.SetTxLogsProcessor(&mock.TxLogsProcessorDatabaseStub{
+		GetLogFromCacheCalled: func(hash []byte) (data.LogHandler, bool) {
+			if string(hash) == txHash {
+				return txLog, true
+			}
+			return nil, false
+		},
+		CleanCalled: func() {
+			numCleanCalls++
+		},
+	})
+
+	txPool := map[string]data.TransactionHandler{
+		txHash:        &transaction.Transaction{},
+		"without log": &transaction.Transaction{},
+	}
+	body := &dataBlock.Body{MiniBlocks: []*dataBlock.MiniBlock{{}}}
+	ei.SaveBlock(body, &dataBlock.Header{}, txPool, nil, nil, []byte("hash"))
+
+	require.Equal(t, map[string]data.LogHandler{txHash: txLog}, savedLogs)
+	require.Equal(t, 1, numCleanCalls)
 }
 
 func TestDataIndexer_EpochChange(t *testing.T) {
@@ -367,11 +400,14 @@ func testCreateIndexer(t *testing.T) {
 	})
 
 	di, err := NewDataIndexer(ArgDataIndexer{
-		Options:            &Options{},
-		Marshalizer:        &marshal.JsonMarshalizer{},
-		EpochStartNotifier: &mock.EpochStartNotifierStub{},
-		DataDispatcher:     dispatcher,
-		ElasticProcessor:   elasticIndexer,
+		Options:             &Options{},
+		Marshalizer:         &marshal.JsonMarshalizer{},
+		EpochStartNotifier:  &mock.EpochStartNotifierStub{},
+		DataDispatcher:      dispatcher,
+		ElasticProcessor:    elasticIndexer,
+		BlocksProvider:      &mock.BlocksProviderStub{},
+		PendingBlocksStorer: genericmocks.NewStorerMock("PendingBlocks", 0),
+		StatusHandler:       statusHandler.NewNilStatusHandler(),
 	})
 	if err != nil {
 		fmt.Println(err)
@@ -468,3 +504,33 @@ func getIndexTemplateAndPolicies() (map[string]*bytes.Buffer, map[string]*bytes.
 
 	return indexTemplates, indexPolicies
 }
+
+func TestDataIndexer_SaveBlockShouldAddTheBackFillItemOnlyBeforeTheFirstBlock(t *testing.T) {
+	t.Parallel()
+
+	items := make([]workItems.WorkItemHandler, 0)
+	arguments := NewDataIndexerArguments()
+	arguments.DataDispatcher = &mock.DispatcherMock{
+		AddCalled: func(item workItems.WorkItemHandler) {
+			items = append(items, item)
+		},
+	}
+	ei, err := NewDataIndexer(arguments)
+	require.Nil(t, err)
+
+	body := &dataBlock.Body{MiniBlocks: []*dataBlock.MiniBlock{{}}}
+	ei.SaveBlock(body, &dataBlock.Header{Nonce: 10}, nil, nil, nil, []byte("hash10"))
+	ei.SaveBlock(body, &dataBlock.Header{Nonce: 11}, nil, nil, nil, []byte("hash11"))
+
+	require.Equal(t, 3, len(items))
+	backFill, ok := items[0].(*backFillItem)
+	require.True(t, ok)
+	assert.Equal(t, uint64(10), backFill.firstNonce)
+	_, ok = items[1].(*pendingBlockItem)
+	assert.True(t, ok)
+	_, ok = items[2].(*pendingBlockItem)
+	assert.True(t, ok)
+
+	pending := newPendingBlocksTracker(arguments.PendingBlocksStorer, arguments.StatusHandler).loadPendingBlocks()
+	assert.Equal(t, 2, len(pending))
+}
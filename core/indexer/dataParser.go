@@ -27,6 +27,26 @@ func (dp *dataParser) getSerializedElasticBlockAndHeaderHash(
 	notarizedHeadersHashes []string,
 	sizeTxs int,
 ) ([]byte, []byte, error) {
+	elasticBlock, headerHash, err := dp.prepareBlock(header, signersIndexes, body, notarizedHeadersHashes, sizeTxs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serializedBlock, err := json.Marshal(elasticBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return serializedBlock, headerHash, nil
+}
+
+func (dp *dataParser) prepareBlock(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	body *block.Body,
+	notarizedHeadersHashes []string,
+	sizeTxs int,
+) (*Block, []byte, error) {
 	headerBytes, err := dp.marshalizer.Marshal(header)
 	if err != nil {
 		return nil, nil, err
@@ -57,7 +77,7 @@ func (dp *dataParser) getSerializedElasticBlockAndHeaderHash(
 	}
 
 	headerHash := dp.hasher.Compute(string(headerBytes))
-	elasticBlock := Block{
+	elasticBlock := &Block{
 		Nonce:                 header.GetNonce(),
 		Round:                 header.GetRound(),
 		Epoch:                 header.GetEpoch(),
@@ -77,12 +97,7 @@ func (dp *dataParser) getSerializedElasticBlockAndHeaderHash(
 		SearchOrder:           computeBlockSearchOrder(header),
 	}
 
-	serializedBlock, err := json.Marshal(elasticBlock)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return serializedBlock, headerHash, nil
+	return elasticBlock, headerHash, nil
 }
 
 func (dp *dataParser) getMiniblocks(header data.HeaderHandler, body *block.Body) []*Miniblock {
@@ -0,0 +1,32 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+)
+
+var errBlocksProviderDisabled = errors.New("blocks provider is disabled")
+
+type blocksProvider struct {
+}
+
+// NewBlocksProvider returns a blocks provider that never finds a block
+func NewBlocksProvider() *blocksProvider {
+	return new(blocksProvider)
+}
+
+// GetBlockByNonce returns error
+func (bp *blocksProvider) GetBlockByNonce(_ uint32, _ uint64) (*workItems.StoredBlock, error) {
+	return nil, errBlocksProviderDisabled
+}
+
+// GetBlockByHash returns error
+func (bp *blocksProvider) GetBlockByHash(_ uint32, _ []byte) (*workItems.StoredBlock, error) {
+	return nil, errBlocksProviderDisabled
+}
+
+// IsInterfaceNil -
+func (bp *blocksProvider) IsInterfaceNil() bool {
+	return bp == nil
+}
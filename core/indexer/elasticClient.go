@@ -128,6 +128,34 @@ func (ec *elasticClient) DoMultiGet(obj objectsMap, index string) (objectsMap, e
 	return decodedBody, nil
 }
 
+// DoSearch will do a search request to elastic server
+func (ec *elasticClient) DoSearch(obj objectsMap, index string) (objectsMap, error) {
+	body, err := encode(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ec.es.Search(
+		ec.es.Search.WithIndex(index),
+		ec.es.Search.WithBody(&body),
+	)
+	if err != nil {
+		log.Warn("elasticClient.DoSearch",
+			"cannot do search no response", err.Error())
+		return nil, err
+	}
+
+	var decodedBody objectsMap
+	err = parseResponse(res, &decodedBody, elasticDefaultErrorResponseHandler)
+	if err != nil {
+		log.Warn("elasticClient.DoSearch",
+			"error parsing response", err.Error())
+		return nil, err
+	}
+
+	return decodedBody, nil
+}
+
 // DoBulkRemove will do a bulk remove to elasticsearch server
 func (ec *elasticClient) DoBulkRemove(index string, hashes []string) error {
 	obj := prepareHashesForBulkRemove(hashes)
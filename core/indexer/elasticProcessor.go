@@ -16,7 +16,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
-	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
 )
 
@@ -178,7 +177,7 @@ func (ei *elasticProcessor) createOpenDistroTemplates(indexTemplates map[string]
 }
 
 func (ei *elasticProcessor) createIndexTemplates(indexTemplates map[string]*bytes.Buffer) error {
-	indexes := []string{txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex}
+	indexes := []string{txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex, logsIndex, scResultsIndex, esdtTransfersIndex}
 	for _, index := range indexes {
 		indexTemplate := getTemplateByName(index, indexTemplates)
 		if indexTemplate != nil {
@@ -193,7 +192,7 @@ func (ei *elasticProcessor) createIndexTemplates(indexTemplates map[string]*byte
 }
 
 func (ei *elasticProcessor) createIndexes() error {
-	indexes := []string{txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex}
+	indexes := []string{txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex, logsIndex, scResultsIndex, esdtTransfersIndex}
 	for _, index := range indexes {
 		indexName := fmt.Sprintf("%s-000001", index)
 		err := ei.elasticClient.CheckAndCreateIndex(indexName)
@@ -206,7 +205,7 @@ func (ei *elasticProcessor) createIndexes() error {
 }
 
 func (ei *elasticProcessor) createAliases() error {
-	indexes := []string{txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex}
+	indexes := []string{txIndex, blockIndex, miniblocksIndex, tpsIndex, ratingIndex, roundIndex, validatorsIndex, accountsIndex, accountsHistoryIndex, logsIndex, scResultsIndex, esdtTransfersIndex}
 	for _, index := range indexes {
 		indexName := fmt.Sprintf("%s-000001", index)
 		err := ei.elasticClient.CheckAndCreateAlias(index, indexName)
@@ -318,11 +317,6 @@ func (ei *elasticProcessor) RemoveMiniblocks(header data.HeaderHandler, body *bl
 	return ei.elasticClient.DoBulkRemove(miniblocksIndex, encodedMiniblocksHashes)
 }
 
-// SetTxLogsProcessor will set tx logs processor
-func (ei *elasticProcessor) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
-	ei.txLogsProcessor = txLogsProc
-}
-
 // SaveMiniblocks will prepare and save information about miniblocks in elasticsearch server
 func (ei *elasticProcessor) SaveMiniblocks(header data.HeaderHandler, body *block.Body) (map[string]bool, error) {
 	if !ei.isIndexEnabled(miniblocksIndex) {
@@ -346,6 +340,16 @@ func (ei *elasticProcessor) SaveTransactions(
 	selfShardID uint32,
 	mbsInDb map[string]bool,
 ) error {
+	err := ei.saveScResults(header, txPool)
+	if err != nil {
+		return err
+	}
+
+	err = ei.saveESDTTransfers(header, txPool)
+	if err != nil {
+		return err
+	}
+
 	if !ei.isIndexEnabled(txIndex) {
 		return nil
 	}
@@ -368,6 +372,75 @@ func (ei *elasticProcessor) SaveTransactions(
 	return ei.indexAlteredAccounts(alteredAccounts)
 }
 
+func (ei *elasticProcessor) saveScResults(header data.HeaderHandler, txPool map[string]data.TransactionHandler) error {
+	if !ei.isIndexEnabled(scResultsIndex) {
+		return nil
+	}
+
+	scResults := ei.prepareScResultsForDatabase(txPool, header)
+	documents := make([]*indexDocument, 0, len(scResults))
+	for _, scResult := range scResults {
+		documents = append(documents, scResult.toIndexDocument())
+	}
+
+	return ei.saveDocuments(documents, scResultsIndex)
+}
+
+func (ei *elasticProcessor) saveESDTTransfers(header data.HeaderHandler, txPool map[string]data.TransactionHandler) error {
+	if !ei.isIndexEnabled(esdtTransfersIndex) {
+		return nil
+	}
+
+	transfers := ei.prepareESDTTransfersForDatabase(txPool, header)
+	documents := make([]*indexDocument, 0, len(transfers))
+	for _, transfer := range transfers {
+		documents = append(documents, transfer.toIndexDocument())
+	}
+
+	return ei.saveDocuments(documents, esdtTransfersIndex)
+}
+
+// SaveLogs will prepare and save the logs generated by the smart contracts executed in the provided block
+func (ei *elasticProcessor) SaveLogs(header data.HeaderHandler, txLogs map[string]data.LogHandler) error {
+	if !ei.isIndexEnabled(logsIndex) {
+		return nil
+	}
+	if check.IfNil(header) {
+		return ErrNilHeaderHandler
+	}
+
+	logs := ei.prepareLogsForDatabase(txLogs, header)
+	documents := make([]*indexDocument, 0, len(logs))
+	for _, txLog := range logs {
+		documents = append(documents, txLog.toIndexDocument())
+	}
+
+	return ei.saveDocuments(documents, logsIndex)
+}
+
+func (ei *elasticProcessor) saveDocuments(documents []*indexDocument, index string) error {
+	if len(documents) == 0 {
+		return nil
+	}
+
+	buffSlice, err := serializeIndexDocuments(documents)
+	if err != nil {
+		return err
+	}
+
+	for idx := range buffSlice {
+		err = ei.elasticClient.DoBulkRequest(&buffSlice[idx], index)
+		if err != nil {
+			log.Warn("indexer indexing bulk of documents",
+				"index", index,
+				"error", err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
 // SaveShardStatistics will prepare and save information about a shard statistics in elasticsearch server
 func (ei *elasticProcessor) SaveShardStatistics(tpsBenchmark statistics.TPSBenchmark) error {
 	if !ei.isIndexEnabled(tpsIndex) {
@@ -599,6 +672,45 @@ func (ei *elasticProcessor) saveAccountsHistory(accountsInfoMap map[string]*Acco
 	return nil
 }
 
+// GetLastIndexedBlockNonce returns the highest nonce of the blocks saved for the provided shard
+func (ei *elasticProcessor) GetLastIndexedBlockNonce(shardID uint32) (uint64, bool, error) {
+	if !ei.isIndexEnabled(blockIndex) {
+		return 0, false, nil
+	}
+
+	response, err := ei.elasticClient.DoSearch(getLastBlockNonceQuery(shardID), blockIndex)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return getNonceFromSearchResponse(response)
+}
+
+func getNonceFromSearchResponse(response objectsMap) (uint64, bool, error) {
+	hits, ok := response["hits"].(map[string]interface{})
+	if !ok {
+		return 0, false, nil
+	}
+	hitsSlice, ok := hits["hits"].([]interface{})
+	if !ok || len(hitsSlice) == 0 {
+		return 0, false, nil
+	}
+	firstHit, ok := hitsSlice[0].(map[string]interface{})
+	if !ok {
+		return 0, false, ErrInvalidSearchResponse
+	}
+	source, ok := firstHit["_source"].(map[string]interface{})
+	if !ok {
+		return 0, false, ErrInvalidSearchResponse
+	}
+	nonce, ok := source["nonce"].(float64)
+	if !ok {
+		return 0, false, ErrInvalidSearchResponse
+	}
+
+	return uint64(nonce), true, nil
+}
+
 func (ei *elasticProcessor) isIndexEnabled(index string) bool {
 	_, isEnabled := ei.enabledIndexes[index]
 	return isEnabled
@@ -849,3 +849,118 @@ func TestElasticProcessor_ComputeBalanceAsFloat(t *testing.T) {
 		assert.Equal(t, tt.output, out)
 	}
 }
+
+func TestElasticProcessor_SaveLogs(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes[logsIndex] = struct{}{}
+
+	indexedDocuments := make(map[string]string)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			indexedDocuments[index] += buff.String()
+			return nil
+		},
+	}
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+
+	txLogs := map[string]data.LogHandler{
+		"txHash": &transaction.Log{Address: []byte("sc address")},
+	}
+	err := elasticDatabase.SaveLogs(&dataBlock.Header{TimeStamp: 10}, txLogs)
+	require.Nil(t, err)
+
+	expectedMeta := fmt.Sprintf(`{ "index" : { "_id" : "%s" } }`, hex.EncodeToString([]byte("txHash")))
+	assert.Contains(t, indexedDocuments[logsIndex], expectedMeta)
+	assert.Contains(t, indexedDocuments[logsIndex], `"timestamp":10`)
+}
+
+func TestElasticProcessor_SaveLogsDisabledIndexShouldNotWrite(t *testing.T) {
+	t.Parallel()
+
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticProcessorArgs())
+
+	txLogs := map[string]data.LogHandler{
+		"txHash": &transaction.Log{Address: []byte("sc address")},
+	}
+	err := elasticDatabase.SaveLogs(&dataBlock.Header{}, txLogs)
+	require.Nil(t, err)
+}
+
+func TestElasticProcessor_SaveTransactionsShouldIndexScResultsAndESDTTransfers(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{scResultsIndex: {}, esdtTransfersIndex: {}}
+
+	indexedDocuments := make(map[string]string)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			indexedDocuments[index] += buff.String()
+			return nil
+		},
+	}
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, arguments)
+
+	esdtData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString([]byte("TKN")) + "@0a"
+	txPool := map[string]data.TransactionHandler{
+		"tx": &transaction.Transaction{Data: []byte(esdtData)},
+		"scr": &smartContractResult.SmartContractResult{
+			Value:          big.NewInt(0),
+			OriginalTxHash: []byte("tx"),
+		},
+	}
+	err := elasticDatabase.SaveTransactions(&dataBlock.Body{}, &dataBlock.Header{}, txPool, 0, map[string]bool{})
+	require.Nil(t, err)
+
+	assert.Contains(t, indexedDocuments[scResultsIndex], hex.EncodeToString([]byte("scr")))
+	assert.Contains(t, indexedDocuments[esdtTransfersIndex], `"token":"TKN","value":"10"`)
+	_, txsIndexed := indexedDocuments[txIndex]
+	assert.False(t, txsIndexed)
+}
+
+func TestElasticProcessor_GetLastIndexedBlockNonce(t *testing.T) {
+	t.Parallel()
+
+	response := objectsMap{}
+	dbWriter := &mock.DatabaseWriterStub{
+		DoSearchCalled: func(query map[string]interface{}, index string) (map[string]interface{}, error) {
+			assert.Equal(t, blockIndex, index)
+			return response, nil
+		},
+	}
+	elasticDatabase := newTestElasticSearchDatabase(dbWriter, createMockElasticProcessorArgs())
+
+	_, found, err := elasticDatabase.GetLastIndexedBlockNonce(0)
+	require.Nil(t, err)
+	assert.False(t, found)
+
+	response = objectsMap{
+		"hits": map[string]interface{}{
+			"hits": []interface{}{
+				map[string]interface{}{"_source": map[string]interface{}{"nonce": float64(12)}},
+			},
+		},
+	}
+	nonce, found, err := elasticDatabase.GetLastIndexedBlockNonce(0)
+	require.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, uint64(12), nonce)
+
+	response = objectsMap{
+		"hits": map[string]interface{}{
+			"hits": []interface{}{
+				map[string]interface{}{"_source": map[string]interface{}{}},
+			},
+		},
+	}
+	_, _, err = elasticDatabase.GetLastIndexedBlockNonce(0)
+	assert.Equal(t, ErrInvalidSearchResponse, err)
+}
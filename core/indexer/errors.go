@@ -48,3 +48,36 @@ var ErrReadPolicyFile = errors.New("error while reading policy file")
 
 // ErrWriteToBuffer signals that a write error occurred
 var ErrWriteToBuffer = errors.New("error while writing to buffer")
+
+// ErrNilSQLDatabase signals that a nil sql database has been provided
+var ErrNilSQLDatabase = errors.New("nil sql database")
+
+// ErrUnknownSQLSchemaVersion signals that the sql database was created by a newer version of the indexer
+var ErrUnknownSQLSchemaVersion = errors.New("unknown sql schema version")
+
+// ErrUnregisteredSQLDriver signals that the configured sql driver is not linked in the current binary
+var ErrUnregisteredSQLDriver = errors.New("unregistered sql driver")
+
+// ErrEmptySQLDriverName signals that the sql indexer was enabled without setting a sql driver
+var ErrEmptySQLDriverName = errors.New("empty sql driver name")
+
+// ErrNilHeaderHandler signals that a nil header handler has been provided
+var ErrNilHeaderHandler = errors.New("nil header handler")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilUint64Converter signals that a nil uint64 byte slice converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
+// ErrNilBlocksProvider signals that a nil blocks provider has been provided
+var ErrNilBlocksProvider = errors.New("nil blocks provider")
+
+// ErrNilPendingBlocksStorer signals that a nil pending blocks storer has been provided
+var ErrNilPendingBlocksStorer = errors.New("nil pending blocks storer")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrInvalidSearchResponse signals that the database returned a search response in an unexpected format
+var ErrInvalidSearchResponse = errors.New("invalid search response")
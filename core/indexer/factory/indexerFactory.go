@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/elastic/go-elasticsearch/v7"
 )
 
@@ -42,6 +43,9 @@ type ArgsIndexerFactory struct {
 	AccountsDB               state.AccountsAdapter
 	TransactionFeeCalculator process.TransactionFeeCalculator
 	IsInImportDBMode         bool
+	BlocksProvider           indexer.BlocksProvider
+	PendingBlocksStorer      storage.Storer
+	StatusHandler            core.AppStatusHandler
 }
 
 // NewIndexer will create a new instance of Indexer
@@ -68,13 +72,16 @@ func NewIndexer(args *ArgsIndexerFactory) (indexer.Indexer, error) {
 	dispatcher.StartIndexData()
 
 	arguments := indexer.ArgDataIndexer{
-		Marshalizer:        args.Marshalizer,
-		Options:            args.Options,
-		NodesCoordinator:   args.NodesCoordinator,
-		EpochStartNotifier: args.EpochStartNotifier,
-		ShardCoordinator:   args.ShardCoordinator,
-		ElasticProcessor:   elasticProcessor,
-		DataDispatcher:     dispatcher,
+		Marshalizer:         args.Marshalizer,
+		Options:             args.Options,
+		NodesCoordinator:    args.NodesCoordinator,
+		EpochStartNotifier:  args.EpochStartNotifier,
+		ShardCoordinator:    args.ShardCoordinator,
+		ElasticProcessor:    elasticProcessor,
+		DataDispatcher:      dispatcher,
+		BlocksProvider:      args.BlocksProvider,
+		PendingBlocksStorer: args.PendingBlocksStorer,
+		StatusHandler:       args.StatusHandler,
 	}
 
 	return indexer.NewDataIndexer(arguments)
@@ -9,7 +9,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,6 +37,9 @@ func createMockIndexerFactoryArgs() *ArgsIndexerFactory {
 		TransactionFeeCalculator: &economicsmocks.EconomicsHandlerStub{},
 		ShardCoordinator:         &mock.ShardCoordinatorMock{},
 		IsInImportDBMode:         false,
+		BlocksProvider:           &mock.BlocksProviderStub{},
+		PendingBlocksStorer:      genericmocks.NewStorerMock("PendingBlocks", 0),
+		StatusHandler:            statusHandler.NewNilStatusHandler(),
 	}
 }
 
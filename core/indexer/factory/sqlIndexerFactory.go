@@ -0,0 +1,178 @@
+package factory
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsSQLIndexerFactory holds all dependencies required by the sql indexer factory in order to create new instances
+type ArgsSQLIndexerFactory struct {
+	Enabled                  bool
+	IndexerCacheSize         int
+	DriverName               string
+	DataSourceName           string
+	ShardCoordinator         sharding.Coordinator
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	EpochStartNotifier       sharding.EpochStartEventNotifier
+	NodesCoordinator         sharding.NodesCoordinator
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+	EnabledIndexes           []string
+	Denomination             int
+	AccountsDB               state.AccountsAdapter
+	TransactionFeeCalculator process.TransactionFeeCalculator
+	IsInImportDBMode         bool
+	BlocksProvider           indexer.BlocksProvider
+	PendingBlocksStorer      storage.Storer
+	StatusHandler            core.AppStatusHandler
+}
+
+// NewSQLIndexer will create a new instance of Indexer that writes the data in a sql database
+func NewSQLIndexer(args *ArgsSQLIndexerFactory) (indexer.Indexer, error) {
+	err := checkSQLIndexerParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if !args.Enabled {
+		return indexer.NewNilIndexer(), nil
+	}
+
+	sqlProcessor, err := createSQLProcessor(args)
+	if err != nil {
+		return nil, err
+	}
+
+	dispatcher, err := indexer.NewDataDispatcher(args.IndexerCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	dispatcher.StartIndexData()
+
+	arguments := indexer.ArgDataIndexer{
+		Marshalizer:         args.Marshalizer,
+		Options:             &indexer.Options{},
+		NodesCoordinator:    args.NodesCoordinator,
+		EpochStartNotifier:  args.EpochStartNotifier,
+		ShardCoordinator:    args.ShardCoordinator,
+		ElasticProcessor:    sqlProcessor,
+		DataDispatcher:      dispatcher,
+		BlocksProvider:      args.BlocksProvider,
+		PendingBlocksStorer: args.PendingBlocksStorer,
+		StatusHandler:       args.StatusHandler,
+	}
+
+	return indexer.NewDataIndexer(arguments)
+}
+
+func isSQLDriverRegistered(driverName string) bool {
+	for _, name := range sql.Drivers() {
+		if name == driverName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckSQLDriver returns an error if the provided sql driver is not linked in the binary. The node binary links the
+// github.com/mattn/go-sqlite3 driver, registered as "sqlite3", only when built with the sqlite build tag
+func CheckSQLDriver(driverName string) error {
+	if len(driverName) == 0 {
+		return fmt.Errorf("%w: the node should be built with the sqlite build tag and the DriverName set to sqlite3",
+			indexer.ErrEmptySQLDriverName)
+	}
+	if !isSQLDriverRegistered(driverName) {
+		return fmt.Errorf("%w: %s, the node should be built with the sqlite build tag and the DriverName set to sqlite3",
+			indexer.ErrUnregisteredSQLDriver, driverName)
+	}
+
+	return nil
+}
+
+// createSQLProcessor opens the database with the configured driver. The statements use the SQLite dialect, so only
+// SQLite drivers are supported
+func createSQLProcessor(args *ArgsSQLIndexerFactory) (indexer.ElasticProcessor, error) {
+	err := CheckSQLDriver(args.DriverName)
+	if err != nil {
+		return nil, err
+	}
+
+	enabledIndexesMap := make(map[string]struct{})
+	for _, index := range args.EnabledIndexes {
+		enabledIndexesMap[index] = struct{}{}
+	}
+	if len(enabledIndexesMap) == 0 {
+		return nil, indexer.ErrEmptyEnabledIndexes
+	}
+
+	db, err := sql.Open(args.DriverName, args.DataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	// the data indexer writes from a single goroutine and SQLite allows only one writer at a time
+	db.SetMaxOpenConns(1)
+
+	sqlProcessorArgs := indexer.ArgSQLProcessor{
+		DB:                       db,
+		Marshalizer:              args.Marshalizer,
+		Hasher:                   args.Hasher,
+		AddressPubkeyConverter:   args.AddressPubkeyConverter,
+		ValidatorPubkeyConverter: args.ValidatorPubkeyConverter,
+		EnabledIndexes:           enabledIndexesMap,
+		AccountsDB:               args.AccountsDB,
+		Denomination:             args.Denomination,
+		TransactionFeeCalculator: args.TransactionFeeCalculator,
+		IsInImportDBMode:         args.IsInImportDBMode,
+		ShardCoordinator:         args.ShardCoordinator,
+	}
+
+	sqlProcessor, err := indexer.NewSQLProcessor(sqlProcessorArgs)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return sqlProcessor, nil
+}
+
+func checkSQLIndexerParams(arguments *ArgsSQLIndexerFactory) error {
+	if arguments.IndexerCacheSize < 0 {
+		return indexer.ErrNegativeCacheSize
+	}
+	if check.IfNil(arguments.AddressPubkeyConverter) {
+		return fmt.Errorf("%w when setting AddressPubkeyConverter in indexer", indexer.ErrNilPubkeyConverter)
+	}
+	if check.IfNil(arguments.ValidatorPubkeyConverter) {
+		return fmt.Errorf("%w when setting ValidatorPubkeyConverter in indexer", indexer.ErrNilPubkeyConverter)
+	}
+	if check.IfNil(arguments.Marshalizer) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(arguments.Hasher) {
+		return core.ErrNilHasher
+	}
+	if check.IfNil(arguments.NodesCoordinator) {
+		return core.ErrNilNodesCoordinator
+	}
+	if check.IfNil(arguments.EpochStartNotifier) {
+		return core.ErrNilEpochStartNotifier
+	}
+	if check.IfNil(arguments.TransactionFeeCalculator) {
+		return core.ErrNilTransactionFeeCalculator
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package factory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockSQLIndexerFactoryArgs(driverName string) *ArgsSQLIndexerFactory {
+	return &ArgsSQLIndexerFactory{
+		Enabled:                  true,
+		IndexerCacheSize:         100,
+		DriverName:               driverName,
+		DataSourceName:           "indexer.sqlite",
+		Marshalizer:              &mock.MarshalizerMock{},
+		Hasher:                   &mock.HasherMock{},
+		EpochStartNotifier:       &mock.EpochStartNotifierStub{},
+		NodesCoordinator:         &mock.NodesCoordinatorMock{},
+		AddressPubkeyConverter:   &mock.PubkeyConverterMock{},
+		ValidatorPubkeyConverter: &mock.PubkeyConverterMock{},
+		EnabledIndexes:           []string{"blocks", "transactions", "miniblocks", "validators", "rounds", "accounts", "rating"},
+		AccountsDB:               &mock.AccountsStub{},
+		TransactionFeeCalculator: &economicsmocks.EconomicsHandlerStub{},
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		BlocksProvider:           &mock.BlocksProviderStub{},
+		PendingBlocksStorer:      genericmocks.NewStorerMock("PendingBlocks", 0),
+		StatusHandler:            statusHandler.NewNilStatusHandler(),
+	}
+}
+
+func TestNewSQLIndexer_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockSQLIndexerFactoryArgs("sqlite3")
+	args.IndexerCacheSize = -1
+	_, err := NewSQLIndexer(args)
+	assert.Equal(t, indexer.ErrNegativeCacheSize, err)
+
+	args = createMockSQLIndexerFactoryArgs("sqlite3")
+	args.Hasher = nil
+	_, err = NewSQLIndexer(args)
+	assert.Equal(t, core.ErrNilHasher, err)
+}
+
+func TestNewSQLIndexer_DisabledShouldReturnNilIndexer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockSQLIndexerFactoryArgs("sqlite3")
+	args.Enabled = false
+	sqlIndexer, err := NewSQLIndexer(args)
+
+	require.Nil(t, err)
+	assert.True(t, sqlIndexer.IsNilIndexer())
+}
+
+func TestNewSQLIndexer_EmptyDriverNameShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sqlIndexer, err := NewSQLIndexer(createMockSQLIndexerFactoryArgs(""))
+
+	assert.Nil(t, sqlIndexer)
+	assert.True(t, errors.Is(err, indexer.ErrEmptySQLDriverName))
+}
+
+func TestNewSQLIndexer_UnregisteredDriverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sqlIndexer, err := NewSQLIndexer(createMockSQLIndexerFactoryArgs("not registered driver"))
+
+	assert.Nil(t, sqlIndexer)
+	assert.True(t, errors.Is(err, indexer.ErrUnregisteredSQLDriver))
+}
+
+func TestCheckSQLDriver(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, errors.Is(CheckSQLDriver(""), indexer.ErrEmptySQLDriverName))
+	assert.True(t, errors.Is(CheckSQLDriver("not registered driver"), indexer.ErrUnregisteredSQLDriver))
+
+	driverMock := &mock.SQLDriverMock{}
+	assert.Nil(t, CheckSQLDriver(driverMock.Register()))
+}
+
+func TestNewSQLIndexer_EmptyEnabledIndexesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	driverMock := &mock.SQLDriverMock{}
+	args := createMockSQLIndexerFactoryArgs(driverMock.Register())
+	args.EnabledIndexes = nil
+	sqlIndexer, err := NewSQLIndexer(args)
+
+	assert.Nil(t, sqlIndexer)
+	assert.Equal(t, indexer.ErrEmptyEnabledIndexes, err)
+}
+
+func TestNewSQLIndexer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	driverMock := &mock.SQLDriverMock{}
+	sqlIndexer, err := NewSQLIndexer(createMockSQLIndexerFactoryArgs(driverMock.Register()))
+
+	require.Nil(t, err)
+	assert.False(t, sqlIndexer.IsNilIndexer())
+	assert.Equal(t, 1, len(driverMock.StatementsContaining("CREATE TABLE IF NOT EXISTS blocks")))
+	assert.Nil(t, sqlIndexer.Close())
+}
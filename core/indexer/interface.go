@@ -53,11 +53,12 @@ type ElasticProcessor interface {
 	RemoveMiniblocks(header data.HeaderHandler, body *block.Body) error
 	SaveMiniblocks(header data.HeaderHandler, body *block.Body) (map[string]bool, error)
 	SaveTransactions(body *block.Body, header data.HeaderHandler, txPool map[string]data.TransactionHandler, selfShardID uint32, mbsInDb map[string]bool) error
+	SaveLogs(header data.HeaderHandler, txLogs map[string]data.LogHandler) error
 	SaveValidatorsRating(index string, validatorsRatingInfo []workItems.ValidatorRatingInfo) error
 	SaveRoundsInfo(infos []workItems.RoundInfo) error
 	SaveShardValidatorsPubKeys(shardID, epoch uint32, shardValidatorsPubKeys [][]byte) error
-	SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase)
 	SaveAccounts(accounts []state.UserAccountHandler) error
+	GetLastIndexedBlockNonce(shardID uint32) (uint64, bool, error)
 	IsInterfaceNil() bool
 }
 
@@ -67,6 +68,7 @@ type DatabaseClientHandler interface {
 	DoBulkRequest(buff *bytes.Buffer, index string) error
 	DoBulkRemove(index string, hashes []string) error
 	DoMultiGet(query objectsMap, index string) (objectsMap, error)
+	DoSearch(query objectsMap, index string) (objectsMap, error)
 
 	CheckAndCreateIndex(index string) error
 	CheckAndCreateAlias(alias string, index string) error
@@ -76,6 +78,13 @@ type DatabaseClientHandler interface {
 	IsInterfaceNil() bool
 }
 
+// BlocksProvider defines what a component able to read the committed blocks from the local storage should do
+type BlocksProvider interface {
+	GetBlockByNonce(shardID uint32, nonce uint64) (*workItems.StoredBlock, error)
+	GetBlockByHash(shardID uint32, headerHash []byte) (*workItems.StoredBlock, error)
+	IsInterfaceNil() bool
+}
+
 // FeesProcessorHandler defines the interface for the transaction fees processor
 type FeesProcessorHandler interface {
 	ComputeGasUsedAndFeeBasedOnRefundValue(tx process.TransactionWithFeeHandler, refundValueStr string) (uint64, *big.Int)
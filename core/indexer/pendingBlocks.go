@@ -0,0 +1,143 @@
+package indexer
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// pendingBlock is the record persisted for each block handed to the indexer and removed once the block was indexed.
+// The block itself is not persisted as it can be rebuilt from the node's storage
+type pendingBlock struct {
+	HeaderHash []byte `json:"headerHash"`
+	ShardID    uint32 `json:"shardID"`
+	Nonce      uint64 `json:"nonce"`
+}
+
+// pendingBlocksTracker keeps track of the blocks that were not yet indexed and computes the indexing lag
+type pendingBlocksTracker struct {
+	storer        storage.Storer
+	marshalizer   marshal.Marshalizer
+	statusHandler core.AppStatusHandler
+
+	mut               sync.Mutex
+	numPending        uint64
+	lastReceivedNonce uint64
+	lastIndexedNonce  uint64
+}
+
+func newPendingBlocksTracker(storer storage.Storer, statusHandler core.AppStatusHandler) *pendingBlocksTracker {
+	return &pendingBlocksTracker{
+		storer:        storer,
+		marshalizer:   &marshal.JsonMarshalizer{},
+		statusHandler: statusHandler,
+	}
+}
+
+// loadPendingBlocks returns the blocks that remained unindexed in the previous session, sorted by nonce
+func (pbt *pendingBlocksTracker) loadPendingBlocks() []*pendingBlock {
+	pending := make([]*pendingBlock, 0)
+	pbt.storer.RangeKeys(func(key []byte, val []byte) bool {
+		record := &pendingBlock{}
+		err := pbt.marshalizer.Unmarshal(record, val)
+		if err != nil {
+			log.Warn("indexer: invalid pending block record, will skip", "key", key, "error", err.Error())
+			return true
+		}
+
+		pending = append(pending, record)
+		return true
+	})
+
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].Nonce < pending[j].Nonce
+	})
+
+	pbt.mut.Lock()
+	pbt.numPending = uint64(len(pending))
+	pbt.updateMetrics()
+	pbt.mut.Unlock()
+
+	return pending
+}
+
+func (pbt *pendingBlocksTracker) add(record *pendingBlock) {
+	buff, err := pbt.marshalizer.Marshal(record)
+	if err == nil {
+		err = pbt.storer.Put(record.HeaderHash, buff)
+	}
+	if err != nil {
+		log.Warn("indexer: could not persist pending block", "hash", record.HeaderHash, "error", err.Error())
+	}
+
+	pbt.mut.Lock()
+	pbt.numPending++
+	if record.Nonce > pbt.lastReceivedNonce {
+		pbt.lastReceivedNonce = record.Nonce
+	}
+	pbt.updateMetrics()
+	pbt.mut.Unlock()
+}
+
+func (pbt *pendingBlocksTracker) remove(record *pendingBlock) {
+	err := pbt.storer.Remove(record.HeaderHash)
+	if err != nil {
+		log.Warn("indexer: could not remove pending block", "hash", record.HeaderHash, "error", err.Error())
+	}
+
+	pbt.mut.Lock()
+	if pbt.numPending > 0 {
+		pbt.numPending--
+	}
+	pbt.mut.Unlock()
+
+	pbt.setIndexed(record.Nonce)
+}
+
+func (pbt *pendingBlocksTracker) setIndexed(nonce uint64) {
+	pbt.mut.Lock()
+	if nonce > pbt.lastIndexedNonce {
+		pbt.lastIndexedNonce = nonce
+	}
+	pbt.updateMetrics()
+	pbt.mut.Unlock()
+}
+
+func (pbt *pendingBlocksTracker) updateMetrics() {
+	lag := uint64(0)
+	if pbt.lastReceivedNonce > pbt.lastIndexedNonce {
+		lag = pbt.lastReceivedNonce - pbt.lastIndexedNonce
+	}
+
+	pbt.statusHandler.SetUInt64Value(core.MetricIndexerLastIndexedNonce, pbt.lastIndexedNonce)
+	pbt.statusHandler.SetUInt64Value(core.MetricIndexerLag, lag)
+	pbt.statusHandler.SetUInt64Value(core.MetricIndexerPendingBlocks, pbt.numPending)
+}
+
+// pendingBlockItem wraps a block work item so that the persisted record is removed only after the block was indexed
+type pendingBlockItem struct {
+	workItems.WorkItemHandler
+	record  *pendingBlock
+	tracker *pendingBlocksTracker
+}
+
+// Save will save the wrapped item and, on success, will mark the block as indexed
+func (pbi *pendingBlockItem) Save() error {
+	err := pbi.WorkItemHandler.Save()
+	if err != nil {
+		return err
+	}
+
+	pbi.tracker.remove(pbi.record)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pbi *pendingBlockItem) IsInterfaceNil() bool {
+	return pbi == nil
+}
@@ -0,0 +1,118 @@
+package indexer
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type metricsRecorder struct {
+	mut     sync.Mutex
+	metrics map[string]uint64
+}
+
+func newMetricsRecorder() (*metricsRecorder, *mock.AppStatusHandlerStub) {
+	recorder := &metricsRecorder{metrics: make(map[string]uint64)}
+	handler := &mock.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			recorder.mut.Lock()
+			recorder.metrics[key] = value
+			recorder.mut.Unlock()
+		},
+	}
+
+	return recorder, handler
+}
+
+func (mr *metricsRecorder) get(key string) uint64 {
+	mr.mut.Lock()
+	defer mr.mut.Unlock()
+
+	return mr.metrics[key]
+}
+
+func TestPendingBlocksTracker_AddAndRemoveShouldPersistAndUpdateMetrics(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("PendingBlocks", 0)
+	recorder, handler := newMetricsRecorder()
+	tracker := newPendingBlocksTracker(storer, handler)
+
+	first := &pendingBlock{HeaderHash: []byte("hash1"), ShardID: 1, Nonce: 10}
+	second := &pendingBlock{HeaderHash: []byte("hash2"), ShardID: 1, Nonce: 11}
+	tracker.add(first)
+	tracker.add(second)
+
+	assert.Equal(t, uint64(2), recorder.get(core.MetricIndexerPendingBlocks))
+	assert.Equal(t, uint64(11), recorder.get(core.MetricIndexerLag))
+	assert.Nil(t, storer.Has(first.HeaderHash))
+
+	tracker.remove(first)
+	assert.NotNil(t, storer.Has(first.HeaderHash))
+	assert.Equal(t, uint64(1), recorder.get(core.MetricIndexerPendingBlocks))
+	assert.Equal(t, uint64(10), recorder.get(core.MetricIndexerLastIndexedNonce))
+	assert.Equal(t, uint64(1), recorder.get(core.MetricIndexerLag))
+
+	tracker.remove(second)
+	assert.Equal(t, uint64(0), recorder.get(core.MetricIndexerPendingBlocks))
+	assert.Equal(t, uint64(0), recorder.get(core.MetricIndexerLag))
+}
+
+func TestPendingBlocksTracker_LoadPendingBlocksShouldSortByNonceAndSkipInvalidRecords(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("PendingBlocks", 0)
+	recorder, handler := newMetricsRecorder()
+	tracker := newPendingBlocksTracker(storer, handler)
+	tracker.add(&pendingBlock{HeaderHash: []byte("hash7"), Nonce: 7})
+	tracker.add(&pendingBlock{HeaderHash: []byte("hash5"), Nonce: 5})
+	tracker.add(&pendingBlock{HeaderHash: []byte("hash6"), Nonce: 6})
+	_ = storer.Put([]byte("invalid"), []byte("not a record"))
+
+	reloadedTracker := newPendingBlocksTracker(storer, handler)
+	pending := reloadedTracker.loadPendingBlocks()
+
+	require.Equal(t, 3, len(pending))
+	assert.Equal(t, uint64(5), pending[0].Nonce)
+	assert.Equal(t, uint64(6), pending[1].Nonce)
+	assert.Equal(t, uint64(7), pending[2].Nonce)
+	assert.Equal(t, []byte("hash5"), pending[0].HeaderHash)
+	assert.Equal(t, uint64(3), recorder.get(core.MetricIndexerPendingBlocks))
+}
+
+func TestPendingBlockItem_SaveShouldRemoveTheRecordOnlyOnSuccess(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("PendingBlocks", 0)
+	_, handler := newMetricsRecorder()
+	tracker := newPendingBlocksTracker(storer, handler)
+	record := &pendingBlock{HeaderHash: []byte("hash"), Nonce: 3}
+	tracker.add(record)
+
+	expectedErr := errors.New("expected error")
+	saveErr := expectedErr
+	item := &pendingBlockItem{
+		WorkItemHandler: &mock.WorkItemHandlerStub{
+			SaveCalled: func() error {
+				return saveErr
+			},
+		},
+		record:  record,
+		tracker: tracker,
+	}
+
+	err := item.Save()
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, storer.Has(record.HeaderHash))
+
+	saveErr = nil
+	err = item.Save()
+	assert.Nil(t, err)
+	assert.NotNil(t, storer.Has(record.HeaderHash))
+}
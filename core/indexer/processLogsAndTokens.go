@@ -0,0 +1,183 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+const argumentsSeparator = "@"
+
+// indexDocument holds a document that will be saved in an index under the provided ID
+type indexDocument struct {
+	id   string
+	body interface{}
+}
+
+func (tdp *txDatabaseProcessor) prepareLogsForDatabase(
+	txLogs map[string]data.LogHandler,
+	header data.HeaderHandler,
+) []*Logs {
+	logs := make([]*Logs, 0, len(txLogs))
+	for txHash, txLog := range txLogs {
+		if check.IfNil(txLog) {
+			continue
+		}
+
+		preparedLog := tdp.prepareTxLog(txLog)
+		logs = append(logs, &Logs{
+			TxHash:    hex.EncodeToString([]byte(txHash)),
+			Address:   preparedLog.Address,
+			Events:    preparedLog.Events,
+			Timestamp: time.Duration(header.GetTimeStamp()),
+		})
+	}
+
+	sort.Slice(logs, func(i, j int) bool {
+		return logs[i].TxHash < logs[j].TxHash
+	})
+
+	return logs
+}
+
+func (tdp *txDatabaseProcessor) prepareScResultsForDatabase(
+	txPool map[string]data.TransactionHandler,
+	header data.HeaderHandler,
+) []*ScResult {
+	scResults := groupSmartContractResults(txPool)
+
+	dbScResults := make([]*ScResult, 0, len(scResults))
+	for scHash, scResult := range scResults {
+		dbScResult := tdp.convertScResultInDatabaseScr(scHash, scResult)
+		dbScResult.Timestamp = time.Duration(header.GetTimeStamp())
+		dbScResults = append(dbScResults, &dbScResult)
+	}
+
+	sort.Slice(dbScResults, func(i, j int) bool {
+		return dbScResults[i].Hash < dbScResults[j].Hash
+	})
+
+	return dbScResults
+}
+
+func (tdp *txDatabaseProcessor) prepareESDTTransfersForDatabase(
+	txPool map[string]data.TransactionHandler,
+	header data.HeaderHandler,
+) []*ESDTTransfer {
+	transfers := make([]*ESDTTransfer, 0)
+	for txHash, tx := range txPool {
+		token, value, ok := decodeESDTTransfer(tx.GetData())
+		if !ok {
+			continue
+		}
+
+		transfer := &ESDTTransfer{
+			TxHash:    hex.EncodeToString([]byte(txHash)),
+			Sender:    tdp.addressPubkeyConverter.Encode(tx.GetSndAddr()),
+			Receiver:  tdp.addressPubkeyConverter.Encode(tx.GetRcvAddr()),
+			Token:     token,
+			Value:     value.String(),
+			Timestamp: time.Duration(header.GetTimeStamp()),
+		}
+
+		switch castedTx := tx.(type) {
+		case *transaction.Transaction:
+		case *smartContractResult.SmartContractResult:
+			transfer.OriginalTxHash = hex.EncodeToString(castedTx.OriginalTxHash)
+		default:
+			continue
+		}
+
+		transfers = append(transfers, transfer)
+	}
+
+	sort.Slice(transfers, func(i, j int) bool {
+		return transfers[i].TxHash < transfers[j].TxHash
+	})
+
+	return transfers
+}
+
+// decodeESDTTransfer decodes a data field in the ESDTTransfer@tokenIdentifier@value[@...] format returning the token
+// identifier and the transferred value
+func decodeESDTTransfer(txData []byte) (string, *big.Int, bool) {
+	arguments := strings.Split(string(txData), argumentsSeparator)
+	if len(arguments) < 3 || arguments[0] != core.BuiltInFunctionESDTTransfer {
+		return "", nil, false
+	}
+
+	token, err := hex.DecodeString(arguments[1])
+	if err != nil || len(token) == 0 {
+		return "", nil, false
+	}
+
+	valueBytes, err := hex.DecodeString(arguments[2])
+	if err != nil {
+		return "", nil, false
+	}
+
+	return string(token), big.NewInt(0).SetBytes(valueBytes), true
+}
+
+func (lp *Logs) toIndexDocument() *indexDocument {
+	return &indexDocument{id: lp.TxHash, body: lp}
+}
+
+func (scr *ScResult) toIndexDocument() *indexDocument {
+	return &indexDocument{id: scr.Hash, body: scr}
+}
+
+func (et *ESDTTransfer) toIndexDocument() *indexDocument {
+	return &indexDocument{id: et.TxHash, body: et}
+}
+
+// serializeIndexDocuments prepares the bulk requests that index the provided documents. As the documents are
+// identified by the hash of the transaction (or smart contract result) they can be safely re-written at forks
+func serializeIndexDocuments(documents []*indexDocument) ([]bytes.Buffer, error) {
+	var buff bytes.Buffer
+	buffSlice := make([]bytes.Buffer, 0)
+	for _, document := range documents {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_id" : "%s" } }%s`, document.id, "\n"))
+		serializedData, err := json.Marshal(document.body)
+		if err != nil {
+			log.Debug("indexer: marshal",
+				"error", "could not serialize document, will skip indexing",
+				"id", document.id)
+			return nil, err
+		}
+		serializedData = append(serializedData, "\n"...)
+
+		buffLenWithCurrentDocument := buff.Len() + len(meta) + len(serializedData)
+		if buffLenWithCurrentDocument > bulkSizeThreshold && buff.Len() != 0 {
+			buffSlice = append(buffSlice, buff)
+			buff = bytes.Buffer{}
+		}
+
+		buff.Grow(len(meta) + len(serializedData))
+		_, err = buff.Write(meta)
+		if err != nil {
+			return nil, err
+		}
+		_, err = buff.Write(serializedData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if buff.Len() != 0 {
+		buffSlice = append(buffSlice, buff)
+	}
+
+	return buffSlice, nil
+}
@@ -0,0 +1,179 @@
+package indexer
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestTxDatabaseProcessor() *txDatabaseProcessor {
+	return newTxDatabaseProcessor(
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&mock.PubkeyConverterMock{},
+		&mock.PubkeyConverterMock{},
+		&economicsmocks.EconomicsHandlerStub{},
+		false,
+		&mock.ShardCoordinatorMock{},
+	)
+}
+
+func createESDTTransferData(token string, value int64) []byte {
+	return []byte(core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString([]byte(token)) +
+		"@" + hex.EncodeToString(big.NewInt(value).Bytes()))
+}
+
+func TestDecodeESDTTransfer(t *testing.T) {
+	t.Parallel()
+
+	token, value, ok := decodeESDTTransfer(createESDTTransferData("TKN-01", 1000))
+	require.True(t, ok)
+	assert.Equal(t, "TKN-01", token)
+	assert.Equal(t, big.NewInt(1000), value)
+
+	token, value, ok = decodeESDTTransfer([]byte(string(createESDTTransferData("TKN-01", 7)) + "@" + hex.EncodeToString([]byte("func"))))
+	require.True(t, ok)
+	assert.Equal(t, "TKN-01", token)
+	assert.Equal(t, big.NewInt(7), value)
+
+	invalidData := []string{
+		"",
+		"transfer@544b4e@0a",
+		core.BuiltInFunctionESDTTransfer,
+		core.BuiltInFunctionESDTTransfer + "@544b4e",
+		core.BuiltInFunctionESDTTransfer + "@@0a",
+		core.BuiltInFunctionESDTTransfer + "@not hex@0a",
+		core.BuiltInFunctionESDTTransfer + "@544b4e@not hex",
+	}
+	for _, txData := range invalidData {
+		_, _, ok = decodeESDTTransfer([]byte(txData))
+		assert.False(t, ok, txData)
+	}
+}
+
+func TestPrepareESDTTransfersForDatabase(t *testing.T) {
+	t.Parallel()
+
+	txPool := map[string]data.TransactionHandler{
+		"tx": &transaction.Transaction{
+			SndAddr: []byte("sender"),
+			RcvAddr: []byte("receiver"),
+			Data:    createESDTTransferData("TKN-01", 100),
+		},
+		"scr": &smartContractResult.SmartContractResult{
+			SndAddr:        []byte("contract"),
+			RcvAddr:        []byte("receiver"),
+			Data:           createESDTTransferData("TKN-02", 5),
+			OriginalTxHash: []byte("original"),
+		},
+		"moveBalance": &transaction.Transaction{Data: []byte("memo")},
+		"reward":      &rewardTx.RewardTx{Value: big.NewInt(10)},
+	}
+
+	transfers := createTestTxDatabaseProcessor().prepareESDTTransfersForDatabase(txPool, &block.Header{TimeStamp: 1234})
+	require.Equal(t, 2, len(transfers))
+
+	assert.Equal(t, &ESDTTransfer{
+		TxHash:         hex.EncodeToString([]byte("scr")),
+		OriginalTxHash: hex.EncodeToString([]byte("original")),
+		Sender:         hex.EncodeToString([]byte("contract")),
+		Receiver:       hex.EncodeToString([]byte("receiver")),
+		Token:          "TKN-02",
+		Value:          "5",
+		Timestamp:      time.Duration(1234),
+	}, transfers[0])
+	assert.Equal(t, &ESDTTransfer{
+		TxHash:    hex.EncodeToString([]byte("tx")),
+		Sender:    hex.EncodeToString([]byte("sender")),
+		Receiver:  hex.EncodeToString([]byte("receiver")),
+		Token:     "TKN-01",
+		Value:     "100",
+		Timestamp: time.Duration(1234),
+	}, transfers[1])
+}
+
+func TestPrepareLogsForDatabase(t *testing.T) {
+	t.Parallel()
+
+	txLogs := map[string]data.LogHandler{
+		"tx2": &transaction.Log{
+			Address: []byte("sc2"),
+			Events: []*transaction.Event{
+				{Address: []byte("addr"), Identifier: []byte("id"), Topics: [][]byte{[]byte("t1")}, Data: []byte("dt")},
+			},
+		},
+		"tx1":     &transaction.Log{Address: []byte("sc1")},
+		"nil log": nil,
+	}
+
+	logs := createTestTxDatabaseProcessor().prepareLogsForDatabase(txLogs, &block.Header{TimeStamp: 99})
+	require.Equal(t, 2, len(logs))
+
+	assert.Equal(t, hex.EncodeToString([]byte("tx1")), logs[0].TxHash)
+	assert.Equal(t, hex.EncodeToString([]byte("sc1")), logs[0].Address)
+	assert.Equal(t, 0, len(logs[0].Events))
+
+	assert.Equal(t, hex.EncodeToString([]byte("tx2")), logs[1].TxHash)
+	assert.Equal(t, time.Duration(99), logs[1].Timestamp)
+	assert.Equal(t, []Event{{
+		Address:    hex.EncodeToString([]byte("addr")),
+		Identifier: hex.EncodeToString([]byte("id")),
+		Topics:     []string{hex.EncodeToString([]byte("t1"))},
+		Data:       hex.EncodeToString([]byte("dt")),
+	}}, logs[1].Events)
+}
+
+func TestPrepareScResultsForDatabase(t *testing.T) {
+	t.Parallel()
+
+	txPool := map[string]data.TransactionHandler{
+		"tx": &transaction.Transaction{},
+		"scr": &smartContractResult.SmartContractResult{
+			Value:          big.NewInt(10),
+			OriginalTxHash: []byte("tx"),
+		},
+	}
+
+	scResults := createTestTxDatabaseProcessor().prepareScResultsForDatabase(txPool, &block.Header{TimeStamp: 5})
+	require.Equal(t, 1, len(scResults))
+	assert.Equal(t, hex.EncodeToString([]byte("scr")), scResults[0].Hash)
+	assert.Equal(t, hex.EncodeToString([]byte("tx")), scResults[0].OriginalTxHash)
+	assert.Equal(t, time.Duration(5), scResults[0].Timestamp)
+}
+
+func TestSerializeIndexDocuments_ShouldSplitInBulksOfLimitedSize(t *testing.T) {
+	t.Parallel()
+
+	numDocuments := 10
+	documents := make([]*indexDocument, 0, numDocuments)
+	for i := 0; i < numDocuments; i++ {
+		documents = append(documents, &indexDocument{
+			id:   string(rune('a' + i)),
+			body: strings.Repeat("x", bulkSizeThreshold/4),
+		})
+	}
+
+	buffSlice, err := serializeIndexDocuments(documents)
+	require.Nil(t, err)
+	require.True(t, len(buffSlice) > 1)
+
+	numIndexedDocuments := 0
+	for _, buff := range buffSlice {
+		assert.True(t, buff.Len() <= bulkSizeThreshold)
+		numIndexedDocuments += strings.Count(buff.String(), `{ "index" : { "_id" : `)
+	}
+	assert.Equal(t, numDocuments, numIndexedDocuments)
+}
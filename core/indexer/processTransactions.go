@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/ElrondNetwork/elrond-go/core"
-	"github.com/ElrondNetwork/elrond-go/core/indexer/disabled"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -30,7 +29,6 @@ const (
 
 type txDatabaseProcessor struct {
 	*commonProcessor
-	txLogsProcessor  process.TransactionLogProcessorDatabase
 	hasher           hashing.Hasher
 	marshalizer      marshal.Marshalizer
 	isInImportMode   bool
@@ -56,7 +54,6 @@ func newTxDatabaseProcessor(
 			txFeeCalculator:          txFeeCalculator,
 			shardCoordinator:         shardCoordinator,
 		},
-		txLogsProcessor:  disabled.NewNilTxLogsProcessor(),
 		isInImportMode:   isInImportMode,
 		shardCoordinator: shardCoordinator,
 		txFeeCalculator:  txFeeCalculator,
@@ -127,19 +124,6 @@ func (tdp *txDatabaseProcessor) prepareTransactionsForDatabase(
 		}
 	}
 
-	// TODO for the moment do not save logs in database
-	// uncomment this when transaction logs need to be saved in database
-	//for hash, tx := range transactions {
-	//	txLog, ok := tdp.txLogsProcessor.GetLogFromCache([]byte(hash))
-	//	if !ok {
-	//		continue
-	//	}
-	//
-	//	tx.Log = tdp.prepareTxLog(txLog)
-	//}
-
-	tdp.txLogsProcessor.Clean()
-
 	return append(convertMapTxsToSlice(transactions), rewardsTxs...), alteredAddresses
 }
 
@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
-	"github.com/ElrondNetwork/elrond-go/core/indexer/disabled"
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -452,7 +451,6 @@ func TestAlteredAddresses(t *testing.T) {
 		},
 	}
 
-	txLogProc := disabled.NewNilTxLogsProcessor()
 	txProc := &txDatabaseProcessor{
 		commonProcessor: &commonProcessor{
 			addressPubkeyConverter: mock.NewPubkeyConverterMock(32),
@@ -461,7 +459,6 @@ func TestAlteredAddresses(t *testing.T) {
 		},
 		marshalizer:      &mock.MarshalizerMock{},
 		hasher:           &mock.HasherMock{},
-		txLogsProcessor:  txLogProc,
 		shardCoordinator: shardCoordinator,
 	}
 
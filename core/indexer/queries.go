@@ -39,3 +39,22 @@ func prepareHashesForBulkRemove(hashes []string) objectsMap {
 		},
 	}
 }
+
+func getLastBlockNonceQuery(shardID uint32) objectsMap {
+	return objectsMap{
+		"size":    1,
+		"_source": []string{"nonce"},
+		"query": objectsMap{
+			"term": objectsMap{
+				"shardId": shardID,
+			},
+		},
+		"sort": []interface{}{
+			objectsMap{
+				"nonce": objectsMap{
+					"order": "desc",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,630 @@
+package indexer
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+const (
+	sqlInsertBlock = `INSERT OR REPLACE INTO blocks (hash, nonce, round, epoch, shard_id, proposer, validators,
+		pub_key_bitmap, size, size_txs, timestamp, tx_count, state_root_hash, prev_hash, miniblocks_hashes,
+		notarized_blocks_hashes, search_order) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	sqlDeleteBlock     = `DELETE FROM blocks WHERE hash = ?`
+	sqlInsertMiniblock = `INSERT INTO miniblocks (hash, sender_shard, receiver_shard, sender_block_hash,
+		receiver_block_hash, type) VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (hash) DO UPDATE SET
+		sender_block_hash = CASE WHEN excluded.sender_block_hash != '' THEN excluded.sender_block_hash ELSE sender_block_hash END,
+		receiver_block_hash = CASE WHEN excluded.receiver_block_hash != '' THEN excluded.receiver_block_hash ELSE receiver_block_hash END`
+	sqlDeleteMiniblock   = `DELETE FROM miniblocks WHERE hash = ?`
+	sqlInsertTransaction = `INTO transactions (hash, miniblock_hash, block_hash, nonce, round, value, receiver, sender,
+		receiver_shard, sender_shard, gas_price, gas_limit, gas_used, fee, data, signature, timestamp, status,
+		sender_username, receiver_username) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	sqlTransactionOnSourceShard      = ` ON CONFLICT (hash) DO NOTHING`
+	sqlTransactionOnDestinationShard = ` ON CONFLICT (hash) DO UPDATE SET status = excluded.status,
+		miniblock_hash = excluded.miniblock_hash, timestamp = excluded.timestamp, gas_used = excluded.gas_used,
+		fee = excluded.fee`
+	sqlInsertScResult = `INSERT OR REPLACE INTO sc_results (hash, tx_hash, nonce, gas_limit, gas_price, value, sender,
+		receiver, data, prev_tx_hash, original_tx_hash, call_type, return_message)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	sqlInsertLogs         = `INSERT OR REPLACE INTO logs (tx_hash, address, events, timestamp) VALUES (?, ?, ?, ?)`
+	sqlInsertESDTTransfer = `INSERT OR REPLACE INTO esdt_transfers (tx_hash, original_tx_hash, sender, receiver, token,
+		value, timestamp) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	sqlInsertRound = `INSERT OR REPLACE INTO rounds (shard_id, round, signers_indexes, block_was_proposed, timestamp)
+		VALUES (?, ?, ?, ?, ?)`
+	sqlInsertValidators     = `INSERT OR REPLACE INTO validators (shard_id, epoch, public_keys) VALUES (?, ?, ?)`
+	sqlInsertRating         = `INSERT OR REPLACE INTO rating (id, validators_rating) VALUES (?, ?)`
+	sqlInsertAccount        = `INSERT OR REPLACE INTO accounts (address, nonce, balance, balance_num) VALUES (?, ?, ?, ?)`
+	sqlSelectLastBlockNonce = `SELECT COUNT(*), COALESCE(MAX(nonce), 0) FROM blocks WHERE shard_id = ?`
+	sqlInsertAccountHistory = `INSERT OR REPLACE INTO accounts_history (address, timestamp, balance) VALUES (?, ?, ?)`
+)
+
+// ArgSQLProcessor is struct that is used to store all components that are needed to a sql processor
+type ArgSQLProcessor struct {
+	DB                       *sql.DB
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	AddressPubkeyConverter   core.PubkeyConverter
+	ValidatorPubkeyConverter core.PubkeyConverter
+	EnabledIndexes           map[string]struct{}
+	AccountsDB               state.AccountsAdapter
+	Denomination             int
+	TransactionFeeCalculator process.TransactionFeeCalculator
+	IsInImportDBMode         bool
+	ShardCoordinator         sharding.Coordinator
+}
+
+// sqlProcessor writes the indexed data in a relational database. The statements are written for SQLite but any
+// database/sql driver that understands the same dialect can be used. It is a drop-in replacement of the elastic
+// processor so it is driven by the same data indexer and dispatcher
+type sqlProcessor struct {
+	*txDatabaseProcessor
+
+	db                     *sql.DB
+	parser                 *dataParser
+	enabledIndexes         map[string]struct{}
+	accountsDB             state.AccountsAdapter
+	dividerForDenomination float64
+	balancePrecision       float64
+}
+
+// NewSQLProcessor creates a new sql processor and brings the database schema to the latest version
+func NewSQLProcessor(arguments ArgSQLProcessor) (ElasticProcessor, error) {
+	err := checkArgSQLProcessor(arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	sp := &sqlProcessor{
+		db: arguments.DB,
+		parser: &dataParser{
+			hasher:      arguments.Hasher,
+			marshalizer: arguments.Marshalizer,
+		},
+		enabledIndexes:         arguments.EnabledIndexes,
+		accountsDB:             arguments.AccountsDB,
+		balancePrecision:       math.Pow(10, float64(numDecimalsInFloatBalance)),
+		dividerForDenomination: math.Pow(10, float64(core.MaxInt(arguments.Denomination, 0))),
+	}
+
+	sp.txDatabaseProcessor = newTxDatabaseProcessor(
+		arguments.Hasher,
+		arguments.Marshalizer,
+		arguments.AddressPubkeyConverter,
+		arguments.ValidatorPubkeyConverter,
+		arguments.TransactionFeeCalculator,
+		arguments.IsInImportDBMode,
+		arguments.ShardCoordinator,
+	)
+
+	err = applySQLSchemaMigrations(sp.db, sqlSchemaMigrations)
+	if err != nil {
+		return nil, err
+	}
+
+	return sp, nil
+}
+
+func checkArgSQLProcessor(arguments ArgSQLProcessor) error {
+	if arguments.DB == nil {
+		return ErrNilSQLDatabase
+	}
+	if check.IfNil(arguments.Marshalizer) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(arguments.Hasher) {
+		return core.ErrNilHasher
+	}
+	if check.IfNil(arguments.AddressPubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+	if check.IfNil(arguments.ValidatorPubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+	if check.IfNil(arguments.AccountsDB) {
+		return ErrNilAccountsDB
+	}
+	if check.IfNil(arguments.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+
+	return nil
+}
+
+// SaveHeader will prepare and save information about a header in the sql database
+func (sp *sqlProcessor) SaveHeader(
+	header data.HeaderHandler,
+	signersIndexes []uint64,
+	body *block.Body,
+	notarizedHeadersHashes []string,
+	txsSize int,
+) error {
+	if !sp.isIndexEnabled(blockIndex) {
+		return nil
+	}
+
+	dbBlock, _, err := sp.parser.prepareBlock(header, signersIndexes, body, notarizedHeadersHashes, txsSize)
+	if err != nil {
+		return err
+	}
+
+	validators, err := json.Marshal(dbBlock.Validators)
+	if err != nil {
+		return err
+	}
+	miniblocksHashes, err := json.Marshal(dbBlock.MiniBlocksHashes)
+	if err != nil {
+		return err
+	}
+	notarizedBlocksHashes, err := json.Marshal(dbBlock.NotarizedBlocksHashes)
+	if err != nil {
+		return err
+	}
+
+	_, err = sp.db.Exec(sqlInsertBlock,
+		dbBlock.Hash, dbBlock.Nonce, dbBlock.Round, dbBlock.Epoch, dbBlock.ShardID, dbBlock.Proposer,
+		string(validators), dbBlock.PubKeyBitmap, dbBlock.Size, dbBlock.SizeTxs, int64(dbBlock.Timestamp),
+		dbBlock.TxCount, dbBlock.StateRootHash, dbBlock.PrevHash, string(miniblocksHashes),
+		string(notarizedBlocksHashes), dbBlock.SearchOrder,
+	)
+
+	return err
+}
+
+// RemoveHeader will remove a block from the sql database
+func (sp *sqlProcessor) RemoveHeader(header data.HeaderHandler) error {
+	headerHash, err := core.CalculateHash(sp.marshalizer, sp.hasher, header)
+	if err != nil {
+		return err
+	}
+
+	_, err = sp.db.Exec(sqlDeleteBlock, hex.EncodeToString(headerHash))
+	return err
+}
+
+// RemoveMiniblocks will remove all miniblocks that are in header from the sql database
+func (sp *sqlProcessor) RemoveMiniblocks(header data.HeaderHandler, body *block.Body) error {
+	if body == nil || len(header.GetMiniBlockHeadersHashes()) == 0 {
+		return nil
+	}
+
+	return sp.executeInTransaction(func(dbTx *sql.Tx) error {
+		selfShardID := header.GetShardID()
+		for _, miniblock := range body.MiniBlocks {
+			if miniblock.Type == block.PeerBlock {
+				continue
+			}
+
+			isDstMe := selfShardID == miniblock.ReceiverShardID
+			isCrossShard := miniblock.ReceiverShardID != miniblock.SenderShardID
+			if isDstMe && isCrossShard {
+				continue
+			}
+
+			miniblockHash, err := core.CalculateHash(sp.marshalizer, sp.hasher, miniblock)
+			if err != nil {
+				log.Debug("indexer.RemoveMiniblocks cannot calculate miniblock hash",
+					"error", err.Error())
+				continue
+			}
+
+			_, err = dbTx.Exec(sqlDeleteMiniblock, hex.EncodeToString(miniblockHash))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// SaveMiniblocks will prepare and save information about miniblocks in the sql database. The returned map is always
+// empty as the transactions statements do not depend on the miniblocks already saved
+func (sp *sqlProcessor) SaveMiniblocks(header data.HeaderHandler, body *block.Body) (map[string]bool, error) {
+	if !sp.isIndexEnabled(miniblocksIndex) {
+		return map[string]bool{}, nil
+	}
+
+	miniblocks := sp.parser.getMiniblocks(header, body)
+	if len(miniblocks) == 0 {
+		return make(map[string]bool), nil
+	}
+
+	return make(map[string]bool), sp.executeInTransaction(func(dbTx *sql.Tx) error {
+		for _, mb := range miniblocks {
+			_, err := dbTx.Exec(sqlInsertMiniblock,
+				mb.Hash, mb.SenderShardID, mb.ReceiverShardID, mb.SenderBlockHash, mb.ReceiverBlockHash, mb.Type)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// SaveTransactions will prepare and save information about a transactions in the sql database
+func (sp *sqlProcessor) SaveTransactions(
+	body *block.Body,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	selfShardID uint32,
+	_ map[string]bool,
+) error {
+	err := sp.saveScResults(header, txPool)
+	if err != nil {
+		return err
+	}
+
+	err = sp.saveESDTTransfers(header, txPool)
+	if err != nil {
+		return err
+	}
+
+	if !sp.isIndexEnabled(txIndex) {
+		return nil
+	}
+
+	headerHash, err := core.CalculateHash(sp.marshalizer, sp.hasher, header)
+	if err != nil {
+		return err
+	}
+	blockHash := hex.EncodeToString(headerHash)
+
+	txs, alteredAccounts := sp.prepareTransactionsForDatabase(body, header, txPool, selfShardID)
+	err = sp.executeInTransaction(func(dbTx *sql.Tx) error {
+		for _, tx := range txs {
+			errSave := saveSQLTransaction(dbTx, tx, blockHash, selfShardID)
+			if errSave != nil {
+				return errSave
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: saving transactions in sql database", "error", err.Error())
+		return err
+	}
+
+	return sp.indexAlteredAccounts(alteredAccounts)
+}
+
+func saveSQLTransaction(dbTx *sql.Tx, tx *Transaction, blockHash string, selfShardID uint32) error {
+	// the same logic as for the elastic search indexer: intra-shard transactions are re-written at forks,
+	// the source shard never overwrites a cross-shard transaction and the destination shard updates its results
+	statement := "INSERT OR REPLACE " + sqlInsertTransaction
+	if !isIntraShardOrInvalid(tx, selfShardID) {
+		if isCrossShardDstMe(tx, selfShardID) {
+			statement = "INSERT " + sqlInsertTransaction + sqlTransactionOnDestinationShard
+		} else {
+			statement = "INSERT " + sqlInsertTransaction + sqlTransactionOnSourceShard
+		}
+	}
+
+	_, err := dbTx.Exec(statement,
+		tx.Hash, tx.MBHash, blockHash, tx.Nonce, tx.Round, tx.Value, tx.Receiver, tx.Sender, tx.ReceiverShard,
+		tx.SenderShard, tx.GasPrice, tx.GasLimit, tx.GasUsed, tx.Fee, tx.Data, tx.Signature, int64(tx.Timestamp),
+		tx.Status, tx.SenderUserName, tx.ReceiverUserName,
+	)
+	if err != nil {
+		return fmt.Errorf("%w for transaction %s", err, tx.Hash)
+	}
+
+	for _, scr := range tx.SmartContractResults {
+		_, err = dbTx.Exec(sqlInsertScResult,
+			scr.Hash, tx.Hash, scr.Nonce, scr.GasLimit, scr.GasPrice, scr.Value, scr.Sender, scr.Receiver,
+			scr.Data, scr.PreTxHash, scr.OriginalTxHash, scr.CallType, scr.ReturnMessage,
+		)
+		if err != nil {
+			return fmt.Errorf("%w for smart contract result %s", err, scr.Hash)
+		}
+	}
+
+	return nil
+}
+
+func (sp *sqlProcessor) saveScResults(header data.HeaderHandler, txPool map[string]data.TransactionHandler) error {
+	if !sp.isIndexEnabled(scResultsIndex) {
+		return nil
+	}
+
+	scResults := sp.prepareScResultsForDatabase(txPool, header)
+	if len(scResults) == 0 {
+		return nil
+	}
+
+	err := sp.executeInTransaction(func(dbTx *sql.Tx) error {
+		for _, scr := range scResults {
+			_, errExec := dbTx.Exec(sqlInsertScResult,
+				scr.Hash, scr.OriginalTxHash, scr.Nonce, scr.GasLimit, scr.GasPrice, scr.Value, scr.Sender,
+				scr.Receiver, scr.Data, scr.PreTxHash, scr.OriginalTxHash, scr.CallType, scr.ReturnMessage,
+			)
+			if errExec != nil {
+				return fmt.Errorf("%w for smart contract result %s", errExec, scr.Hash)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: saving smart contract results in sql database", "error", err.Error())
+	}
+
+	return err
+}
+
+func (sp *sqlProcessor) saveESDTTransfers(header data.HeaderHandler, txPool map[string]data.TransactionHandler) error {
+	if !sp.isIndexEnabled(esdtTransfersIndex) {
+		return nil
+	}
+
+	transfers := sp.prepareESDTTransfersForDatabase(txPool, header)
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	err := sp.executeInTransaction(func(dbTx *sql.Tx) error {
+		for _, transfer := range transfers {
+			_, errExec := dbTx.Exec(sqlInsertESDTTransfer,
+				transfer.TxHash, transfer.OriginalTxHash, transfer.Sender, transfer.Receiver, transfer.Token,
+				transfer.Value, int64(transfer.Timestamp),
+			)
+			if errExec != nil {
+				return fmt.Errorf("%w for esdt transfer %s", errExec, transfer.TxHash)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: saving esdt transfers in sql database", "error", err.Error())
+	}
+
+	return err
+}
+
+// SaveLogs will prepare and save the logs generated by the smart contracts executed in the provided block
+func (sp *sqlProcessor) SaveLogs(header data.HeaderHandler, txLogs map[string]data.LogHandler) error {
+	if !sp.isIndexEnabled(logsIndex) {
+		return nil
+	}
+	if check.IfNil(header) {
+		return ErrNilHeaderHandler
+	}
+
+	logs := sp.prepareLogsForDatabase(txLogs, header)
+	if len(logs) == 0 {
+		return nil
+	}
+
+	err := sp.executeInTransaction(func(dbTx *sql.Tx) error {
+		for _, txLog := range logs {
+			events, errMarshal := json.Marshal(txLog.Events)
+			if errMarshal != nil {
+				return errMarshal
+			}
+
+			_, errExec := dbTx.Exec(sqlInsertLogs, txLog.TxHash, txLog.Address, string(events), int64(txLog.Timestamp))
+			if errExec != nil {
+				return fmt.Errorf("%w for logs of transaction %s", errExec, txLog.TxHash)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("indexer: saving logs in sql database", "error", err.Error())
+	}
+
+	return err
+}
+
+// SaveShardStatistics does nothing as the statistics can be computed from the saved blocks and transactions
+func (sp *sqlProcessor) SaveShardStatistics(_ statistics.TPSBenchmark) error {
+	return nil
+}
+
+// SaveValidatorsRating will save validators rating
+func (sp *sqlProcessor) SaveValidatorsRating(index string, validatorsRatingInfo []workItems.ValidatorRatingInfo) error {
+	if !sp.isIndexEnabled(ratingIndex) {
+		return nil
+	}
+
+	marshalizedInfoRating, err := json.Marshal(validatorsRatingInfo)
+	if err != nil {
+		return err
+	}
+
+	_, err = sp.db.Exec(sqlInsertRating, index, string(marshalizedInfoRating))
+	return err
+}
+
+// SaveShardValidatorsPubKeys will prepare and save information about a shard validators public keys in the sql database
+func (sp *sqlProcessor) SaveShardValidatorsPubKeys(shardID, epoch uint32, shardValidatorsPubKeys [][]byte) error {
+	if !sp.isIndexEnabled(validatorsIndex) {
+		return nil
+	}
+
+	publicKeys := make([]string, 0, len(shardValidatorsPubKeys))
+	for _, validatorPk := range shardValidatorsPubKeys {
+		publicKeys = append(publicKeys, sp.validatorPubkeyConverter.Encode(validatorPk))
+	}
+
+	marshalizedPublicKeys, err := json.Marshal(publicKeys)
+	if err != nil {
+		return err
+	}
+
+	_, err = sp.db.Exec(sqlInsertValidators, shardID, epoch, string(marshalizedPublicKeys))
+	return err
+}
+
+// SaveRoundsInfo will prepare and save information about a slice of rounds in the sql database
+func (sp *sqlProcessor) SaveRoundsInfo(infos []workItems.RoundInfo) error {
+	if !sp.isIndexEnabled(roundIndex) {
+		return nil
+	}
+
+	return sp.executeInTransaction(func(dbTx *sql.Tx) error {
+		for _, info := range infos {
+			signersIndexes, err := json.Marshal(info.SignersIndexes)
+			if err != nil {
+				return err
+			}
+
+			_, err = dbTx.Exec(sqlInsertRound,
+				info.ShardId, info.Index, string(signersIndexes), info.BlockWasProposed, int64(info.Timestamp))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (sp *sqlProcessor) indexAlteredAccounts(accounts map[string]struct{}) error {
+	if !sp.isIndexEnabled(accountsIndex) {
+		return nil
+	}
+
+	accountsToIndex := make([]state.UserAccountHandler, 0)
+	for address := range accounts {
+		addressBytes, err := sp.addressPubkeyConverter.Decode(address)
+		if err != nil {
+			log.Warn("cannot decode address", "address", address, "error", err)
+			continue
+		}
+
+		if sp.shardCoordinator.ComputeId(addressBytes) != sp.shardCoordinator.SelfId() {
+			continue
+		}
+
+		account, err := sp.accountsDB.LoadAccount(addressBytes)
+		if err != nil {
+			log.Warn("cannot load account", "address bytes", addressBytes, "error", err)
+			continue
+		}
+
+		userAccount, ok := account.(state.UserAccountHandler)
+		if !ok {
+			log.Warn("cannot cast AccountHandler to type UserAccountHandler")
+			continue
+		}
+
+		accountsToIndex = append(accountsToIndex, userAccount)
+	}
+
+	if len(accountsToIndex) == 0 {
+		return nil
+	}
+
+	return sp.SaveAccounts(accountsToIndex)
+}
+
+// SaveAccounts will prepare and save information about provided accounts in the sql database
+func (sp *sqlProcessor) SaveAccounts(accounts []state.UserAccountHandler) error {
+	if !sp.isIndexEnabled(accountsIndex) {
+		return nil
+	}
+
+	saveHistory := sp.isIndexEnabled(accountsHistoryIndex)
+	currentTimestamp := time.Now().Unix()
+
+	return sp.executeInTransaction(func(dbTx *sql.Tx) error {
+		for _, userAccount := range accounts {
+			address := sp.addressPubkeyConverter.Encode(userAccount.AddressBytes())
+			balance := userAccount.GetBalance()
+
+			_, err := dbTx.Exec(sqlInsertAccount,
+				address, userAccount.GetNonce(), balance.String(), sp.computeBalanceAsFloat(balance))
+			if err != nil {
+				return err
+			}
+
+			if !saveHistory {
+				continue
+			}
+
+			_, err = dbTx.Exec(sqlInsertAccountHistory, address, currentTimestamp, balance.String())
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (sp *sqlProcessor) executeInTransaction(handler func(dbTx *sql.Tx) error) error {
+	dbTx, err := sp.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = handler(dbTx)
+	if err != nil {
+		log.LogIfError(dbTx.Rollback())
+		return err
+	}
+
+	return dbTx.Commit()
+}
+
+// GetLastIndexedBlockNonce returns the highest nonce of the blocks saved for the provided shard
+func (sp *sqlProcessor) GetLastIndexedBlockNonce(shardID uint32) (uint64, bool, error) {
+	if !sp.isIndexEnabled(blockIndex) {
+		return 0, false, nil
+	}
+
+	var numBlocks int64
+	var nonce int64
+	err := sp.db.QueryRow(sqlSelectLastBlockNonce, shardID).Scan(&numBlocks, &nonce)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return uint64(nonce), numBlocks > 0, nil
+}
+
+func (sp *sqlProcessor) isIndexEnabled(index string) bool {
+	_, isEnabled := sp.enabledIndexes[index]
+	return isEnabled
+}
+
+func (sp *sqlProcessor) computeBalanceAsFloat(balance *big.Int) float64 {
+	balanceBigFloat := big.NewFloat(0).SetInt(balance)
+	balanceFloat64, _ := balanceBigFloat.Float64()
+
+	bal := balanceFloat64 / sp.dividerForDenomination
+	balanceFloatWithDecimals := math.Round(bal*sp.balancePrecision) / sp.balancePrecision
+
+	return core.MaxFloat64(balanceFloatWithDecimals, 0)
+}
+
+// Close will close the sql database
+func (sp *sqlProcessor) Close() error {
+	return sp.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *sqlProcessor) IsInterfaceNil() bool {
+	return sp == nil
+}
@@ -0,0 +1,313 @@
+package indexer
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockSQLProcessorArgs(driverMock *mock.SQLDriverMock) ArgSQLProcessor {
+	db, _ := sql.Open(driverMock.Register(), "")
+
+	return ArgSQLProcessor{
+		DB:                       db,
+		AddressPubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		ValidatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		Hasher:                   &mock.HasherMock{},
+		Marshalizer:              &mock.MarshalizerMock{},
+		EnabledIndexes: map[string]struct{}{
+			blockIndex: {}, txIndex: {}, miniblocksIndex: {}, validatorsIndex: {}, roundIndex: {}, accountsIndex: {}, ratingIndex: {}, accountsHistoryIndex: {},
+		},
+		AccountsDB:               &mock.AccountsStub{},
+		TransactionFeeCalculator: &economicsmocks.EconomicsHandlerStub{},
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+	}
+}
+
+func createSchemaVersionQueryHandler(version int64) func(query string, args []driver.Value) ([]string, [][]driver.Value, error) {
+	return func(query string, args []driver.Value) ([]string, [][]driver.Value, error) {
+		return []string{"version"}, [][]driver.Value{{version}}, nil
+	}
+}
+
+func TestNewSQLProcessor_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockSQLProcessorArgs(&mock.SQLDriverMock{})
+	args.DB = nil
+	sp, err := NewSQLProcessor(args)
+	assert.Nil(t, sp)
+	assert.Equal(t, ErrNilSQLDatabase, err)
+
+	args = createMockSQLProcessorArgs(&mock.SQLDriverMock{})
+	args.AccountsDB = nil
+	sp, err = NewSQLProcessor(args)
+	assert.Nil(t, sp)
+	assert.Equal(t, ErrNilAccountsDB, err)
+}
+
+func TestNewSQLProcessor_ShouldApplyAllMigrationsOnEmptyDatabase(t *testing.T) {
+	t.Parallel()
+
+	driverMock := &mock.SQLDriverMock{}
+	sp, err := NewSQLProcessor(createMockSQLProcessorArgs(driverMock))
+	require.Nil(t, err)
+	assert.False(t, sp.IsInterfaceNil())
+
+	assert.Equal(t, 1, len(driverMock.StatementsContaining("CREATE TABLE IF NOT EXISTS blocks")))
+	versionStatements := driverMock.StatementsContaining("INSERT INTO schema_version")
+	require.Equal(t, len(sqlSchemaMigrations), len(versionStatements))
+	assert.Equal(t, int64(len(sqlSchemaMigrations)), versionStatements[len(versionStatements)-1].Args[0])
+}
+
+func TestNewSQLProcessor_UpToDateDatabaseShouldNotApplyMigrations(t *testing.T) {
+	t.Parallel()
+
+	driverMock := &mock.SQLDriverMock{
+		QueryCalled: createSchemaVersionQueryHandler(int64(len(sqlSchemaMigrations))),
+	}
+	_, err := NewSQLProcessor(createMockSQLProcessorArgs(driverMock))
+	require.Nil(t, err)
+
+	assert.Equal(t, 0, len(driverMock.StatementsContaining("CREATE TABLE IF NOT EXISTS blocks")))
+	assert.Equal(t, 0, len(driverMock.StatementsContaining("INSERT INTO schema_version")))
+}
+
+func TestNewSQLProcessor_NewerDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	driverMock := &mock.SQLDriverMock{
+		QueryCalled: createSchemaVersionQueryHandler(int64(len(sqlSchemaMigrations) + 1)),
+	}
+	sp, err := NewSQLProcessor(createMockSQLProcessorArgs(driverMock))
+
+	assert.Nil(t, sp)
+	assert.True(t, errors.Is(err, ErrUnknownSQLSchemaVersion))
+}
+
+func TestNewSQLProcessor_FailedMigrationShouldRollback(t *testing.T) {
+	t.Parallel()
+
+	localErr := errors.New("local error")
+	driverMock := &mock.SQLDriverMock{
+		ExecCalled: func(query string, args []driver.Value) error {
+			if strings.Contains(query, "CREATE TABLE IF NOT EXISTS transactions") {
+				return localErr
+			}
+			return nil
+		},
+	}
+	sp, err := NewSQLProcessor(createMockSQLProcessorArgs(driverMock))
+
+	assert.Nil(t, sp)
+	assert.True(t, errors.Is(err, localErr))
+	assert.Equal(t, 1, len(driverMock.StatementsContaining("ROLLBACK")))
+	assert.Equal(t, 0, len(driverMock.StatementsContaining("INSERT INTO schema_version")))
+}
+
+func TestSqlProcessor_SaveHeader(t *testing.T) {
+	t.Parallel()
+
+	driverMock := &mock.SQLDriverMock{}
+	sp, _ := NewSQLProcessor(createMockSQLProcessorArgs(driverMock))
+
+	header := &dataBlock.Header{Nonce: 37, Round: 40, ShardID: 1}
+	err := sp.SaveHeader(header, []uint64{3, 4}, newTestBlockBody(), nil, 100)
+	require.Nil(t, err)
+
+	statements := driverMock.StatementsContaining("INTO blocks")
+	require.Equal(t, 1, len(statements))
+	assert.Equal(t, int64(37), statements[0].Args[1])
+	assert.Equal(t, int64(3), statements[0].Args[5])
+	assert.Equal(t, "[3,4]", statements[0].Args[6])
+}
+
+func TestSqlProcessor_SaveHeaderDisabledIndexShouldNotWrite(t *testing.T) {
+	t.Parallel()
+
+	driverMock := &mock.SQLDriverMock{}
+	args := createMockSQLProcessorArgs(driverMock)
+	args.EnabledIndexes = map[string]struct{}{}
+	sp, _ := NewSQLProcessor(args)
+
+	err := sp.SaveHeader(&dataBlock.Header{}, nil, &dataBlock.Body{}, nil, 0)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(driverMock.StatementsContaining("INTO blocks")))
+}
+
+func TestSqlProcessor_SaveMiniblocksAndTransactions(t *testing.T) {
+	t.Parallel()
+
+	driverMock := &mock.SQLDriverMock{}
+	sp, _ := NewSQLProcessor(createMockSQLProcessorArgs(driverMock))
+
+	body := newTestBlockBody()
+	header := &dataBlock.Header{Nonce: 1, TxCount: 3, MiniBlockHeaders: []dataBlock.MiniBlockHeader{{}, {}}}
+	_, err := sp.SaveMiniblocks(header, body)
+	require.Nil(t, err)
+	assert.Equal(t, 2, len(driverMock.StatementsContaining("INSERT INTO miniblocks")))
+
+	err = sp.SaveTransactions(body, header, newTestTxPool(), 2, map[string]bool{})
+	require.Nil(t, err)
+	assert.Equal(t, 3, len(driverMock.StatementsContaining("INTO transactions")))
+
+	err = sp.RemoveMiniblocks(header, body)
+	require.Nil(t, err)
+	assert.Equal(t, 2, len(driverMock.StatementsContaining("DELETE FROM miniblocks")))
+}
+
+func TestSaveSQLTransaction_ShouldUseTheSameUpsertLogicAsElastic(t *testing.T) {
+	t.Parallel()
+
+	driverMock := &mock.SQLDriverMock{}
+	db, _ := sql.Open(driverMock.Register(), "")
+	dbTx, _ := db.Begin()
+
+	intraShardTx := &Transaction{Hash: "intra", SenderShard: 1, ReceiverShard: 1}
+	err := saveSQLTransaction(dbTx, intraShardTx, "blockHash", 1)
+	require.Nil(t, err)
+
+	crossShardTx := &Transaction{Hash: "cross", SenderShard: 0, ReceiverShard: 1}
+	err = saveSQLTransaction(dbTx, crossShardTx, "blockHash", 0)
+	require.Nil(t, err)
+	err = saveSQLTransaction(dbTx, crossShardTx, "blockHash", 1)
+	require.Nil(t, err)
+
+	statements := driverMock.StatementsContaining("INTO transactions")
+	require.Equal(t, 3, len(statements))
+	assert.True(t, strings.HasPrefix(statements[0].Query, "INSERT OR REPLACE"))
+	assert.True(t, strings.HasSuffix(statements[1].Query, "DO NOTHING"))
+	assert.True(t, strings.Contains(statements[2].Query, "DO UPDATE SET status = excluded.status"))
+}
+
+func TestSqlProcessor_SaveTransactionsErrorShouldRollback(t *testing.T) {
+	t.Parallel()
+
+	localErr := errors.New("local error")
+	driverMock := &mock.SQLDriverMock{}
+	sp, _ := NewSQLProcessor(createMockSQLProcessorArgs(driverMock))
+	driverMock.ExecCalled = func(query string, args []driver.Value) error {
+		if strings.Contains(query, "INTO transactions") {
+			return localErr
+		}
+		return nil
+	}
+
+	err := sp.SaveTransactions(newTestBlockBody(), &dataBlock.Header{}, newTestTxPool(), 2, map[string]bool{})
+	assert.True(t, errors.Is(err, localErr))
+	assert.Equal(t, 1, len(driverMock.StatementsContaining("ROLLBACK")))
+}
+
+func TestSqlProcessor_SaveRoundsValidatorsRatingAndAccounts(t *testing.T) {
+	t.Parallel()
+
+	driverMock := &mock.SQLDriverMock{}
+	sp, _ := NewSQLProcessor(createMockSQLProcessorArgs(driverMock))
+
+	err := sp.SaveRoundsInfo([]workItems.RoundInfo{{Index: 1}, {Index: 2}})
+	require.Nil(t, err)
+	assert.Equal(t, 2, len(driverMock.StatementsContaining("INTO rounds")))
+
+	err = sp.SaveShardValidatorsPubKeys(0, 1, [][]byte{[]byte("pk1")})
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(driverMock.StatementsContaining("INTO validators")))
+
+	err = sp.SaveValidatorsRating("0_1", []workItems.ValidatorRatingInfo{{PublicKey: "pk1", Rating: 50}})
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(driverMock.StatementsContaining("INTO rating")))
+
+	account, _ := state.NewUserAccount([]byte("addr"))
+	_ = account.AddToBalance(big.NewInt(10))
+	err = sp.SaveAccounts([]state.UserAccountHandler{account})
+	require.Nil(t, err)
+	accountStatements := driverMock.StatementsContaining("INTO accounts (")
+	require.Equal(t, 1, len(accountStatements))
+	assert.Equal(t, "10", accountStatements[0].Args[2])
+	assert.Equal(t, 1, len(driverMock.StatementsContaining("INTO accounts_history")))
+}
+
+func TestSqlProcessor_SaveLogsScResultsAndESDTTransfers(t *testing.T) {
+	t.Parallel()
+
+	driverMock := &mock.SQLDriverMock{}
+	args := createMockSQLProcessorArgs(driverMock)
+	args.EnabledIndexes = map[string]struct{}{logsIndex: {}, scResultsIndex: {}, esdtTransfersIndex: {}}
+	sp, _ := NewSQLProcessor(args)
+
+	header := &dataBlock.Header{TimeStamp: 20}
+	txLogs := map[string]data.LogHandler{
+		"tx": &transaction.Log{Address: []byte("sc address")},
+	}
+	err := sp.SaveLogs(header, txLogs)
+	require.Nil(t, err)
+
+	statements := driverMock.StatementsContaining("INTO logs")
+	require.Equal(t, 1, len(statements))
+	assert.Equal(t, hex.EncodeToString([]byte("tx")), statements[0].Args[0])
+	assert.Equal(t, int64(20), statements[0].Args[3])
+
+	esdtData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString([]byte("TKN")) + "@0a"
+	txPool := map[string]data.TransactionHandler{
+		"tx": &transaction.Transaction{Data: []byte(esdtData)},
+		"scr": &smartContractResult.SmartContractResult{
+			Value:          big.NewInt(0),
+			OriginalTxHash: []byte("tx"),
+		},
+	}
+	err = sp.SaveTransactions(&dataBlock.Body{}, header, txPool, 0, map[string]bool{})
+	require.Nil(t, err)
+
+	statements = driverMock.StatementsContaining("INTO sc_results")
+	require.Equal(t, 1, len(statements))
+	assert.Equal(t, hex.EncodeToString([]byte("tx")), statements[0].Args[1])
+
+	statements = driverMock.StatementsContaining("INTO esdt_transfers")
+	require.Equal(t, 1, len(statements))
+	assert.Equal(t, "TKN", statements[0].Args[4])
+	assert.Equal(t, "10", statements[0].Args[5])
+	assert.Equal(t, 0, len(driverMock.StatementsContaining("INTO transactions")))
+}
+
+func TestSqlProcessor_GetLastIndexedBlockNonce(t *testing.T) {
+	t.Parallel()
+
+	numBlocks := int64(0)
+	driverMock := &mock.SQLDriverMock{
+		QueryCalled: func(query string, args []driver.Value) ([]string, [][]driver.Value, error) {
+			if strings.Contains(query, "FROM blocks") {
+				assert.Equal(t, int64(1), args[0])
+				return []string{"count", "nonce"}, [][]driver.Value{{numBlocks, int64(37)}}, nil
+			}
+
+			return []string{"version"}, [][]driver.Value{{int64(len(sqlSchemaMigrations))}}, nil
+		},
+	}
+	sp, err := NewSQLProcessor(createMockSQLProcessorArgs(driverMock))
+	require.Nil(t, err)
+
+	_, found, err := sp.GetLastIndexedBlockNonce(1)
+	require.Nil(t, err)
+	assert.False(t, found)
+
+	numBlocks = 5
+	nonce, found, err := sp.GetLastIndexedBlockNonce(1)
+	require.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, uint64(37), nonce)
+}
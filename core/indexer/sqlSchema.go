@@ -0,0 +1,192 @@
+package indexer
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// sqlSchemaMigrations holds the statements that bring the database schema from one version to the next one. The
+// schema version N is reached after applying the first N entries. Existing entries must never be changed, a schema
+// modification should always be done by appending a new entry
+var sqlSchemaMigrations = [][]string{
+	{
+		`CREATE TABLE IF NOT EXISTS blocks (
+			hash TEXT PRIMARY KEY,
+			nonce INTEGER NOT NULL,
+			round INTEGER NOT NULL,
+			epoch INTEGER NOT NULL,
+			shard_id INTEGER NOT NULL,
+			proposer INTEGER NOT NULL,
+			validators TEXT NOT NULL,
+			pub_key_bitmap TEXT NOT NULL,
+			size INTEGER NOT NULL,
+			size_txs INTEGER NOT NULL,
+			timestamp INTEGER NOT NULL,
+			tx_count INTEGER NOT NULL,
+			state_root_hash TEXT NOT NULL,
+			prev_hash TEXT NOT NULL,
+			miniblocks_hashes TEXT NOT NULL,
+			notarized_blocks_hashes TEXT NOT NULL,
+			search_order INTEGER NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS blocks_shard_nonce ON blocks (shard_id, nonce)`,
+		`CREATE INDEX IF NOT EXISTS blocks_epoch ON blocks (epoch)`,
+		`CREATE TABLE IF NOT EXISTS miniblocks (
+			hash TEXT PRIMARY KEY,
+			sender_shard INTEGER NOT NULL,
+			receiver_shard INTEGER NOT NULL,
+			sender_block_hash TEXT NOT NULL,
+			receiver_block_hash TEXT NOT NULL,
+			type TEXT NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS transactions (
+			hash TEXT PRIMARY KEY,
+			miniblock_hash TEXT NOT NULL,
+			block_hash TEXT NOT NULL,
+			nonce INTEGER NOT NULL,
+			round INTEGER NOT NULL,
+			value TEXT NOT NULL,
+			receiver TEXT NOT NULL,
+			sender TEXT NOT NULL,
+			receiver_shard INTEGER NOT NULL,
+			sender_shard INTEGER NOT NULL,
+			gas_price INTEGER NOT NULL,
+			gas_limit INTEGER NOT NULL,
+			gas_used INTEGER NOT NULL,
+			fee TEXT NOT NULL,
+			data BLOB,
+			signature TEXT NOT NULL,
+			timestamp INTEGER NOT NULL,
+			status TEXT NOT NULL,
+			sender_username BLOB,
+			receiver_username BLOB
+		)`,
+		`CREATE INDEX IF NOT EXISTS transactions_sender ON transactions (sender, timestamp)`,
+		`CREATE INDEX IF NOT EXISTS transactions_receiver ON transactions (receiver, timestamp)`,
+		`CREATE INDEX IF NOT EXISTS transactions_miniblock ON transactions (miniblock_hash)`,
+		`CREATE TABLE IF NOT EXISTS sc_results (
+			hash TEXT PRIMARY KEY,
+			tx_hash TEXT NOT NULL,
+			nonce INTEGER NOT NULL,
+			gas_limit INTEGER NOT NULL,
+			gas_price INTEGER NOT NULL,
+			value TEXT NOT NULL,
+			sender TEXT NOT NULL,
+			receiver TEXT NOT NULL,
+			data BLOB,
+			prev_tx_hash TEXT NOT NULL,
+			original_tx_hash TEXT NOT NULL,
+			call_type TEXT NOT NULL,
+			return_message TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS sc_results_tx_hash ON sc_results (tx_hash)`,
+		`CREATE TABLE IF NOT EXISTS rounds (
+			shard_id INTEGER NOT NULL,
+			round INTEGER NOT NULL,
+			signers_indexes TEXT NOT NULL,
+			block_was_proposed INTEGER NOT NULL,
+			timestamp INTEGER NOT NULL,
+			PRIMARY KEY (shard_id, round)
+		)`,
+		`CREATE TABLE IF NOT EXISTS validators (
+			shard_id INTEGER NOT NULL,
+			epoch INTEGER NOT NULL,
+			public_keys TEXT NOT NULL,
+			PRIMARY KEY (shard_id, epoch)
+		)`,
+		`CREATE TABLE IF NOT EXISTS rating (
+			id TEXT PRIMARY KEY,
+			validators_rating TEXT NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS accounts (
+			address TEXT PRIMARY KEY,
+			nonce INTEGER NOT NULL,
+			balance TEXT NOT NULL,
+			balance_num REAL NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS accounts_history (
+			address TEXT NOT NULL,
+			timestamp INTEGER NOT NULL,
+			balance TEXT NOT NULL,
+			PRIMARY KEY (address, timestamp)
+		)`,
+	},
+	{
+		`CREATE TABLE IF NOT EXISTS logs (
+			tx_hash TEXT PRIMARY KEY,
+			address TEXT NOT NULL,
+			events TEXT NOT NULL,
+			timestamp INTEGER NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS logs_address ON logs (address, timestamp)`,
+		`CREATE TABLE IF NOT EXISTS esdt_transfers (
+			tx_hash TEXT PRIMARY KEY,
+			original_tx_hash TEXT NOT NULL,
+			sender TEXT NOT NULL,
+			receiver TEXT NOT NULL,
+			token TEXT NOT NULL,
+			value TEXT NOT NULL,
+			timestamp INTEGER NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS esdt_transfers_token ON esdt_transfers (token, timestamp)`,
+		`CREATE INDEX IF NOT EXISTS esdt_transfers_sender ON esdt_transfers (sender, timestamp)`,
+		`CREATE INDEX IF NOT EXISTS esdt_transfers_receiver ON esdt_transfers (receiver, timestamp)`,
+	},
+}
+
+const createSchemaVersionTable = `CREATE TABLE IF NOT EXISTS schema_version (version INTEGER NOT NULL)`
+const selectSchemaVersion = `SELECT COALESCE(MAX(version), 0) FROM schema_version`
+const insertSchemaVersion = `INSERT INTO schema_version (version) VALUES (?)`
+
+// applySQLSchemaMigrations brings the database schema to the latest version. Each migration is applied in its own
+// database transaction so a failure leaves the database at the last fully applied version
+func applySQLSchemaMigrations(db *sql.DB, migrations [][]string) error {
+	_, err := db.Exec(createSchemaVersionTable)
+	if err != nil {
+		return err
+	}
+
+	var currentVersion int
+	err = db.QueryRow(selectSchemaVersion).Scan(&currentVersion)
+	if err != nil {
+		return err
+	}
+	if currentVersion > len(migrations) {
+		return fmt.Errorf("%w: database version %d, latest known version %d",
+			ErrUnknownSQLSchemaVersion, currentVersion, len(migrations))
+	}
+
+	for version := currentVersion + 1; version <= len(migrations); version++ {
+		err = applySQLSchemaMigration(db, version, migrations[version-1])
+		if err != nil {
+			return fmt.Errorf("%w while applying schema version %d", err, version)
+		}
+
+		log.Debug("indexer: applied sql schema migration", "version", version)
+	}
+
+	return nil
+}
+
+func applySQLSchemaMigration(db *sql.DB, version int, statements []string) error {
+	dbTx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range statements {
+		_, err = dbTx.Exec(statement)
+		if err != nil {
+			_ = dbTx.Rollback()
+			return err
+		}
+	}
+
+	_, err = dbTx.Exec(insertSchemaVersion, version)
+	if err != nil {
+		_ = dbTx.Rollback()
+		return err
+	}
+
+	return dbTx.Commit()
+}
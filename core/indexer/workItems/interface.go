@@ -17,6 +17,7 @@ type saveBlockIndexer interface {
 	SaveHeader(header data.HeaderHandler, signersIndexes []uint64, body *block.Body, notarizedHeadersHashes []string, txsSize int) error
 	SaveMiniblocks(header data.HeaderHandler, body *block.Body) (map[string]bool, error)
 	SaveTransactions(body *block.Body, header data.HeaderHandler, txPool map[string]data.TransactionHandler, selfShardID uint32, mbsInDb map[string]bool) error
+	SaveLogs(header data.HeaderHandler, txLogs map[string]data.LogHandler) error
 }
 
 type saveRatingIndexer interface {
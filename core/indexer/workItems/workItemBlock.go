@@ -12,12 +12,22 @@ import (
 
 var log = logger.GetOrCreate("core/indexer/workItems")
 
+// StoredBlock holds a committed block, as it was recovered from the local storage
+type StoredBlock struct {
+	HeaderHash []byte
+	Header     data.HeaderHandler
+	Body       *block.Body
+	TxPool     map[string]data.TransactionHandler
+	TxLogs     map[string]data.LogHandler
+}
+
 type itemBlock struct {
 	indexer                saveBlockIndexer
 	marshalizer            marshal.Marshalizer
 	bodyHandler            data.BodyHandler
 	headerHandler          data.HeaderHandler
 	txPool                 map[string]data.TransactionHandler
+	txLogs                 map[string]data.LogHandler
 	signersIndexes         []uint64
 	notarizedHeadersHashes []string
 	headerHash             []byte
@@ -30,6 +40,7 @@ func NewItemBlock(
 	bodyHandler data.BodyHandler,
 	headerHandler data.HeaderHandler,
 	txPool map[string]data.TransactionHandler,
+	txLogs map[string]data.LogHandler,
 	signersIndexes []uint64,
 	notarizedHeadersHashes []string,
 	headerHash []byte,
@@ -39,6 +50,7 @@ func NewItemBlock(
 		bodyHandler:            bodyHandler,
 		headerHandler:          headerHandler,
 		txPool:                 txPool,
+		txLogs:                 txLogs,
 		signersIndexes:         signersIndexes,
 		notarizedHeadersHashes: notarizedHeadersHashes,
 		marshalizer:            marshalizer,
@@ -85,6 +97,12 @@ func (wib *itemBlock) Save() error {
 			err, logger.DisplayByteSlice(wib.headerHash), wib.headerHandler.GetNonce())
 	}
 
+	err = wib.indexer.SaveLogs(wib.headerHandler, wib.txLogs)
+	if err != nil {
+		return fmt.Errorf("%w when saving logs, block hash %s, nonce %d",
+			err, logger.DisplayByteSlice(wib.headerHash), wib.headerHandler.GetNonce())
+	}
+
 	return nil
 }
 
@@ -46,6 +46,7 @@ func TestItemBlock_SaveNilHeaderShouldRetNil(t *testing.T) {
 		},
 		nil,
 		nil,
+		nil,
 		[]uint64{},
 		[]string{},
 		[]byte("hash"),
@@ -70,6 +71,7 @@ func TestItemBlock_SaveHeaderShouldErr(t *testing.T) {
 		},
 		&dataBlock.Header{},
 		nil,
+		nil,
 		[]uint64{},
 		[]string{},
 		[]byte("hash"),
@@ -101,6 +103,7 @@ func TestItemBlock_SaveNoMiniblocksShoulCallSaveHeader(t *testing.T) {
 		&dataBlock.Body{},
 		&dataBlock.Header{},
 		nil,
+		nil,
 		[]uint64{},
 		[]string{},
 		[]byte("hash"),
@@ -126,6 +129,7 @@ func TestItemBlock_SaveMiniblocksShouldErr(t *testing.T) {
 		},
 		&dataBlock.Header{},
 		nil,
+		nil,
 		[]uint64{},
 		[]string{},
 		[]byte("hash"),
@@ -150,6 +154,7 @@ func TestItemBlock_SaveTransactionsShouldErr(t *testing.T) {
 		},
 		&dataBlock.Header{},
 		nil,
+		nil,
 		[]uint64{},
 		[]string{},
 		[]byte("hash"),
@@ -183,6 +188,7 @@ func TestItemBlock_SaveShouldWork(t *testing.T) {
 		},
 		&dataBlock.Header{},
 		nil,
+		nil,
 		[]uint64{},
 		[]string{},
 		[]byte("hash"),
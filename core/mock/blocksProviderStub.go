@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+)
+
+// BlocksProviderStub -
+type BlocksProviderStub struct {
+	GetBlockByNonceCalled func(shardID uint32, nonce uint64) (*workItems.StoredBlock, error)
+	GetBlockByHashCalled  func(shardID uint32, headerHash []byte) (*workItems.StoredBlock, error)
+}
+
+// GetBlockByNonce -
+func (bps *BlocksProviderStub) GetBlockByNonce(shardID uint32, nonce uint64) (*workItems.StoredBlock, error) {
+	if bps.GetBlockByNonceCalled != nil {
+		return bps.GetBlockByNonceCalled(shardID, nonce)
+	}
+
+	return nil, errNotImplemented
+}
+
+// GetBlockByHash -
+func (bps *BlocksProviderStub) GetBlockByHash(shardID uint32, headerHash []byte) (*workItems.StoredBlock, error) {
+	if bps.GetBlockByHashCalled != nil {
+		return bps.GetBlockByHashCalled(shardID, headerHash)
+	}
+
+	return nil, errNotImplemented
+}
+
+// IsInterfaceNil -
+func (bps *BlocksProviderStub) IsInterfaceNil() bool {
+	return bps == nil
+}
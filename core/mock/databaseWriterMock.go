@@ -12,6 +12,7 @@ type DatabaseWriterStub struct {
 	DoBulkRequestCalled func(buff *bytes.Buffer, index string) error
 	DoBulkRemoveCalled  func(index string, hashes []string) error
 	DoMultiGetCalled    func(query map[string]interface{}, index string) (map[string]interface{}, error)
+	DoSearchCalled      func(query map[string]interface{}, index string) (map[string]interface{}, error)
 }
 
 // DoRequest --
@@ -39,6 +40,15 @@ func (dwm *DatabaseWriterStub) DoMultiGet(query map[string]interface{}, index st
 	return nil, nil
 }
 
+// DoSearch -
+func (dwm *DatabaseWriterStub) DoSearch(query map[string]interface{}, index string) (map[string]interface{}, error) {
+	if dwm.DoSearchCalled != nil {
+		return dwm.DoSearchCalled(query, index)
+	}
+
+	return nil, nil
+}
+
 // DoBulkRemove -
 func (dwm *DatabaseWriterStub) DoBulkRemove(index string, hashes []string) error {
 	if dwm.DoBulkRemoveCalled != nil {
@@ -6,7 +6,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
-	"github.com/ElrondNetwork/elrond-go/process"
 )
 
 // ElasticProcessorStub -
@@ -17,11 +16,12 @@ type ElasticProcessorStub struct {
 	RemoveMiniblocksCalled           func(header data.HeaderHandler, body *block.Body) error
 	SaveMiniblocksCalled             func(header data.HeaderHandler, body *block.Body) (map[string]bool, error)
 	SaveTransactionsCalled           func(body *block.Body, header data.HeaderHandler, txPool map[string]data.TransactionHandler, selfShardID uint32, mbsInDb map[string]bool) error
+	SaveLogsCalled                   func(header data.HeaderHandler, txLogs map[string]data.LogHandler) error
 	SaveValidatorsRatingCalled       func(index string, validatorsRatingInfo []workItems.ValidatorRatingInfo) error
 	SaveRoundsInfoCalled             func(infos []workItems.RoundInfo) error
 	SaveShardValidatorsPubKeysCalled func(shardID, epoch uint32, shardValidatorsPubKeys [][]byte) error
-	SetTxLogsProcessorCalled         func(txLogsProc process.TransactionLogProcessorDatabase)
 	SaveAccountsCalled               func(acc []state.UserAccountHandler) error
+	GetLastIndexedBlockNonceCalled   func(shardID uint32) (uint64, bool, error)
 }
 
 // SaveShardStatistics -
@@ -72,6 +72,14 @@ func (eim *ElasticProcessorStub) SaveTransactions(body *block.Body, header data.
 	return nil
 }
 
+// SaveLogs -
+func (eim *ElasticProcessorStub) SaveLogs(header data.HeaderHandler, txLogs map[string]data.LogHandler) error {
+	if eim.SaveLogsCalled != nil {
+		return eim.SaveLogsCalled(header, txLogs)
+	}
+	return nil
+}
+
 // SaveValidatorsRating -
 func (eim *ElasticProcessorStub) SaveValidatorsRating(index string, validatorsRatingInfo []workItems.ValidatorRatingInfo) error {
 	if eim.SaveValidatorsRatingCalled != nil {
@@ -96,13 +104,6 @@ func (eim *ElasticProcessorStub) SaveShardValidatorsPubKeys(shardID, epoch uint3
 	return nil
 }
 
-// SetTxLogsProcessor -
-func (eim *ElasticProcessorStub) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
-	if eim.SetTxLogsProcessorCalled != nil {
-		eim.SetTxLogsProcessorCalled(txLogsProc)
-	}
-}
-
 // SaveAccounts -
 func (eim *ElasticProcessorStub) SaveAccounts(acc []state.UserAccountHandler) error {
 	if eim.SaveAccountsCalled != nil {
@@ -112,6 +113,15 @@ func (eim *ElasticProcessorStub) SaveAccounts(acc []state.UserAccountHandler) er
 	return nil
 }
 
+// GetLastIndexedBlockNonce -
+func (eim *ElasticProcessorStub) GetLastIndexedBlockNonce(shardID uint32) (uint64, bool, error) {
+	if eim.GetLastIndexedBlockNonceCalled != nil {
+		return eim.GetLastIndexedBlockNonceCalled(shardID)
+	}
+
+	return 0, false, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (eim *ElasticProcessorStub) IsInterfaceNil() bool {
 	return eim == nil
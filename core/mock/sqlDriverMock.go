@@ -0,0 +1,170 @@
+package mock
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var sqlDriverMockCounter uint32
+
+// SQLStatementMock -
+type SQLStatementMock struct {
+	Query string
+	Args  []driver.Value
+}
+
+// SQLDriverMock is a database/sql driver that records all executed statements
+type SQLDriverMock struct {
+	mutStatements sync.Mutex
+	statements    []SQLStatementMock
+	ExecCalled    func(query string, args []driver.Value) error
+	QueryCalled   func(query string, args []driver.Value) ([]string, [][]driver.Value, error)
+}
+
+// Register -
+func (sdm *SQLDriverMock) Register() string {
+	name := fmt.Sprintf("sqlDriverMock%d", atomic.AddUint32(&sqlDriverMockCounter, 1))
+	sql.Register(name, sdm)
+
+	return name
+}
+
+// Statements -
+func (sdm *SQLDriverMock) Statements() []SQLStatementMock {
+	sdm.mutStatements.Lock()
+	defer sdm.mutStatements.Unlock()
+
+	statements := make([]SQLStatementMock, len(sdm.statements))
+	copy(statements, sdm.statements)
+
+	return statements
+}
+
+// StatementsContaining -
+func (sdm *SQLDriverMock) StatementsContaining(substring string) []SQLStatementMock {
+	statements := make([]SQLStatementMock, 0)
+	for _, statement := range sdm.Statements() {
+		if strings.Contains(statement.Query, substring) {
+			statements = append(statements, statement)
+		}
+	}
+
+	return statements
+}
+
+// Open -
+func (sdm *SQLDriverMock) Open(_ string) (driver.Conn, error) {
+	return &sqlConnMock{driverMock: sdm}, nil
+}
+
+func (sdm *SQLDriverMock) exec(query string, args []driver.Value) error {
+	sdm.mutStatements.Lock()
+	sdm.statements = append(sdm.statements, SQLStatementMock{Query: query, Args: args})
+	sdm.mutStatements.Unlock()
+
+	if sdm.ExecCalled != nil {
+		return sdm.ExecCalled(query, args)
+	}
+
+	return nil
+}
+
+func (sdm *SQLDriverMock) query(query string, args []driver.Value) (driver.Rows, error) {
+	if sdm.QueryCalled == nil {
+		return &sqlRowsMock{columns: []string{"value"}, values: [][]driver.Value{{int64(0)}}}, nil
+	}
+
+	columns, values, err := sdm.QueryCalled(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlRowsMock{columns: columns, values: values}, nil
+}
+
+type sqlConnMock struct {
+	driverMock *SQLDriverMock
+}
+
+// Prepare -
+func (scm *sqlConnMock) Prepare(query string) (driver.Stmt, error) {
+	return &sqlStmtMock{driverMock: scm.driverMock, query: query}, nil
+}
+
+// Close -
+func (scm *sqlConnMock) Close() error {
+	return nil
+}
+
+// Begin -
+func (scm *sqlConnMock) Begin() (driver.Tx, error) {
+	return scm, scm.driverMock.exec("BEGIN", nil)
+}
+
+// Commit -
+func (scm *sqlConnMock) Commit() error {
+	return scm.driverMock.exec("COMMIT", nil)
+}
+
+// Rollback -
+func (scm *sqlConnMock) Rollback() error {
+	return scm.driverMock.exec("ROLLBACK", nil)
+}
+
+type sqlStmtMock struct {
+	driverMock *SQLDriverMock
+	query      string
+}
+
+// Close -
+func (ssm *sqlStmtMock) Close() error {
+	return nil
+}
+
+// NumInput -
+func (ssm *sqlStmtMock) NumInput() int {
+	return -1
+}
+
+// Exec -
+func (ssm *sqlStmtMock) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(1), ssm.driverMock.exec(ssm.query, args)
+}
+
+// Query -
+func (ssm *sqlStmtMock) Query(args []driver.Value) (driver.Rows, error) {
+	return ssm.driverMock.query(ssm.query, args)
+}
+
+type sqlRowsMock struct {
+	columns []string
+	values  [][]driver.Value
+	index   int
+}
+
+// Columns -
+func (srm *sqlRowsMock) Columns() []string {
+	return srm.columns
+}
+
+// Close -
+func (srm *sqlRowsMock) Close() error {
+	return nil
+}
+
+// Next -
+func (srm *sqlRowsMock) Next(dest []driver.Value) error {
+	if srm.index >= len(srm.values) {
+		return io.EOF
+	}
+
+	copy(dest, srm.values[srm.index])
+	srm.index++
+
+	return nil
+}
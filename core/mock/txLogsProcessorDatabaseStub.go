@@ -0,0 +1,38 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data"
+
+// TxLogsProcessorDatabaseStub -
+type TxLogsProcessorDatabaseStub struct {
+	GetLogFromCacheCalled           func(txHash []byte) (data.LogHandler, bool)
+	EnableLogToBeSavedInCacheCalled func()
+	CleanCalled                     func()
+}
+
+// GetLogFromCache -
+func (stub *TxLogsProcessorDatabaseStub) GetLogFromCache(txHash []byte) (data.LogHandler, bool) {
+	if stub.GetLogFromCacheCalled != nil {
+		return stub.GetLogFromCacheCalled(txHash)
+	}
+
+	return nil, false
+}
+
+// EnableLogToBeSavedInCache -
+func (stub *TxLogsProcessorDatabaseStub) EnableLogToBeSavedInCache() {
+	if stub.EnableLogToBeSavedInCacheCalled != nil {
+		stub.EnableLogToBeSavedInCacheCalled()
+	}
+}
+
+// Clean -
+func (stub *TxLogsProcessorDatabaseStub) Clean() {
+	if stub.CleanCalled != nil {
+		stub.CleanCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *TxLogsProcessorDatabaseStub) IsInterfaceNil() bool {
+	return stub == nil
+}
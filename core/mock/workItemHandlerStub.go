@@ -0,0 +1,20 @@
+package mock
+
+// WorkItemHandlerStub -
+type WorkItemHandlerStub struct {
+	SaveCalled func() error
+}
+
+// Save -
+func (wihs *WorkItemHandlerStub) Save() error {
+	if wihs.SaveCalled != nil {
+		return wihs.SaveCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (wihs *WorkItemHandlerStub) IsInterfaceNil() bool {
+	return wihs == nil
+}
@@ -0,0 +1,126 @@
+package outport
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	schemeWebSocket       = "ws"
+	schemeSecureWebSocket = "wss"
+	schemeTCP             = "tcp"
+)
+
+const frameLengthSize = 4
+const maxReceivedFrameSize = 1 << 20
+const dialTimeout = 10 * time.Second
+
+func checkURL(address string) (*url.URL, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case schemeWebSocket, schemeSecureWebSocket, schemeTCP:
+		return u, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedURLScheme, u.Scheme)
+	}
+}
+
+func dial(address string) (connection, error) {
+	u, err := checkURL(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme == schemeTCP {
+		conn, errDial := net.DialTimeout(schemeTCP, u.Host, dialTimeout)
+		if errDial != nil {
+			return nil, errDial
+		}
+
+		return &tcpConnection{conn: conn}, nil
+	}
+
+	dialer := &websocket.Dialer{
+		Proxy:            websocket.DefaultDialer.Proxy,
+		HandshakeTimeout: dialTimeout,
+	}
+	conn, _, err := dialer.Dial(u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wsConnection{conn: conn}, nil
+}
+
+// wsConnection sends each message as a websocket text frame
+type wsConnection struct {
+	conn *websocket.Conn
+}
+
+// WriteMessage will write the message as a text frame
+func (wc *wsConnection) WriteMessage(buff []byte) error {
+	return wc.conn.WriteMessage(websocket.TextMessage, buff)
+}
+
+// ReadMessage will read the next frame
+func (wc *wsConnection) ReadMessage() ([]byte, error) {
+	_, buff, err := wc.conn.ReadMessage()
+	return buff, err
+}
+
+// Close will close the underlying connection
+func (wc *wsConnection) Close() error {
+	return wc.conn.Close()
+}
+
+// tcpConnection prefixes each message with its length as a 4 bytes big endian unsigned integer
+type tcpConnection struct {
+	conn net.Conn
+}
+
+// WriteMessage will write the length prefixed message
+func (tc *tcpConnection) WriteMessage(buff []byte) error {
+	frame := make([]byte, frameLengthSize+len(buff))
+	binary.BigEndian.PutUint32(frame, uint32(len(buff)))
+	copy(frame[frameLengthSize:], buff)
+
+	_, err := tc.conn.Write(frame)
+	return err
+}
+
+// ReadMessage will read the next length prefixed message
+func (tc *tcpConnection) ReadMessage() ([]byte, error) {
+	header := make([]byte, frameLengthSize)
+	_, err := io.ReadFull(tc.conn, header)
+	if err != nil {
+		return nil, err
+	}
+
+	frameLength := binary.BigEndian.Uint32(header)
+	if frameLength > maxReceivedFrameSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrFrameTooLarge, frameLength)
+	}
+
+	buff := make([]byte, frameLength)
+	_, err = io.ReadFull(tc.conn, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff, nil
+}
+
+// Close will close the underlying connection
+func (tc *tcpConnection) Close() error {
+	return tc.conn.Close()
+}
@@ -0,0 +1,100 @@
+package outport
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// ArgsSaveBlock holds the data of a finalized block that will be pushed to the drivers
+type ArgsSaveBlock struct {
+	HeaderHash             []byte
+	Header                 data.HeaderHandler
+	Body                   data.BodyHandler
+	TransactionsPool       map[string]data.TransactionHandler
+	TransactionsLogs       map[string]data.LogHandler
+	SignersIndexes         []uint64
+	NotarizedHeadersHashes []string
+}
+
+// The types of the messages sent by the stream driver
+const (
+	MessageTypeSaveBlock             = "saveBlock"
+	MessageTypeRevertBlock           = "revertBlock"
+	MessageTypeSaveRounds            = "saveRounds"
+	MessageTypeSaveValidatorsPubKeys = "saveValidatorsPubKeys"
+	MessageTypeSaveValidatorsRating  = "saveValidatorsRating"
+	MessageTypeSaveAccounts          = "saveAccounts"
+	MessageTypeResumeRejected        = "resumeRejected"
+)
+
+// StreamMessage is the envelope of each message sent by the stream driver. The counter is strictly increasing and
+// should be used by the external process when acknowledging messages
+type StreamMessage struct {
+	Counter uint64      `json:"counter"`
+	Type    string      `json:"type"`
+	ShardID uint32      `json:"shardID"`
+	Nonce   uint64      `json:"nonce"`
+	Payload interface{} `json:"payload"`
+}
+
+// StreamHandshake is the message that the external process should send right after the connection is established.
+// All pending blocks with a nonce lower than ResumeFromNonce will not be sent anymore. A zero ResumeFromNonce
+// accepts whatever the driver still holds
+type StreamHandshake struct {
+	ResumeFromNonce uint64 `json:"resumeFromNonce"`
+}
+
+// ResumeRejectedPayload is the payload of the resumeRejected message, sent before closing the connection when the
+// blocks starting from the requested nonce were dropped, acknowledged or produced before the node started. The
+// external process should fill the gap from another source and resume from FirstAvailableNonce
+type ResumeRejectedPayload struct {
+	RequestedNonce      uint64 `json:"requestedNonce"`
+	FirstAvailableNonce uint64 `json:"firstAvailableNonce"`
+}
+
+// StreamAck is the message sent by the external process to acknowledge all messages up to (and including) the
+// provided counter
+type StreamAck struct {
+	Counter uint64 `json:"counter"`
+}
+
+// BlockPayload is the payload of a saveBlock message. All the maps are keyed by the hex encoded hashes
+type BlockPayload struct {
+	HeaderHash             string                             `json:"headerHash"`
+	Header                 data.HeaderHandler                 `json:"header"`
+	Body                   data.BodyHandler                   `json:"body"`
+	Transactions           map[string]data.TransactionHandler `json:"transactions"`
+	Logs                   map[string]data.LogHandler         `json:"logs"`
+	SignersIndexes         []uint64                           `json:"signersIndexes"`
+	NotarizedHeadersHashes []string                           `json:"notarizedHeadersHashes"`
+}
+
+// RevertBlockPayload is the payload of a revertBlock message
+type RevertBlockPayload struct {
+	Header data.HeaderHandler `json:"header"`
+	Body   data.BodyHandler   `json:"body"`
+}
+
+// RoundsPayload is the payload of a saveRounds message
+type RoundsPayload struct {
+	RoundsInfo []workItems.RoundInfo `json:"roundsInfo"`
+}
+
+// ValidatorsPubKeysPayload is the payload of a saveValidatorsPubKeys message. The keys are hex encoded
+type ValidatorsPubKeysPayload struct {
+	Epoch             uint32              `json:"epoch"`
+	ValidatorsPubKeys map[uint32][]string `json:"validatorsPubKeys"`
+}
+
+// ValidatorsRatingPayload is the payload of a saveValidatorsRating message
+type ValidatorsRatingPayload struct {
+	IndexID    string                          `json:"indexID"`
+	RatingInfo []workItems.ValidatorRatingInfo `json:"ratingInfo"`
+}
+
+// AccountPayload holds the state of an account in a saveAccounts message
+type AccountPayload struct {
+	Address string `json:"address"`
+	Nonce   uint64 `json:"nonce"`
+	Balance string `json:"balance"`
+}
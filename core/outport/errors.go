@@ -0,0 +1,28 @@
+package outport
+
+import "errors"
+
+// ErrNilDriver signals that a nil driver was provided
+var ErrNilDriver = errors.New("nil driver")
+
+// ErrNilIndexer signals that a nil indexer was provided
+var ErrNilIndexer = errors.New("nil indexer")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrInvalidMaxPendingMessages signals that an invalid maximum number of pending messages was provided
+var ErrInvalidMaxPendingMessages = errors.New("invalid maximum number of pending messages")
+
+// ErrInvalidRetryDuration signals that an invalid retry duration was provided
+var ErrInvalidRetryDuration = errors.New("invalid retry duration")
+
+// ErrUnsupportedURLScheme signals that the provided URL has an unsupported scheme
+var ErrUnsupportedURLScheme = errors.New("unsupported URL scheme")
+
+// ErrFrameTooLarge signals that a received frame exceeds the maximum allowed size
+var ErrFrameTooLarge = errors.New("frame too large")
+
+// ErrResumeNonceNotAvailable signals that the blocks starting from the nonce requested by the external process are not
+// available anymore
+var ErrResumeNonceNotAvailable = errors.New("resume nonce not available")
@@ -0,0 +1,9 @@
+package outport
+
+// AwaitedResumeNonce -
+func (sd *streamDriver) AwaitedResumeNonce() uint64 {
+	sd.mutPending.Lock()
+	defer sd.mutPending.Unlock()
+
+	return sd.awaitedResumeNonce
+}
@@ -0,0 +1,86 @@
+package outport
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ Driver = (*indexerDriver)(nil)
+
+// indexerDriver adapts an indexer.Indexer (such as the elastic search indexer) to the Driver interface
+type indexerDriver struct {
+	indexer indexer.Indexer
+}
+
+// NewIndexerDriver will create a driver that forwards all data to the provided indexer
+func NewIndexerDriver(dataIndexer indexer.Indexer) (*indexerDriver, error) {
+	if check.IfNil(dataIndexer) {
+		return nil, ErrNilIndexer
+	}
+
+	return &indexerDriver{
+		indexer: dataIndexer,
+	}, nil
+}
+
+// SetTxLogsProcessor will set the transaction logs processor on the wrapped indexer
+func (id *indexerDriver) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	id.indexer.SetTxLogsProcessor(txLogsProc)
+}
+
+// SaveBlock will save the block in the wrapped indexer
+func (id *indexerDriver) SaveBlock(args *ArgsSaveBlock) error {
+	id.indexer.SaveBlock(args.Body, args.Header, args.TransactionsPool, args.SignersIndexes, args.NotarizedHeadersHashes, args.HeaderHash)
+	return nil
+}
+
+// RevertBlock will revert the block in the wrapped indexer
+func (id *indexerDriver) RevertBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	id.indexer.RevertIndexedBlock(header, body)
+	return nil
+}
+
+// SaveRoundsInfo will save the rounds info in the wrapped indexer
+func (id *indexerDriver) SaveRoundsInfo(roundsInfos []workItems.RoundInfo) error {
+	id.indexer.SaveRoundsInfo(roundsInfos)
+	return nil
+}
+
+// UpdateTPS will update the tps benchmark in the wrapped indexer
+func (id *indexerDriver) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) error {
+	id.indexer.UpdateTPS(tpsBenchmark)
+	return nil
+}
+
+// SaveValidatorsPubKeys will save the validators public keys in the wrapped indexer
+func (id *indexerDriver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	id.indexer.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
+	return nil
+}
+
+// SaveValidatorsRating will save the validators rating in the wrapped indexer
+func (id *indexerDriver) SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) error {
+	id.indexer.SaveValidatorsRating(indexID, infoRating)
+	return nil
+}
+
+// SaveAccounts will save the accounts in the wrapped indexer
+func (id *indexerDriver) SaveAccounts(accounts []state.UserAccountHandler) error {
+	id.indexer.SaveAccounts(accounts)
+	return nil
+}
+
+// Close will close the wrapped indexer
+func (id *indexerDriver) Close() error {
+	return id.indexer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (id *indexerDriver) IsInterfaceNil() bool {
+	return id == nil
+}
@@ -0,0 +1,33 @@
+package outport_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/outport"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIndexerDriver_NilIndexerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	driver, err := outport.NewIndexerDriver(nil)
+
+	assert.Nil(t, driver)
+	assert.Equal(t, outport.ErrNilIndexer, err)
+}
+
+func TestIndexerDriver_ShouldForwardToIndexer(t *testing.T) {
+	t.Parallel()
+
+	driver, err := outport.NewIndexerDriver(indexer.NewNilIndexer())
+	require.Nil(t, err)
+	assert.False(t, driver.IsInterfaceNil())
+
+	err = driver.SaveBlock(&outport.ArgsSaveBlock{Header: &block.Header{}, Body: &block.Body{}})
+	assert.Nil(t, err)
+	assert.Nil(t, driver.RevertBlock(&block.Header{}, &block.Body{}))
+	assert.Nil(t, driver.Close())
+}
@@ -0,0 +1,35 @@
+package outport
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// Driver defines a component able to push the finalized data produced by the node to an external system
+// (a database, a message queue, another process and so on)
+type Driver interface {
+	SaveBlock(args *ArgsSaveBlock) error
+	RevertBlock(header data.HeaderHandler, body data.BodyHandler) error
+	SaveRoundsInfo(roundsInfos []workItems.RoundInfo) error
+	UpdateTPS(tpsBenchmark statistics.TPSBenchmark) error
+	SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error
+	SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) error
+	SaveAccounts(accounts []state.UserAccountHandler) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// txLogsProcessorSetter defines a driver that wants to manage the transaction logs processor by itself
+type txLogsProcessorSetter interface {
+	SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase)
+}
+
+// connection defines a bidirectional message oriented connection
+type connection interface {
+	WriteMessage(buff []byte) error
+	ReadMessage() ([]byte, error)
+	Close() error
+}
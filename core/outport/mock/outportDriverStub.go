@@ -0,0 +1,98 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/outport"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// OutportDriverStub -
+type OutportDriverStub struct {
+	SaveBlockCalled             func(args *outport.ArgsSaveBlock) error
+	RevertBlockCalled           func(header data.HeaderHandler, body data.BodyHandler) error
+	SaveRoundsInfoCalled        func(roundsInfos []workItems.RoundInfo) error
+	UpdateTPSCalled             func(tpsBenchmark statistics.TPSBenchmark) error
+	SaveValidatorsPubKeysCalled func(validatorsPubKeys map[uint32][][]byte, epoch uint32) error
+	SaveValidatorsRatingCalled  func(indexID string, infoRating []workItems.ValidatorRatingInfo) error
+	SaveAccountsCalled          func(accounts []state.UserAccountHandler) error
+	CloseCalled                 func() error
+}
+
+// SaveBlock -
+func (stub *OutportDriverStub) SaveBlock(args *outport.ArgsSaveBlock) error {
+	if stub.SaveBlockCalled != nil {
+		return stub.SaveBlockCalled(args)
+	}
+
+	return nil
+}
+
+// RevertBlock -
+func (stub *OutportDriverStub) RevertBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	if stub.RevertBlockCalled != nil {
+		return stub.RevertBlockCalled(header, body)
+	}
+
+	return nil
+}
+
+// SaveRoundsInfo -
+func (stub *OutportDriverStub) SaveRoundsInfo(roundsInfos []workItems.RoundInfo) error {
+	if stub.SaveRoundsInfoCalled != nil {
+		return stub.SaveRoundsInfoCalled(roundsInfos)
+	}
+
+	return nil
+}
+
+// UpdateTPS -
+func (stub *OutportDriverStub) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) error {
+	if stub.UpdateTPSCalled != nil {
+		return stub.UpdateTPSCalled(tpsBenchmark)
+	}
+
+	return nil
+}
+
+// SaveValidatorsPubKeys -
+func (stub *OutportDriverStub) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	if stub.SaveValidatorsPubKeysCalled != nil {
+		return stub.SaveValidatorsPubKeysCalled(validatorsPubKeys, epoch)
+	}
+
+	return nil
+}
+
+// SaveValidatorsRating -
+func (stub *OutportDriverStub) SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) error {
+	if stub.SaveValidatorsRatingCalled != nil {
+		return stub.SaveValidatorsRatingCalled(indexID, infoRating)
+	}
+
+	return nil
+}
+
+// SaveAccounts -
+func (stub *OutportDriverStub) SaveAccounts(accounts []state.UserAccountHandler) error {
+	if stub.SaveAccountsCalled != nil {
+		return stub.SaveAccountsCalled(accounts)
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *OutportDriverStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *OutportDriverStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,193 @@
+package outport
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("core/outport")
+
+var _ indexer.Indexer = (*outport)(nil)
+
+type outport struct {
+	drivers       []Driver
+	mutTxLogsProc sync.RWMutex
+	txLogsProc    process.TransactionLogProcessorDatabase
+}
+
+// NewOutport will create a component that forwards all the finalized data to the provided drivers. The returned
+// component satisfies the indexer.Indexer interface so it can be used wherever an indexer is expected
+func NewOutport(drivers ...Driver) (*outport, error) {
+	for _, driver := range drivers {
+		if check.IfNil(driver) {
+			return nil, ErrNilDriver
+		}
+	}
+
+	return &outport{
+		drivers: drivers,
+	}, nil
+}
+
+// SetTxLogsProcessor will set the transaction logs processor. The logs of each block will be read from its cache and
+// forwarded to the drivers. The drivers that read the logs by themselves receive a view of the logs processor that
+// can not clean the cache, the cache being cleaned by the outport after all drivers received the block
+func (o *outport) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	if check.IfNil(txLogsProc) {
+		return
+	}
+
+	for _, driver := range o.drivers {
+		setter, ok := driver.(txLogsProcessorSetter)
+		if !ok {
+			continue
+		}
+
+		setter.SetTxLogsProcessor(&nonCleaningTxLogsProcessor{
+			TransactionLogProcessorDatabase: txLogsProc,
+		})
+	}
+
+	o.mutTxLogsProc.Lock()
+	o.txLogsProc = txLogsProc
+	o.mutTxLogsProc.Unlock()
+}
+
+// SaveBlock will forward the finalized block to all drivers
+func (o *outport) SaveBlock(
+	body data.BodyHandler,
+	header data.HeaderHandler,
+	txPool map[string]data.TransactionHandler,
+	signersIndexes []uint64,
+	notarizedHeadersHashes []string,
+	headerHash []byte,
+) {
+	o.mutTxLogsProc.RLock()
+	defer o.mutTxLogsProc.RUnlock()
+
+	args := &ArgsSaveBlock{
+		HeaderHash:             headerHash,
+		Header:                 header,
+		Body:                   body,
+		TransactionsPool:       txPool,
+		TransactionsLogs:       o.getTransactionsLogs(txPool),
+		SignersIndexes:         signersIndexes,
+		NotarizedHeadersHashes: notarizedHeadersHashes,
+	}
+
+	for _, driver := range o.drivers {
+		err := driver.SaveBlock(args)
+		log.LogIfError(err, "outport SaveBlock", "nonce", header.GetNonce())
+	}
+
+	if !check.IfNil(o.txLogsProc) {
+		o.txLogsProc.Clean()
+	}
+}
+
+func (o *outport) getTransactionsLogs(txPool map[string]data.TransactionHandler) map[string]data.LogHandler {
+	logs := make(map[string]data.LogHandler)
+	if check.IfNil(o.txLogsProc) {
+		return logs
+	}
+
+	for txHash := range txPool {
+		txLog, ok := o.txLogsProc.GetLogFromCache([]byte(txHash))
+		if !ok || check.IfNil(txLog) {
+			continue
+		}
+
+		logs[txHash] = txLog
+	}
+
+	return logs
+}
+
+// RevertIndexedBlock will forward the reverted block to all drivers
+func (o *outport) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) {
+	for _, driver := range o.drivers {
+		err := driver.RevertBlock(header, body)
+		log.LogIfError(err, "outport RevertBlock", "nonce", header.GetNonce())
+	}
+}
+
+// SaveRoundsInfo will forward the rounds info to all drivers
+func (o *outport) SaveRoundsInfo(roundsInfos []workItems.RoundInfo) {
+	for _, driver := range o.drivers {
+		err := driver.SaveRoundsInfo(roundsInfos)
+		log.LogIfError(err, "outport SaveRoundsInfo")
+	}
+}
+
+// UpdateTPS will forward the tps benchmark to all drivers
+func (o *outport) UpdateTPS(tpsBenchmark statistics.TPSBenchmark) {
+	for _, driver := range o.drivers {
+		err := driver.UpdateTPS(tpsBenchmark)
+		log.LogIfError(err, "outport UpdateTPS")
+	}
+}
+
+// SaveValidatorsPubKeys will forward the validators public keys to all drivers
+func (o *outport) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) {
+	for _, driver := range o.drivers {
+		err := driver.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
+		log.LogIfError(err, "outport SaveValidatorsPubKeys", "epoch", epoch)
+	}
+}
+
+// SaveValidatorsRating will forward the validators rating info to all drivers
+func (o *outport) SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) {
+	for _, driver := range o.drivers {
+		err := driver.SaveValidatorsRating(indexID, infoRating)
+		log.LogIfError(err, "outport SaveValidatorsRating", "index", indexID)
+	}
+}
+
+// SaveAccounts will forward the accounts to all drivers
+func (o *outport) SaveAccounts(accounts []state.UserAccountHandler) {
+	for _, driver := range o.drivers {
+		err := driver.SaveAccounts(accounts)
+		log.LogIfError(err, "outport SaveAccounts")
+	}
+}
+
+// Close will close all drivers
+func (o *outport) Close() error {
+	var lastError error
+	for _, driver := range o.drivers {
+		err := driver.Close()
+		if err != nil {
+			log.Error("outport close driver", "error", err.Error())
+			lastError = err
+		}
+	}
+
+	return lastError
+}
+
+// nonCleaningTxLogsProcessor is the view of the transaction logs processor given to the drivers
+type nonCleaningTxLogsProcessor struct {
+	process.TransactionLogProcessorDatabase
+}
+
+// Clean does nothing as the logs cache is cleaned by the outport
+func (ntlp *nonCleaningTxLogsProcessor) Clean() {
+}
+
+// IsNilIndexer returns true if there is no driver attached to the outport
+func (o *outport) IsNilIndexer() bool {
+	return len(o.drivers) == 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (o *outport) IsInterfaceNil() bool {
+	return o == nil
+}
@@ -0,0 +1,144 @@
+package outport_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/outport"
+	outportMock "github.com/ElrondNetwork/elrond-go/core/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type txLogsProcessorSetterDriver struct {
+	outportMock.OutportDriverStub
+	txLogsProc process.TransactionLogProcessorDatabase
+}
+
+func (driver *txLogsProcessorSetterDriver) SetTxLogsProcessor(txLogsProc process.TransactionLogProcessorDatabase) {
+	driver.txLogsProc = txLogsProc
+}
+
+func TestNewOutport_NilDriverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	o, err := outport.NewOutport(&outportMock.OutportDriverStub{}, nil)
+
+	assert.Nil(t, o)
+	assert.Equal(t, outport.ErrNilDriver, err)
+}
+
+func TestNewOutport_NoDriversShouldBeNilIndexer(t *testing.T) {
+	t.Parallel()
+
+	o, err := outport.NewOutport()
+
+	require.Nil(t, err)
+	assert.False(t, o.IsInterfaceNil())
+	assert.True(t, o.IsNilIndexer())
+}
+
+func TestOutport_SaveBlockShouldForwardToAllDriversWithLogs(t *testing.T) {
+	t.Parallel()
+
+	txHash := "txHash"
+	txLog := &transaction.Log{Address: []byte("address")}
+	numCleanCalls := 0
+	txLogsProc := &mock.TxLogsProcessorDatabaseStub{
+		GetLogFromCacheCalled: func(hash []byte) (data.LogHandler, bool) {
+			if string(hash) == txHash {
+				return txLog, true
+			}
+			return nil, false
+		},
+		CleanCalled: func() {
+			numCleanCalls++
+		},
+	}
+
+	savedArgs := make([]*outport.ArgsSaveBlock, 0)
+	driver := &outportMock.OutportDriverStub{
+		SaveBlockCalled: func(args *outport.ArgsSaveBlock) error {
+			savedArgs = append(savedArgs, args)
+			return errors.New("a failing driver should not stop the others")
+		},
+	}
+
+	o, _ := outport.NewOutport(driver, driver)
+	o.SetTxLogsProcessor(txLogsProc)
+
+	header := &block.Header{Nonce: 5}
+	txPool := map[string]data.TransactionHandler{
+		txHash:  &transaction.Transaction{},
+		"other": &transaction.Transaction{},
+	}
+	o.SaveBlock(&block.Body{}, header, txPool, []uint64{1}, []string{"notarized"}, []byte("hash"))
+
+	require.Equal(t, 2, len(savedArgs))
+	assert.Equal(t, header, savedArgs[0].Header)
+	assert.Equal(t, []byte("hash"), savedArgs[0].HeaderHash)
+	assert.Equal(t, map[string]data.LogHandler{txHash: txLog}, savedArgs[0].TransactionsLogs)
+	assert.Equal(t, 1, numCleanCalls)
+}
+
+func TestOutport_SetTxLogsProcessorShouldGiveDriversAViewThatCanNotClean(t *testing.T) {
+	t.Parallel()
+
+	numCleanCalls := 0
+	txLogsProc := &mock.TxLogsProcessorDatabaseStub{
+		CleanCalled: func() {
+			numCleanCalls++
+		},
+	}
+
+	driver := &txLogsProcessorSetterDriver{}
+	driver.SaveBlockCalled = func(_ *outport.ArgsSaveBlock) error {
+		driver.txLogsProc.Clean()
+		return nil
+	}
+	o, _ := outport.NewOutport(driver, driver)
+	o.SetTxLogsProcessor(txLogsProc)
+	o.SaveBlock(&block.Body{}, &block.Header{}, map[string]data.TransactionHandler{}, nil, nil, nil)
+
+	require.NotNil(t, driver.txLogsProc)
+	assert.Equal(t, 1, numCleanCalls)
+}
+
+func TestOutport_OtherMethodsShouldForwardToAllDrivers(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	driver := &outportMock.OutportDriverStub{
+		RevertBlockCalled: func(_ data.HeaderHandler, _ data.BodyHandler) error {
+			numCalls++
+			return nil
+		},
+		SaveRoundsInfoCalled: func(_ []workItems.RoundInfo) error {
+			numCalls++
+			return nil
+		},
+		SaveValidatorsRatingCalled: func(_ string, _ []workItems.ValidatorRatingInfo) error {
+			numCalls++
+			return nil
+		},
+		CloseCalled: func() error {
+			numCalls++
+			return nil
+		},
+	}
+
+	o, _ := outport.NewOutport(driver, driver)
+	o.RevertIndexedBlock(&block.Header{}, &block.Body{})
+	o.SaveRoundsInfo(nil)
+	o.SaveValidatorsRating("0", nil)
+	err := o.Close()
+
+	assert.Nil(t, err)
+	assert.Equal(t, 8, numCalls)
+}
@@ -0,0 +1,506 @@
+package outport
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/workItems"
+	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var _ Driver = (*streamDriver)(nil)
+
+// ArgsStreamDriver holds the arguments needed to create a new stream driver
+type ArgsStreamDriver struct {
+	URL                string
+	Marshalizer        marshal.Marshalizer
+	SelfShardID        uint32
+	RetryDuration      time.Duration
+	MaxPendingMessages int
+}
+
+type pendingMessage struct {
+	counter uint64
+	nonce   uint64
+	buff    []byte
+}
+
+// streamDriver pushes every item as a message on a websocket or a plain TCP connection. The messages are kept until
+// the external process acknowledges them so they will be sent again if the connection breaks. When the connection
+// is established, the external process tells the driver from which block nonce it wants to resume. The messages are
+// only kept in memory, so the driver rejects the handshake if it can no longer send all the blocks from that nonce
+type streamDriver struct {
+	url                string
+	marshalizer        marshal.Marshalizer
+	selfShardID        uint32
+	retryDuration      time.Duration
+	maxPendingMessages int
+	dialHandler        func(address string) (connection, error)
+
+	mutPending  sync.Mutex
+	pending     []*pendingMessage
+	lastCounter uint64
+	// firstAvailableNonce is the lowest block nonce from which all the block messages can still be sent, or zero if
+	// no block message was pushed yet
+	firstAvailableNonce uint64
+	// awaitedResumeNonce is the nonce requested by the external process before any block message was pushed
+	awaitedResumeNonce uint64
+
+	chNewMessage  chan struct{}
+	mutConnection sync.Mutex
+	currentConn   connection
+	cancelFunc    context.CancelFunc
+	closeOnce     sync.Once
+}
+
+// NewStreamDriver will create a new stream driver and will start connecting to the provided URL
+func NewStreamDriver(args ArgsStreamDriver) (*streamDriver, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if args.MaxPendingMessages <= 0 {
+		return nil, ErrInvalidMaxPendingMessages
+	}
+	if args.RetryDuration <= 0 {
+		return nil, ErrInvalidRetryDuration
+	}
+	_, err := checkURL(args.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	sd := newStreamDriver(args, dial)
+
+	var ctx context.Context
+	ctx, sd.cancelFunc = context.WithCancel(context.Background())
+	go sd.run(ctx)
+
+	return sd, nil
+}
+
+func newStreamDriver(args ArgsStreamDriver, dialHandler func(address string) (connection, error)) *streamDriver {
+	return &streamDriver{
+		url:                args.URL,
+		marshalizer:        args.Marshalizer,
+		selfShardID:        args.SelfShardID,
+		retryDuration:      args.RetryDuration,
+		maxPendingMessages: args.MaxPendingMessages,
+		dialHandler:        dialHandler,
+		pending:            make([]*pendingMessage, 0),
+		chNewMessage:       make(chan struct{}, 1),
+		cancelFunc:         func() {},
+	}
+}
+
+// SaveBlock will push a saveBlock message
+func (sd *streamDriver) SaveBlock(args *ArgsSaveBlock) error {
+	transactions := make(map[string]data.TransactionHandler, len(args.TransactionsPool))
+	for txHash, tx := range args.TransactionsPool {
+		transactions[hex.EncodeToString([]byte(txHash))] = tx
+	}
+
+	logs := make(map[string]data.LogHandler, len(args.TransactionsLogs))
+	for txHash, txLog := range args.TransactionsLogs {
+		logs[hex.EncodeToString([]byte(txHash))] = txLog
+	}
+
+	payload := &BlockPayload{
+		HeaderHash:             hex.EncodeToString(args.HeaderHash),
+		Header:                 args.Header,
+		Body:                   args.Body,
+		Transactions:           transactions,
+		Logs:                   logs,
+		SignersIndexes:         args.SignersIndexes,
+		NotarizedHeadersHashes: args.NotarizedHeadersHashes,
+	}
+
+	return sd.push(MessageTypeSaveBlock, args.Header.GetShardID(), args.Header.GetNonce(), payload)
+}
+
+// RevertBlock will push a revertBlock message
+func (sd *streamDriver) RevertBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	payload := &RevertBlockPayload{
+		Header: header,
+		Body:   body,
+	}
+
+	return sd.push(MessageTypeRevertBlock, header.GetShardID(), header.GetNonce(), payload)
+}
+
+// SaveRoundsInfo will push a saveRounds message
+func (sd *streamDriver) SaveRoundsInfo(roundsInfos []workItems.RoundInfo) error {
+	return sd.push(MessageTypeSaveRounds, sd.selfShardID, 0, &RoundsPayload{RoundsInfo: roundsInfos})
+}
+
+// UpdateTPS does nothing as the external process can compute the statistics from the saved blocks
+func (sd *streamDriver) UpdateTPS(_ statistics.TPSBenchmark) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys will push a saveValidatorsPubKeys message
+func (sd *streamDriver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	hexPubKeys := make(map[uint32][]string, len(validatorsPubKeys))
+	for shardID, pubKeys := range validatorsPubKeys {
+		hexPubKeys[shardID] = make([]string, 0, len(pubKeys))
+		for _, pubKey := range pubKeys {
+			hexPubKeys[shardID] = append(hexPubKeys[shardID], hex.EncodeToString(pubKey))
+		}
+	}
+
+	payload := &ValidatorsPubKeysPayload{
+		Epoch:             epoch,
+		ValidatorsPubKeys: hexPubKeys,
+	}
+
+	return sd.push(MessageTypeSaveValidatorsPubKeys, sd.selfShardID, 0, payload)
+}
+
+// SaveValidatorsRating will push a saveValidatorsRating message
+func (sd *streamDriver) SaveValidatorsRating(indexID string, infoRating []workItems.ValidatorRatingInfo) error {
+	payload := &ValidatorsRatingPayload{
+		IndexID:    indexID,
+		RatingInfo: infoRating,
+	}
+
+	return sd.push(MessageTypeSaveValidatorsRating, sd.selfShardID, 0, payload)
+}
+
+// SaveAccounts will push a saveAccounts message
+func (sd *streamDriver) SaveAccounts(accounts []state.UserAccountHandler) error {
+	payload := make([]*AccountPayload, 0, len(accounts))
+	for _, account := range accounts {
+		if check.IfNil(account) {
+			continue
+		}
+
+		payload = append(payload, &AccountPayload{
+			Address: hex.EncodeToString(account.AddressBytes()),
+			Nonce:   account.GetNonce(),
+			Balance: account.GetBalance().String(),
+		})
+	}
+
+	return sd.push(MessageTypeSaveAccounts, sd.selfShardID, 0, payload)
+}
+
+func (sd *streamDriver) push(messageType string, shardID uint32, nonce uint64, payload interface{}) error {
+	sd.mutPending.Lock()
+	isAlreadyStreamed := messageType == MessageTypeSaveBlock && sd.firstAvailableNonce == 0 && nonce < sd.awaitedResumeNonce
+	if isAlreadyStreamed {
+		sd.mutPending.Unlock()
+		return nil
+	}
+
+	counter := sd.lastCounter + 1
+	message := &StreamMessage{
+		Counter: counter,
+		Type:    messageType,
+		ShardID: shardID,
+		Nonce:   nonce,
+		Payload: payload,
+	}
+
+	buff, err := sd.marshalizer.Marshal(message)
+	if err != nil {
+		sd.mutPending.Unlock()
+		return err
+	}
+
+	sd.lastCounter = counter
+	if len(sd.pending) >= sd.maxPendingMessages {
+		log.Warn("outport stream driver: too many unacknowledged messages, dropping the oldest one",
+			"counter", sd.pending[0].counter, "nonce", sd.pending[0].nonce)
+		sd.markUnavailable(sd.pending[0])
+		sd.pending = sd.pending[1:]
+	}
+	sd.pending = append(sd.pending, &pendingMessage{
+		counter: counter,
+		nonce:   nonce,
+		buff:    buff,
+	})
+	isResumeNonceMissed := sd.setFirstAvailableNonce(messageType, nonce)
+	sd.mutPending.Unlock()
+
+	if isResumeNonceMissed {
+		// the next handshake will be rejected
+		sd.closeCurrentConnection()
+	}
+
+	select {
+	case sd.chNewMessage <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func (sd *streamDriver) run(ctx context.Context) {
+	for {
+		conn, err := sd.connect()
+		if err != nil {
+			log.Debug("outport stream driver: can not connect", "url", sd.url, "error", err.Error())
+		} else {
+			log.Info("outport stream driver: connected", "url", sd.url)
+			sd.serve(ctx, conn)
+			sd.setConnection(nil)
+			log.LogIfError(conn.Close())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(sd.retryDuration):
+		}
+	}
+}
+
+func (sd *streamDriver) connect() (connection, error) {
+	conn, err := sd.dialHandler(sd.url)
+	if err != nil {
+		return nil, err
+	}
+	sd.setConnection(conn)
+
+	buff, err := conn.ReadMessage()
+	if err != nil {
+		sd.setConnection(nil)
+		log.LogIfError(conn.Close())
+		return nil, err
+	}
+
+	handshake := &StreamHandshake{}
+	err = sd.marshalizer.Unmarshal(handshake, buff)
+	if err != nil {
+		sd.setConnection(nil)
+		log.LogIfError(conn.Close())
+		return nil, err
+	}
+
+	err = sd.resumeFromNonce(handshake.ResumeFromNonce)
+	if err != nil {
+		sd.rejectHandshake(conn, handshake.ResumeFromNonce)
+		sd.setConnection(nil)
+		log.LogIfError(conn.Close())
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// setFirstAvailableNonce records the nonce of the first saved block pushed since the driver started and returns true
+// if the external process already asked to resume from an older nonce. It should be called under mutPending
+func (sd *streamDriver) setFirstAvailableNonce(messageType string, nonce uint64) bool {
+	if messageType != MessageTypeSaveBlock || sd.firstAvailableNonce > 0 {
+		return false
+	}
+
+	sd.firstAvailableNonce = nonce
+	awaitedResumeNonce := sd.awaitedResumeNonce
+	sd.awaitedResumeNonce = 0
+	if awaitedResumeNonce == 0 || awaitedResumeNonce >= nonce {
+		return false
+	}
+
+	log.Error("outport stream driver: the external process asked for blocks produced before the node started",
+		"requested nonce", awaitedResumeNonce, "first available nonce", nonce)
+
+	return true
+}
+
+// markUnavailable raises the first available nonce above the nonce of a block message that will not be kept anymore.
+// It should be called under mutPending
+func (sd *streamDriver) markUnavailable(msg *pendingMessage) {
+	isBlockMessage := msg.nonce > 0
+	if isBlockMessage && sd.firstAvailableNonce <= msg.nonce {
+		sd.firstAvailableNonce = msg.nonce + 1
+	}
+}
+
+// resumeFromNonce checks that all the blocks starting from the provided nonce can still be sent and drops the ones
+// that the external process already has
+func (sd *streamDriver) resumeFromNonce(nonce uint64) error {
+	sd.mutPending.Lock()
+	defer sd.mutPending.Unlock()
+
+	if nonce == 0 {
+		return nil
+	}
+	if sd.firstAvailableNonce == 0 {
+		sd.awaitedResumeNonce = nonce
+		return nil
+	}
+	if nonce < sd.firstAvailableNonce {
+		log.Error("outport stream driver: rejecting the handshake as the requested blocks are not available anymore",
+			"requested nonce", nonce, "first available nonce", sd.firstAvailableNonce)
+		return ErrResumeNonceNotAvailable
+	}
+
+	sd.dropPendingBelowNonce(nonce)
+
+	return nil
+}
+
+func (sd *streamDriver) rejectHandshake(conn connection, requestedNonce uint64) {
+	sd.mutPending.Lock()
+	message := &StreamMessage{
+		Type: MessageTypeResumeRejected,
+		Payload: &ResumeRejectedPayload{
+			RequestedNonce:      requestedNonce,
+			FirstAvailableNonce: sd.firstAvailableNonce,
+		},
+	}
+	sd.mutPending.Unlock()
+
+	buff, err := sd.marshalizer.Marshal(message)
+	if err != nil {
+		log.Debug("outport stream driver: can not marshal the rejection", "error", err.Error())
+		return
+	}
+
+	err = conn.WriteMessage(buff)
+	if err != nil {
+		log.Debug("outport stream driver: can not send the rejection", "error", err.Error())
+	}
+}
+
+func (sd *streamDriver) closeCurrentConnection() {
+	sd.mutConnection.Lock()
+	defer sd.mutConnection.Unlock()
+
+	if sd.currentConn != nil {
+		log.LogIfError(sd.currentConn.Close())
+	}
+}
+
+func (sd *streamDriver) setConnection(conn connection) {
+	sd.mutConnection.Lock()
+	sd.currentConn = conn
+	sd.mutConnection.Unlock()
+}
+
+// dropPendingBelowNonce removes the block related messages that the external process already has. It should be called
+// under mutPending
+func (sd *streamDriver) dropPendingBelowNonce(nonce uint64) {
+	if sd.firstAvailableNonce < nonce {
+		sd.firstAvailableNonce = nonce
+	}
+
+	remaining := make([]*pendingMessage, 0, len(sd.pending))
+	for _, msg := range sd.pending {
+		isBlockMessage := msg.nonce > 0
+		if isBlockMessage && msg.nonce < nonce {
+			continue
+		}
+
+		remaining = append(remaining, msg)
+	}
+	sd.pending = remaining
+}
+
+func (sd *streamDriver) serve(ctx context.Context, conn connection) {
+	chErr := make(chan error, 1)
+	go sd.readAcknowledges(conn, chErr)
+
+	lastSentCounter := uint64(0)
+	for {
+		for _, msg := range sd.getPendingAfter(lastSentCounter) {
+			err := conn.WriteMessage(msg.buff)
+			if err != nil {
+				log.Debug("outport stream driver: write", "error", err.Error())
+				return
+			}
+
+			lastSentCounter = msg.counter
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-chErr:
+			log.Debug("outport stream driver: read", "error", err.Error())
+			return
+		case <-sd.chNewMessage:
+		}
+	}
+}
+
+func (sd *streamDriver) getPendingAfter(counter uint64) []*pendingMessage {
+	sd.mutPending.Lock()
+	defer sd.mutPending.Unlock()
+
+	messages := make([]*pendingMessage, 0, len(sd.pending))
+	for _, msg := range sd.pending {
+		if msg.counter > counter {
+			messages = append(messages, msg)
+		}
+	}
+
+	return messages
+}
+
+func (sd *streamDriver) readAcknowledges(conn connection, chErr chan error) {
+	for {
+		buff, err := conn.ReadMessage()
+		if err != nil {
+			chErr <- err
+			return
+		}
+
+		ack := &StreamAck{}
+		err = sd.marshalizer.Unmarshal(ack, buff)
+		if err != nil {
+			log.Debug("outport stream driver: invalid acknowledge", "error", err.Error())
+			continue
+		}
+
+		sd.acknowledge(ack.Counter)
+	}
+}
+
+// acknowledge removes all the messages up to (and including) the provided counter
+func (sd *streamDriver) acknowledge(counter uint64) {
+	sd.mutPending.Lock()
+	defer sd.mutPending.Unlock()
+
+	index := 0
+	for index < len(sd.pending) && sd.pending[index].counter <= counter {
+		sd.markUnavailable(sd.pending[index])
+		index++
+	}
+	sd.pending = sd.pending[index:]
+}
+
+// NumPendingMessages returns the number of messages not yet acknowledged by the external process
+func (sd *streamDriver) NumPendingMessages() int {
+	sd.mutPending.Lock()
+	defer sd.mutPending.Unlock()
+
+	return len(sd.pending)
+}
+
+// Close will stop the driver and close the current connection, if any
+func (sd *streamDriver) Close() error {
+	var err error
+	sd.closeOnce.Do(func() {
+		sd.cancelFunc()
+
+		sd.mutConnection.Lock()
+		if sd.currentConn != nil {
+			err = sd.currentConn.Close()
+		}
+		sd.mutConnection.Unlock()
+	})
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sd *streamDriver) IsInterfaceNil() bool {
+	return sd == nil
+}
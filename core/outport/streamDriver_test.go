@@ -0,0 +1,416 @@
+package outport_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/outport"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 5 * time.Second
+
+func createMockArgsStreamDriver(url string) outport.ArgsStreamDriver {
+	return outport.ArgsStreamDriver{
+		URL:                url,
+		Marshalizer:        &marshal.JsonMarshalizer{},
+		SelfShardID:        0,
+		RetryDuration:      10 * time.Millisecond,
+		MaxPendingMessages: 100,
+	}
+}
+
+func writeTCPFrame(conn net.Conn, buff []byte) error {
+	frame := make([]byte, 4+len(buff))
+	binary.BigEndian.PutUint32(frame, uint32(len(buff)))
+	copy(frame[4:], buff)
+	_, err := conn.Write(frame)
+
+	return err
+}
+
+func readTCPFrame(conn net.Conn) ([]byte, error) {
+	header := make([]byte, 4)
+	_, err := io.ReadFull(conn, header)
+	if err != nil {
+		return nil, err
+	}
+
+	buff := make([]byte, binary.BigEndian.Uint32(header))
+	_, err = io.ReadFull(conn, buff)
+
+	return buff, err
+}
+
+func readStreamMessage(t *testing.T, conn net.Conn) *outport.StreamMessage {
+	_ = conn.SetReadDeadline(time.Now().Add(testTimeout))
+	buff, err := readTCPFrame(conn)
+	require.Nil(t, err)
+
+	msg := &outport.StreamMessage{}
+	err = json.Unmarshal(buff, msg)
+	require.Nil(t, err)
+
+	return msg
+}
+
+func acceptWithHandshake(t *testing.T, listener net.Listener, resumeFromNonce uint64) net.Conn {
+	conn, err := listener.Accept()
+	require.Nil(t, err)
+
+	buff, _ := json.Marshal(&outport.StreamHandshake{ResumeFromNonce: resumeFromNonce})
+	err = writeTCPFrame(conn, buff)
+	require.Nil(t, err)
+
+	return conn
+}
+
+func waitForPendingMessages(t *testing.T, driver interface{ NumPendingMessages() int }, expected int) {
+	deadline := time.Now().Add(testTimeout)
+	for time.Now().Before(deadline) {
+		if driver.NumPendingMessages() == expected {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	assert.Fail(t, "timeout waiting for pending messages", "expected %d, got %d", expected, driver.NumPendingMessages())
+}
+
+func waitForHandshake(t *testing.T, driver interface{ AwaitedResumeNonce() uint64 }) {
+	deadline := time.Now().Add(testTimeout)
+	for time.Now().Before(deadline) {
+		if driver.AwaitedResumeNonce() > 0 {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	assert.Fail(t, "timeout waiting for the handshake")
+}
+
+func TestNewStreamDriver_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStreamDriver("tcp://127.0.0.1:1")
+	args.Marshalizer = nil
+	driver, err := outport.NewStreamDriver(args)
+	assert.Nil(t, driver)
+	assert.Equal(t, outport.ErrNilMarshalizer, err)
+
+	args = createMockArgsStreamDriver("tcp://127.0.0.1:1")
+	args.MaxPendingMessages = 0
+	driver, err = outport.NewStreamDriver(args)
+	assert.Nil(t, driver)
+	assert.Equal(t, outport.ErrInvalidMaxPendingMessages, err)
+
+	args = createMockArgsStreamDriver("tcp://127.0.0.1:1")
+	args.RetryDuration = 0
+	driver, err = outport.NewStreamDriver(args)
+	assert.Nil(t, driver)
+	assert.Equal(t, outport.ErrInvalidRetryDuration, err)
+
+	args = createMockArgsStreamDriver("http://127.0.0.1:1")
+	driver, err = outport.NewStreamDriver(args)
+	assert.Nil(t, driver)
+	assert.True(t, errors.Is(err, outport.ErrUnsupportedURLScheme))
+}
+
+func TestStreamDriver_TCPShouldSendAndRemoveAcknowledgedMessages(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer func() {
+		_ = listener.Close()
+	}()
+
+	driver, err := outport.NewStreamDriver(createMockArgsStreamDriver("tcp://" + listener.Addr().String()))
+	require.Nil(t, err)
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	conn := acceptWithHandshake(t, listener, 0)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err = driver.SaveBlock(&outport.ArgsSaveBlock{
+		HeaderHash: []byte("hash"),
+		Header:     &block.Header{Nonce: 7, ShardID: 1},
+		Body:       &block.Body{},
+	})
+	require.Nil(t, err)
+
+	msg := readStreamMessage(t, conn)
+	assert.Equal(t, uint64(1), msg.Counter)
+	assert.Equal(t, outport.MessageTypeSaveBlock, msg.Type)
+	assert.Equal(t, uint64(7), msg.Nonce)
+	assert.Equal(t, uint32(1), msg.ShardID)
+	assert.Equal(t, 1, driver.NumPendingMessages())
+
+	buff, _ := json.Marshal(&outport.StreamAck{Counter: msg.Counter})
+	err = writeTCPFrame(conn, buff)
+	require.Nil(t, err)
+
+	waitForPendingMessages(t, driver, 0)
+}
+
+func TestStreamDriver_ShouldResumeFromTheRequestedNonce(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer func() {
+		_ = listener.Close()
+	}()
+
+	driver, err := outport.NewStreamDriver(createMockArgsStreamDriver("tcp://" + listener.Addr().String()))
+	require.Nil(t, err)
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		err = driver.SaveBlock(&outport.ArgsSaveBlock{
+			Header: &block.Header{Nonce: nonce},
+			Body:   &block.Body{},
+		})
+		require.Nil(t, err)
+	}
+
+	//first connection receives everything but does not acknowledge anything
+	conn := acceptWithHandshake(t, listener, 0)
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		msg := readStreamMessage(t, conn)
+		assert.Equal(t, nonce, msg.Nonce)
+	}
+	_ = conn.Close()
+
+	//after reconnecting, the external process already has the first 2 blocks
+	conn = acceptWithHandshake(t, listener, 3)
+	defer func() {
+		_ = conn.Close()
+	}()
+	msg := readStreamMessage(t, conn)
+	assert.Equal(t, uint64(3), msg.Nonce)
+	assert.Equal(t, uint64(3), msg.Counter)
+	waitForPendingMessages(t, driver, 1)
+}
+
+func readResumeRejection(t *testing.T, conn net.Conn) *outport.ResumeRejectedPayload {
+	msg := struct {
+		Type    string                        `json:"type"`
+		Payload outport.ResumeRejectedPayload `json:"payload"`
+	}{}
+
+	_ = conn.SetReadDeadline(time.Now().Add(testTimeout))
+	buff, err := readTCPFrame(conn)
+	require.Nil(t, err)
+	err = json.Unmarshal(buff, &msg)
+	require.Nil(t, err)
+	require.Equal(t, outport.MessageTypeResumeRejected, msg.Type)
+
+	_, err = readTCPFrame(conn)
+	require.NotNil(t, err, "the connection should have been closed")
+
+	return &msg.Payload
+}
+
+func TestStreamDriver_ResumeFromDroppedBlocksShouldRejectTheHandshake(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer func() {
+		_ = listener.Close()
+	}()
+
+	args := createMockArgsStreamDriver("tcp://" + listener.Addr().String())
+	args.MaxPendingMessages = 2
+	driver, err := outport.NewStreamDriver(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	for nonce := uint64(1); nonce <= 4; nonce++ {
+		err = driver.SaveBlock(&outport.ArgsSaveBlock{
+			Header: &block.Header{Nonce: nonce},
+			Body:   &block.Body{},
+		})
+		require.Nil(t, err)
+	}
+
+	//blocks 1 and 2 were dropped as the buffer holds only 2 messages
+	conn := acceptWithHandshake(t, listener, 2)
+	rejection := readResumeRejection(t, conn)
+	_ = conn.Close()
+	assert.Equal(t, uint64(2), rejection.RequestedNonce)
+	assert.Equal(t, uint64(3), rejection.FirstAvailableNonce)
+
+	conn = acceptWithHandshake(t, listener, 3)
+	defer func() {
+		_ = conn.Close()
+	}()
+	for nonce := uint64(3); nonce <= 4; nonce++ {
+		msg := readStreamMessage(t, conn)
+		assert.Equal(t, nonce, msg.Nonce)
+	}
+}
+
+func TestStreamDriver_ResumeFromBlocksBeforeTheStartShouldRejectTheHandshake(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer func() {
+		_ = listener.Close()
+	}()
+
+	driver, err := outport.NewStreamDriver(createMockArgsStreamDriver("tcp://" + listener.Addr().String()))
+	require.Nil(t, err)
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	//the external process asks for block 5 while the restarted node continues from block 8
+	conn := acceptWithHandshake(t, listener, 5)
+	waitForHandshake(t, driver)
+	err = driver.SaveBlock(&outport.ArgsSaveBlock{
+		Header: &block.Header{Nonce: 8},
+		Body:   &block.Body{},
+	})
+	require.Nil(t, err)
+
+	_ = conn.SetReadDeadline(time.Now().Add(testTimeout))
+	for {
+		_, err = readTCPFrame(conn)
+		if err != nil {
+			break
+		}
+	}
+	_ = conn.Close()
+
+	conn = acceptWithHandshake(t, listener, 5)
+	defer func() {
+		_ = conn.Close()
+	}()
+	rejection := readResumeRejection(t, conn)
+	assert.Equal(t, uint64(5), rejection.RequestedNonce)
+	assert.Equal(t, uint64(8), rejection.FirstAvailableNonce)
+}
+
+func TestStreamDriver_ResumeBeforeTheStartShouldSkipTheStreamedBlocks(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer func() {
+		_ = listener.Close()
+	}()
+
+	driver, err := outport.NewStreamDriver(createMockArgsStreamDriver("tcp://" + listener.Addr().String()))
+	require.Nil(t, err)
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	conn := acceptWithHandshake(t, listener, 10)
+	defer func() {
+		_ = conn.Close()
+	}()
+	waitForHandshake(t, driver)
+
+	for nonce := uint64(9); nonce <= 11; nonce++ {
+		err = driver.SaveBlock(&outport.ArgsSaveBlock{
+			Header: &block.Header{Nonce: nonce},
+			Body:   &block.Body{},
+		})
+		require.Nil(t, err)
+	}
+
+	for nonce := uint64(10); nonce <= 11; nonce++ {
+		msg := readStreamMessage(t, conn)
+		assert.Equal(t, nonce, msg.Nonce)
+	}
+}
+
+func TestStreamDriver_TooManyPendingMessagesShouldDropTheOldest(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStreamDriver("tcp://127.0.0.1:1")
+	args.MaxPendingMessages = 2
+	args.RetryDuration = time.Hour
+	driver, err := outport.NewStreamDriver(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	for i := 0; i < 5; i++ {
+		err = driver.SaveRoundsInfo(nil)
+		require.Nil(t, err)
+	}
+
+	assert.Equal(t, 2, driver.NumPendingMessages())
+}
+
+func TestStreamDriver_WebSocketShouldSendMessages(t *testing.T) {
+	t.Parallel()
+
+	chMessages := make(chan []byte, 10)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		buff, _ := json.Marshal(&outport.StreamHandshake{})
+		_ = conn.WriteMessage(websocket.TextMessage, buff)
+		for {
+			_, message, errRead := conn.ReadMessage()
+			if errRead != nil {
+				return
+			}
+			chMessages <- message
+		}
+	}))
+	defer server.Close()
+
+	driver, err := outport.NewStreamDriver(createMockArgsStreamDriver(strings.Replace(server.URL, "http", "ws", 1)))
+	require.Nil(t, err)
+	defer func() {
+		_ = driver.Close()
+	}()
+
+	err = driver.SaveValidatorsPubKeys(map[uint32][][]byte{0: {[]byte("pk")}}, 2)
+	require.Nil(t, err)
+
+	select {
+	case buff := <-chMessages:
+		msg := &outport.StreamMessage{}
+		err = json.Unmarshal(buff, msg)
+		require.Nil(t, err)
+		assert.Equal(t, outport.MessageTypeSaveValidatorsPubKeys, msg.Type)
+	case <-time.After(testTimeout):
+		assert.Fail(t, "timeout waiting for the websocket message")
+	}
+}
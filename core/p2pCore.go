@@ -33,9 +33,18 @@ type P2PPeerInfo struct {
 
 // QueryP2PPeerInfo represents a DTO used in exporting p2p peer info after a query
 type QueryP2PPeerInfo struct {
-	IsBlacklisted bool     `json:"isblacklisted"`
-	Pid           string   `json:"pid"`
-	Pk            string   `json:"pk"`
-	PeerType      string   `json:"peertype"`
-	Addresses     []string `json:"addresses"`
+	IsBlacklisted bool              `json:"isblacklisted"`
+	Pid           string            `json:"pid"`
+	Pk            string            `json:"pk"`
+	PeerType      string            `json:"peertype"`
+	Addresses     []string          `json:"addresses"`
+	PidReputation P2PPeerReputation `json:"pidreputation"`
+	PkReputation  P2PPeerReputation `json:"pkreputation"`
+}
+
+// P2PPeerReputation represents the persisted reputation of a peer ID or of a validator public key
+type P2PPeerReputation struct {
+	Score       float64 `json:"score"`
+	NumOffences uint32  `json:"numoffences"`
+	BannedUntil int64   `json:"banneduntil"`
 }
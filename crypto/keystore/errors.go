@@ -0,0 +1,30 @@
+package keystore
+
+import "errors"
+
+// ErrEmptyPassphrase signals that an empty passphrase was provided
+var ErrEmptyPassphrase = errors.New("empty passphrase")
+
+// ErrNilPemBlock signals that a nil pem block was provided
+var ErrNilPemBlock = errors.New("nil pem block")
+
+// ErrNotEncryptedPemBlock signals that a pem block which is not encrypted was provided
+var ErrNotEncryptedPemBlock = errors.New("pem block is not encrypted")
+
+// ErrUnknownKdf signals that an unknown key derivation function was provided
+var ErrUnknownKdf = errors.New("unknown key derivation function")
+
+// ErrUnknownCipher signals that an unknown cipher was provided
+var ErrUnknownCipher = errors.New("unknown cipher")
+
+// ErrInvalidKdfParams signals that invalid key derivation function parameters were provided
+var ErrInvalidKdfParams = errors.New("invalid key derivation function parameters")
+
+// ErrInvalidHeader signals that an invalid encrypted pem block header was found
+var ErrInvalidHeader = errors.New("invalid encrypted pem block header")
+
+// ErrDecryptionFailed signals that the decryption failed, usually because of a wrong passphrase
+var ErrDecryptionFailed = errors.New("decryption failed, wrong passphrase or corrupted key")
+
+// ErrMissingPassphrase signals that no passphrase source was configured
+var ErrMissingPassphrase = errors.New("missing passphrase: neither a passphrase file nor an environment variable was provided")
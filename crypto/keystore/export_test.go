@@ -0,0 +1,14 @@
+package keystore
+
+func SetLightKdfParams() {
+	defaultScryptParams = scryptParams{
+		n: 1 << 10,
+		r: 8,
+		p: 1,
+	}
+	defaultArgon2Params = argon2Params{
+		time:    1,
+		memory:  1024,
+		threads: 1,
+	}
+}
@@ -0,0 +1,284 @@
+package keystore
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+)
+
+// EncryptedBlockTypePrefix is the prefix of the pem block type that holds an encrypted private key
+const EncryptedBlockTypePrefix = "ENCRYPTED "
+
+// KdfScrypt is the name of the scrypt key derivation function
+const KdfScrypt = "scrypt"
+
+// KdfArgon2id is the name of the argon2id key derivation function
+const KdfArgon2id = "argon2id"
+
+const cipherXChaCha20Poly1305 = "xchacha20-poly1305"
+
+const headerKdf = "Kdf"
+const headerKdfParams = "Kdf-Params"
+const headerSalt = "Salt"
+const headerCipher = "Cipher"
+const headerNonce = "Nonce"
+
+const saltLen = 32
+const derivedKeyLen = chacha20poly1305.KeySize
+
+// upper bounds used when decrypting so that a crafted file can not make the node allocate huge amounts of memory
+const maxScryptN = 1 << 20
+const maxScryptR = 32
+const maxScryptP = 16
+const maxArgon2Time = 16
+const maxArgon2Memory = 1024 * 1024
+const maxArgon2Threads = 64
+
+type scryptParams struct {
+	n int
+	r int
+	p int
+}
+
+type argon2Params struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+}
+
+var defaultScryptParams = scryptParams{
+	n: 1 << 17,
+	r: 8,
+	p: 1,
+}
+
+var defaultArgon2Params = argon2Params{
+	time:    3,
+	memory:  64 * 1024,
+	threads: 4,
+}
+
+// EncryptPemBlock encrypts the provided pem block with a key derived from the passphrase using the provided
+// key derivation function. The block type is prefixed with EncryptedBlockTypePrefix and it is used as additional
+// authenticated data so the public key identifier of the block can not be tampered with
+func EncryptPemBlock(block *pem.Block, passphrase []byte, kdf string) (*pem.Block, error) {
+	if block == nil {
+		return nil, ErrNilPemBlock
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	salt := make([]byte, saltLen)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	var kdfParams string
+	switch kdf {
+	case KdfScrypt:
+		kdfParams = defaultScryptParams.String()
+	case KdfArgon2id:
+		kdfParams = defaultArgon2Params.String()
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKdf, kdf)
+	}
+
+	derivedKey, err := deriveKey(passphrase, salt, kdf, kdfParams)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := chacha20poly1305.NewX(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedBlockType := EncryptedBlockTypePrefix + block.Type
+
+	return &pem.Block{
+		Type: encryptedBlockType,
+		Headers: map[string]string{
+			headerKdf:       kdf,
+			headerKdfParams: kdfParams,
+			headerSalt:      hex.EncodeToString(salt),
+			headerCipher:    cipherXChaCha20Poly1305,
+			headerNonce:     hex.EncodeToString(nonce),
+		},
+		Bytes: aead.Seal(nil, nonce, block.Bytes, []byte(encryptedBlockType)),
+	}, nil
+}
+
+// DecryptPemBlock decrypts the provided encrypted pem block returning the original, plain, pem block
+func DecryptPemBlock(block *pem.Block, passphrase []byte) (*pem.Block, error) {
+	if block == nil {
+		return nil, ErrNilPemBlock
+	}
+	if !IsEncryptedPemBlock(block) {
+		return nil, ErrNotEncryptedPemBlock
+	}
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	cipherName := block.Headers[headerCipher]
+	if cipherName != cipherXChaCha20Poly1305 {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCipher, cipherName)
+	}
+
+	salt, err := decodeHexHeader(block, headerSalt)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := decodeHexHeader(block, headerNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := deriveKey(passphrase, salt, block.Headers[headerKdf], block.Headers[headerKdfParams])
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := chacha20poly1305.NewX(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("%w: invalid %s length", ErrInvalidHeader, headerNonce)
+	}
+
+	plainBytes, err := aead.Open(nil, nonce, block.Bytes, []byte(block.Type))
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+
+	return &pem.Block{
+		Type:  strings.TrimPrefix(block.Type, EncryptedBlockTypePrefix),
+		Bytes: plainBytes,
+	}, nil
+}
+
+// IsEncryptedPemBlock returns true if the provided pem block holds an encrypted private key
+func IsEncryptedPemBlock(block *pem.Block) bool {
+	if block == nil {
+		return false
+	}
+
+	return strings.HasPrefix(block.Type, EncryptedBlockTypePrefix)
+}
+
+func decodeHexHeader(block *pem.Block, header string) ([]byte, error) {
+	value, found := block.Headers[header]
+	if !found {
+		return nil, fmt.Errorf("%w: missing %s", ErrInvalidHeader, header)
+	}
+
+	buff, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s for %s", ErrInvalidHeader, err.Error(), header)
+	}
+
+	return buff, nil
+}
+
+func deriveKey(passphrase []byte, salt []byte, kdf string, kdfParams string) ([]byte, error) {
+	params, err := parseKdfParams(kdfParams)
+	if err != nil {
+		return nil, err
+	}
+
+	switch kdf {
+	case KdfScrypt:
+		sp, errParams := newScryptParams(params)
+		if errParams != nil {
+			return nil, errParams
+		}
+
+		return scrypt.Key(passphrase, salt, sp.n, sp.r, sp.p, derivedKeyLen)
+	case KdfArgon2id:
+		ap, errParams := newArgon2Params(params)
+		if errParams != nil {
+			return nil, errParams
+		}
+
+		return argon2.IDKey(passphrase, salt, ap.time, ap.memory, ap.threads, derivedKeyLen), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKdf, kdf)
+	}
+}
+
+func parseKdfParams(kdfParams string) (map[string]uint64, error) {
+	params := make(map[string]uint64)
+	for _, pair := range strings.Split(kdfParams, ",") {
+		keyValue := strings.Split(pair, "=")
+		if len(keyValue) != 2 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidKdfParams, kdfParams)
+		}
+
+		value, err := strconv.ParseUint(keyValue[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidKdfParams, err.Error())
+		}
+
+		params[keyValue[0]] = value
+	}
+
+	return params, nil
+}
+
+func newScryptParams(params map[string]uint64) (*scryptParams, error) {
+	n, r, p := params["n"], params["r"], params["p"]
+	isValid := n > 1 && n <= maxScryptN && n&(n-1) == 0 &&
+		r > 0 && r <= maxScryptR &&
+		p > 0 && p <= maxScryptP
+	if !isValid {
+		return nil, fmt.Errorf("%w for %s", ErrInvalidKdfParams, KdfScrypt)
+	}
+
+	return &scryptParams{
+		n: int(n),
+		r: int(r),
+		p: int(p),
+	}, nil
+}
+
+// String returns the scrypt parameters in the format stored in the pem header
+func (sp scryptParams) String() string {
+	return fmt.Sprintf("n=%d,r=%d,p=%d", sp.n, sp.r, sp.p)
+}
+
+func newArgon2Params(params map[string]uint64) (*argon2Params, error) {
+	t, m, p := params["t"], params["m"], params["p"]
+	isValid := t > 0 && t <= maxArgon2Time &&
+		m > 0 && m <= maxArgon2Memory &&
+		p > 0 && p <= maxArgon2Threads
+	if !isValid {
+		return nil, fmt.Errorf("%w for %s", ErrInvalidKdfParams, KdfArgon2id)
+	}
+
+	return &argon2Params{
+		time:    uint32(t),
+		memory:  uint32(m),
+		threads: uint8(p),
+	}, nil
+}
+
+// String returns the argon2 parameters in the format stored in the pem header
+func (ap argon2Params) String() string {
+	return fmt.Sprintf("t=%d,m=%d,p=%d", ap.time, ap.memory, ap.threads)
+}
@@ -0,0 +1,109 @@
+package keystore_test
+
+import (
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	keystore.SetLightKdfParams()
+}
+
+func createPlainBlock() *pem.Block {
+	return &pem.Block{
+		Type:  "PRIVATE KEY for pk",
+		Bytes: []byte("secret key bytes"),
+	}
+}
+
+func TestEncryptPemBlock_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	block, err := keystore.EncryptPemBlock(nil, []byte("pass"), keystore.KdfScrypt)
+	assert.Nil(t, block)
+	assert.Equal(t, keystore.ErrNilPemBlock, err)
+
+	block, err = keystore.EncryptPemBlock(createPlainBlock(), nil, keystore.KdfScrypt)
+	assert.Nil(t, block)
+	assert.Equal(t, keystore.ErrEmptyPassphrase, err)
+
+	block, err = keystore.EncryptPemBlock(createPlainBlock(), []byte("pass"), "pbkdf2")
+	assert.Nil(t, block)
+	assert.True(t, errors.Is(err, keystore.ErrUnknownKdf))
+}
+
+func TestEncryptDecryptPemBlock_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	for _, kdf := range []string{keystore.KdfScrypt, keystore.KdfArgon2id} {
+		plainBlock := createPlainBlock()
+		passphrase := []byte("passphrase")
+
+		encryptedBlock, err := keystore.EncryptPemBlock(plainBlock, passphrase, kdf)
+		require.Nil(t, err)
+		assert.True(t, keystore.IsEncryptedPemBlock(encryptedBlock))
+		assert.Equal(t, keystore.EncryptedBlockTypePrefix+plainBlock.Type, encryptedBlock.Type)
+		assert.NotEqual(t, plainBlock.Bytes, encryptedBlock.Bytes)
+
+		//the block should survive the pem encoding
+		recoveredBlock, _ := pem.Decode(pem.EncodeToMemory(encryptedBlock))
+		decryptedBlock, err := keystore.DecryptPemBlock(recoveredBlock, passphrase)
+		require.Nil(t, err)
+		assert.Equal(t, plainBlock.Type, decryptedBlock.Type)
+		assert.Equal(t, plainBlock.Bytes, decryptedBlock.Bytes)
+	}
+}
+
+func TestDecryptPemBlock_WrongPassphraseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	encryptedBlock, _ := keystore.EncryptPemBlock(createPlainBlock(), []byte("passphrase"), keystore.KdfScrypt)
+
+	decryptedBlock, err := keystore.DecryptPemBlock(encryptedBlock, []byte("wrong passphrase"))
+	assert.Nil(t, decryptedBlock)
+	assert.Equal(t, keystore.ErrDecryptionFailed, err)
+}
+
+func TestDecryptPemBlock_TamperedBlockTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	passphrase := []byte("passphrase")
+	encryptedBlock, _ := keystore.EncryptPemBlock(createPlainBlock(), passphrase, keystore.KdfScrypt)
+	encryptedBlock.Type = keystore.EncryptedBlockTypePrefix + "PRIVATE KEY for another pk"
+
+	decryptedBlock, err := keystore.DecryptPemBlock(encryptedBlock, passphrase)
+	assert.Nil(t, decryptedBlock)
+	assert.Equal(t, keystore.ErrDecryptionFailed, err)
+}
+
+func TestDecryptPemBlock_InvalidBlocksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	passphrase := []byte("passphrase")
+	decryptedBlock, err := keystore.DecryptPemBlock(createPlainBlock(), passphrase)
+	assert.Nil(t, decryptedBlock)
+	assert.Equal(t, keystore.ErrNotEncryptedPemBlock, err)
+
+	encryptedBlock, _ := keystore.EncryptPemBlock(createPlainBlock(), passphrase, keystore.KdfScrypt)
+	encryptedBlock.Headers["Kdf-Params"] = "n=4294967295,r=8,p=1"
+	decryptedBlock, err = keystore.DecryptPemBlock(encryptedBlock, passphrase)
+	assert.Nil(t, decryptedBlock)
+	assert.True(t, errors.Is(err, keystore.ErrInvalidKdfParams))
+
+	encryptedBlock, _ = keystore.EncryptPemBlock(createPlainBlock(), passphrase, keystore.KdfArgon2id)
+	encryptedBlock.Headers["Cipher"] = "aes-128-ctr"
+	decryptedBlock, err = keystore.DecryptPemBlock(encryptedBlock, passphrase)
+	assert.Nil(t, decryptedBlock)
+	assert.True(t, errors.Is(err, keystore.ErrUnknownCipher))
+
+	encryptedBlock, _ = keystore.EncryptPemBlock(createPlainBlock(), passphrase, keystore.KdfArgon2id)
+	delete(encryptedBlock.Headers, "Salt")
+	decryptedBlock, err = keystore.DecryptPemBlock(encryptedBlock, passphrase)
+	assert.Nil(t, decryptedBlock)
+	assert.True(t, errors.Is(err, keystore.ErrInvalidHeader))
+}
@@ -0,0 +1,37 @@
+package keystore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// LoadPassphrase returns the passphrase read from the provided file or, if the file name is empty, from the
+// provided environment variable. The trailing new line characters are removed from the passphrase file content
+func LoadPassphrase(passphraseFile string, envVariable string) ([]byte, error) {
+	if len(passphraseFile) > 0 {
+		buff, err := ioutil.ReadFile(passphraseFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w while reading the passphrase file %s", err, passphraseFile)
+		}
+
+		passphrase := strings.TrimRight(string(buff), "\r\n")
+		if len(passphrase) == 0 {
+			return nil, fmt.Errorf("%w in file %s", ErrEmptyPassphrase, passphraseFile)
+		}
+
+		return []byte(passphrase), nil
+	}
+
+	if len(envVariable) == 0 {
+		return nil, ErrMissingPassphrase
+	}
+
+	passphrase := os.Getenv(envVariable)
+	if len(passphrase) == 0 {
+		return nil, fmt.Errorf("%w in environment variable %s", ErrEmptyPassphrase, envVariable)
+	}
+
+	return []byte(passphrase), nil
+}
@@ -0,0 +1,64 @@
+package keystore_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPassphrase_NoSourceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	passphrase, err := keystore.LoadPassphrase("", "")
+
+	assert.Nil(t, passphrase)
+	assert.Equal(t, keystore.ErrMissingPassphrase, err)
+}
+
+func TestLoadPassphrase_FromFileShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "passphrase")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	filename := filepath.Join(dir, "passphrase.txt")
+	err = ioutil.WriteFile(filename, []byte("secret passphrase\n"), 0600)
+	require.Nil(t, err)
+
+	passphrase, err := keystore.LoadPassphrase(filename, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret passphrase"), passphrase)
+
+	err = ioutil.WriteFile(filename, []byte("\n"), 0600)
+	require.Nil(t, err)
+
+	passphrase, err = keystore.LoadPassphrase(filename, "")
+	assert.Nil(t, passphrase)
+	assert.True(t, errors.Is(err, keystore.ErrEmptyPassphrase))
+}
+
+func TestLoadPassphrase_FromEnvironmentShouldWork(t *testing.T) {
+	envVariable := "ELROND_KEYSTORE_TEST_PASSPHRASE"
+
+	passphrase, err := keystore.LoadPassphrase("", envVariable)
+	assert.Nil(t, passphrase)
+	assert.True(t, errors.Is(err, keystore.ErrEmptyPassphrase))
+
+	_ = os.Setenv(envVariable, "secret passphrase")
+	defer func() {
+		_ = os.Unsetenv(envVariable)
+	}()
+
+	passphrase, err = keystore.LoadPassphrase("", envVariable)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret passphrase"), passphrase)
+}
@@ -0,0 +1,14 @@
+package api
+
+// AccountStorage represents a page of the key-value pairs stored in the data trie of an account, sorted by key
+type AccountStorage struct {
+	Address string             `json:"address"`
+	Total   uint64             `json:"total"`
+	Pairs   []*StorageKeyValue `json:"pairs"`
+}
+
+// StorageKeyValue represents a hex encoded key-value pair from the data trie of an account
+type StorageKeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
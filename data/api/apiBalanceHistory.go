@@ -0,0 +1,18 @@
+package api
+
+// BalanceHistory represents a page of the balance changes of an account, as it is returned by the api routes
+type BalanceHistory struct {
+	Address string           `json:"address"`
+	Total   uint64           `json:"total"`
+	Changes []*BalanceChange `json:"changes"`
+}
+
+// BalanceChange represents the balance and the nonce of an account after the block containing one of its
+// transactions was committed
+type BalanceChange struct {
+	Balance    string `json:"balance"`
+	Nonce      uint64 `json:"nonce"`
+	BlockNonce uint64 `json:"blockNonce"`
+	BlockHash  string `json:"blockHash"`
+	TxHash     string `json:"txHash"`
+}
@@ -0,0 +1,38 @@
+package api
+
+// DelegationContract is the structure that holds the decoded configuration and state of a delegation contract
+type DelegationContract struct {
+	Address              string   `json:"address"`
+	Owner                string   `json:"owner"`
+	ServiceFee           uint64   `json:"serviceFee"`
+	MaxDelegationCap     string   `json:"maxDelegationCap"`
+	InitialOwnerFunds    string   `json:"initialOwnerFunds"`
+	AutomaticActivation  bool     `json:"automaticActivation"`
+	WithDelegationCap    bool     `json:"withDelegationCap"`
+	ChangeableServiceFee bool     `json:"changeableServiceFee"`
+	CreatedNonce         uint64   `json:"createdNonce"`
+	UnBondPeriod         uint64   `json:"unBondPeriod"`
+	TotalActiveStake     string   `json:"totalActiveStake"`
+	TotalUnStaked        string   `json:"totalUnStaked"`
+	NumDelegators        uint64   `json:"numDelegators"`
+	StakedNodes          []string `json:"stakedNodes"`
+	NotStakedNodes       []string `json:"notStakedNodes"`
+	UnStakedNodes        []string `json:"unStakedNodes"`
+}
+
+// DelegatorFunds is the structure that holds the funds of a delegator in a delegation contract
+type DelegatorFunds struct {
+	Contract         string             `json:"contract"`
+	Address          string             `json:"address"`
+	ActiveStake      string             `json:"activeStake"`
+	ClaimableRewards string             `json:"claimableRewards"`
+	TotalUnStaked    string             `json:"totalUnStaked"`
+	TotalUnBondable  string             `json:"totalUnBondable"`
+	UnDelegated      []*UnDelegatedFund `json:"unDelegated"`
+}
+
+// UnDelegatedFund is the structure that holds an undelegated fund and the number of nonces until it can be withdrawn
+type UnDelegatedFund struct {
+	Value           string `json:"value"`
+	RemainingNonces uint64 `json:"remainingNonces"`
+}
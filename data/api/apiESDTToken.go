@@ -0,0 +1,21 @@
+package api
+
+// ESDTToken is the structure that holds the properties of an issued ESDT token, as saved by the ESDT system SC
+type ESDTToken struct {
+	Identifier        string `json:"identifier"`
+	Name              string `json:"name"`
+	Ticker            string `json:"ticker"`
+	Owner             string `json:"owner"`
+	NumDecimals       uint32 `json:"decimals"`
+	MintedValue       string `json:"minted"`
+	BurntValue        string `json:"burnt"`
+	CirculatingSupply string `json:"circulatingSupply"`
+	IsPaused          bool   `json:"isPaused"`
+	CanUpgrade        bool   `json:"canUpgrade"`
+	CanMint           bool   `json:"canMint"`
+	CanBurn           bool   `json:"canBurn"`
+	CanChangeOwner    bool   `json:"canChangeOwner"`
+	CanPause          bool   `json:"canPause"`
+	CanFreeze         bool   `json:"canFreeze"`
+	CanWipe           bool   `json:"canWipe"`
+}
@@ -0,0 +1,33 @@
+package api
+
+// GovernanceProposal is the structure that holds a decoded governance proposal and its voting tallies
+type GovernanceProposal struct {
+	ID             string `json:"id"`
+	Issuer         string `json:"issuer"`
+	GitHubCommit   string `json:"gitHubCommit"`
+	StartVoteNonce uint64 `json:"startVoteNonce"`
+	EndVoteNonce   uint64 `json:"endVoteNonce"`
+	Status         string `json:"status"`
+	Yes            int32  `json:"yes"`
+	No             int32  `json:"no"`
+	Veto           int32  `json:"veto"`
+	DontCare       int32  `json:"dontCare"`
+	NumVoters      int    `json:"numVoters"`
+	Closed         bool   `json:"closed"`
+	Passed         bool   `json:"passed"`
+}
+
+// GovernanceVote is the structure that holds the vote of an address on a governance proposal
+type GovernanceVote struct {
+	Voter    string `json:"voter"`
+	Vote     string `json:"vote"`
+	NumVotes int32  `json:"numVotes"`
+}
+
+// GovernanceVoteTransaction holds the fields of a transaction that casts a vote on a governance proposal. The
+// transaction still has to be completed with the sender fields, the gas and the signature
+type GovernanceVoteTransaction struct {
+	Receiver string `json:"receiver"`
+	Value    string `json:"value"`
+	Data     string `json:"data"`
+}
@@ -0,0 +1,9 @@
+package api
+
+// UsernameResolution is the structure that holds the owner of a username, as registered in the DNS smart contracts
+type UsernameResolution struct {
+	Username    string `json:"username"`
+	Address     string `json:"address"`
+	DNSContract string `json:"dnsContract"`
+	DNSShard    uint32 `json:"dnsShard"`
+}
@@ -0,0 +1,41 @@
+package api
+
+// ValidatorQueueEntry is the structure that holds a BLS key waiting in the staking queue
+type ValidatorQueueEntry struct {
+	Position      uint32 `json:"position"`
+	BLSKey        string `json:"blsKey"`
+	Owner         string `json:"owner"`
+	RewardAddress string `json:"rewardAddress"`
+	RegisterNonce uint64 `json:"registerNonce"`
+}
+
+// ValidatorKeyStatus is the structure that holds the staking status of a BLS key
+type ValidatorKeyStatus struct {
+	BLSKey                string `json:"blsKey"`
+	Status                string `json:"status"`
+	Owner                 string `json:"owner"`
+	RewardAddress         string `json:"rewardAddress"`
+	StakeValue            string `json:"stakeValue"`
+	RegisterNonce         uint64 `json:"registerNonce"`
+	StakedNonce           uint64 `json:"stakedNonce"`
+	UnStakedNonce         uint64 `json:"unStakedNonce"`
+	UnStakedEpoch         uint32 `json:"unStakedEpoch"`
+	RemainingUnBondPeriod uint64 `json:"remainingUnBondPeriod"`
+	QueuePosition         uint32 `json:"queuePosition"`
+	QueueSize             uint32 `json:"queueSize"`
+	JailedNonce           uint64 `json:"jailedNonce"`
+	NumJailed             uint32 `json:"numJailed"`
+}
+
+// ValidatorOwner is the structure that holds the stake, the top-up and the BLS keys of a validator owner
+type ValidatorOwner struct {
+	Address        string                `json:"address"`
+	RewardAddress  string                `json:"rewardAddress"`
+	TotalStaked    string                `json:"totalStaked"`
+	TotalUnStaked  string                `json:"totalUnStaked"`
+	TopUp          string                `json:"topUp"`
+	TopUpPerNode   string                `json:"topUpPerNode"`
+	NumRegistered  uint32                `json:"numRegistered"`
+	NumActiveNodes uint64                `json:"numActiveNodes"`
+	Keys           []*ValidatorKeyStatus `json:"keys"`
+}
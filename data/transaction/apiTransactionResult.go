@@ -43,6 +43,13 @@ type ApiTransactionResult struct {
 	Receipt                           *ReceiptApi               `json:"receipt,omitempty"`
 	SmartContractResults              []*ApiSmartContractResult `json:"smartContractResults,omitempty"`
 	Status                            TxStatus                  `json:"status,omitempty"`
+	DecodedData                       *DecodedCallDataApi       `json:"decodedData,omitempty"`
+}
+
+// DecodedCallDataApi holds the called function and its arguments, decoded using the ABI of the called contract
+type DecodedCallDataApi struct {
+	Function  string        `json:"function"`
+	Arguments []interface{} `json:"arguments"`
 }
 
 // SimulationResults is the data transfer object which will hold results for simulation a transaction's execution
@@ -52,6 +59,70 @@ type SimulationResults struct {
 	ScResults  map[string]*ApiSmartContractResult `json:"scResults,omitempty"`
 	Receipts   map[string]*ReceiptApi             `json:"receipts,omitempty"`
 	Hash       string                             `json:"hash,omitempty"`
+	Accounts   []*SimulatedAccountApi             `json:"accounts,omitempty"`
+	Logs       []*LogEventApi                     `json:"logs,omitempty"`
+	GasUsage   *SimulationGasUsageApi             `json:"gasUsage,omitempty"`
+}
+
+// SimulatedAccountApi represents the changes a simulated transaction would apply on an account
+type SimulatedAccountApi struct {
+	Address       string            `json:"address"`
+	Balance       string            `json:"balance"`
+	BalanceDelta  string            `json:"balanceDelta"`
+	Nonce         uint64            `json:"nonce"`
+	NonceDelta    uint64            `json:"nonceDelta"`
+	Removed       bool              `json:"removed,omitempty"`
+	StorageWrites map[string]string `json:"storageWrites,omitempty"`
+}
+
+// LogEventApi represents a smart contract log event with changed fields' types in order to make it friendly for API's json
+type LogEventApi struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics,omitempty"`
+	Data       []byte   `json:"data,omitempty"`
+}
+
+// SimulationGasUsageApi holds the gas a simulated transaction would consume, split in categories
+type SimulationGasUsageApi struct {
+	MoveBalance uint64 `json:"moveBalance"`
+	Processing  uint64 `json:"processing"`
+	Refunded    uint64 `json:"refunded"`
+	Total       uint64 `json:"total"`
+}
+
+// TraceResults is the data transfer object which will hold the call tree of a re-executed historical transaction
+type TraceResults struct {
+	Hash           string              `json:"hash"`
+	BlockHash      string              `json:"blockHash"`
+	BlockNonce     uint64              `json:"blockNonce"`
+	ParentRootHash string              `json:"parentRootHash"`
+	Status         TxStatus            `json:"status,omitempty"`
+	FailReason     string              `json:"failReason,omitempty"`
+	Steps          []*ExecutionStepApi `json:"steps"`
+}
+
+// ExecutionStepApi represents one step of a traced smart contract execution: a contract call, a contract deployment
+// or a built-in function call, together with the steps it triggered. The nested contract calls have no gas used
+type ExecutionStepApi struct {
+	Type          string              `json:"type"`
+	Caller        string              `json:"caller,omitempty"`
+	Contract      string              `json:"contract,omitempty"`
+	Function      string              `json:"function,omitempty"`
+	CallValue     string              `json:"callValue,omitempty"`
+	GasProvided   uint64              `json:"gasProvided,omitempty"`
+	GasUsed       uint64              `json:"gasUsed,omitempty"`
+	ReturnCode    string              `json:"returnCode,omitempty"`
+	ReturnMessage string              `json:"returnMessage,omitempty"`
+	StorageReads  []*StorageAccessApi `json:"storageReads,omitempty"`
+	StorageWrites []*StorageAccessApi `json:"storageWrites,omitempty"`
+	Steps         []*ExecutionStepApi `json:"steps,omitempty"`
+}
+
+// StorageAccessApi represents a hex encoded storage key together with the value read or written
+type StorageAccessApi struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
 }
 
 // ApiSmartContractResult represents a smart contract result with changed fields' types in order to make it friendly for API's json
@@ -21,6 +21,14 @@ type VMOutputApi struct {
 	Logs            []*LogEntryApi               `json:"logs"`
 }
 
+// TypedVMOutputApi holds the result of a smart contract query, with the return data decoded using the contract ABI
+type TypedVMOutputApi struct {
+	ReturnData    []interface{} `json:"returnData"`
+	ReturnCode    string        `json:"returnCode"`
+	ReturnMessage string        `json:"returnMessage"`
+	GasRemaining  uint64        `json:"gasRemaining"`
+}
+
 // StorageUpdateApi is a wrapper over vmcommon's StorageUpdate
 type StorageUpdateApi struct {
 	Offset []byte `json:"offset"`
@@ -23,5 +23,12 @@ var ErrNilPeerState = errors.New("nil peer state")
 // ErrNilAccountState signals that a nil account state has been provided
 var ErrNilAccountState = errors.New("nil account state")
 
+// ErrNilAbiRegistry signals that a nil contracts ABI registry has been provided
+var ErrNilAbiRegistry = errors.New("nil ABI registry")
+
 // ErrNilTransactionSimulatorProcessor signals that a nil transaction simulator processor has been provided
 var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator processor")
+
+// ErrReceiverUsernameNotNormalized signals that the receiver username of a transaction is not the full username
+// registered in the DNS smart contracts
+var ErrReceiverUsernameNotNormalized = errors.New("the receiver username should be the full registered username")
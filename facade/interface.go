@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -34,6 +35,12 @@ type NodeHandler interface {
 	// GetAllESDTTokens returns the value of a key from a given account
 	GetAllESDTTokens(address string) ([]string, error)
 
+	// GetBalanceHistory returns a page of the balance changes of a given account
+	GetBalanceHistory(address string, from uint64, size uint64) (*api.BalanceHistory, error)
+
+	// GetKeyValuePairs returns a page of the key-value pairs stored by a given account
+	GetKeyValuePairs(address string, prefix string, from uint64, size uint64) (*api.AccountStorage, error)
+
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
@@ -48,6 +55,9 @@ type NodeHandler interface {
 	//GetTransaction will return a transaction based on the hash
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 
+	// TraceTransaction re-executes a historical transaction and returns the call tree of its smart contract executions
+	TraceTransaction(hash string) (*transaction.TraceResults, error)
+
 	// GetAccount returns an accountResponse containing information
 	//  about the account correlated with provided address
 	GetAccount(address string) (state.UserAccountHandler, error)
@@ -79,15 +89,38 @@ type NodeHandler interface {
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessBatch(txs []*transaction.Transaction) ([]*transaction.SimulationResults, error)
+	IsInterfaceNil() bool
+}
+
+// AbiRegistry defines the contracts ABI registry used to encode the arguments and to decode the results of queries
+type AbiRegistry interface {
+	SetContractAbi(address []byte, abiBytes []byte) error
+	EncodeArguments(address []byte, function string, arguments []json.RawMessage) ([][]byte, error)
+	DecodeReturnData(address []byte, function string, returnData [][]byte) ([]interface{}, error)
 	IsInterfaceNil() bool
 }
 
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueries(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue() (*big.Int, error)
+	GetESDTTokenIdentifiers() ([]string, error)
+	GetESDTToken(identifier string) (*api.ESDTToken, error)
+	GetGovernanceProposals() ([]*api.GovernanceProposal, error)
+	GetGovernanceProposal(id string) (*api.GovernanceProposal, error)
+	GetGovernanceProposalVotes(id string) ([]*api.GovernanceVote, error)
+	GetGovernanceVoteTransaction(id string, vote string, validator string) (*api.GovernanceVoteTransaction, error)
+	GetDelegationContracts() ([]string, error)
+	GetDelegationContract(contract string) (*api.DelegationContract, error)
+	GetDelegator(contract string, address string) (*api.DelegatorFunds, error)
+	GetValidatorQueue() ([]*api.ValidatorQueueEntry, error)
+	GetValidatorKeyStatus(blsKey string) (*api.ValidatorKeyStatus, error)
+	GetValidatorOwner(address string) (*api.ValidatorOwner, error)
+	ResolveUsername(username string) (*api.UsernameResolution, error)
 	IsInterfaceNil() bool
 }
 
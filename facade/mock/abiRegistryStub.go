@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"encoding/json"
+)
+
+// AbiRegistryStub -
+type AbiRegistryStub struct {
+	SetContractAbiCalled   func(address []byte, abiBytes []byte) error
+	EncodeArgumentsCalled  func(address []byte, function string, arguments []json.RawMessage) ([][]byte, error)
+	DecodeReturnDataCalled func(address []byte, function string, returnData [][]byte) ([]interface{}, error)
+}
+
+// SetContractAbi -
+func (ars *AbiRegistryStub) SetContractAbi(address []byte, abiBytes []byte) error {
+	if ars.SetContractAbiCalled != nil {
+		return ars.SetContractAbiCalled(address, abiBytes)
+	}
+
+	return nil
+}
+
+// EncodeArguments -
+func (ars *AbiRegistryStub) EncodeArguments(address []byte, function string, arguments []json.RawMessage) ([][]byte, error) {
+	if ars.EncodeArgumentsCalled != nil {
+		return ars.EncodeArgumentsCalled(address, function, arguments)
+	}
+
+	return make([][]byte, 0), nil
+}
+
+// DecodeReturnData -
+func (ars *AbiRegistryStub) DecodeReturnData(address []byte, function string, returnData [][]byte) ([]interface{}, error) {
+	if ars.DecodeReturnDataCalled != nil {
+		return ars.DecodeReturnDataCalled(address, function, returnData)
+	}
+
+	return make([]interface{}, 0), nil
+}
+
+// IsInterfaceNil -
+func (ars *AbiRegistryStub) IsInterfaceNil() bool {
+	return ars == nil
+}
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -12,9 +13,23 @@ import (
 // ApiResolverStub -
 type ApiResolverStub struct {
 	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueriesHandler           func(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error)
 	StatusMetricsHandler              func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	GetTotalStakedValueHandler        func() (*big.Int, error)
+	GetESDTTokenIdentifiersHandler    func() ([]string, error)
+	GetESDTTokenHandler               func(identifier string) (*api.ESDTToken, error)
+	GetGovernanceProposalsHandler     func() ([]*api.GovernanceProposal, error)
+	GetGovernanceProposalHandler      func(id string) (*api.GovernanceProposal, error)
+	GetGovernanceProposalVotesHandler func(id string) ([]*api.GovernanceVote, error)
+	GetGovernanceVoteTxHandler        func(id string, vote string, validator string) (*api.GovernanceVoteTransaction, error)
+	GetDelegationContractsHandler     func() ([]string, error)
+	GetDelegationContractHandler      func(contract string) (*api.DelegationContract, error)
+	GetDelegatorHandler               func(contract string, address string) (*api.DelegatorFunds, error)
+	GetValidatorQueueHandler          func() ([]*api.ValidatorQueueEntry, error)
+	GetValidatorKeyStatusHandler      func(blsKey string) (*api.ValidatorKeyStatus, error)
+	GetValidatorOwnerHandler          func(address string) (*api.ValidatorOwner, error)
+	ResolveUsernameHandler            func(username string) (*api.UsernameResolution, error)
 }
 
 // ExecuteSCQuery -
@@ -22,6 +37,11 @@ func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return ars.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCQueries -
+func (ars *ApiResolverStub) ExecuteSCQueries(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error) {
+	return ars.ExecuteSCQueriesHandler(queries)
+}
+
 // StatusMetrics -
 func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 	return ars.StatusMetricsHandler()
@@ -37,6 +57,71 @@ func (ars *ApiResolverStub) GetTotalStakedValue() (*big.Int, error) {
 	return ars.GetTotalStakedValueHandler()
 }
 
+// GetESDTTokenIdentifiers -
+func (ars *ApiResolverStub) GetESDTTokenIdentifiers() ([]string, error) {
+	return ars.GetESDTTokenIdentifiersHandler()
+}
+
+// GetESDTToken -
+func (ars *ApiResolverStub) GetESDTToken(identifier string) (*api.ESDTToken, error) {
+	return ars.GetESDTTokenHandler(identifier)
+}
+
+// GetGovernanceProposals -
+func (ars *ApiResolverStub) GetGovernanceProposals() ([]*api.GovernanceProposal, error) {
+	return ars.GetGovernanceProposalsHandler()
+}
+
+// GetGovernanceProposal -
+func (ars *ApiResolverStub) GetGovernanceProposal(id string) (*api.GovernanceProposal, error) {
+	return ars.GetGovernanceProposalHandler(id)
+}
+
+// GetGovernanceProposalVotes -
+func (ars *ApiResolverStub) GetGovernanceProposalVotes(id string) ([]*api.GovernanceVote, error) {
+	return ars.GetGovernanceProposalVotesHandler(id)
+}
+
+// GetGovernanceVoteTransaction -
+func (ars *ApiResolverStub) GetGovernanceVoteTransaction(id string, vote string, validator string) (*api.GovernanceVoteTransaction, error) {
+	return ars.GetGovernanceVoteTxHandler(id, vote, validator)
+}
+
+// GetDelegationContracts -
+func (ars *ApiResolverStub) GetDelegationContracts() ([]string, error) {
+	return ars.GetDelegationContractsHandler()
+}
+
+// GetDelegationContract -
+func (ars *ApiResolverStub) GetDelegationContract(contract string) (*api.DelegationContract, error) {
+	return ars.GetDelegationContractHandler(contract)
+}
+
+// GetDelegator -
+func (ars *ApiResolverStub) GetDelegator(contract string, address string) (*api.DelegatorFunds, error) {
+	return ars.GetDelegatorHandler(contract, address)
+}
+
+// GetValidatorQueue -
+func (ars *ApiResolverStub) GetValidatorQueue() ([]*api.ValidatorQueueEntry, error) {
+	return ars.GetValidatorQueueHandler()
+}
+
+// GetValidatorKeyStatus -
+func (ars *ApiResolverStub) GetValidatorKeyStatus(blsKey string) (*api.ValidatorKeyStatus, error) {
+	return ars.GetValidatorKeyStatusHandler(blsKey)
+}
+
+// GetValidatorOwner -
+func (ars *ApiResolverStub) GetValidatorOwner(address string) (*api.ValidatorOwner, error) {
+	return ars.GetValidatorOwnerHandler(address)
+}
+
+// ResolveUsername -
+func (ars *ApiResolverStub) ResolveUsername(username string) (*api.UsernameResolution, error) {
+	return ars.ResolveUsernameHandler(username)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	return ars == nil
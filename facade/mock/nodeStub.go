@@ -42,6 +42,9 @@ type NodeStub struct {
 	GetUsernameCalled                              func(address string) (string, error)
 	GetESDTBalanceCalled                           func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                         func(address string) ([]string, error)
+	GetBalanceHistoryCalled                        func(address string, from uint64, size uint64) (*api.BalanceHistory, error)
+	GetKeyValuePairsCalled                         func(address string, prefix string, from uint64, size uint64) (*api.AccountStorage, error)
+	TraceTransactionCalled                         func(hash string) (*transaction.TraceResults, error)
 }
 
 // GetUsername -
@@ -189,6 +192,33 @@ func (ns *NodeStub) GetAllESDTTokens(address string) ([]string, error) {
 	return []string{""}, nil
 }
 
+// GetBalanceHistory -
+func (ns *NodeStub) GetBalanceHistory(address string, from uint64, size uint64) (*api.BalanceHistory, error) {
+	if ns.GetBalanceHistoryCalled != nil {
+		return ns.GetBalanceHistoryCalled(address, from, size)
+	}
+
+	return nil, nil
+}
+
+// GetKeyValuePairs -
+func (ns *NodeStub) GetKeyValuePairs(address string, prefix string, from uint64, size uint64) (*api.AccountStorage, error) {
+	if ns.GetKeyValuePairsCalled != nil {
+		return ns.GetKeyValuePairsCalled(address, prefix, from, size)
+	}
+
+	return nil, nil
+}
+
+// TraceTransaction -
+func (ns *NodeStub) TraceTransaction(hash string) (*transaction.TraceResults, error) {
+	if ns.TraceTransactionCalled != nil {
+		return ns.TraceTransactionCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ns *NodeStub) IsInterfaceNil() bool {
 	return ns == nil
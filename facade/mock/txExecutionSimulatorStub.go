@@ -6,7 +6,8 @@ import (
 
 // TxExecutionSimulatorStub -
 type TxExecutionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessTxCalled    func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessBatchCalled func(txs []*transaction.Transaction) ([]*transaction.SimulationResults, error)
 }
 
 // ProcessTx -
@@ -18,6 +19,15 @@ func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*tran
 	return &transaction.SimulationResults{}, nil
 }
 
+// ProcessBatch -
+func (t *TxExecutionSimulatorStub) ProcessBatch(txs []*transaction.Transaction) ([]*transaction.SimulationResults, error) {
+	if t.ProcessBatchCalled != nil {
+		return t.ProcessBatchCalled(txs)
+	}
+
+	return make([]*transaction.SimulationResults, 0), nil
+}
+
 // IsInterfaceNil -
 func (t *TxExecutionSimulatorStub) IsInterfaceNil() bool {
 	return t == nil
@@ -3,6 +3,7 @@ package facade
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"time"
@@ -10,10 +11,14 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api"
 	"github.com/ElrondNetwork/elrond-go/api/address"
+	"github.com/ElrondNetwork/elrond-go/api/delegation"
+	"github.com/ElrondNetwork/elrond-go/api/esdt"
+	"github.com/ElrondNetwork/elrond-go/api/governance"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/node"
 	transactionApi "github.com/ElrondNetwork/elrond-go/api/transaction"
+	"github.com/ElrondNetwork/elrond-go/api/usernames"
 	"github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -41,9 +46,13 @@ const DefaultRestInterface = "localhost:8080"
 const DefaultRestPortOff = "off"
 
 var _ = address.FacadeHandler(&nodeFacade{})
+var _ = esdt.FacadeHandler(&nodeFacade{})
+var _ = delegation.FacadeHandler(&nodeFacade{})
+var _ = governance.FacadeHandler(&nodeFacade{})
 var _ = hardfork.FacadeHandler(&nodeFacade{})
 var _ = node.FacadeHandler(&nodeFacade{})
 var _ = transactionApi.FacadeHandler(&nodeFacade{})
+var _ = usernames.FacadeHandler(&nodeFacade{})
 var _ = validator.FacadeHandler(&nodeFacade{})
 var _ = vmValues.FacadeHandler(&nodeFacade{})
 
@@ -59,6 +68,7 @@ type ArgNodeFacade struct {
 	Node                   NodeHandler
 	ApiResolver            ApiResolver
 	TxSimulatorProcessor   TransactionSimulatorProcessor
+	AbiRegistry            AbiRegistry
 	RestAPIServerDebugMode bool
 	WsAntifloodConfig      config.WebServerAntifloodConfig
 	FacadeConfig           config.FacadeConfig
@@ -74,6 +84,7 @@ type nodeFacade struct {
 	syncer                 ntp.SyncTimer
 	tpsBenchmark           *statistics.TpsBenchmark
 	txSimulatorProc        TransactionSimulatorProcessor
+	abiRegistry            AbiRegistry
 	config                 config.FacadeConfig
 	apiRoutesConfig        config.ApiRoutesConfig
 	endpointsThrottlers    map[string]core.Throttler
@@ -96,6 +107,9 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.TxSimulatorProcessor) {
 		return nil, ErrNilTransactionSimulatorProcessor
 	}
+	if check.IfNil(arg.AbiRegistry) {
+		return nil, ErrNilAbiRegistry
+	}
 	if len(arg.ApiRoutesConfig.APIPackages) == 0 {
 		return nil, ErrNoApiRoutesConfig
 	}
@@ -122,6 +136,7 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		apiResolver:            arg.ApiResolver,
 		restAPIServerDebugMode: arg.RestAPIServerDebugMode,
 		txSimulatorProc:        arg.TxSimulatorProcessor,
+		abiRegistry:            arg.AbiRegistry,
 		wsAntifloodConfig:      arg.WsAntifloodConfig,
 		config:                 arg.FacadeConfig,
 		apiRoutesConfig:        arg.ApiRoutesConfig,
@@ -280,7 +295,20 @@ func (nf *nodeFacade) GetAllESDTTokens(address string) ([]string, error) {
 	return nf.node.GetAllESDTTokens(address)
 }
 
-// CreateTransaction creates a transaction from all needed fields
+// GetBalanceHistory returns a page of the balance changes of a given address
+func (nf *nodeFacade) GetBalanceHistory(address string, from uint64, size uint64) (*apiData.BalanceHistory, error) {
+	return nf.node.GetBalanceHistory(address, from, size)
+}
+
+// GetKeyValuePairs returns a page of the key-value pairs stored by the given address under keys with the given prefix
+func (nf *nodeFacade) GetKeyValuePairs(address string, prefix string, from uint64, size uint64) (*apiData.AccountStorage, error) {
+	return nf.node.GetKeyValuePairs(address, prefix, from, size)
+}
+
+// CreateTransaction creates a transaction from all needed fields. If only the receiver username is provided, the
+// receiver address is resolved through the DNS smart contracts, so the signature must be computed over the address
+// owning the username. As the signature also covers the username, which is compared byte by byte with the one of the
+// receiver account, the full registered username (including the .elrond suffix) is required
 func (nf *nodeFacade) CreateTransaction(
 	nonce uint64,
 	value string,
@@ -296,6 +324,16 @@ func (nf *nodeFacade) CreateTransaction(
 	version uint32,
 	options uint32,
 ) (*transaction.Transaction, []byte, error) {
+	if len(receiver) == 0 && len(receiverUsername) > 0 {
+		resolution, err := nf.apiResolver.ResolveUsername(string(receiverUsername))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w while resolving the receiver username", err)
+		}
+		if resolution.Username != string(receiverUsername) {
+			return nil, nil, fmt.Errorf("%w: %s instead of %s", ErrReceiverUsernameNotNormalized, receiverUsername, resolution.Username)
+		}
+		receiver = resolution.Address
+	}
 
 	return nf.node.CreateTransaction(nonce, value, receiver, receiverUsername, sender, senderUsername, gasPrice, gasLimit, txData, signatureHex, chainID, version, options)
 }
@@ -325,11 +363,21 @@ func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction)
 	return nf.txSimulatorProc.ProcessTx(tx)
 }
 
+// SimulateTransactionsExecution will simulate the execution of an ordered list of transactions and will return the results of each step
+func (nf *nodeFacade) SimulateTransactionsExecution(txs []*transaction.Transaction) ([]*transaction.SimulationResults, error) {
+	return nf.txSimulatorProc.ProcessBatch(txs)
+}
+
 // GetTransaction gets the transaction with a specified hash
 func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nf.node.GetTransaction(hash, withResults)
 }
 
+// TraceTransaction re-executes a historical transaction and returns the call tree of its smart contract executions
+func (nf *nodeFacade) TraceTransaction(hash string) (*transaction.TraceResults, error) {
+	return nf.node.TraceTransaction(hash)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
@@ -366,6 +414,71 @@ func (nf *nodeFacade) GetTotalStakedValue() (*big.Int, error) {
 	return nf.apiResolver.GetTotalStakedValue()
 }
 
+// GetESDTTokenIdentifiers will return the identifiers of all the issued ESDT tokens
+func (nf *nodeFacade) GetESDTTokenIdentifiers() ([]string, error) {
+	return nf.apiResolver.GetESDTTokenIdentifiers()
+}
+
+// GetESDTToken will return the properties of the provided ESDT token
+func (nf *nodeFacade) GetESDTToken(identifier string) (*apiData.ESDTToken, error) {
+	return nf.apiResolver.GetESDTToken(identifier)
+}
+
+// GetValidatorQueue will return the BLS keys waiting in the staking queue
+func (nf *nodeFacade) GetValidatorQueue() ([]*apiData.ValidatorQueueEntry, error) {
+	return nf.apiResolver.GetValidatorQueue()
+}
+
+// GetValidatorKeyStatus will return the staking status of a BLS key
+func (nf *nodeFacade) GetValidatorKeyStatus(blsKey string) (*apiData.ValidatorKeyStatus, error) {
+	return nf.apiResolver.GetValidatorKeyStatus(blsKey)
+}
+
+// GetValidatorOwner will return the stake, the top-up and the BLS keys of a validator owner
+func (nf *nodeFacade) GetValidatorOwner(address string) (*apiData.ValidatorOwner, error) {
+	return nf.apiResolver.GetValidatorOwner(address)
+}
+
+// ResolveUsername will return the address owning the provided username, as registered in the DNS smart contracts
+func (nf *nodeFacade) ResolveUsername(username string) (*apiData.UsernameResolution, error) {
+	return nf.apiResolver.ResolveUsername(username)
+}
+
+// GetDelegationContracts will return the addresses of all the delegation contracts
+func (nf *nodeFacade) GetDelegationContracts() ([]string, error) {
+	return nf.apiResolver.GetDelegationContracts()
+}
+
+// GetDelegationContract will return the configuration, the stake and the nodes of a delegation contract
+func (nf *nodeFacade) GetDelegationContract(contract string) (*apiData.DelegationContract, error) {
+	return nf.apiResolver.GetDelegationContract(contract)
+}
+
+// GetDelegator will return the funds of a delegator in a delegation contract
+func (nf *nodeFacade) GetDelegator(contract string, address string) (*apiData.DelegatorFunds, error) {
+	return nf.apiResolver.GetDelegator(contract, address)
+}
+
+// GetGovernanceProposals will return all the governance proposals
+func (nf *nodeFacade) GetGovernanceProposals() ([]*apiData.GovernanceProposal, error) {
+	return nf.apiResolver.GetGovernanceProposals()
+}
+
+// GetGovernanceProposal will return the governance proposal with the provided identifier
+func (nf *nodeFacade) GetGovernanceProposal(id string) (*apiData.GovernanceProposal, error) {
+	return nf.apiResolver.GetGovernanceProposal(id)
+}
+
+// GetGovernanceProposalVotes will return the votes cast on the provided governance proposal
+func (nf *nodeFacade) GetGovernanceProposalVotes(id string) ([]*apiData.GovernanceVote, error) {
+	return nf.apiResolver.GetGovernanceProposalVotes(id)
+}
+
+// GetGovernanceVoteTransaction will return the fields of a transaction that votes on the provided governance proposal
+func (nf *nodeFacade) GetGovernanceVoteTransaction(id string, vote string, validator string) (*apiData.GovernanceVoteTransaction, error) {
+	return nf.apiResolver.GetGovernanceVoteTransaction(id, vote, validator)
+}
+
 // ExecuteSCQuery retrieves data from existing SC trie
 func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
@@ -376,6 +489,57 @@ func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, e
 	return nf.convertVmOutputToApiResponse(vmOutput), nil
 }
 
+// ExecuteSCQueries retrieves data from existing SC tries, running all the queries against the same state
+func (nf *nodeFacade) ExecuteSCQueries(queries []*process.SCQuery) ([]*vm.VMOutputApi, error) {
+	vmOutputs, err := nf.apiResolver.ExecuteSCQueries(queries)
+	if err != nil {
+		return nil, err
+	}
+
+	apiOutputs := make([]*vm.VMOutputApi, 0, len(vmOutputs))
+	for _, vmOutput := range vmOutputs {
+		apiOutputs = append(apiOutputs, nf.convertVmOutputToApiResponse(vmOutput))
+	}
+
+	return apiOutputs, nil
+}
+
+// ExecuteSCQueryTyped encodes the JSON arguments and decodes the returned data of a query using the ABI registered
+// for the queried contract
+func (nf *nodeFacade) ExecuteSCQueryTyped(query *process.SCQuery, arguments []json.RawMessage) (*vm.TypedVMOutputApi, error) {
+	encodedArguments, err := nf.abiRegistry.EncodeArguments(query.ScAddress, query.FuncName, arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	query.Arguments = encodedArguments
+	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	typedOutput := &vm.TypedVMOutputApi{
+		ReturnCode:    vmOutput.ReturnCode.String(),
+		ReturnMessage: vmOutput.ReturnMessage,
+		GasRemaining:  vmOutput.GasRemaining,
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return typedOutput, nil
+	}
+
+	typedOutput.ReturnData, err = nf.abiRegistry.DecodeReturnData(query.ScAddress, query.FuncName, vmOutput.ReturnData)
+	if err != nil {
+		return nil, err
+	}
+
+	return typedOutput, nil
+}
+
+// SetContractAbi registers the ABI of the provided contract
+func (nf *nodeFacade) SetContractAbi(address []byte, abiBytes []byte) error {
+	return nf.abiRegistry.SetContractAbi(address, abiBytes)
+}
+
 // PprofEnabled returns if profiling mode should be active or not on the application
 func (nf *nodeFacade) PprofEnabled() bool {
 	return nf.config.PprofEnabled
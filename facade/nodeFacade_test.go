@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -14,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	apiData "github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -33,6 +35,7 @@ func createMockArguments() ArgNodeFacade {
 		ApiResolver:            &mock.ApiResolverStub{},
 		RestAPIServerDebugMode: false,
 		TxSimulatorProcessor:   &mock.TxExecutionSimulatorStub{},
+		AbiRegistry:            &mock.AbiRegistryStub{},
 		WsAntifloodConfig: config.WebServerAntifloodConfig{
 			SimultaneousRequests:         1,
 			SameSourceRequests:           1,
@@ -78,6 +81,17 @@ func TestNewNodeFacade_WithNilApiResolverShouldErr(t *testing.T) {
 	assert.Equal(t, ErrNilApiResolver, err)
 }
 
+func TestNewNodeFacade_WithNilAbiRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.AbiRegistry = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilAbiRegistry, err)
+}
+
 func TestNewNodeFacade_WithInvalidSimultaneousRequestsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -379,6 +393,98 @@ func TestNodeFacade_GetDataValue(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeFacade_ExecuteSCQueries(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error) {
+			return []*vmcommon.VMOutput{
+				{ReturnCode: vmcommon.Ok, ReturnData: [][]byte{[]byte("data")}},
+				{ReturnCode: vmcommon.UserError, ReturnMessage: "failed"},
+			}, nil
+		},
+	}
+	nf, err := NewNodeFacade(arg)
+	require.NoError(t, err)
+
+	vmOutputs, err := nf.ExecuteSCQueries(make([]*process.SCQuery, 2))
+	require.Nil(t, err)
+	require.Len(t, vmOutputs, 2)
+	assert.Equal(t, [][]byte{[]byte("data")}, vmOutputs[0].ReturnData)
+	assert.Equal(t, vmcommon.UserError.String(), vmOutputs[1].ReturnCode)
+	assert.Equal(t, "failed", vmOutputs[1].ReturnMessage)
+}
+
+func TestNodeFacade_ExecuteSCQueryTyped(t *testing.T) {
+	t.Parallel()
+
+	query := &process.SCQuery{ScAddress: []byte("contract"), FuncName: "getValue"}
+	arguments := []json.RawMessage{json.RawMessage(`"7"`)}
+	arg := createMockArguments()
+	arg.AbiRegistry = &mock.AbiRegistryStub{
+		EncodeArgumentsCalled: func(address []byte, function string, args []json.RawMessage) ([][]byte, error) {
+			assert.Equal(t, query.ScAddress, address)
+			assert.Equal(t, query.FuncName, function)
+			assert.Equal(t, arguments, args)
+			return [][]byte{{7}}, nil
+		},
+		DecodeReturnDataCalled: func(address []byte, function string, returnData [][]byte) ([]interface{}, error) {
+			assert.Equal(t, [][]byte{{14}}, returnData)
+			return []interface{}{uint64(14)}, nil
+		},
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, [][]byte{{7}}, query.Arguments)
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, ReturnData: [][]byte{{14}}, GasRemaining: 10}, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	typedOutput, err := nf.ExecuteSCQueryTyped(query, arguments)
+	require.Nil(t, err)
+	assert.Equal(t, []interface{}{uint64(14)}, typedOutput.ReturnData)
+	assert.Equal(t, vmcommon.Ok.String(), typedOutput.ReturnCode)
+	assert.Equal(t, uint64(10), typedOutput.GasRemaining)
+}
+
+func TestNodeFacade_ExecuteSCQueryTypedFailedExecutionShouldNotDecode(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.AbiRegistry = &mock.AbiRegistryStub{
+		DecodeReturnDataCalled: func(_ []byte, _ string, _ [][]byte) ([]interface{}, error) {
+			assert.Fail(t, "should have not decoded the return data")
+			return nil, nil
+		},
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: "failed"}, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	typedOutput, err := nf.ExecuteSCQueryTyped(&process.SCQuery{}, nil)
+	require.Nil(t, err)
+	assert.Nil(t, typedOutput.ReturnData)
+	assert.Equal(t, vmcommon.UserError.String(), typedOutput.ReturnCode)
+	assert.Equal(t, "failed", typedOutput.ReturnMessage)
+
+	expectedErr := errors.New("expected error")
+	arg.AbiRegistry = &mock.AbiRegistryStub{
+		EncodeArgumentsCalled: func(_ []byte, _ string, _ []json.RawMessage) ([][]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	nf, _ = NewNodeFacade(arg)
+
+	typedOutput, err = nf.ExecuteSCQueryTyped(&process.SCQuery{}, nil)
+	assert.Nil(t, typedOutput)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestNodeFacade_EmptyRestInterface(t *testing.T) {
 	t.Parallel()
 
@@ -503,6 +609,86 @@ func TestNodeFacade_CreateTransaction(t *testing.T) {
 	assert.True(t, nodeCreateTxWasCalled)
 }
 
+func TestNodeFacade_CreateTransactionWithReceiverUsernameOnlyShouldResolveTheReceiver(t *testing.T) {
+	t.Parallel()
+
+	resolvedReceiver := "erd1owner"
+	node := &mock.NodeStub{
+		CreateTransactionHandler: func(_ uint64, _ string, receiver string, receiverUsername []byte, _ string, _ []byte, _ uint64, _ uint64, _ []byte, _ string, _ string, _, _ uint32) (*transaction.Transaction, []byte, error) {
+			assert.Equal(t, resolvedReceiver, receiver)
+			assert.Equal(t, []byte("alice.elrond"), receiverUsername)
+			return &transaction.Transaction{}, nil, nil
+		},
+	}
+	apiResolver := &mock.ApiResolverStub{
+		ResolveUsernameHandler: func(username string) (*apiData.UsernameResolution, error) {
+			assert.Equal(t, "alice.elrond", username)
+			return &apiData.UsernameResolution{Username: username, Address: resolvedReceiver}, nil
+		},
+	}
+	arg := createMockArguments()
+	arg.Node = node
+	arg.ApiResolver = apiResolver
+	nf, _ := NewNodeFacade(arg)
+
+	tx, _, err := nf.CreateTransaction(0, "0", "", []byte("alice.elrond"), "0", nil, 0, 0, nil, "0", "chainID", 1, 0)
+	assert.Nil(t, err)
+	assert.NotNil(t, tx)
+
+	expectedErr := errors.New("expected error")
+	apiResolver.ResolveUsernameHandler = func(_ string) (*apiData.UsernameResolution, error) {
+		return nil, expectedErr
+	}
+	tx, _, err = nf.CreateTransaction(0, "0", "", []byte("alice.elrond"), "0", nil, 0, 0, nil, "0", "chainID", 1, 0)
+	assert.Nil(t, tx)
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestNodeFacade_CreateTransactionWithReceiverUsernameWithoutSuffixShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodeCreateTxWasCalled := false
+	node := &mock.NodeStub{
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ []byte, _ string, _ []byte, _ uint64, _ uint64, _ []byte, _ string, _ string, _, _ uint32) (*transaction.Transaction, []byte, error) {
+			nodeCreateTxWasCalled = true
+			return &transaction.Transaction{}, nil, nil
+		},
+	}
+	apiResolver := &mock.ApiResolverStub{
+		ResolveUsernameHandler: func(username string) (*apiData.UsernameResolution, error) {
+			assert.Equal(t, "alice", username)
+			return &apiData.UsernameResolution{Username: "alice.elrond", Address: "erd1owner"}, nil
+		},
+	}
+	arg := createMockArguments()
+	arg.Node = node
+	arg.ApiResolver = apiResolver
+	nf, _ := NewNodeFacade(arg)
+
+	tx, _, err := nf.CreateTransaction(0, "0", "", []byte("alice"), "0", nil, 0, 0, nil, "0", "chainID", 1, 0)
+	assert.Nil(t, tx)
+	assert.True(t, errors.Is(err, ErrReceiverUsernameNotNormalized))
+	assert.False(t, nodeCreateTxWasCalled)
+}
+
+func TestNodeFacade_ResolveUsername(t *testing.T) {
+	t.Parallel()
+
+	expectedResolution := &apiData.UsernameResolution{Username: "alice.elrond", Address: "erd1owner"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		ResolveUsernameHandler: func(username string) (*apiData.UsernameResolution, error) {
+			assert.Equal(t, "alice", username)
+			return expectedResolution, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	resolution, err := nf.ResolveUsername("alice")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResolution, resolution)
+}
+
 func TestNodeFacade_Trigger(t *testing.T) {
 	t.Parallel()
 
@@ -3,12 +3,14 @@ package factory
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/pem"
 	"fmt"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 )
 
@@ -21,16 +23,19 @@ type cryptoSigningParamsLoader struct {
 	skPemFileName       string
 	suite               crypto.Suite
 	skPkProviderHandler func() ([]byte, []byte, error)
+	passphraseHandler   func() ([]byte, error)
 	isInImportMode      bool
 }
 
-// NewCryptoSigningParamsLoader returns a new instance of cryptoSigningParamsLoader
+// NewCryptoSigningParamsLoader returns a new instance of cryptoSigningParamsLoader. The passphrase handler
+// will only be called if the secret key is stored encrypted in the pem file
 func NewCryptoSigningParamsLoader(
 	pubkeyConverter core.PubkeyConverter,
 	skIndex int,
 	skPemFileName string,
 	suite crypto.Suite,
 	isInImportMode bool,
+	passphraseHandler func() ([]byte, error),
 ) (*cryptoSigningParamsLoader, error) {
 	if check.IfNil(pubkeyConverter) {
 		return nil, ErrNilPubKeyConverter
@@ -38,13 +43,17 @@ func NewCryptoSigningParamsLoader(
 	if check.IfNil(suite) {
 		return nil, ErrNilSuite
 	}
+	if passphraseHandler == nil {
+		return nil, ErrNilPassphraseHandler
+	}
 
 	cspf := &cryptoSigningParamsLoader{
-		pubkeyConverter: pubkeyConverter,
-		skIndex:         skIndex,
-		skPemFileName:   skPemFileName,
-		suite:           suite,
-		isInImportMode:  isInImportMode,
+		pubkeyConverter:   pubkeyConverter,
+		skIndex:           skIndex,
+		skPemFileName:     skPemFileName,
+		suite:             suite,
+		isInImportMode:    isInImportMode,
+		passphraseHandler: passphraseHandler,
 	}
 	cspf.skPkProviderHandler = cspf.getSkPk
 
@@ -108,7 +117,19 @@ func (cspf *cryptoSigningParamsLoader) generateCryptoParams(cryptoParams *Crypto
 
 func (cspf *cryptoSigningParamsLoader) getSkPk() ([]byte, []byte, error) {
 	skIndex := cspf.skIndex
-	encodedSk, pkString, err := core.LoadSkPkFromPemFile(cspf.skPemFileName, skIndex)
+	blk, err := core.LoadPemBlockFromFile(cspf.skPemFileName, skIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if keystore.IsEncryptedPemBlock(blk) {
+		blk, err = cspf.decryptPemBlock(blk)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	encodedSk, pkString, err := core.GetSkPkFromPemBlock(blk)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -125,3 +146,17 @@ func (cspf *cryptoSigningParamsLoader) getSkPk() ([]byte, []byte, error) {
 
 	return skBytes, pkBytes, nil
 }
+
+func (cspf *cryptoSigningParamsLoader) decryptPemBlock(blk *pem.Block) (*pem.Block, error) {
+	passphrase, err := cspf.passphraseHandler()
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the passphrase for %s", err, cspf.skPemFileName)
+	}
+
+	decryptedBlk, err := keystore.DecryptPemBlock(blk, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decrypting %s", err, cspf.skPemFileName)
+	}
+
+	return decryptedBlk, nil
+}
@@ -1,18 +1,31 @@
 package factory
 
 import (
+	"encoding/hex"
+	"encoding/pem"
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/crypto/keystore"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/stretchr/testify/require"
 )
 
+func createMockPassphraseHandler() func() ([]byte, error) {
+	return func() ([]byte, error) {
+		return []byte("passphrase"), nil
+	}
+}
+
 func TestNewCryptoSigningParamsLoader_NilPubKeyConverterShoulldErr(t *testing.T) {
 	t.Parallel()
 
-	cspf, err := NewCryptoSigningParamsLoader(nil, 0, "name", &mock.SuiteStub{}, false)
+	cspf, err := NewCryptoSigningParamsLoader(nil, 0, "name", &mock.SuiteStub{}, false, createMockPassphraseHandler())
 	require.Nil(t, cspf)
 	require.Equal(t, ErrNilPubKeyConverter, err)
 }
@@ -20,15 +33,23 @@ func TestNewCryptoSigningParamsLoader_NilPubKeyConverterShoulldErr(t *testing.T)
 func TestNewCryptoSigningParamsLoader_NilSuiteShouldErr(t *testing.T) {
 	t.Parallel()
 
-	cspf, err := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", nil, false)
+	cspf, err := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", nil, false, createMockPassphraseHandler())
 	require.Nil(t, cspf)
 	require.Equal(t, ErrNilSuite, err)
 }
 
+func TestNewCryptoSigningParamsLoader_NilPassphraseHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cspf, err := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.SuiteStub{}, false, nil)
+	require.Nil(t, cspf)
+	require.Equal(t, ErrNilPassphraseHandler, err)
+}
+
 func TestNewCryptoSigningParamsLoader_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
-	cspf, err := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.SuiteStub{}, false)
+	cspf, err := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.SuiteStub{}, false, createMockPassphraseHandler())
 	require.NoError(t, err)
 	require.NotNil(t, cspf)
 }
@@ -37,7 +58,7 @@ func TestCryptoSigningParamsLoader_Create_GetSkPkErrorsShouldErr(t *testing.T) {
 	t.Parallel()
 
 	expectedErr := errors.New("error while getting the sk and pk")
-	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.SuiteStub{}, false)
+	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.SuiteStub{}, false, createMockPassphraseHandler())
 
 	cspf.SetSkPkProviderHandler(func() ([]byte, []byte, error) {
 		return nil, nil, expectedErr
@@ -70,7 +91,7 @@ func TestCryptoSigningParamsLoader_Create_PubKeyMissmatchShouldErr(t *testing.T)
 			}
 		},
 	}
-	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", suite, false)
+	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", suite, false, createMockPassphraseHandler())
 
 	cspf.SetSkPkProviderHandler(func() ([]byte, []byte, error) {
 		return []byte("sk"), diffPubkey2, nil
@@ -103,7 +124,7 @@ func TestCryptoSigningParamsLoader_CreateShouldWork(t *testing.T) {
 			}
 		},
 	}
-	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", suite, false)
+	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", suite, false, createMockPassphraseHandler())
 
 	cspf.SetSkPkProviderHandler(func() ([]byte, []byte, error) {
 		return []byte("sk"), pubKey, nil
@@ -116,9 +137,83 @@ func TestCryptoSigningParamsLoader_CreateShouldWork(t *testing.T) {
 func TestCryptoSigningParamsLoader_GetSkPk_PathNotFound(t *testing.T) {
 	t.Parallel()
 
-	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.SuiteStub{}, false)
+	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, "name", &mock.SuiteStub{}, false, createMockPassphraseHandler())
 	sk, pk, err := cspf.GetSkPk()
 	require.Error(t, err)
 	require.Nil(t, sk)
 	require.Nil(t, pk)
 }
+
+func createEncryptedPemFile(t *testing.T, skBytes []byte, pkString string, passphrase []byte) string {
+	dir, err := ioutil.TempDir("", "cryptoSigningParams")
+	require.Nil(t, err)
+
+	blk := &pem.Block{
+		Type:  "PRIVATE KEY for " + pkString,
+		Bytes: []byte(hex.EncodeToString(skBytes)),
+	}
+	encryptedBlk, err := keystore.EncryptPemBlock(blk, passphrase, keystore.KdfArgon2id)
+	require.Nil(t, err)
+
+	filename := filepath.Join(dir, "validatorKey.pem")
+	err = ioutil.WriteFile(filename, pem.EncodeToMemory(encryptedBlk), core.FileModeUserReadWrite)
+	require.Nil(t, err)
+
+	return filename
+}
+
+func TestCryptoSigningParamsLoader_GetSkPkEncryptedFileShouldWork(t *testing.T) {
+	t.Parallel()
+
+	skBytes := []byte("secret key")
+	pkBytes := []byte("public key")
+	filename := createEncryptedPemFile(t, skBytes, hex.EncodeToString(pkBytes), []byte("passphrase"))
+	defer func() {
+		_ = os.RemoveAll(filepath.Dir(filename))
+	}()
+
+	pubkeyConverter := &mock.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			return hex.DecodeString(humanReadable)
+		},
+	}
+	cspf, _ := NewCryptoSigningParamsLoader(pubkeyConverter, 0, filename, &mock.SuiteStub{}, false, createMockPassphraseHandler())
+	sk, pk, err := cspf.GetSkPk()
+	require.Nil(t, err)
+	require.Equal(t, skBytes, sk)
+	require.Equal(t, pkBytes, pk)
+}
+
+func TestCryptoSigningParamsLoader_GetSkPkEncryptedFileWrongPassphraseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	filename := createEncryptedPemFile(t, []byte("secret key"), "pk", []byte("another passphrase"))
+	defer func() {
+		_ = os.RemoveAll(filepath.Dir(filename))
+	}()
+
+	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, filename, &mock.SuiteStub{}, false, createMockPassphraseHandler())
+	sk, pk, err := cspf.GetSkPk()
+	require.True(t, errors.Is(err, keystore.ErrDecryptionFailed))
+	require.Nil(t, sk)
+	require.Nil(t, pk)
+}
+
+func TestCryptoSigningParamsLoader_GetSkPkEncryptedFilePassphraseErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	filename := createEncryptedPemFile(t, []byte("secret key"), "pk", []byte("passphrase"))
+	defer func() {
+		_ = os.RemoveAll(filepath.Dir(filename))
+	}()
+
+	expectedErr := errors.New("expected error")
+	passphraseHandler := func() ([]byte, error) {
+		return nil, expectedErr
+	}
+	cspf, _ := NewCryptoSigningParamsLoader(&mock.PubkeyConverterStub{}, 0, filename, &mock.SuiteStub{}, false, passphraseHandler)
+	sk, pk, err := cspf.GetSkPk()
+	require.True(t, errors.Is(err, expectedErr))
+	require.Nil(t, sk)
+	require.Nil(t, pk)
+}
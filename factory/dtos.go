@@ -79,4 +79,5 @@ type NetworkComponents struct {
 	OutputAntifloodHandler P2PAntifloodHandler
 	PeerBlackListHandler   process.PeerBlackListCacher
 	PkTimeCache            process.TimeCacher
+	PeerReputationHandler  process.PeerReputationHandler
 }
@@ -82,3 +82,6 @@ var ErrNilStatusHandler = errors.New("nil status handler provided")
 
 // ErrWrongTypeAssertion signals that a wrong type assertion occurred
 var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrNilPassphraseHandler signals that a nil passphrase handler was provided
+var ErrNilPassphraseHandler = errors.New("nil passphrase handler")
@@ -37,6 +37,7 @@ type P2PAntifloodHandler interface {
 	SetDebugger(debugger process.AntifloodDebugger) error
 	SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error
 	SetTopicsForAll(topics ...string)
+	SetTrustedPeers(pids ...core.PeerID)
 	ApplyConsensusSize(size int)
 	BlacklistPeer(peer core.PeerID, reason string, duration time.Duration)
 	IsOriginatorEligibleForTopic(pid core.PeerID, topic string) error
@@ -57,6 +58,7 @@ type EconomicsHandler interface {
 	ComputeMoveBalanceFee(tx process.TransactionWithFeeHandler) *big.Int
 	CheckValidityTxValues(tx process.TransactionWithFeeHandler) error
 	MinGasPrice() uint64
+	CurrentMinGasPrice() uint64
 	MinGasLimit() uint64
 	GasPerDataByte() uint64
 	GasPriceModifier() float64
@@ -15,12 +15,13 @@ import (
 )
 
 type networkComponentsFactory struct {
-	p2pConfig     config.P2PConfig
-	mainConfig    config.Config
-	statusHandler core.AppStatusHandler
-	listenAddress string
-	marshalizer   marshal.Marshalizer
-	syncer        p2p.SyncTimer
+	p2pConfig             config.P2PConfig
+	mainConfig            config.Config
+	statusHandler         core.AppStatusHandler
+	listenAddress         string
+	marshalizer           marshal.Marshalizer
+	syncer                p2p.SyncTimer
+	peerReputationHandler process.PeerReputationHandler
 }
 
 // NewNetworkComponentsFactory returns a new instance of a network components factory
@@ -30,6 +31,7 @@ func NewNetworkComponentsFactory(
 	statusHandler core.AppStatusHandler,
 	marshalizer marshal.Marshalizer,
 	syncer p2p.SyncTimer,
+	peerReputationHandler process.PeerReputationHandler,
 ) (*networkComponentsFactory, error) {
 	if check.IfNil(statusHandler) {
 		return nil, ErrNilStatusHandler
@@ -37,14 +39,18 @@ func NewNetworkComponentsFactory(
 	if check.IfNil(marshalizer) {
 		return nil, fmt.Errorf("%w in NewNetworkComponentsFactory", ErrNilMarshalizer)
 	}
+	if check.IfNil(peerReputationHandler) {
+		return nil, fmt.Errorf("%w in NewNetworkComponentsFactory", process.ErrNilPeerReputationHandler)
+	}
 
 	return &networkComponentsFactory{
-		p2pConfig:     p2pConfig,
-		marshalizer:   marshalizer,
-		mainConfig:    mainConfig,
-		statusHandler: statusHandler,
-		listenAddress: libp2p.ListenAddrWithIp4AndTcp,
-		syncer:        syncer,
+		p2pConfig:             p2pConfig,
+		marshalizer:           marshalizer,
+		mainConfig:            mainConfig,
+		statusHandler:         statusHandler,
+		listenAddress:         libp2p.ListenAddrWithIp4AndTcp,
+		syncer:                syncer,
+		peerReputationHandler: peerReputationHandler,
 	}, nil
 }
 
@@ -66,6 +72,7 @@ func (ncf *networkComponentsFactory) Create() (*NetworkComponents, error) {
 		ncf.mainConfig,
 		ncf.statusHandler,
 		netMessenger.ID(),
+		ncf.peerReputationHandler,
 	)
 	if errNewAntiflood != nil {
 		return nil, errNewAntiflood
@@ -89,6 +96,8 @@ func (ncf *networkComponentsFactory) Create() (*NetworkComponents, error) {
 		return nil, fmt.Errorf("%w when casting input antiflood handler to structs/P2PAntifloodHandler", ErrWrongTypeAssertion)
 	}
 
+	inputAntifloodHandler.SetTrustedPeers(netMessenger.TrustedPeerIDs()...)
+
 	outAntifloodHandler, errOutputAntiflood := antifloodFactory.NewP2POutputAntiFlood(ncf.mainConfig)
 	if errOutputAntiflood != nil {
 		return nil, errOutputAntiflood
@@ -105,5 +114,6 @@ func (ncf *networkComponentsFactory) Create() (*NetworkComponents, error) {
 		OutputAntifloodHandler: outputAntifloodHandler,
 		PeerBlackListHandler:   peerIdBlackList,
 		PkTimeCache:            pkTimeCache,
+		PeerReputationHandler:  ncf.peerReputationHandler,
 	}, nil
 }
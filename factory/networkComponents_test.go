@@ -7,6 +7,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,6 +21,7 @@ func TestNewNetworkComponentsFactory_NilStatusHandlerShouldErr(t *testing.T) {
 		nil,
 		&mock.MarshalizerMock{},
 		&libp2p.LocalSyncTimer{},
+		&disabled.PeerReputationHandler{},
 	)
 	require.Nil(t, ncf)
 	require.Equal(t, ErrNilStatusHandler, err)
@@ -33,11 +36,27 @@ func TestNewNetworkComponentsFactory_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.AppStatusHandlerMock{},
 		nil,
 		&libp2p.LocalSyncTimer{},
+		&disabled.PeerReputationHandler{},
 	)
 	require.Nil(t, ncf)
 	require.True(t, errors.Is(err, ErrNilMarshalizer))
 }
 
+func TestNewNetworkComponentsFactory_NilPeerReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ncf, err := NewNetworkComponentsFactory(
+		config.P2PConfig{},
+		config.Config{},
+		&mock.AppStatusHandlerMock{},
+		&mock.MarshalizerMock{},
+		&libp2p.LocalSyncTimer{},
+		nil,
+	)
+	require.Nil(t, ncf)
+	require.True(t, errors.Is(err, process.ErrNilPeerReputationHandler))
+}
+
 func TestNewNetworkComponentsFactory_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -47,6 +66,7 @@ func TestNewNetworkComponentsFactory_OkValsShouldWork(t *testing.T) {
 		&mock.AppStatusHandlerMock{},
 		&mock.MarshalizerMock{},
 		&libp2p.LocalSyncTimer{},
+		&disabled.PeerReputationHandler{},
 	)
 	require.NoError(t, err)
 	require.NotNil(t, ncf)
@@ -59,6 +79,7 @@ func TestNetworkComponentsFactory_Create_ShouldErrDueToBadConfig(t *testing.T) {
 		&mock.AppStatusHandlerMock{},
 		&mock.MarshalizerMock{},
 		&libp2p.LocalSyncTimer{},
+		&disabled.PeerReputationHandler{},
 	)
 
 	nc, err := ncf.Create()
@@ -103,6 +124,7 @@ func TestNetworkComponentsFactory_Create_ShouldWork(t *testing.T) {
 		&mock.AppStatusHandlerMock{},
 		&mock.MarshalizerMock{},
 		&libp2p.LocalSyncTimer{},
+		&disabled.PeerReputationHandler{},
 	)
 
 	ncf.SetListenAddress(libp2p.ListenLocalhostAddrWithIp4AndTcp)
@@ -10,6 +10,7 @@ import (
 type QueryServiceStub struct {
 	ComputeScCallGasLimitCalled func(tx *transaction.Transaction) (uint64, error)
 	ExecuteQueryCalled          func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueriesCalled        func(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error)
 }
 
 // ComputeScCallGasLimit -
@@ -30,6 +31,15 @@ func (qss *QueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMO
 	return &vmcommon.VMOutput{}, nil
 }
 
+// ExecuteQueries -
+func (qss *QueryServiceStub) ExecuteQueries(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error) {
+	if qss.ExecuteQueriesCalled != nil {
+		return qss.ExecuteQueriesCalled(queries)
+	}
+
+	return make([]*vmcommon.VMOutput, len(queries)), nil
+}
+
 // IsInterfaceNil -
 func (qss *QueryServiceStub) IsInterfaceNil() bool {
 	return qss == nil
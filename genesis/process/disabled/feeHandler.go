@@ -21,6 +21,11 @@ func (fh *FeeHandler) DeveloperPercentage() float64 {
 	return 0
 }
 
+// CurrentMinGasPrice returns 0
+func (fh *FeeHandler) CurrentMinGasPrice() uint64 {
+	return 0
+}
+
 // MinGasPrice returns 0
 func (fh *FeeHandler) MinGasPrice() uint64 {
 	return 0
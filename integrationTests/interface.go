@@ -1,6 +1,7 @@
 package integrationTests
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/api"
@@ -60,11 +61,22 @@ type Facade interface {
 	GetCode(account state.UserAccountHandler) []byte
 	GetESDTBalance(address string, key string) (string, string, error)
 	GetAllESDTTokens(address string) ([]string, error)
+	GetBalanceHistory(address string, from uint64, size uint64) (*dataApi.BalanceHistory, error)
+	GetKeyValuePairs(address string, prefix string, from uint64, size uint64) (*dataApi.AccountStorage, error)
 	GetBlockByHash(hash string, withTxs bool) (*dataApi.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*dataApi.Block, error)
 	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
 	GetTotalStakedValue() (*big.Int, error)
+	GetESDTTokenIdentifiers() ([]string, error)
+	GetESDTToken(identifier string) (*dataApi.ESDTToken, error)
+	GetDelegationContracts() ([]string, error)
+	GetDelegationContract(contract string) (*dataApi.DelegationContract, error)
+	GetDelegator(contract string, address string) (*dataApi.DelegatorFunds, error)
+	GetGovernanceProposals() ([]*dataApi.GovernanceProposal, error)
+	GetGovernanceProposal(id string) (*dataApi.GovernanceProposal, error)
+	GetGovernanceProposalVotes(id string) ([]*dataApi.GovernanceVote, error)
+	GetGovernanceVoteTransaction(id string, vote string, validator string) (*dataApi.GovernanceVoteTransaction, error)
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	TpsBenchmark() *statistics.TpsBenchmark
 	StatusMetrics() external.StatusMetricsHandler
@@ -78,12 +90,21 @@ type Facade interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	SimulateTransactionsExecution(txs []*transaction.Transaction) ([]*transaction.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	TraceTransaction(hash string) (*transaction.TraceResults, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetValidatorQueue() ([]*dataApi.ValidatorQueueEntry, error)
+	GetValidatorKeyStatus(blsKey string) (*dataApi.ValidatorKeyStatus, error)
+	GetValidatorOwner(address string) (*dataApi.ValidatorOwner, error)
+	ResolveUsername(username string) (*dataApi.UsernameResolution, error)
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueries([]*process.SCQuery) ([]*vm.VMOutputApi, error)
+	ExecuteSCQueryTyped(query *process.SCQuery, arguments []json.RawMessage) (*vm.TypedVMOutputApi, error)
+	SetContractAbi(address []byte, abiBytes []byte) error
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	CreateMiddlewareLimiters() ([]api.MiddlewareProcessor, error)
 	IsInterfaceNil() bool
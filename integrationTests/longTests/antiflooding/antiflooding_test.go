@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/factory"
 	"github.com/stretchr/testify/assert"
 )
@@ -127,6 +128,7 @@ func createProcessors(peers []p2p.Messenger, topic string, idxBadPeers []int, id
 				createDisabledConfig(),
 				&mock.AppStatusHandlerStub{},
 				peers[i].ID(),
+				&disabled.PeerReputationHandler{},
 			)
 			log.LogIfError(err)
 		}
@@ -137,6 +139,7 @@ func createProcessors(peers []p2p.Messenger, topic string, idxBadPeers []int, id
 				createWorkableConfig(),
 				statusHandler,
 				peers[i].ID(),
+				&disabled.PeerReputationHandler{},
 			)
 			log.LogIfError(err)
 		}
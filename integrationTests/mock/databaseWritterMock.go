@@ -12,6 +12,7 @@ type DatabaseWriterStub struct {
 	DoBulkRequestCalled func(buff *bytes.Buffer, index string) error
 	DoBulkRemoveCalled  func(index string, hashes []string) error
 	DoMultiGetCalled    func(query map[string]interface{}, index string) (map[string]interface{}, error)
+	DoSearchCalled      func(query map[string]interface{}, index string) (map[string]interface{}, error)
 }
 
 // DoRequest --
@@ -39,6 +40,15 @@ func (dws *DatabaseWriterStub) DoMultiGet(query map[string]interface{}, index st
 	return nil, nil
 }
 
+// DoSearch -
+func (dws *DatabaseWriterStub) DoSearch(query map[string]interface{}, index string) (map[string]interface{}, error) {
+	if dws.DoSearchCalled != nil {
+		return dws.DoSearchCalled(query, index)
+	}
+
+	return nil, nil
+}
+
 // DoBulkRemove -
 func (dws *DatabaseWriterStub) DoBulkRemove(index string, hashes []string) error {
 	if dws.DoBulkRemoveCalled != nil {
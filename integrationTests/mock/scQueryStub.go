@@ -9,6 +9,7 @@ import (
 // ScQueryStub -
 type ScQueryStub struct {
 	ExecuteQueryCalled          func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueriesCalled        func(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error)
 	ComputeScCallGasLimitCalled func(tx *transaction.Transaction) (uint64, error)
 }
 
@@ -20,6 +21,14 @@ func (s *ScQueryStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput,
 	return &vmcommon.VMOutput{}, nil
 }
 
+// ExecuteQueries -
+func (s *ScQueryStub) ExecuteQueries(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error) {
+	if s.ExecuteQueriesCalled != nil {
+		return s.ExecuteQueriesCalled(queries)
+	}
+	return make([]*vmcommon.VMOutput, len(queries)), nil
+}
+
 // ComputeScCallGasLimit --
 func (s *ScQueryStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	if s.ComputeScCallGasLimitCalled != nil {
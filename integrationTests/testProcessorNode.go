@@ -1899,7 +1899,8 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		argumentsBase.BlockChainHook = tpn.BlockchainHook
 		argumentsBase.TxCoordinator = tpn.TxCoordinator
 		arguments := block.ArgShardProcessor{
-			ArgBaseProcessor: argumentsBase,
+			ArgBaseProcessor:    argumentsBase,
+			MinGasPriceAdjuster: economics.NewDisabledMinGasPriceAdjuster(),
 		}
 
 		tpn.BlockProcessor, err = block.NewShardProcessor(arguments)
@@ -11,10 +11,14 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/parsers"
 	nodeFacade "github.com/ElrondNetwork/elrond-go/facade"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/node/abi"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/systemSCAPI"
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
 	"github.com/ElrondNetwork/elrond-go/node/txsimulator"
+	"github.com/ElrondNetwork/elrond-go/node/usernamesAPI"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/transaction"
@@ -69,11 +73,17 @@ func (node *TestProcessorNodeWithTestWebServer) DoRequest(request *http.Request)
 
 func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
 	apiResolver, txSimulator := createFacadeComponents(tpn)
+	abiRegistry, _ := abi.NewAbiRegistry(abi.ArgsAbiRegistry{
+		PubkeyConverter:   TestAddressPubkeyConverter,
+		MaxAbiSizeInBytes: 262144,
+		MaxNumAbis:        1000,
+	})
 
 	return nodeFacade.ArgNodeFacade{
 		Node:                   tpn.Node,
 		ApiResolver:            apiResolver,
 		TxSimulatorProcessor:   txSimulator,
+		AbiRegistry:            abiRegistry,
 		RestAPIServerDebugMode: false,
 		WsAntifloodConfig: config.WebServerAntifloodConfig{
 			SimultaneousRequests:         1000,
@@ -91,14 +101,18 @@ func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
 func createTestApiConfig() config.ApiRoutesConfig {
 	routes := map[string][]string{
 		"node":        {"/status", "/metrics", "/heartbeatstatus", "/statistics", "/p2pstatus", "/debug", "/peerinfo"},
-		"address":     {"/:address", "/:address/balance", "/:address/username", "/:address/key/:key", "/:address/esdt", "/:address/esdt/:tokenIdentifier"},
+		"address":     {"/:address", "/:address/balance", "/:address/username", "/:address/key/:key", "/:address/esdt", "/:address/esdt/:tokenIdentifier", "/:address/history", "/:address/keys"},
 		"hardfork":    {"/trigger"},
 		"network":     {"/status", "/total-staked", "/economics", "/config"},
 		"log":         {"/log"},
-		"validator":   {"/statistics"},
-		"vm-values":   {"/hex", "/string", "/int", "/query"},
-		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash"},
+		"validator":   {"/statistics", "/queue", "/:blsKey/status", "/owner/:address"},
+		"vm-values":   {"/hex", "/string", "/int", "/query", "/query-multiple", "/query-typed", "/abi/:address"},
+		"transaction": {"/send", "/simulate", "/simulate-batch", "/send-multiple", "/cost", "/:txhash", "/:txhash/trace"},
 		"block":       {"/by-nonce/:nonce", "/by-hash/:hash"},
+		"esdt":        {"/:token"},
+		"delegation":  {"/:contract", "/:contract/delegators/:address"},
+		"governance":  {"/proposals", "/proposals/:id", "/proposals/:id/votes", "/proposals/:id/vote-transaction"},
+		"usernames":   {"/:name"},
 	}
 
 	routesConfig := config.ApiRoutesConfig{
@@ -158,7 +172,56 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	totalStakedValueHandler, err := totalStakedAPI.CreateTotalStakedValueHandler(args)
 	log.LogIfError(err)
 
-	apiResolver, err := external.NewNodeApiResolver(tpn.SCQueryService, &mock.StatusMetricsStub{}, txCostHandler, totalStakedValueHandler)
+	argsSystemSCAPI := &systemSCAPI.ArgsSystemSCAPIHandlers{
+		ShardID:                tpn.ShardCoordinator.SelfId(),
+		InternalMarshalizer:    TestMarshalizer,
+		Accounts:               tpn.AccntState,
+		AddressPubkeyConverter: TestAddressPubkeyConverter,
+		BlockChain:             tpn.BlockChain,
+		SCQueryService:         tpn.SCQueryService,
+		ValidatorAccounts:      tpn.PeerState,
+		StakingSystemSCConfig: config.StakingSystemSCConfig{
+			GenesisNodePrice: "1000",
+			UnBondPeriod:     1,
+		},
+	}
+	esdtTokensHandler, err := systemSCAPI.CreateESDTTokensHandler(argsSystemSCAPI)
+	log.LogIfError(err)
+
+	governanceHandler, err := systemSCAPI.CreateGovernanceHandler(argsSystemSCAPI)
+	log.LogIfError(err)
+
+	delegationHandler, err := systemSCAPI.CreateDelegationHandler(argsSystemSCAPI)
+	log.LogIfError(err)
+
+	validatorHandler, err := systemSCAPI.CreateValidatorHandler(argsSystemSCAPI)
+	log.LogIfError(err)
+
+	argsUsernameProcessor := usernamesAPI.ArgsUsernameProcessor{
+		DNSAddresses:     make(map[string]struct{}),
+		ShardCoordinator: tpn.ShardCoordinator,
+		SCQueryService:   tpn.SCQueryService,
+		Hasher:           keccak.Keccak{},
+		PubkeyConverter:  TestAddressPubkeyConverter,
+	}
+	usernameHandler, err := usernamesAPI.NewUsernameProcessor(argsUsernameProcessor)
+	log.LogIfError(err)
+
+	argsApiResolver := external.ArgNodeApiResolver{
+		SCQueryService:          tpn.SCQueryService,
+		StatusMetricsHandler:    &mock.StatusMetricsStub{},
+		TxCostHandler:           txCostHandler,
+		TotalStakedValueHandler: totalStakedValueHandler,
+		ESDTTokensHandler:       esdtTokensHandler,
+		GovernanceHandler:       governanceHandler,
+		DelegationHandler:       delegationHandler,
+		ValidatorHandler:        validatorHandler,
+		UsernameHandler:         usernameHandler,
+	}
+	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
+	log.LogIfError(err)
+
+	accountsJournal, err := txsimulator.NewReadOnlyAccountsDB(tpn.AccntState)
 	log.LogIfError(err)
 
 	argSimulator := txsimulator.ArgsTxSimulator{
@@ -166,6 +229,10 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		IntermmediateProcContainer: tpn.InterimProcContainer,
 		AddressPubKeyConverter:     TestAddressPubkeyConverter,
 		ShardCoordinator:           tpn.ShardCoordinator,
+		AccountsJournal:            accountsJournal,
+		LogsCollector:              txsimulator.NewLogsCollector(),
+		GasHandler:                 tpn.GasHandler,
+		EconomicsHandler:           tpn.EconomicsData,
 	}
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(argSimulator)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/core/indexer/disabled"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -17,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/stretchr/testify/require"
 )
 
@@ -60,11 +62,14 @@ func CreateTestIndexer(
 			IndexerCacheSize: 100,
 			UseKibana:        false,
 		},
-		NodesCoordinator:   &mock.NodesCoordinatorMock{},
-		EpochStartNotifier: &mock.EpochStartNotifierStub{},
-		ShardCoordinator:   coordinator,
-		ElasticProcessor:   elasticProcessor,
-		DataDispatcher:     dispatcher,
+		NodesCoordinator:    &mock.NodesCoordinatorMock{},
+		EpochStartNotifier:  &mock.EpochStartNotifierStub{},
+		ShardCoordinator:    coordinator,
+		ElasticProcessor:    elasticProcessor,
+		DataDispatcher:      dispatcher,
+		BlocksProvider:      disabled.NewBlocksProvider(),
+		PendingBlocksStorer: CreateMemUnit(),
+		StatusHandler:       statusHandler.NewNilStatusHandler(),
 	}
 
 	testIndexer, err := indexer.NewDataIndexer(arguments)
@@ -0,0 +1,370 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"unicode/utf8"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node"
+)
+
+const h256Length = 32
+
+type typeKind int
+
+const (
+	unknownKind typeKind = iota
+	bigUintKind
+	bigIntKind
+	unsignedKind
+	signedKind
+	boolKind
+	addressKind
+	bytesKind
+	stringKind
+)
+
+type typeInfo struct {
+	kind typeKind
+	// numBits is the size of the fixed width numeric types
+	numBits int
+	// length is the required length of the fixed size byte arrays, 0 meaning any length
+	length int
+}
+
+var supportedTypes = map[string]typeInfo{
+	"BigUint":                   {kind: bigUintKind},
+	"BigInt":                    {kind: bigIntKind},
+	"u8":                        {kind: unsignedKind, numBits: 8},
+	"u16":                       {kind: unsignedKind, numBits: 16},
+	"u32":                       {kind: unsignedKind, numBits: 32},
+	"u64":                       {kind: unsignedKind, numBits: 64},
+	"usize":                     {kind: unsignedKind, numBits: 32},
+	"i8":                        {kind: signedKind, numBits: 8},
+	"i16":                       {kind: signedKind, numBits: 16},
+	"i32":                       {kind: signedKind, numBits: 32},
+	"i64":                       {kind: signedKind, numBits: 64},
+	"isize":                     {kind: signedKind, numBits: 32},
+	"bool":                      {kind: boolKind},
+	"Address":                   {kind: addressKind},
+	"bytes":                     {kind: bytesKind},
+	"BoxedBytes":                {kind: bytesKind},
+	"Vec<u8>":                   {kind: bytesKind},
+	"ManagedBuffer":             {kind: bytesKind},
+	"&[u8]":                     {kind: bytesKind},
+	"H256":                      {kind: bytesKind, length: h256Length},
+	"utf-8 string":              {kind: stringKind},
+	"String":                    {kind: stringKind},
+	"&str":                      {kind: stringKind},
+	"TokenIdentifier":           {kind: stringKind},
+	"EgldOrEsdtTokenIdentifier": {kind: stringKind},
+}
+
+func isSupportedType(abiType string) bool {
+	_, ok := supportedTypes[abiType]
+	return ok
+}
+
+// codec converts between the JSON representation of the values and their top-level encoding, as used in
+// smart contract call arguments and return data
+type codec struct {
+	pubkeyConverter core.PubkeyConverter
+}
+
+// encodeValues encodes the JSON values for the provided params, expanding a trailing variadic or optional param
+func (c *codec) encodeValues(params []*AbiParam, values []json.RawMessage) ([][]byte, error) {
+	err := checkNumValues(params, len(values))
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := make([][]byte, 0, len(values))
+	for i, value := range values {
+		param := params[minInt(i, len(params)-1)]
+		elementType, _ := unwrapMultiType(param.Type)
+		data, errEncode := c.encodeValue(elementType, value)
+		if errEncode != nil {
+			return nil, fmt.Errorf("value %d: %w", i, errEncode)
+		}
+
+		encoded = append(encoded, data)
+	}
+
+	return encoded, nil
+}
+
+// decodeValues decodes the values for the provided params. The values of a trailing variadic param are returned as
+// a single list and a missing optional value is returned as nil
+func (c *codec) decodeValues(params []*AbiParam, values [][]byte) ([]interface{}, error) {
+	err := checkNumValues(params, len(values))
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := make([]interface{}, 0, len(params))
+	for i, param := range params {
+		elementType, isMulti := unwrapMultiType(param.Type)
+		if !isMulti {
+			value, errDecode := c.decodeValue(elementType, values[i])
+			if errDecode != nil {
+				return nil, fmt.Errorf("value %d: %w", i, errDecode)
+			}
+
+			decoded = append(decoded, value)
+			continue
+		}
+
+		multiValues := make([]interface{}, 0, len(values)-i)
+		for j := i; j < len(values); j++ {
+			value, errDecode := c.decodeValue(elementType, values[j])
+			if errDecode != nil {
+				return nil, fmt.Errorf("value %d: %w", j, errDecode)
+			}
+
+			multiValues = append(multiValues, value)
+		}
+
+		switch {
+		case isVariadicType(param.Type):
+			decoded = append(decoded, multiValues)
+		case len(multiValues) == 0:
+			decoded = append(decoded, nil)
+		default:
+			decoded = append(decoded, multiValues[0])
+		}
+	}
+
+	return decoded, nil
+}
+
+func checkNumValues(params []*AbiParam, numValues int) error {
+	minValues, maxValues := len(params), len(params)
+	if len(params) > 0 {
+		lastType := params[len(params)-1].Type
+		_, isMulti := unwrapMultiType(lastType)
+		if isMulti {
+			minValues--
+		}
+		if isVariadicType(lastType) {
+			maxValues = numValues
+		}
+	}
+
+	if numValues < minValues || numValues > maxValues {
+		return fmt.Errorf("%w: got %d, expected between %d and %d",
+			node.ErrWrongNumberOfAbiValues, numValues, minValues, maxValues)
+	}
+
+	return nil
+}
+
+func (c *codec) encodeValue(abiType string, value json.RawMessage) ([]byte, error) {
+	info, ok := supportedTypes[abiType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", node.ErrUnsupportedAbiType, abiType)
+	}
+
+	switch info.kind {
+	case bigUintKind, unsignedKind, bigIntKind, signedKind:
+		number, err := parseNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		err = checkNumberRange(info, number)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, abiType)
+		}
+		if info.kind == bigIntKind || info.kind == signedKind {
+			return encodeSigned(number), nil
+		}
+		return number.Bytes(), nil
+	case boolKind:
+		var flag bool
+		err := json.Unmarshal(value, &flag)
+		if err != nil {
+			return nil, fmt.Errorf("%w: expected bool", node.ErrInvalidAbiValue)
+		}
+		if flag {
+			return []byte{1}, nil
+		}
+		return make([]byte, 0), nil
+	case addressKind:
+		text, err := parseString(value)
+		if err != nil {
+			return nil, err
+		}
+		address, err := c.pubkeyConverter.Decode(text)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", node.ErrInvalidAbiValue, err)
+		}
+		if len(address) != c.pubkeyConverter.Len() {
+			return nil, fmt.Errorf("%w: Address", node.ErrInvalidAbiValue)
+		}
+		return address, nil
+	case bytesKind:
+		text, err := parseString(value)
+		if err != nil {
+			return nil, err
+		}
+		data, err := hex.DecodeString(text)
+		if err != nil {
+			return nil, fmt.Errorf("%w: expected hex string", node.ErrInvalidAbiValue)
+		}
+		if info.length > 0 && len(data) != info.length {
+			return nil, fmt.Errorf("%w: expected %d bytes for %s", node.ErrInvalidAbiValue, info.length, abiType)
+		}
+		return data, nil
+	default:
+		text, err := parseString(value)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(text), nil
+	}
+}
+
+func (c *codec) decodeValue(abiType string, data []byte) (interface{}, error) {
+	info, ok := supportedTypes[abiType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", node.ErrUnsupportedAbiType, abiType)
+	}
+
+	switch info.kind {
+	case bigUintKind:
+		return big.NewInt(0).SetBytes(data).String(), nil
+	case bigIntKind:
+		return decodeSigned(data).String(), nil
+	case unsignedKind:
+		number := big.NewInt(0).SetBytes(data)
+		err := checkNumberRange(info, number)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, abiType)
+		}
+		return number.Uint64(), nil
+	case signedKind:
+		number := decodeSigned(data)
+		err := checkNumberRange(info, number)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, abiType)
+		}
+		return number.Int64(), nil
+	case boolKind:
+		switch {
+		case len(data) == 0:
+			return false, nil
+		case bytes.Equal(data, []byte{1}):
+			return true, nil
+		default:
+			return nil, fmt.Errorf("%w: bool", node.ErrInvalidAbiValue)
+		}
+	case addressKind:
+		if len(data) != c.pubkeyConverter.Len() {
+			return nil, fmt.Errorf("%w: Address", node.ErrInvalidAbiValue)
+		}
+		return c.pubkeyConverter.Encode(data), nil
+	case bytesKind:
+		if info.length > 0 && len(data) != info.length {
+			return nil, fmt.Errorf("%w: expected %d bytes for %s", node.ErrInvalidAbiValue, info.length, abiType)
+		}
+		return hex.EncodeToString(data), nil
+	default:
+		if !utf8.Valid(data) {
+			return nil, fmt.Errorf("%w: invalid utf-8 for %s", node.ErrInvalidAbiValue, abiType)
+		}
+		return string(data), nil
+	}
+}
+
+// parseNumber accepts both JSON numbers and decimal strings, as big numbers do not fit into a JSON number
+func parseNumber(value json.RawMessage) (*big.Int, error) {
+	text := string(bytes.TrimSpace(value))
+	if len(text) > 0 && text[0] == '"' {
+		var err error
+		text, err = parseString(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	number, ok := big.NewInt(0).SetString(text, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected integer", node.ErrInvalidAbiValue)
+	}
+
+	return number, nil
+}
+
+func parseString(value json.RawMessage) (string, error) {
+	var text string
+	err := json.Unmarshal(value, &text)
+	if err != nil {
+		return "", fmt.Errorf("%w: expected string", node.ErrInvalidAbiValue)
+	}
+
+	return text, nil
+}
+
+func checkNumberRange(info typeInfo, number *big.Int) error {
+	isUnsigned := info.kind == bigUintKind || info.kind == unsignedKind
+	if isUnsigned && number.Sign() < 0 {
+		return fmt.Errorf("%w: negative value", node.ErrInvalidAbiValue)
+	}
+	if info.numBits == 0 {
+		return nil
+	}
+
+	if isUnsigned {
+		if number.BitLen() > info.numBits {
+			return fmt.Errorf("%w: value out of range", node.ErrInvalidAbiValue)
+		}
+		return nil
+	}
+
+	limit := big.NewInt(0).Lsh(big.NewInt(1), uint(info.numBits-1))
+	minValue := big.NewInt(0).Neg(limit)
+	if number.Cmp(minValue) < 0 || number.Cmp(limit) >= 0 {
+		return fmt.Errorf("%w: value out of range", node.ErrInvalidAbiValue)
+	}
+
+	return nil
+}
+
+// encodeSigned returns the minimal two's complement big endian representation of the number
+func encodeSigned(number *big.Int) []byte {
+	switch number.Sign() {
+	case 0:
+		return make([]byte, 0)
+	case 1:
+		data := number.Bytes()
+		if data[0]&0x80 != 0 {
+			data = append([]byte{0}, data...)
+		}
+		return data
+	default:
+		magnitudeMinusOne := big.NewInt(0).Sub(big.NewInt(0).Neg(number), big.NewInt(1))
+		numBytes := magnitudeMinusOne.BitLen()/8 + 1
+		modulus := big.NewInt(0).Lsh(big.NewInt(1), uint(8*numBytes))
+		return big.NewInt(0).Add(modulus, number).Bytes()
+	}
+}
+
+func decodeSigned(data []byte) *big.Int {
+	number := big.NewInt(0).SetBytes(data)
+	if len(data) > 0 && data[0]&0x80 != 0 {
+		modulus := big.NewInt(0).Lsh(big.NewInt(1), uint(8*len(data)))
+		number.Sub(number, modulus)
+	}
+
+	return number
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,138 @@
+package abi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createCodec() *codec {
+	return &codec{pubkeyConverter: mock.NewPubkeyConverterMock(32)}
+}
+
+func TestCodec_EncodeDecodeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	address := hex.EncodeToString(make([]byte, 32))
+	tests := []struct {
+		abiType string
+		value   string
+		encoded []byte
+		decoded interface{}
+	}{
+		{abiType: "BigUint", value: `"1000000000000000000000"`, decoded: "1000000000000000000000"},
+		{abiType: "BigUint", value: `0`, encoded: []byte{}, decoded: "0"},
+		{abiType: "BigInt", value: `"-1"`, encoded: []byte{0xff}, decoded: "-1"},
+		{abiType: "BigInt", value: `128`, encoded: []byte{0x00, 0x80}, decoded: "128"},
+		{abiType: "BigInt", value: `-129`, encoded: []byte{0xff, 0x7f}, decoded: "-129"},
+		{abiType: "u8", value: `255`, encoded: []byte{0xff}, decoded: uint64(255)},
+		{abiType: "u64", value: `"18446744073709551615"`, decoded: uint64(18446744073709551615)},
+		{abiType: "i8", value: `-128`, encoded: []byte{0x80}, decoded: int64(-128)},
+		{abiType: "i32", value: `256`, encoded: []byte{0x01, 0x00}, decoded: int64(256)},
+		{abiType: "bool", value: `true`, encoded: []byte{1}, decoded: true},
+		{abiType: "bool", value: `false`, encoded: []byte{}, decoded: false},
+		{abiType: "Address", value: `"` + address + `"`, encoded: make([]byte, 32), decoded: address},
+		{abiType: "bytes", value: `"abcd"`, encoded: []byte{0xab, 0xcd}, decoded: "abcd"},
+		{abiType: "TokenIdentifier", value: `"WEGLD-abcdef"`, encoded: []byte("WEGLD-abcdef"), decoded: "WEGLD-abcdef"},
+	}
+
+	c := createCodec()
+	for _, tt := range tests {
+		encoded, err := c.encodeValue(tt.abiType, json.RawMessage(tt.value))
+		require.Nil(t, err, tt.abiType+" "+tt.value)
+		if tt.encoded != nil {
+			require.Equal(t, tt.encoded, encoded, tt.abiType+" "+tt.value)
+		}
+
+		decoded, err := c.decodeValue(tt.abiType, encoded)
+		require.Nil(t, err, tt.abiType+" "+tt.value)
+		require.Equal(t, tt.decoded, decoded, tt.abiType+" "+tt.value)
+	}
+}
+
+func TestCodec_EncodeInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		abiType string
+		value   string
+	}{
+		{abiType: "BigUint", value: `-1`},
+		{abiType: "BigUint", value: `"1.5"`},
+		{abiType: "u8", value: `256`},
+		{abiType: "i8", value: `128`},
+		{abiType: "i8", value: `-129`},
+		{abiType: "bool", value: `1`},
+		{abiType: "Address", value: `"abcd"`},
+		{abiType: "bytes", value: `"not hex"`},
+		{abiType: "H256", value: `"abcd"`},
+		{abiType: "String", value: `12`},
+	}
+
+	c := createCodec()
+	for _, tt := range tests {
+		_, err := c.encodeValue(tt.abiType, json.RawMessage(tt.value))
+		require.True(t, errors.Is(err, node.ErrInvalidAbiValue), tt.abiType+" "+tt.value)
+	}
+
+	_, err := c.encodeValue("List<u8>", json.RawMessage(`[]`))
+	require.True(t, errors.Is(err, node.ErrUnsupportedAbiType))
+}
+
+func TestCodec_DecodeInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	c := createCodec()
+
+	_, err := c.decodeValue("u8", []byte{1, 0})
+	require.True(t, errors.Is(err, node.ErrInvalidAbiValue))
+
+	_, err = c.decodeValue("bool", []byte{2})
+	require.True(t, errors.Is(err, node.ErrInvalidAbiValue))
+
+	_, err = c.decodeValue("Address", []byte{1})
+	require.True(t, errors.Is(err, node.ErrInvalidAbiValue))
+
+	_, err = c.decodeValue("String", []byte{0xff})
+	require.True(t, errors.Is(err, node.ErrInvalidAbiValue))
+}
+
+func TestCodec_MultiValueParams(t *testing.T) {
+	t.Parallel()
+
+	c := createCodec()
+	variadicParams := []*AbiParam{{Type: "u8"}, {Type: "variadic<u32>"}}
+
+	encoded, err := c.encodeValues(variadicParams, []json.RawMessage{json.RawMessage(`1`), json.RawMessage(`2`), json.RawMessage(`3`)})
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{{1}, {2}, {3}}, encoded)
+
+	decoded, err := c.decodeValues(variadicParams, encoded)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{uint64(1), []interface{}{uint64(2), uint64(3)}}, decoded)
+
+	decoded, err = c.decodeValues(variadicParams, [][]byte{{1}})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{uint64(1), []interface{}{}}, decoded)
+
+	optionalParams := []*AbiParam{{Type: "optional<BigUint>"}}
+	decoded, err = c.decodeValues(optionalParams, nil)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{nil}, decoded)
+
+	decoded, err = c.decodeValues(optionalParams, [][]byte{big.NewInt(5).Bytes()})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"5"}, decoded)
+
+	_, err = c.decodeValues(optionalParams, [][]byte{{1}, {2}})
+	require.True(t, errors.Is(err, node.ErrWrongNumberOfAbiValues))
+
+	_, err = c.encodeValues([]*AbiParam{{Type: "u8"}}, nil)
+	require.True(t, errors.Is(err, node.ErrWrongNumberOfAbiValues))
+}
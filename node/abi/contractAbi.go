@@ -0,0 +1,109 @@
+package abi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/node"
+)
+
+const (
+	variadicTypePrefix = "variadic<"
+	optionalTypePrefix = "optional<"
+	wrapperTypeSuffix  = ">"
+)
+
+// ContractAbi describes the endpoints of a smart contract
+type ContractAbi struct {
+	Name      string         `json:"name"`
+	Endpoints []*AbiEndpoint `json:"endpoints"`
+}
+
+// AbiEndpoint describes the arguments and the results of a smart contract endpoint
+type AbiEndpoint struct {
+	Name    string      `json:"name"`
+	Inputs  []*AbiParam `json:"inputs"`
+	Outputs []*AbiParam `json:"outputs"`
+}
+
+// AbiParam describes an endpoint argument or result
+type AbiParam struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type"`
+}
+
+// getEndpoint returns the endpoint with the provided name
+func (contractAbi *ContractAbi) getEndpoint(name string) (*AbiEndpoint, error) {
+	for _, endpoint := range contractAbi.Endpoints {
+		if endpoint.Name == name {
+			return endpoint, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", node.ErrAbiEndpointNotFound, name)
+}
+
+// validate checks that the endpoint names are unique and that all the types can be handled
+func (contractAbi *ContractAbi) validate() error {
+	if len(contractAbi.Endpoints) == 0 {
+		return fmt.Errorf("%w: no endpoints defined", node.ErrInvalidContractAbi)
+	}
+
+	names := make(map[string]struct{}, len(contractAbi.Endpoints))
+	for _, endpoint := range contractAbi.Endpoints {
+		if endpoint == nil || len(endpoint.Name) == 0 {
+			return fmt.Errorf("%w: unnamed endpoint", node.ErrInvalidContractAbi)
+		}
+		_, exists := names[endpoint.Name]
+		if exists {
+			return fmt.Errorf("%w: duplicated endpoint %s", node.ErrInvalidContractAbi, endpoint.Name)
+		}
+		names[endpoint.Name] = struct{}{}
+
+		err := validateParams(endpoint.Inputs)
+		if err != nil {
+			return fmt.Errorf("%w: endpoint %s inputs: %v", node.ErrInvalidContractAbi, endpoint.Name, err)
+		}
+		err = validateParams(endpoint.Outputs)
+		if err != nil {
+			return fmt.Errorf("%w: endpoint %s outputs: %v", node.ErrInvalidContractAbi, endpoint.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// validateParams checks the param types, allowing a variadic or optional type only on the last position
+func validateParams(params []*AbiParam) error {
+	for i, param := range params {
+		if param == nil {
+			return fmt.Errorf("nil param at position %d", i)
+		}
+
+		elementType, isMulti := unwrapMultiType(param.Type)
+		if isMulti && i != len(params)-1 {
+			return fmt.Errorf("type %s is allowed only on the last position", param.Type)
+		}
+		if !isSupportedType(elementType) {
+			return fmt.Errorf("%w: %s", node.ErrUnsupportedAbiType, param.Type)
+		}
+	}
+
+	return nil
+}
+
+// unwrapMultiType returns the element type of a variadic<T> or optional<T> type and true, or the provided type and
+// false for all the other types
+func unwrapMultiType(abiType string) (string, bool) {
+	for _, prefix := range []string{variadicTypePrefix, optionalTypePrefix} {
+		if strings.HasPrefix(abiType, prefix) && strings.HasSuffix(abiType, wrapperTypeSuffix) {
+			return abiType[len(prefix) : len(abiType)-len(wrapperTypeSuffix)], true
+		}
+	}
+
+	return abiType, false
+}
+
+func isVariadicType(abiType string) bool {
+	return strings.HasPrefix(abiType, variadicTypePrefix)
+}
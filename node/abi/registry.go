@@ -0,0 +1,232 @@
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/parsers"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+)
+
+const abiFileSuffix = ".abi.json"
+
+var log = logger.GetOrCreate("node/abi")
+
+// ArgsAbiRegistry holds the arguments required for creating a new contracts ABI registry
+type ArgsAbiRegistry struct {
+	PubkeyConverter core.PubkeyConverter
+	// Directory holds the <bech32 address>.abi.json files. If empty, the registered ABIs are only kept in memory
+	Directory         string
+	MaxAbiSizeInBytes uint32
+	MaxNumAbis        uint32
+}
+
+type abiRegistry struct {
+	mutAbis           sync.RWMutex
+	abis              map[string]*ContractAbi
+	directory         string
+	maxAbiSizeInBytes int
+	maxNumAbis        int
+	codec             *codec
+}
+
+// NewAbiRegistry creates a contracts ABI registry, loading the ABI files found in the configured directory
+func NewAbiRegistry(args ArgsAbiRegistry) (*abiRegistry, error) {
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, node.ErrNilPubkeyConverter
+	}
+	if args.MaxAbiSizeInBytes == 0 {
+		return nil, fmt.Errorf("%w for the maximum ABI size", node.ErrInvalidAbiRegistryLimit)
+	}
+	if args.MaxNumAbis == 0 {
+		return nil, fmt.Errorf("%w for the maximum number of ABIs", node.ErrInvalidAbiRegistryLimit)
+	}
+
+	registry := &abiRegistry{
+		abis:              make(map[string]*ContractAbi),
+		directory:         args.Directory,
+		maxAbiSizeInBytes: int(args.MaxAbiSizeInBytes),
+		maxNumAbis:        int(args.MaxNumAbis),
+		codec:             &codec{pubkeyConverter: args.PubkeyConverter},
+	}
+
+	err := registry.loadDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+func (ar *abiRegistry) loadDirectory() error {
+	if len(ar.directory) == 0 {
+		return nil
+	}
+
+	files, err := ioutil.ReadDir(ar.directory)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), abiFileSuffix) {
+			continue
+		}
+
+		address, errDecode := ar.codec.pubkeyConverter.Decode(strings.TrimSuffix(file.Name(), abiFileSuffix))
+		if errDecode != nil {
+			return fmt.Errorf("%w for ABI file %s", errDecode, file.Name())
+		}
+		if len(ar.abis) >= ar.maxNumAbis {
+			return fmt.Errorf("%w in directory %s, maximum is %d", node.ErrTooManyContractAbis, ar.directory, ar.maxNumAbis)
+		}
+		if file.Size() > int64(ar.maxAbiSizeInBytes) {
+			return fmt.Errorf("%w: ABI file %s", node.ErrContractAbiTooLarge, file.Name())
+		}
+
+		abiBytes, errRead := ioutil.ReadFile(filepath.Join(ar.directory, file.Name()))
+		if errRead != nil {
+			return errRead
+		}
+
+		contractAbi, errParse := parseContractAbi(abiBytes)
+		if errParse != nil {
+			return fmt.Errorf("%w in ABI file %s", errParse, file.Name())
+		}
+
+		ar.abis[string(address)] = contractAbi
+	}
+
+	log.Debug("loaded contract ABIs", "directory", ar.directory, "num ABIs", len(ar.abis))
+
+	return nil
+}
+
+func parseContractAbi(abiBytes []byte) (*ContractAbi, error) {
+	contractAbi := &ContractAbi{}
+	err := json.Unmarshal(abiBytes, contractAbi)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", node.ErrInvalidContractAbi, err)
+	}
+
+	err = contractAbi.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return contractAbi, nil
+}
+
+// SetContractAbi validates and registers the ABI of the provided contract, replacing the existing one, if any.
+// The ABI is also saved in the configured directory so it will be loaded on the next start. A new contract is
+// rejected once the registry holds the configured maximum number of ABIs
+func (ar *abiRegistry) SetContractAbi(address []byte, abiBytes []byte) error {
+	if len(address) != ar.codec.pubkeyConverter.Len() {
+		return node.ErrInvalidAddressLength
+	}
+	if len(abiBytes) > ar.maxAbiSizeInBytes {
+		return fmt.Errorf("%w: %d bytes, maximum is %d", node.ErrContractAbiTooLarge, len(abiBytes), ar.maxAbiSizeInBytes)
+	}
+
+	contractAbi, err := parseContractAbi(abiBytes)
+	if err != nil {
+		return err
+	}
+
+	ar.mutAbis.Lock()
+	defer ar.mutAbis.Unlock()
+
+	_, exists := ar.abis[string(address)]
+	if !exists && len(ar.abis) >= ar.maxNumAbis {
+		return fmt.Errorf("%w, maximum is %d", node.ErrTooManyContractAbis, ar.maxNumAbis)
+	}
+
+	if len(ar.directory) > 0 {
+		err = os.MkdirAll(ar.directory, os.ModePerm)
+		if err != nil {
+			return err
+		}
+
+		fileName := ar.codec.pubkeyConverter.Encode(address) + abiFileSuffix
+		err = ioutil.WriteFile(filepath.Join(ar.directory, fileName), abiBytes, core.FileModeUserReadWrite)
+		if err != nil {
+			return err
+		}
+	}
+
+	ar.abis[string(address)] = contractAbi
+
+	return nil
+}
+
+// EncodeArguments encodes the JSON arguments of the contract endpoint
+func (ar *abiRegistry) EncodeArguments(address []byte, function string, arguments []json.RawMessage) ([][]byte, error) {
+	endpoint, err := ar.getEndpoint(address, function)
+	if err != nil {
+		return nil, err
+	}
+
+	return ar.codec.encodeValues(endpoint.Inputs, arguments)
+}
+
+// DecodeReturnData decodes the data returned by the contract endpoint
+func (ar *abiRegistry) DecodeReturnData(address []byte, function string, returnData [][]byte) ([]interface{}, error) {
+	endpoint, err := ar.getEndpoint(address, function)
+	if err != nil {
+		return nil, err
+	}
+
+	return ar.codec.decodeValues(endpoint.Outputs, returnData)
+}
+
+// DecodeCallData decodes the function@arg1@arg2... data field of a transaction sent to the contract
+func (ar *abiRegistry) DecodeCallData(address []byte, data []byte) (*transaction.DecodedCallDataApi, error) {
+	function, arguments, err := parsers.NewCallArgsParser().ParseData(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := ar.getEndpoint(address, function)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedArguments, err := ar.codec.decodeValues(endpoint.Inputs, arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.DecodedCallDataApi{
+		Function:  function,
+		Arguments: decodedArguments,
+	}, nil
+}
+
+func (ar *abiRegistry) getEndpoint(address []byte, function string) (*AbiEndpoint, error) {
+	ar.mutAbis.RLock()
+	contractAbi, found := ar.abis[string(address)]
+	ar.mutAbis.RUnlock()
+
+	if !found {
+		return nil, node.ErrContractAbiNotFound
+	}
+
+	return contractAbi.getEndpoint(function)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ar *abiRegistry) IsInterfaceNil() bool {
+	return ar == nil
+}
@@ -0,0 +1,217 @@
+package abi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testAbi = `{
+	"name": "adder",
+	"endpoints": [
+		{"name": "add", "inputs": [{"name": "value", "type": "BigUint"}]},
+		{"name": "getSum", "outputs": [{"type": "BigUint"}]},
+		{"name": "getValues", "inputs": [{"name": "owner", "type": "Address"}], "outputs": [{"type": "variadic<u32>"}]}
+	]
+}`
+
+var contractAddress = []byte("contract address with 32 bytes..")
+
+func createTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "abi")
+	require.Nil(t, err)
+
+	return dir
+}
+
+func createArgsAbiRegistry() ArgsAbiRegistry {
+	return ArgsAbiRegistry{
+		PubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		MaxAbiSizeInBytes: 1024,
+		MaxNumAbis:        2,
+	}
+}
+
+func TestNewAbiRegistry(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsAbiRegistry()
+	args.PubkeyConverter = nil
+	registry, err := NewAbiRegistry(args)
+	require.Nil(t, registry)
+	require.Equal(t, node.ErrNilPubkeyConverter, err)
+
+	args = createArgsAbiRegistry()
+	args.MaxAbiSizeInBytes = 0
+	registry, err = NewAbiRegistry(args)
+	require.Nil(t, registry)
+	require.True(t, errors.Is(err, node.ErrInvalidAbiRegistryLimit))
+
+	args = createArgsAbiRegistry()
+	args.MaxNumAbis = 0
+	registry, err = NewAbiRegistry(args)
+	require.Nil(t, registry)
+	require.True(t, errors.Is(err, node.ErrInvalidAbiRegistryLimit))
+
+	args = createArgsAbiRegistry()
+	args.Directory = filepath.Join(os.TempDir(), "missing abi directory")
+	registry, err = NewAbiRegistry(args)
+	require.Nil(t, err)
+	require.False(t, registry.IsInterfaceNil())
+}
+
+func TestAbiRegistry_SetContractAbiInvalidAbiShouldErr(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := NewAbiRegistry(createArgsAbiRegistry())
+
+	err := registry.SetContractAbi([]byte("short"), []byte(testAbi))
+	require.Equal(t, node.ErrInvalidAddressLength, err)
+
+	invalidAbis := []string{
+		`not a json`,
+		`{"name": "empty"}`,
+		`{"endpoints": [{"name": "a"}, {"name": "a"}]}`,
+		`{"endpoints": [{"inputs": []}]}`,
+		`{"endpoints": [{"name": "a", "inputs": [{"type": "List<u8>"}]}]}`,
+		`{"endpoints": [{"name": "a", "inputs": [{"type": "variadic<u8>"}, {"type": "u8"}]}]}`,
+	}
+	for _, invalidAbi := range invalidAbis {
+		err = registry.SetContractAbi(contractAddress, []byte(invalidAbi))
+		require.True(t, errors.Is(err, node.ErrInvalidContractAbi), invalidAbi)
+	}
+}
+
+func TestAbiRegistry_SetContractAbiShouldEnforceTheLimits(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := NewAbiRegistry(createArgsAbiRegistry())
+
+	largeAbi := `{"name": "` + strings.Repeat("a", 1024) + `", "endpoints": [{"name": "a"}]}`
+	err := registry.SetContractAbi(contractAddress, []byte(largeAbi))
+	require.True(t, errors.Is(err, node.ErrContractAbiTooLarge))
+
+	secondAddress := []byte("second contract with 32 bytes...")
+	thirdAddress := []byte("third contract with 32 bytes....")
+	require.Nil(t, registry.SetContractAbi(contractAddress, []byte(testAbi)))
+	require.Nil(t, registry.SetContractAbi(secondAddress, []byte(testAbi)))
+
+	err = registry.SetContractAbi(thirdAddress, []byte(testAbi))
+	require.True(t, errors.Is(err, node.ErrTooManyContractAbis))
+
+	err = registry.SetContractAbi(secondAddress, []byte(testAbi))
+	require.Nil(t, err, "replacing an existing ABI should not be limited")
+}
+
+func TestAbiRegistry_EncodeAndDecodeShouldUseTheContractAbi(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := NewAbiRegistry(createArgsAbiRegistry())
+
+	_, err := registry.EncodeArguments(contractAddress, "add", nil)
+	require.Equal(t, node.ErrContractAbiNotFound, err)
+
+	err = registry.SetContractAbi(contractAddress, []byte(testAbi))
+	require.Nil(t, err)
+
+	_, err = registry.EncodeArguments(contractAddress, "missing", nil)
+	require.True(t, errors.Is(err, node.ErrAbiEndpointNotFound))
+
+	arguments, err := registry.EncodeArguments(contractAddress, "add", []json.RawMessage{json.RawMessage(`"256"`)})
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{{1, 0}}, arguments)
+
+	results, err := registry.DecodeReturnData(contractAddress, "getValues", [][]byte{{1}, {2}})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{[]interface{}{uint64(1), uint64(2)}}, results)
+}
+
+func TestAbiRegistry_DecodeCallData(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := NewAbiRegistry(createArgsAbiRegistry())
+	_ = registry.SetContractAbi(contractAddress, []byte(testAbi))
+
+	owner := make([]byte, 32)
+	owner[31] = 1
+	decodedData, err := registry.DecodeCallData(contractAddress, []byte("getValues@"+hex.EncodeToString(owner)))
+	require.Nil(t, err)
+	require.Equal(t, "getValues", decodedData.Function)
+	require.Equal(t, []interface{}{hex.EncodeToString(owner)}, decodedData.Arguments)
+
+	_, err = registry.DecodeCallData(contractAddress, []byte("add@01@02"))
+	require.True(t, errors.Is(err, node.ErrWrongNumberOfAbiValues))
+
+	_, err = registry.DecodeCallData([]byte("other contract"), []byte("add@01"))
+	require.Equal(t, node.ErrContractAbiNotFound, err)
+}
+
+func TestAbiRegistry_RegisteredAbisShouldBeLoadedFromDirectory(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createArgsAbiRegistry()
+	args.Directory = filepath.Join(dir, "abi")
+	registry, _ := NewAbiRegistry(args)
+	err := registry.SetContractAbi(contractAddress, []byte(testAbi))
+	require.Nil(t, err)
+
+	reloadedRegistry, err := NewAbiRegistry(args)
+	require.Nil(t, err)
+
+	arguments, err := reloadedRegistry.EncodeArguments(contractAddress, "add", []json.RawMessage{json.RawMessage(`1`)})
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{{1}}, arguments)
+}
+
+func TestAbiRegistry_InvalidAbiFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	fileName := hex.EncodeToString(contractAddress) + abiFileSuffix
+	err := ioutil.WriteFile(filepath.Join(dir, fileName), []byte(`{"endpoints": []}`), 0600)
+	require.Nil(t, err)
+
+	args := createArgsAbiRegistry()
+	args.Directory = dir
+	registry, err := NewAbiRegistry(args)
+	require.Nil(t, registry)
+	require.True(t, errors.Is(err, node.ErrInvalidContractAbi))
+}
+
+func TestAbiRegistry_TooManyAbiFilesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createArgsAbiRegistry()
+	args.Directory = dir
+	registry, _ := NewAbiRegistry(args)
+	require.Nil(t, registry.SetContractAbi(contractAddress, []byte(testAbi)))
+	require.Nil(t, registry.SetContractAbi([]byte("second contract with 32 bytes..."), []byte(testAbi)))
+
+	args.MaxNumAbis = 1
+	registry, err := NewAbiRegistry(args)
+	require.Nil(t, registry)
+	require.True(t, errors.Is(err, node.ErrTooManyContractAbis))
+}
@@ -97,6 +97,9 @@ var ErrGenesisBlockNotInitialized = errors.New("genesis block is not initialized
 // ErrNilPeerDenialEvaluator signals that a nil peer denial evaluator was provided
 var ErrNilPeerDenialEvaluator = errors.New("nil peer denial evaluator")
 
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler was provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
 // ErrNilTimeCache signals that a nil time cache was provided
 var ErrNilTimeCache = errors.New("nil time cache")
 
@@ -217,6 +220,21 @@ var ErrNilTxSimulatorProcessor = errors.New("nil transaction simulator processor
 // ErrNilIntermediateProcessorContainer signals that intermediate processors container is nil
 var ErrNilIntermediateProcessorContainer = errors.New("intermediate processor container is nil")
 
+// ErrNilAccountsJournal signals that a nil accounts journal has been provided
+var ErrNilAccountsJournal = errors.New("nil accounts journal")
+
+// ErrNilLogsCollector signals that a nil logs collector has been provided
+var ErrNilLogsCollector = errors.New("nil logs collector")
+
+// ErrNilGasHandler signals that a nil gas handler has been provided
+var ErrNilGasHandler = errors.New("nil gas handler")
+
+// ErrNilEconomicsHandler signals that a nil economics handler has been provided
+var ErrNilEconomicsHandler = errors.New("nil economics handler")
+
+// ErrEmptyTransactionsBatch signals that an empty batch of transactions has been provided
+var ErrEmptyTransactionsBatch = errors.New("empty transactions batch")
+
 // ErrTransactionNotFound signals that a transaction was not found
 var ErrTransactionNotFound = errors.New("transaction not found")
 
@@ -231,3 +249,65 @@ var ErrNilTransactionVersionChecker = errors.New("nil transaction version checke
 
 // ErrNilDataTrie signals that user account has a nil data trie
 var ErrNilDataTrie = errors.New("nil data trie")
+
+// ErrNilTransactionTracer signals that a nil transaction tracer has been provided
+var ErrNilTransactionTracer = errors.New("nil transaction tracer")
+
+// ErrTransactionTracerDisabled signals that the transaction tracer is not enabled on this node
+var ErrTransactionTracerDisabled = errors.New("transaction tracer is disabled")
+
+// ErrDbLookupExtensionsDisabled signals that an operation requiring the db lookup extensions has been requested while
+// the extensions are disabled
+var ErrDbLookupExtensionsDisabled = errors.New("db lookup extensions are disabled")
+
+// ErrTransactionNotTraceable signals that the requested transaction type can not be traced
+var ErrTransactionNotTraceable = errors.New("transaction can not be traced")
+
+// ErrCannotReplayBlockTransaction signals that a transaction executed in the same block before the traced one could not
+// be re-executed
+var ErrCannotReplayBlockTransaction = errors.New("cannot replay block transaction")
+
+// ErrNilSCResultsProcessor signals that a nil smart contract results processor has been provided
+var ErrNilSCResultsProcessor = errors.New("nil smart contract results processor")
+
+// ErrNilBlockChainHook signals that a nil blockchain hook has been provided
+var ErrNilBlockChainHook = errors.New("nil blockchain hook")
+
+// ErrNilCallTracer signals that a nil call tracer has been provided
+var ErrNilCallTracer = errors.New("nil call tracer")
+
+// ErrNilTransactionProcessor signals that a nil transaction processor has been provided
+var ErrNilTransactionProcessor = errors.New("nil transaction processor")
+
+// ErrNilAbiRegistry signals that a nil contracts ABI registry has been provided
+var ErrNilAbiRegistry = errors.New("nil ABI registry")
+
+// ErrContractAbiNotFound signals that no ABI has been registered for the requested contract
+var ErrContractAbiNotFound = errors.New("contract ABI not found")
+
+// ErrAbiEndpointNotFound signals that the contract ABI does not define the requested endpoint
+var ErrAbiEndpointNotFound = errors.New("endpoint not found in the contract ABI")
+
+// ErrContractAbiTooLarge signals that the provided contract ABI exceeds the configured maximum size
+var ErrContractAbiTooLarge = errors.New("contract ABI too large")
+
+// ErrTooManyContractAbis signals that the contracts ABI registry already holds the configured maximum number of ABIs
+var ErrTooManyContractAbis = errors.New("too many contract ABIs")
+
+// ErrInvalidAbiRegistryLimit signals that a zero maximum ABI size or number of ABIs has been provided
+var ErrInvalidAbiRegistryLimit = errors.New("invalid ABI registry limit")
+
+// ErrInvalidContractAbi signals that an invalid contract ABI has been provided
+var ErrInvalidContractAbi = errors.New("invalid contract ABI")
+
+// ErrUnsupportedAbiType signals that a contract ABI uses a type which can not be encoded or decoded
+var ErrUnsupportedAbiType = errors.New("unsupported ABI type")
+
+// ErrInvalidAbiValue signals that a value does not match its ABI type
+var ErrInvalidAbiValue = errors.New("invalid value for ABI type")
+
+// ErrWrongNumberOfAbiValues signals that the number of values does not match the ABI definition
+var ErrWrongNumberOfAbiValues = errors.New("wrong number of values for the ABI definition")
+
+// ErrTooManyStorageKeys signals that the account holds more matching storage keys than a query is allowed to return
+var ErrTooManyStorageKeys = errors.New("too many storage keys, use a longer key prefix")
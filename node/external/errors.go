@@ -13,3 +13,18 @@ var ErrNilTransactionCostHandler = errors.New("nil transaction cost handler")
 
 // ErrNilTotalStakedValueHandler signals that a nil total staked value handler has been provided
 var ErrNilTotalStakedValueHandler = errors.New("nil total staked value handler")
+
+// ErrNilESDTTokensHandler signals that a nil ESDT tokens handler has been provided
+var ErrNilESDTTokensHandler = errors.New("nil ESDT tokens handler")
+
+// ErrNilGovernanceHandler signals that a nil governance handler has been provided
+var ErrNilGovernanceHandler = errors.New("nil governance handler")
+
+// ErrNilDelegationHandler signals that a nil delegation handler has been provided
+var ErrNilDelegationHandler = errors.New("nil delegation handler")
+
+// ErrNilValidatorHandler signals that a nil validator handler has been provided
+var ErrNilValidatorHandler = errors.New("nil validator handler")
+
+// ErrNilUsernameHandler signals that a nil username handler has been provided
+var ErrNilUsernameHandler = errors.New("nil username handler")
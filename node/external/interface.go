@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -11,6 +12,7 @@ import (
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueries(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	IsInterfaceNil() bool
 }
@@ -37,3 +39,42 @@ type TotalStakedValueHandler interface {
 	GetTotalStakedValue() (*big.Int, error)
 	IsInterfaceNil() bool
 }
+
+// ESDTTokensHandler defines the behavior of a component able to return the issued ESDT tokens
+type ESDTTokensHandler interface {
+	GetESDTTokenIdentifiers() ([]string, error)
+	GetESDTToken(identifier string) (*api.ESDTToken, error)
+	IsInterfaceNil() bool
+}
+
+// GovernanceHandler defines the behavior of a component able to return the governance proposals and votes
+type GovernanceHandler interface {
+	GetGovernanceProposals() ([]*api.GovernanceProposal, error)
+	GetGovernanceProposal(id string) (*api.GovernanceProposal, error)
+	GetGovernanceProposalVotes(id string) ([]*api.GovernanceVote, error)
+	GetGovernanceVoteTransaction(id string, vote string, validator string) (*api.GovernanceVoteTransaction, error)
+	IsInterfaceNil() bool
+}
+
+// DelegationHandler defines the behavior of a component able to return the delegation contracts and their delegators
+type DelegationHandler interface {
+	GetDelegationContracts() ([]string, error)
+	GetDelegationContract(contract string) (*api.DelegationContract, error)
+	GetDelegator(contract string, address string) (*api.DelegatorFunds, error)
+	IsInterfaceNil() bool
+}
+
+// ValidatorHandler defines the behavior of a component able to return the staking queue and the status of validators
+type ValidatorHandler interface {
+	GetValidatorQueue() ([]*api.ValidatorQueueEntry, error)
+	GetValidatorKeyStatus(blsKey string) (*api.ValidatorKeyStatus, error)
+	GetValidatorOwner(address string) (*api.ValidatorOwner, error)
+	IsInterfaceNil() bool
+}
+
+// UsernameHandler defines the behavior of a component able to return the owner of a username registered in the DNS
+// smart contracts
+type UsernameHandler interface {
+	ResolveUsername(username string) (*api.UsernameResolution, error)
+	IsInterfaceNil() bool
+}
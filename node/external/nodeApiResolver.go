@@ -5,43 +5,77 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
+// ArgNodeApiResolver represents the DTO structure used in the NewNodeApiResolver constructor
+type ArgNodeApiResolver struct {
+	SCQueryService          SCQueryService
+	StatusMetricsHandler    StatusMetricsHandler
+	TxCostHandler           TransactionCostHandler
+	TotalStakedValueHandler TotalStakedValueHandler
+	ESDTTokensHandler       ESDTTokensHandler
+	GovernanceHandler       GovernanceHandler
+	DelegationHandler       DelegationHandler
+	ValidatorHandler        ValidatorHandler
+	UsernameHandler         UsernameHandler
+}
+
 // NodeApiResolver can resolve API requests
 type NodeApiResolver struct {
 	scQueryService          SCQueryService
 	statusMetricsHandler    StatusMetricsHandler
 	txCostHandler           TransactionCostHandler
 	totalStakedValueHandler TotalStakedValueHandler
+	esdtTokensHandler       ESDTTokensHandler
+	governanceHandler       GovernanceHandler
+	delegationHandler       DelegationHandler
+	validatorHandler        ValidatorHandler
+	usernameHandler         UsernameHandler
 }
 
 // NewNodeApiResolver creates a new NodeApiResolver instance
-func NewNodeApiResolver(
-	scQueryService SCQueryService,
-	statusMetricsHandler StatusMetricsHandler,
-	txCostHandler TransactionCostHandler,
-	totalStakedValueHandler TotalStakedValueHandler,
-) (*NodeApiResolver, error) {
-	if check.IfNil(scQueryService) {
+func NewNodeApiResolver(arg ArgNodeApiResolver) (*NodeApiResolver, error) {
+	if check.IfNil(arg.SCQueryService) {
 		return nil, ErrNilSCQueryService
 	}
-	if check.IfNil(statusMetricsHandler) {
+	if check.IfNil(arg.StatusMetricsHandler) {
 		return nil, ErrNilStatusMetrics
 	}
-	if check.IfNil(txCostHandler) {
+	if check.IfNil(arg.TxCostHandler) {
 		return nil, ErrNilTransactionCostHandler
 	}
-	if check.IfNil(totalStakedValueHandler) {
+	if check.IfNil(arg.TotalStakedValueHandler) {
 		return nil, ErrNilTotalStakedValueHandler
 	}
+	if check.IfNil(arg.ESDTTokensHandler) {
+		return nil, ErrNilESDTTokensHandler
+	}
+	if check.IfNil(arg.GovernanceHandler) {
+		return nil, ErrNilGovernanceHandler
+	}
+	if check.IfNil(arg.DelegationHandler) {
+		return nil, ErrNilDelegationHandler
+	}
+	if check.IfNil(arg.ValidatorHandler) {
+		return nil, ErrNilValidatorHandler
+	}
+	if check.IfNil(arg.UsernameHandler) {
+		return nil, ErrNilUsernameHandler
+	}
 
 	return &NodeApiResolver{
-		scQueryService:          scQueryService,
-		statusMetricsHandler:    statusMetricsHandler,
-		txCostHandler:           txCostHandler,
-		totalStakedValueHandler: totalStakedValueHandler,
+		scQueryService:          arg.SCQueryService,
+		statusMetricsHandler:    arg.StatusMetricsHandler,
+		txCostHandler:           arg.TxCostHandler,
+		totalStakedValueHandler: arg.TotalStakedValueHandler,
+		esdtTokensHandler:       arg.ESDTTokensHandler,
+		governanceHandler:       arg.GovernanceHandler,
+		delegationHandler:       arg.DelegationHandler,
+		validatorHandler:        arg.ValidatorHandler,
+		usernameHandler:         arg.UsernameHandler,
 	}, nil
 }
 
@@ -50,6 +84,11 @@ func (nar *NodeApiResolver) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nar.scQueryService.ExecuteQuery(query)
 }
 
+// ExecuteSCQueries retrieves data stored in SC accounts by running all the provided queries against the same state
+func (nar *NodeApiResolver) ExecuteSCQueries(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error) {
+	return nar.scQueryService.ExecuteQueries(queries)
+}
+
 // StatusMetrics returns an implementation of the StatusMetricsHandler interface
 func (nar *NodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
@@ -65,6 +104,71 @@ func (nar *NodeApiResolver) GetTotalStakedValue() (*big.Int, error) {
 	return nar.totalStakedValueHandler.GetTotalStakedValue()
 }
 
+// GetESDTTokenIdentifiers will return the identifiers of all the issued ESDT tokens
+func (nar *NodeApiResolver) GetESDTTokenIdentifiers() ([]string, error) {
+	return nar.esdtTokensHandler.GetESDTTokenIdentifiers()
+}
+
+// GetESDTToken will return the properties of the provided ESDT token
+func (nar *NodeApiResolver) GetESDTToken(identifier string) (*api.ESDTToken, error) {
+	return nar.esdtTokensHandler.GetESDTToken(identifier)
+}
+
+// GetGovernanceProposals will return all the governance proposals
+func (nar *NodeApiResolver) GetGovernanceProposals() ([]*api.GovernanceProposal, error) {
+	return nar.governanceHandler.GetGovernanceProposals()
+}
+
+// GetGovernanceProposal will return the governance proposal with the provided identifier
+func (nar *NodeApiResolver) GetGovernanceProposal(id string) (*api.GovernanceProposal, error) {
+	return nar.governanceHandler.GetGovernanceProposal(id)
+}
+
+// GetGovernanceProposalVotes will return the votes cast on the provided governance proposal
+func (nar *NodeApiResolver) GetGovernanceProposalVotes(id string) ([]*api.GovernanceVote, error) {
+	return nar.governanceHandler.GetGovernanceProposalVotes(id)
+}
+
+// GetGovernanceVoteTransaction will return the fields of a transaction that votes on the provided governance proposal
+func (nar *NodeApiResolver) GetGovernanceVoteTransaction(id string, vote string, validator string) (*api.GovernanceVoteTransaction, error) {
+	return nar.governanceHandler.GetGovernanceVoteTransaction(id, vote, validator)
+}
+
+// GetDelegationContracts will return the addresses of all the delegation contracts
+func (nar *NodeApiResolver) GetDelegationContracts() ([]string, error) {
+	return nar.delegationHandler.GetDelegationContracts()
+}
+
+// GetDelegationContract will return the configuration, the stake and the nodes of a delegation contract
+func (nar *NodeApiResolver) GetDelegationContract(contract string) (*api.DelegationContract, error) {
+	return nar.delegationHandler.GetDelegationContract(contract)
+}
+
+// GetDelegator will return the funds of a delegator in a delegation contract
+func (nar *NodeApiResolver) GetDelegator(contract string, address string) (*api.DelegatorFunds, error) {
+	return nar.delegationHandler.GetDelegator(contract, address)
+}
+
+// GetValidatorQueue will return the BLS keys waiting in the staking queue
+func (nar *NodeApiResolver) GetValidatorQueue() ([]*api.ValidatorQueueEntry, error) {
+	return nar.validatorHandler.GetValidatorQueue()
+}
+
+// GetValidatorKeyStatus will return the staking status of a BLS key
+func (nar *NodeApiResolver) GetValidatorKeyStatus(blsKey string) (*api.ValidatorKeyStatus, error) {
+	return nar.validatorHandler.GetValidatorKeyStatus(blsKey)
+}
+
+// GetValidatorOwner will return the stake, the top-up and the BLS keys of a validator owner
+func (nar *NodeApiResolver) GetValidatorOwner(address string) (*api.ValidatorOwner, error) {
+	return nar.validatorHandler.GetValidatorOwner(address)
+}
+
+// ResolveUsername will return the address owning the provided username
+func (nar *NodeApiResolver) ResolveUsername(username string) (*api.UsernameResolution, error) {
+	return nar.usernameHandler.ResolveUsername(username)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *NodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
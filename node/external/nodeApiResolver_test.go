@@ -5,18 +5,37 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/systemSCAPI"
 	"github.com/ElrondNetwork/elrond-go/node/totalStakedAPI"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
 )
 
+func createMockArgs() external.ArgNodeApiResolver {
+	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
+
+	return external.ArgNodeApiResolver{
+		SCQueryService:          &mock.SCQueryServiceStub{},
+		StatusMetricsHandler:    &mock.StatusMetricsStub{},
+		TxCostHandler:           &mock.TransactionCostEstimatorMock{},
+		TotalStakedValueHandler: totalStakedAPIHandler,
+		ESDTTokensHandler:       systemSCAPI.NewDisabledESDTTokensProcessor(),
+		GovernanceHandler:       systemSCAPI.NewDisabledGovernanceProcessor(),
+		DelegationHandler:       systemSCAPI.NewDisabledDelegationProcessor(),
+		ValidatorHandler:        systemSCAPI.NewDisabledValidatorProcessor(),
+		UsernameHandler:         &mock.UsernameHandlerStub{},
+	}
+}
+
 func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 	t.Parallel()
 
-	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler)
+	arg := createMockArgs()
+	arg.SCQueryService = nil
+	nar, err := external.NewNodeApiResolver(arg)
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilSCQueryService, err)
@@ -25,8 +44,9 @@ func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 	t.Parallel()
 
-	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler)
+	arg := createMockArgs()
+	arg.StatusMetricsHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilStatusMetrics, err)
@@ -35,8 +55,9 @@ func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 func TestNewNodeApiResolver_NilTransactionCostEstsimator(t *testing.T) {
 	t.Parallel()
 
-	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil, totalStakedAPIHandler)
+	arg := createMockArgs()
+	arg.TxCostHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilTransactionCostHandler, err)
@@ -45,17 +66,73 @@ func TestNewNodeApiResolver_NilTransactionCostEstsimator(t *testing.T) {
 func TestNewNodeApiResolver_NilTotalStakedValueHandler(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, nil)
+	arg := createMockArgs()
+	arg.TotalStakedValueHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilTotalStakedValueHandler, err)
 }
 
+func TestNewNodeApiResolver_NilESDTTokensHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.ESDTTokensHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilESDTTokensHandler, err)
+}
+
+func TestNewNodeApiResolver_NilGovernanceHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.GovernanceHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGovernanceHandler, err)
+}
+
+func TestNewNodeApiResolver_NilDelegationHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.DelegationHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilDelegationHandler, err)
+}
+
+func TestNewNodeApiResolver_NilValidatorHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.ValidatorHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilValidatorHandler, err)
+}
+
+func TestNewNodeApiResolver_NilUsernameHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.UsernameHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilUsernameHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, totalStakedAPIHandler)
+	nar, err := external.NewNodeApiResolver(createMockArgs())
 
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(nar))
@@ -64,17 +141,15 @@ func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	t.Parallel()
 
-	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
 	wasCalled := false
-	nar, _ := external.NewNodeApiResolver(&mock.SCQueryServiceStub{
+	arg := createMockArgs()
+	arg.SCQueryService = &mock.SCQueryServiceStub{
 		ExecuteQueryCalled: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
 			wasCalled = true
 			return &vmcommon.VMOutput{}, nil
 		},
-	},
-		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{},
-		totalStakedAPIHandler,
-	)
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
 
 	_, _ = nar.ExecuteSCQuery(&process.SCQuery{
 		ScAddress: []byte{0},
@@ -84,22 +159,36 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_ExecuteSCQueriesShouldCall(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockArgs()
+	arg.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error) {
+			wasCalled = true
+			return make([]*vmcommon.VMOutput, len(queries)), nil
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	_, _ = nar.ExecuteSCQueries([]*process.SCQuery{{ScAddress: []byte{0}, FuncName: "function"}})
+
+	assert.True(t, wasCalled)
+}
+
 func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
-	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
 	wasCalled := false
-	nar, _ := external.NewNodeApiResolver(
-		&mock.SCQueryServiceStub{},
-		&mock.StatusMetricsStub{
-			StatusMetricsMapWithoutP2PCalled: func() map[string]interface{} {
-				wasCalled = true
-				return nil
-			},
+	arg := createMockArgs()
+	arg.StatusMetricsHandler = &mock.StatusMetricsStub{
+		StatusMetricsMapWithoutP2PCalled: func() map[string]interface{} {
+			wasCalled = true
+			return nil
 		},
-		&mock.TransactionCostEstimatorMock{},
-		totalStakedAPIHandler,
-	)
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
 	assert.True(t, wasCalled)
@@ -108,19 +197,15 @@ func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T)
 func TestNodeApiResolver_StatusP2pMetricsMapShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
-	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
 	wasCalled := false
-	nar, _ := external.NewNodeApiResolver(
-		&mock.SCQueryServiceStub{},
-		&mock.StatusMetricsStub{
-			StatusP2pMetricsMapCalled: func() map[string]interface{} {
-				wasCalled = true
-				return nil
-			},
+	arg := createMockArgs()
+	arg.StatusMetricsHandler = &mock.StatusMetricsStub{
+		StatusP2pMetricsMapCalled: func() map[string]interface{} {
+			wasCalled = true
+			return nil
 		},
-		&mock.TransactionCostEstimatorMock{},
-		totalStakedAPIHandler,
-	)
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
 	assert.True(t, wasCalled)
@@ -129,19 +214,15 @@ func TestNodeApiResolver_StatusP2pMetricsMapShouldBeCalled(t *testing.T) {
 func TestNodeApiResolver_StatusMetricsMapWhitoutP2PShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
-	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
 	wasCalled := false
-	nar, _ := external.NewNodeApiResolver(
-		&mock.SCQueryServiceStub{},
-		&mock.StatusMetricsStub{
-			StatusMetricsMapWithoutP2PCalled: func() map[string]interface{} {
-				wasCalled = true
-				return nil
-			},
+	arg := createMockArgs()
+	arg.StatusMetricsHandler = &mock.StatusMetricsStub{
+		StatusMetricsMapWithoutP2PCalled: func() map[string]interface{} {
+			wasCalled = true
+			return nil
 		},
-		&mock.TransactionCostEstimatorMock{},
-		totalStakedAPIHandler,
-	)
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
 	assert.True(t, wasCalled)
@@ -150,19 +231,15 @@ func TestNodeApiResolver_StatusMetricsMapWhitoutP2PShouldBeCalled(t *testing.T)
 func TestNodeApiResolver_StatusP2PMetricsMapShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
-	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
 	wasCalled := false
-	nar, _ := external.NewNodeApiResolver(
-		&mock.SCQueryServiceStub{},
-		&mock.StatusMetricsStub{
-			StatusP2pMetricsMapCalled: func() map[string]interface{} {
-				wasCalled = true
-				return nil
-			},
+	arg := createMockArgs()
+	arg.StatusMetricsHandler = &mock.StatusMetricsStub{
+		StatusP2pMetricsMapCalled: func() map[string]interface{} {
+			wasCalled = true
+			return nil
 		},
-		&mock.TransactionCostEstimatorMock{},
-		totalStakedAPIHandler,
-	)
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
 	assert.True(t, wasCalled)
@@ -171,20 +248,90 @@ func TestNodeApiResolver_StatusP2PMetricsMapShouldBeCalled(t *testing.T) {
 func TestNodeApiResolver_NetworkMetricsMapShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
-	totalStakedAPIHandler, _ := totalStakedAPI.NewDisabledTotalStakedValueProcessor()
 	wasCalled := false
-	nar, _ := external.NewNodeApiResolver(
-		&mock.SCQueryServiceStub{},
-		&mock.StatusMetricsStub{
-			NetworkMetricsCalled: func() map[string]interface{} {
-				wasCalled = true
-				return nil
-			},
+	arg := createMockArgs()
+	arg.StatusMetricsHandler = &mock.StatusMetricsStub{
+		NetworkMetricsCalled: func() map[string]interface{} {
+			wasCalled = true
+			return nil
 		},
-		&mock.TransactionCostEstimatorMock{},
-		totalStakedAPIHandler,
-	)
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
 	_ = nar.StatusMetrics().NetworkMetrics()
 
 	assert.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_GetESDTTokenShouldBeCalled(t *testing.T) {
+	t.Parallel()
+
+	nar, _ := external.NewNodeApiResolver(createMockArgs())
+
+	token, err := nar.GetESDTToken("TKN-aabbcc")
+	assert.Nil(t, token)
+	assert.Equal(t, systemSCAPI.ErrCannotReturnSystemSCDataFromShardNode, err)
+
+	identifiers, err := nar.GetESDTTokenIdentifiers()
+	assert.Nil(t, identifiers)
+	assert.Equal(t, systemSCAPI.ErrCannotReturnSystemSCDataFromShardNode, err)
+}
+
+func TestNodeApiResolver_GetGovernanceProposalsShouldBeCalled(t *testing.T) {
+	t.Parallel()
+
+	nar, _ := external.NewNodeApiResolver(createMockArgs())
+
+	proposals, err := nar.GetGovernanceProposals()
+	assert.Nil(t, proposals)
+	assert.Equal(t, systemSCAPI.ErrCannotReturnSystemSCDataFromShardNode, err)
+
+	votes, err := nar.GetGovernanceProposalVotes("id")
+	assert.Nil(t, votes)
+	assert.Equal(t, systemSCAPI.ErrCannotReturnSystemSCDataFromShardNode, err)
+}
+
+func TestNodeApiResolver_GetDelegationContractsShouldBeCalled(t *testing.T) {
+	t.Parallel()
+
+	nar, _ := external.NewNodeApiResolver(createMockArgs())
+
+	contracts, err := nar.GetDelegationContracts()
+	assert.Nil(t, contracts)
+	assert.Equal(t, systemSCAPI.ErrCannotReturnSystemSCDataFromShardNode, err)
+
+	delegator, err := nar.GetDelegator("contract", "address")
+	assert.Nil(t, delegator)
+	assert.Equal(t, systemSCAPI.ErrCannotReturnSystemSCDataFromShardNode, err)
+}
+
+func TestNodeApiResolver_GetValidatorQueueShouldBeCalled(t *testing.T) {
+	t.Parallel()
+
+	nar, _ := external.NewNodeApiResolver(createMockArgs())
+
+	queue, err := nar.GetValidatorQueue()
+	assert.Nil(t, queue)
+	assert.Equal(t, systemSCAPI.ErrCannotReturnSystemSCDataFromShardNode, err)
+
+	owner, err := nar.GetValidatorOwner("address")
+	assert.Nil(t, owner)
+	assert.Equal(t, systemSCAPI.ErrCannotReturnSystemSCDataFromShardNode, err)
+}
+
+func TestNodeApiResolver_ResolveUsernameShouldBeCalled(t *testing.T) {
+	t.Parallel()
+
+	expectedResolution := &api.UsernameResolution{Username: "alice.elrond", Address: "address"}
+	arg := createMockArgs()
+	arg.UsernameHandler = &mock.UsernameHandlerStub{
+		ResolveUsernameCalled: func(username string) (*api.UsernameResolution, error) {
+			assert.Equal(t, "alice", username)
+			return expectedResolution, nil
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	resolution, err := nar.ResolveUsername("alice")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResolution, resolution)
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -83,3 +85,20 @@ type HeartbeatHandler interface {
 	Sender() *process.Sender
 	IsInterfaceNil() bool
 }
+
+// TransactionTracer defines the component able to re-execute a historical transaction and to record its call tree
+type TransactionTracer interface {
+	TraceTransaction(
+		tx data.TransactionHandler,
+		precedingTxs []data.TransactionHandler,
+		header data.HeaderHandler,
+		parentHeader data.HeaderHandler,
+	) (*transaction.TraceResults, error)
+	IsInterfaceNil() bool
+}
+
+// AbiRegistry defines the component able to decode the data of the transactions sent to contracts with a known ABI
+type AbiRegistry interface {
+	DecodeCallData(address []byte, data []byte) (*transaction.DecodedCallDataApi, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// AbiRegistryStub -
+type AbiRegistryStub struct {
+	DecodeCallDataCalled func(address []byte, data []byte) (*transaction.DecodedCallDataApi, error)
+}
+
+// DecodeCallData -
+func (ars *AbiRegistryStub) DecodeCallData(address []byte, data []byte) (*transaction.DecodedCallDataApi, error) {
+	if ars.DecodeCallDataCalled != nil {
+		return ars.DecodeCallDataCalled(address, data)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (ars *AbiRegistryStub) IsInterfaceNil() bool {
+	return ars == nil
+}
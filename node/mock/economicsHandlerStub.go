@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// EconomicsHandlerStub -
+type EconomicsHandlerStub struct {
+	SplitTxGasInCategoriesCalled func(tx process.TransactionWithFeeHandler) (uint64, uint64)
+}
+
+// SplitTxGasInCategories -
+func (ehs *EconomicsHandlerStub) SplitTxGasInCategories(tx process.TransactionWithFeeHandler) (uint64, uint64) {
+	if ehs.SplitTxGasInCategoriesCalled != nil {
+		return ehs.SplitTxGasInCategoriesCalled(tx)
+	}
+
+	return 0, 0
+}
+
+// IsInterfaceNil -
+func (ehs *EconomicsHandlerStub) IsInterfaceNil() bool {
+	return ehs == nil
+}
@@ -0,0 +1,28 @@
+package mock
+
+// GasHandlerStub -
+type GasHandlerStub struct {
+	InitCalled             func()
+	TotalGasRefundedCalled func() uint64
+}
+
+// Init -
+func (ghs *GasHandlerStub) Init() {
+	if ghs.InitCalled != nil {
+		ghs.InitCalled()
+	}
+}
+
+// TotalGasRefunded -
+func (ghs *GasHandlerStub) TotalGasRefunded() uint64 {
+	if ghs.TotalGasRefundedCalled != nil {
+		return ghs.TotalGasRefundedCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (ghs *GasHandlerStub) IsInterfaceNil() bool {
+	return ghs == nil
+}
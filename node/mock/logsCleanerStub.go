@@ -0,0 +1,18 @@
+package mock
+
+// LogsCleanerStub -
+type LogsCleanerStub struct {
+	CleanCalled func()
+}
+
+// Clean -
+func (lcs *LogsCleanerStub) Clean() {
+	if lcs.CleanCalled != nil {
+		lcs.CleanCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (lcs *LogsCleanerStub) IsInterfaceNil() bool {
+	return lcs == nil
+}
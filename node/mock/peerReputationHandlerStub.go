@@ -0,0 +1,72 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// PeerReputationHandlerStub -
+type PeerReputationHandlerStub struct {
+	RecordPeerIDOffenceCalled    func(pid core.PeerID, span time.Duration) time.Duration
+	RecordPublicKeyOffenceCalled func(pk string, span time.Duration) time.Duration
+	PeerIDReputationCalled       func(pid core.PeerID) core.P2PPeerReputation
+	PublicKeyReputationCalled    func(pk string) core.P2PPeerReputation
+	RestoreBansCalled            func(peerBlackListCacher process.PeerBlackListCacher, publicKeysCacher process.TimeCacher) error
+}
+
+// RecordPeerIDOffence -
+func (prhs *PeerReputationHandlerStub) RecordPeerIDOffence(pid core.PeerID, span time.Duration) time.Duration {
+	if prhs.RecordPeerIDOffenceCalled != nil {
+		return prhs.RecordPeerIDOffenceCalled(pid, span)
+	}
+
+	return span
+}
+
+// RecordPublicKeyOffence -
+func (prhs *PeerReputationHandlerStub) RecordPublicKeyOffence(pk string, span time.Duration) time.Duration {
+	if prhs.RecordPublicKeyOffenceCalled != nil {
+		return prhs.RecordPublicKeyOffenceCalled(pk, span)
+	}
+
+	return span
+}
+
+// PeerIDReputation -
+func (prhs *PeerReputationHandlerStub) PeerIDReputation(pid core.PeerID) core.P2PPeerReputation {
+	if prhs.PeerIDReputationCalled != nil {
+		return prhs.PeerIDReputationCalled(pid)
+	}
+
+	return core.P2PPeerReputation{}
+}
+
+// PublicKeyReputation -
+func (prhs *PeerReputationHandlerStub) PublicKeyReputation(pk string) core.P2PPeerReputation {
+	if prhs.PublicKeyReputationCalled != nil {
+		return prhs.PublicKeyReputationCalled(pk)
+	}
+
+	return core.P2PPeerReputation{}
+}
+
+// RestoreBans -
+func (prhs *PeerReputationHandlerStub) RestoreBans(peerBlackListCacher process.PeerBlackListCacher, publicKeysCacher process.TimeCacher) error {
+	if prhs.RestoreBansCalled != nil {
+		return prhs.RestoreBansCalled(peerBlackListCacher, publicKeysCacher)
+	}
+
+	return nil
+}
+
+// Close -
+func (prhs *PeerReputationHandlerStub) Close() error {
+	return nil
+}
+
+// IsInterfaceNil -
+func (prhs *PeerReputationHandlerStub) IsInterfaceNil() bool {
+	return prhs == nil
+}
@@ -9,6 +9,7 @@ import (
 // SCQueryServiceStub -
 type SCQueryServiceStub struct {
 	ExecuteQueryCalled           func(*process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueriesCalled         func([]*process.SCQuery) ([]*vmcommon.VMOutput, error)
 	ComputeScCallGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 }
 
@@ -17,6 +18,11 @@ func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vm
 	return serviceStub.ExecuteQueryCalled(query)
 }
 
+// ExecuteQueries -
+func (serviceStub *SCQueryServiceStub) ExecuteQueries(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error) {
+	return serviceStub.ExecuteQueriesCalled(queries)
+}
+
 // ComputeScCallGasLimit -
 func (serviceStub *SCQueryServiceStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return serviceStub.ComputeScCallGasLimitHandler(tx)
@@ -0,0 +1,30 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// TransactionTracerStub -
+type TransactionTracerStub struct {
+	TraceTransactionCalled func(tx data.TransactionHandler, precedingTxs []data.TransactionHandler, header data.HeaderHandler, parentHeader data.HeaderHandler) (*transaction.TraceResults, error)
+}
+
+// TraceTransaction -
+func (tts *TransactionTracerStub) TraceTransaction(
+	tx data.TransactionHandler,
+	precedingTxs []data.TransactionHandler,
+	header data.HeaderHandler,
+	parentHeader data.HeaderHandler,
+) (*transaction.TraceResults, error) {
+	if tts.TraceTransactionCalled != nil {
+		return tts.TraceTransactionCalled(tx, precedingTxs, header, parentHeader)
+	}
+
+	return &transaction.TraceResults{}, nil
+}
+
+// IsInterfaceNil -
+func (tts *TransactionTracerStub) IsInterfaceNil() bool {
+	return tts == nil
+}
@@ -17,6 +17,7 @@ type FeeHandlerStub struct {
 	CheckValidityTxValuesCalled   func(tx process.TransactionWithFeeHandler) error
 	DeveloperPercentageCalled     func() float64
 	MinGasPriceCalled             func() uint64
+	CurrentMinGasPriceCalled      func() uint64
 	GasPriceModifierCalled        func() float64
 	ComputeFeeForProcessingCalled func(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int
 	GenesisTotalSupplyCalled      func() *big.Int
@@ -38,6 +39,14 @@ func (fhs *FeeHandlerStub) GasPriceModifier() float64 {
 	return 1.0
 }
 
+// CurrentMinGasPrice -
+func (fhs *FeeHandlerStub) CurrentMinGasPrice() uint64 {
+	if fhs.CurrentMinGasPriceCalled != nil {
+		return fhs.CurrentMinGasPriceCalled()
+	}
+	return 0
+}
+
 // MinGasPrice -
 func (fhs *FeeHandlerStub) MinGasPrice() uint64 {
 	if fhs.MinGasPriceCalled != nil {
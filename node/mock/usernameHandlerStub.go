@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+// UsernameHandlerStub -
+type UsernameHandlerStub struct {
+	ResolveUsernameCalled func(username string) (*api.UsernameResolution, error)
+}
+
+// ResolveUsername -
+func (uhs *UsernameHandlerStub) ResolveUsername(username string) (*api.UsernameResolution, error) {
+	if uhs.ResolveUsernameCalled != nil {
+		return uhs.ResolveUsernameCalled(username)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (uhs *UsernameHandlerStub) IsInterfaceNil() bool {
+	return uhs == nil
+}
@@ -50,6 +50,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/sync/storageBootstrap"
+	disabledAntiflood "github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -94,6 +95,7 @@ type Node struct {
 	interceptorsContainer         process.InterceptorsContainer
 	resolversFinder               dataRetriever.ResolversFinder
 	peerDenialEvaluator           p2p.PeerDenialEvaluator
+	peerReputationHandler         process.PeerReputationHandler
 	appStatusHandler              core.AppStatusHandler
 	validatorStatistics           process.ValidatorStatisticsProcessor
 	hardforkTrigger               HardforkTrigger
@@ -163,6 +165,9 @@ type Node struct {
 
 	watchdog          core.WatchdogTimer
 	historyRepository dblookupext.HistoryRepository
+	txTracer          TransactionTracer
+	abiRegistry       AbiRegistry
+	maxNumStorageKeys uint32
 
 	enableSignTxWithHashEpoch uint32
 	txSignHasher              hashing.Hasher
@@ -188,6 +193,8 @@ func NewNode(opts ...Option) (*Node, error) {
 		currentSendingGoRoutines: 0,
 		appStatusHandler:         statusHandler.NewNilStatusHandler(),
 		queryHandlers:            make(map[string]debug.QueryHandler),
+		peerReputationHandler:    &disabledAntiflood.PeerReputationHandler{},
+		maxNumStorageKeys:        defaultMaxNumStorageKeys,
 	}
 	for _, opt := range opts {
 		err := opt(node)
@@ -1319,6 +1326,7 @@ func (n *Node) createPidInfo(p core.PeerID) core.QueryP2PPeerInfo {
 		Pid:           p.Pretty(),
 		Addresses:     n.messenger.PeerAddresses(p),
 		IsBlacklisted: n.peerDenialEvaluator.IsDenied(p),
+		PidReputation: n.peerReputationHandler.PeerIDReputation(p),
 	}
 
 	peerInfo := n.networkShardingCollector.GetPeerInfo(p)
@@ -1327,6 +1335,7 @@ func (n *Node) createPidInfo(p core.PeerID) core.QueryP2PPeerInfo {
 		result.Pk = ""
 	} else {
 		result.Pk = n.validatorPubkeyConverter.Encode(peerInfo.PkBytes)
+		result.PkReputation = n.peerReputationHandler.PublicKeyReputation(string(peerInfo.PkBytes))
 	}
 
 	return result
@@ -0,0 +1,97 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+const defaultMaxNumStorageKeys = 50000
+
+// GetKeyValuePairs returns at most size key-value pairs stored by the provided address under keys starting with the
+// provided hex encoded prefix, skipping the first from pairs. The pairs are sorted by key. As all the matching pairs
+// are collected before sorting, an error is returned if there are more than the configured maximum number of keys
+func (n *Node) GetKeyValuePairs(address string, prefix string, from uint64, size uint64) (*api.AccountStorage, error) {
+	prefixBytes, err := hex.DecodeString(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key prefix: %w", err)
+	}
+
+	account, err := n.getAccountHandler(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	accountStorage := &api.AccountStorage{
+		Address: address,
+		Pairs:   make([]*api.StorageKeyValue, 0),
+	}
+	if check.IfNil(userAccount.DataTrie()) {
+		return accountStorage, nil
+	}
+
+	pairs, err := n.getAllKeyValuePairs(userAccount.AddressBytes(), userAccount.DataTrie(), prefixBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].Key < pairs[j].Key
+	})
+
+	accountStorage.Total = uint64(len(pairs))
+	for i := from; i < accountStorage.Total && i-from < size; i++ {
+		accountStorage.Pairs = append(accountStorage.Pairs, pairs[i])
+	}
+
+	return accountStorage, nil
+}
+
+func (n *Node) getAllKeyValuePairs(address []byte, dataTrie data.Trie, prefix []byte) ([]*api.StorageKeyValue, error) {
+	rootHash, err := dataTrie.Root()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chLeaves, err := dataTrie.GetAllLeavesOnChannel(rootHash, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make([]*api.StorageKeyValue, 0)
+	for leaf := range chLeaves {
+		if !bytes.HasPrefix(leaf.Key(), prefix) {
+			continue
+		}
+		if uint32(len(pairs)) >= n.maxNumStorageKeys {
+			return nil, fmt.Errorf("%w: maximum %d keys", ErrTooManyStorageKeys, n.maxNumStorageKeys)
+		}
+
+		suffix := append(append(make([]byte, 0, len(leaf.Key())+len(address)), leaf.Key()...), address...)
+		value, errTrim := leaf.ValueWithoutSuffix(suffix)
+		if errTrim != nil {
+			return nil, fmt.Errorf("%w for key %s", errTrim, hex.EncodeToString(leaf.Key()))
+		}
+
+		pairs = append(pairs, &api.StorageKeyValue{
+			Key:   hex.EncodeToString(leaf.Key()),
+			Value: hex.EncodeToString(value),
+		})
+	}
+
+	return pairs, nil
+}
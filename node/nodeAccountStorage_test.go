@@ -0,0 +1,109 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithAccountStorage(t *testing.T, storage map[string]string, opts ...node.Option) *node.Node {
+	address := []byte("newaddress")
+	acc, _ := state.NewUserAccount(address)
+	acc.DataTrieTracker().SetDataTrie(
+		&mock.TrieStub{
+			GetAllLeavesOnChannelCalled: func(rootHash []byte) (chan core.KeyValueHolder, error) {
+				ch := make(chan core.KeyValueHolder, len(storage))
+				for key, value := range storage {
+					storedValue := append(append([]byte(value), key...), address...)
+					ch <- keyValStorage.NewKeyValStorage([]byte(key), storedValue)
+				}
+				close(ch)
+
+				return ch, nil
+			},
+		})
+
+	accDB := &mock.AccountsStub{
+		GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+			return acc, nil
+		},
+	}
+
+	opts = append(opts,
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accDB),
+	)
+	n, err := node.NewNode(opts...)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetKeyValuePairsShouldReturnSortedPages(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithAccountStorage(t, map[string]string{
+		"key3":  "value3",
+		"key1":  "value1",
+		"other": "value",
+		"key2":  "value2",
+	})
+
+	storage, err := n.GetKeyValuePairs(createDummyHexAddress(64), "", 0, 10)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(4), storage.Total)
+	require.Len(t, storage.Pairs, 4)
+	assert.Equal(t, hex.EncodeToString([]byte("key1")), storage.Pairs[0].Key)
+	assert.Equal(t, hex.EncodeToString([]byte("value1")), storage.Pairs[0].Value)
+	assert.Equal(t, hex.EncodeToString([]byte("other")), storage.Pairs[3].Key)
+
+	storage, err = n.GetKeyValuePairs(createDummyHexAddress(64), hex.EncodeToString([]byte("key")), 1, 5)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(3), storage.Total)
+	assert.Equal(t, []*api.StorageKeyValue{
+		{Key: hex.EncodeToString([]byte("key2")), Value: hex.EncodeToString([]byte("value2"))},
+		{Key: hex.EncodeToString([]byte("key3")), Value: hex.EncodeToString([]byte("value3"))},
+	}, storage.Pairs)
+
+	storage, err = n.GetKeyValuePairs(createDummyHexAddress(64), "", 10, 5)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(4), storage.Total)
+	assert.Empty(t, storage.Pairs)
+}
+
+func TestNode_GetKeyValuePairsTooManyKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithAccountStorage(t, map[string]string{
+		"key1":  "value1",
+		"key2":  "value2",
+		"other": "value",
+	}, node.WithMaxNumStorageKeys(1))
+
+	storage, err := n.GetKeyValuePairs(createDummyHexAddress(64), "", 0, 10)
+	assert.Nil(t, storage)
+	assert.True(t, errors.Is(err, node.ErrTooManyStorageKeys))
+
+	storage, err = n.GetKeyValuePairs(createDummyHexAddress(64), hex.EncodeToString([]byte("other")), 0, 10)
+	require.Nil(t, err)
+	assert.Len(t, storage.Pairs, 1)
+}
+
+func TestNode_GetKeyValuePairsInvalidPrefixShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithAccountStorage(t, map[string]string{})
+
+	storage, err := n.GetKeyValuePairs(createDummyHexAddress(64), "zz", 0, 10)
+	assert.Nil(t, storage)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,43 @@
+package node
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// GetBalanceHistory returns at most size balance changes of the provided address, starting with the one at index from.
+// The changes are read from the node's own storage and are sorted from the oldest to the newest
+func (n *Node) GetBalanceHistory(address string, from uint64, size uint64) (*api.BalanceHistory, error) {
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := n.historyRepository.GetBalanceHistory(addressBytes, from, size)
+	if err != nil {
+		return nil, err
+	}
+
+	apiHistory := &api.BalanceHistory{
+		Address: address,
+		Total:   history.Total,
+		Changes: make([]*api.BalanceChange, 0, len(history.Changes)),
+	}
+	for _, change := range history.Changes {
+		apiHistory.Changes = append(apiHistory.Changes, &api.BalanceChange{
+			Balance:    change.Balance,
+			Nonce:      change.Nonce,
+			BlockNonce: change.BlockNonce,
+			BlockHash:  hex.EncodeToString(change.BlockHash),
+			TxHash:     hex.EncodeToString(change.TxHash),
+		})
+	}
+
+	return apiHistory, nil
+}
@@ -0,0 +1,71 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetBalanceHistoryInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
+	)
+
+	history, err := n.GetBalanceHistory("invalid address", 0, 10)
+	assert.Nil(t, history)
+	assert.Error(t, err)
+}
+
+func TestNode_GetBalanceHistoryNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
+	)
+
+	history, err := n.GetBalanceHistory(createDummyHexAddress(64), 0, 10)
+	assert.Nil(t, history)
+	assert.Equal(t, dblookupext.ErrBalanceHistoryNotEnabled, err)
+}
+
+func TestNode_GetBalanceHistoryShouldWork(t *testing.T) {
+	t.Parallel()
+
+	address := createDummyHexAddress(64)
+	historyRepo := &testscommon.HistoryRepositoryStub{
+		GetBalanceHistoryCalled: func(addressBytes []byte, from uint64, size uint64) (*dblookupext.BalanceHistory, error) {
+			assert.Equal(t, address, hex.EncodeToString(addressBytes))
+			assert.Equal(t, uint64(2), from)
+			assert.Equal(t, uint64(5), size)
+
+			return &dblookupext.BalanceHistory{
+				Changes: []*dblookupext.BalanceChange{{Balance: "10", Nonce: 1, BlockNonce: 4, TxHash: []byte("tx")}},
+				Total:   3,
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithHistoryRepository(historyRepo),
+	)
+
+	history, err := n.GetBalanceHistory(address, 2, 5)
+	require.Nil(t, err)
+
+	expectedHistory := &api.BalanceHistory{
+		Address: address,
+		Total:   3,
+		Changes: []*api.BalanceChange{{Balance: "10", Nonce: 1, BlockNonce: 4, TxHash: hex.EncodeToString([]byte("tx"))}},
+	}
+	assert.Equal(t, expectedHistory, history)
+}
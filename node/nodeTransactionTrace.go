@@ -0,0 +1,214 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+type hashedTransaction struct {
+	hash []byte
+	tx   data.TransactionHandler
+}
+
+// TraceTransaction re-executes a historical transaction or smart contract result on top of the state committed by the
+// parent of the block which included it and returns the call tree of the smart contract executions it triggered
+func (n *Node) TraceTransaction(txHash string) (*transaction.TraceResults, error) {
+	if check.IfNil(n.txTracer) {
+		return nil, ErrTransactionTracerDisabled
+	}
+	if !n.historyRepository.IsEnabled() {
+		return nil, ErrDbLookupExtensionsDisabled
+	}
+
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	miniblockMetadata, err := n.historyRepository.GetMiniblockMetadataByTxHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrTransactionNotFound.Error(), err)
+	}
+
+	txBytes, txType, found := n.getTxBytesFromStorageByEpoch(hash, miniblockMetadata.Epoch)
+	if !found {
+		return nil, ErrCannotRetrieveTransaction
+	}
+
+	tx, err := n.unmarshalTraceableTransaction(txBytes, txType)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := n.getHeaderFromStorage(miniblockMetadata.HeaderHash, miniblockMetadata.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	parentHeader, err := n.getHeaderFromStorage(header.GetPrevHash(), header.GetEpoch())
+	if err != nil {
+		return nil, err
+	}
+
+	precedingTxs, err := n.getPrecedingBlockTransactions(hash, header, miniblockMetadata.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := n.txTracer.TraceTransaction(tx, precedingTxs, header, parentHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	results.Hash = txHash
+	results.BlockHash = hex.EncodeToString(miniblockMetadata.HeaderHash)
+	results.BlockNonce = header.GetNonce()
+	results.ParentRootHash = hex.EncodeToString(parentHeader.GetRootHash())
+
+	return results, nil
+}
+
+func (n *Node) unmarshalTraceableTransaction(txBytes []byte, txType transaction.TxType) (data.TransactionHandler, error) {
+	switch txType {
+	case transaction.TxTypeNormal:
+		tx := &transaction.Transaction{}
+		err := n.internalMarshalizer.Unmarshal(tx, txBytes)
+		return tx, err
+	case transaction.TxTypeUnsigned:
+		scr := &smartContractResult.SmartContractResult{}
+		err := n.internalMarshalizer.Unmarshal(scr, txBytes)
+		return scr, err
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrTransactionNotTraceable, txType)
+	}
+}
+
+// getPrecedingBlockTransactions returns the transactions and smart contract results the block executed before the
+// provided one, in the order the block processing did: the cross shard miniblocks with destination in this shard, in
+// the order of the block, followed by the transactions sent from this shard, sorted by sender and nonce. Rewards and
+// peer changes are not replayed
+func (n *Node) getPrecedingBlockTransactions(
+	txHash []byte,
+	header data.HeaderHandler,
+	epoch uint32,
+) ([]data.TransactionHandler, error) {
+	selfShardID := n.shardCoordinator.SelfId()
+	txsToMe := make([]*hashedTransaction, 0)
+	txsFromMe := make([]*hashedTransaction, 0)
+	for _, miniblockHash := range header.GetMiniBlockHeadersHashes() {
+		miniblock, err := n.getMiniblockFromStorage(miniblockHash, epoch)
+		if err != nil {
+			return nil, err
+		}
+
+		isFromMe := miniblock.SenderShardID == selfShardID
+		isTxMiniblockFromMe := isFromMe && (miniblock.Type == block.TxBlock || miniblock.Type == block.InvalidBlock)
+		isExecutedMiniblockToMe := !isFromMe && miniblock.ReceiverShardID == selfShardID &&
+			(miniblock.Type == block.TxBlock || miniblock.Type == block.SmartContractResultBlock)
+		if !isTxMiniblockFromMe && !isExecutedMiniblockToMe {
+			continue
+		}
+
+		txs, err := n.getMiniblockTransactions(miniblock, epoch)
+		if err != nil {
+			return nil, err
+		}
+
+		if isFromMe {
+			txsFromMe = append(txsFromMe, txs...)
+			continue
+		}
+		txsToMe = append(txsToMe, txs...)
+	}
+
+	sort.Slice(txsFromMe, func(i, j int) bool {
+		delta := bytes.Compare(txsFromMe[i].tx.GetSndAddr(), txsFromMe[j].tx.GetSndAddr())
+		if delta == 0 {
+			return txsFromMe[i].tx.GetNonce() < txsFromMe[j].tx.GetNonce()
+		}
+
+		return delta < 0
+	})
+
+	precedingTxs := make([]data.TransactionHandler, 0)
+	for _, hashedTx := range append(txsToMe, txsFromMe...) {
+		if bytes.Equal(hashedTx.hash, txHash) {
+			return precedingTxs, nil
+		}
+
+		precedingTxs = append(precedingTxs, hashedTx.tx)
+	}
+
+	return nil, fmt.Errorf("%w: it was not executed on its own in this shard's block", ErrTransactionNotTraceable)
+}
+
+func (n *Node) getMiniblockTransactions(miniblock *block.MiniBlock, epoch uint32) ([]*hashedTransaction, error) {
+	txs := make([]*hashedTransaction, 0, len(miniblock.TxHashes))
+	for _, txHash := range miniblock.TxHashes {
+		txBytes, txType, found := n.getTxBytesFromStorageByEpoch(txHash, epoch)
+		if !found {
+			return nil, fmt.Errorf("%w: %s", ErrCannotRetrieveTransaction, hex.EncodeToString(txHash))
+		}
+
+		tx, err := n.unmarshalTraceableTransaction(txBytes, txType)
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, &hashedTransaction{hash: txHash, tx: tx})
+	}
+
+	return txs, nil
+}
+
+func (n *Node) getMiniblockFromStorage(hash []byte, epoch uint32) (*block.MiniBlock, error) {
+	miniblockBytes, err := n.store.GetStorer(dataRetriever.MiniBlockUnit).GetFromEpoch(hash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	miniblock := &block.MiniBlock{}
+	err = n.internalMarshalizer.Unmarshal(miniblock, miniblockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniblock, nil
+}
+
+// getHeaderFromStorage loads a header of this shard, looking for it in the storage of the provided epoch first and
+// then in the storage of the previous epoch, as the parent of an epoch start block belongs to the previous epoch
+func (n *Node) getHeaderFromStorage(hash []byte, epoch uint32) (data.HeaderHandler, error) {
+	unit := dataRetriever.BlockHeaderUnit
+	var header data.HeaderHandler = &block.Header{}
+	if n.shardCoordinator.SelfId() == core.MetachainShardId {
+		unit = dataRetriever.MetaBlockUnit
+		header = &block.MetaBlock{}
+	}
+
+	storer := n.store.GetStorer(unit)
+	headerBytes, err := storer.GetFromEpoch(hash, epoch)
+	if err != nil && epoch > 0 {
+		headerBytes, err = storer.GetFromEpoch(hash, epoch-1)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = n.internalMarshalizer.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
@@ -0,0 +1,165 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_TraceTransactionTracerDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _, _, _ := createNode(t, 0, true)
+
+	results, err := n.TraceTransaction(hex.EncodeToString([]byte("a")))
+	require.Nil(t, results)
+	require.Equal(t, ErrTransactionTracerDisabled, err)
+}
+
+func TestNode_TraceTransactionDbLookupExtensionsDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _, _, _ := createNode(t, 0, false)
+	_ = n.ApplyOptions(WithTransactionTracer(&mock.TransactionTracerStub{}))
+
+	results, err := n.TraceTransaction(hex.EncodeToString([]byte("a")))
+	require.Nil(t, results)
+	require.Equal(t, ErrDbLookupExtensionsDisabled, err)
+}
+
+func TestNode_TraceTransactionRewardTxShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, historyRepo := createNode(t, 0, true)
+	_ = n.ApplyOptions(WithTransactionTracer(&mock.TransactionTracerStub{}))
+	setupGetMiniblockMetadataByTxHash(historyRepo, block.RewardsBlock, 1, 1, 0, []byte("header"), 2)
+	_ = chainStorer.Rewards.Put([]byte("a"), []byte("reward"))
+
+	results, err := n.TraceTransaction(hex.EncodeToString([]byte("a")))
+	require.Nil(t, results)
+	require.True(t, errors.Is(err, ErrTransactionNotTraceable))
+}
+
+func putTraceBlockData(t *testing.T, n *Node, chainStorer *genericmocks.ChainStorerMock, txs map[string]*transaction.Transaction, miniblocks ...*block.MiniBlock) *block.Header {
+	for hash, tx := range txs {
+		err := chainStorer.Transactions.PutWithMarshalizer([]byte(hash), tx, n.internalMarshalizer)
+		require.Nil(t, err)
+	}
+
+	header := &block.Header{Nonce: 2, Epoch: 1, PrevHash: []byte("parent")}
+	for i, miniblock := range miniblocks {
+		miniblockHash := []byte(fmt.Sprintf("miniblock%d", i))
+		err := chainStorer.HdrNonce.PutWithMarshalizer(miniblockHash, miniblock, n.internalMarshalizer)
+		require.Nil(t, err)
+		header.MiniBlockHeaders = append(header.MiniBlockHeaders, block.MiniBlockHeader{Hash: miniblockHash})
+	}
+	err := chainStorer.HdrNonce.PutWithMarshalizer([]byte("header"), header, n.internalMarshalizer)
+	require.Nil(t, err)
+
+	return header
+}
+
+func TestNode_TraceTransactionShouldTraceOnTheParentHeader(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, historyRepo := createNode(t, 1, true)
+	setupGetMiniblockMetadataByTxHash(historyRepo, block.TxBlock, 1, 1, 1, []byte("header"), 2)
+
+	tx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("alice")}
+	header := putTraceBlockData(t, n, chainStorer,
+		map[string]*transaction.Transaction{"a": tx},
+		&block.MiniBlock{TxHashes: [][]byte{[]byte("a")}, SenderShardID: 1, ReceiverShardID: 1, Type: block.TxBlock},
+	)
+	parentHeader := &block.Header{Nonce: 1, Epoch: 1, RootHash: []byte("root hash")}
+	_ = chainStorer.HdrNonce.PutWithMarshalizer([]byte("parent"), parentHeader, n.internalMarshalizer)
+
+	_ = n.ApplyOptions(WithTransactionTracer(&mock.TransactionTracerStub{
+		TraceTransactionCalled: func(
+			tracedTx data.TransactionHandler,
+			precedingTxs []data.TransactionHandler,
+			hdr data.HeaderHandler,
+			parentHdr data.HeaderHandler,
+		) (*transaction.TraceResults, error) {
+			require.Equal(t, tx, tracedTx)
+			require.Empty(t, precedingTxs)
+			require.Equal(t, header.Nonce, hdr.GetNonce())
+			require.Equal(t, parentHeader.RootHash, parentHdr.GetRootHash())
+
+			return &transaction.TraceResults{Status: transaction.TxStatusSuccess}, nil
+		},
+	}))
+
+	results, err := n.TraceTransaction(hex.EncodeToString([]byte("a")))
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString([]byte("a")), results.Hash)
+	require.Equal(t, hex.EncodeToString([]byte("header")), results.BlockHash)
+	require.Equal(t, uint64(2), results.BlockNonce)
+	require.Equal(t, hex.EncodeToString([]byte("root hash")), results.ParentRootHash)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+}
+
+func TestNode_TraceTransactionShouldProvideThePrecedingTransactionsInProcessingOrder(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, historyRepo := createNode(t, 1, true)
+	setupGetMiniblockMetadataByTxHash(historyRepo, block.TxBlock, 1, 1, 1, []byte("header"), 2)
+
+	tracedTx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice")}
+	previousTxFromMe := &transaction.Transaction{Nonce: 6, SndAddr: []byte("alice")}
+	nextTxFromMe := &transaction.Transaction{Nonce: 8, SndAddr: []byte("alice")}
+	txToMe := &transaction.Transaction{Nonce: 3, SndAddr: []byte("bob")}
+	_ = chainStorer.Rewards.Put([]byte("r"), []byte("reward"))
+	_ = putTraceBlockData(t, n, chainStorer,
+		map[string]*transaction.Transaction{"a": tracedTx, "b": previousTxFromMe, "c": nextTxFromMe, "d": txToMe},
+		&block.MiniBlock{TxHashes: [][]byte{[]byte("c"), []byte("a")}, SenderShardID: 1, ReceiverShardID: 1, Type: block.TxBlock},
+		&block.MiniBlock{TxHashes: [][]byte{[]byte("b")}, SenderShardID: 1, ReceiverShardID: 0, Type: block.TxBlock},
+		&block.MiniBlock{TxHashes: [][]byte{[]byte("r")}, SenderShardID: core.MetachainShardId, ReceiverShardID: 1, Type: block.RewardsBlock},
+		&block.MiniBlock{TxHashes: [][]byte{[]byte("d")}, SenderShardID: 0, ReceiverShardID: 1, Type: block.TxBlock},
+	)
+	_ = chainStorer.HdrNonce.PutWithMarshalizer([]byte("parent"), &block.Header{}, n.internalMarshalizer)
+
+	_ = n.ApplyOptions(WithTransactionTracer(&mock.TransactionTracerStub{
+		TraceTransactionCalled: func(
+			tx data.TransactionHandler,
+			precedingTxs []data.TransactionHandler,
+			_ data.HeaderHandler,
+			_ data.HeaderHandler,
+		) (*transaction.TraceResults, error) {
+			require.Equal(t, tracedTx, tx)
+			require.Equal(t, []data.TransactionHandler{txToMe, previousTxFromMe}, precedingTxs)
+
+			return &transaction.TraceResults{}, nil
+		},
+	}))
+
+	_, err := n.TraceTransaction(hex.EncodeToString([]byte("a")))
+	require.Nil(t, err)
+}
+
+func TestNode_TraceTransactionNotExecutedInItsBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, historyRepo := createNode(t, 1, true)
+	setupGetMiniblockMetadataByTxHash(historyRepo, block.TxBlock, 1, 0, 1, []byte("header"), 2)
+
+	tx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice")}
+	_ = putTraceBlockData(t, n, chainStorer,
+		map[string]*transaction.Transaction{"a": tx},
+		&block.MiniBlock{TxHashes: [][]byte{[]byte("a")}, SenderShardID: 1, ReceiverShardID: 0, Type: block.ReceiptBlock},
+	)
+	_ = chainStorer.HdrNonce.PutWithMarshalizer([]byte("parent"), &block.Header{}, n.internalMarshalizer)
+	_ = n.ApplyOptions(WithTransactionTracer(&mock.TransactionTracerStub{}))
+
+	results, err := n.TraceTransaction(hex.EncodeToString([]byte("a")))
+	require.Nil(t, results)
+	require.True(t, errors.Is(err, ErrTransactionNotTraceable))
+}
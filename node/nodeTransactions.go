@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	rewardTxData "github.com/ElrondNetwork/elrond-go/data/rewardTx"
@@ -21,6 +22,17 @@ func (n *Node) GetTransaction(txHash string, withResults bool) (*transaction.Api
 		return nil, err
 	}
 
+	tx, err := n.getTransaction(hash, withResults)
+	if err != nil {
+		return nil, err
+	}
+
+	n.putDecodedDataInTransaction(tx)
+
+	return tx, nil
+}
+
+func (n *Node) getTransaction(hash []byte, withResults bool) (*transaction.ApiTransactionResult, error) {
 	tx, err := n.optionallyGetTransactionFromPool(hash)
 	if err != nil {
 		return nil, err
@@ -36,6 +48,21 @@ func (n *Node) GetTransaction(txHash string, withResults bool) (*transaction.Api
 	return n.getTransactionFromStorage(hash)
 }
 
+// putDecodedDataInTransaction decodes the data field of the transaction if the receiver contract has a known ABI
+func (n *Node) putDecodedDataInTransaction(tx *transaction.ApiTransactionResult) {
+	if check.IfNil(n.abiRegistry) || check.IfNil(tx.Tx) || len(tx.Tx.GetData()) == 0 {
+		return
+	}
+
+	decodedData, err := n.abiRegistry.DecodeCallData(tx.Tx.GetRcvAddr(), tx.Tx.GetData())
+	if err != nil {
+		log.Trace("putDecodedDataInTransaction", "hash", tx.Hash, "error", err)
+		return
+	}
+
+	tx.DecodedData = decodedData
+}
+
 func (n *Node) optionallyGetTransactionFromPool(hash []byte) (*transaction.ApiTransactionResult, error) {
 	txObj, txType, found := n.getTxObjFromDataPool(hash)
 	if !found {
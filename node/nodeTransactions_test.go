@@ -99,6 +99,40 @@ func TestNode_GetTransaction_FromPool(t *testing.T) {
 	require.Equal(t, transaction.TxStatusPending, actualG.Status)
 }
 
+func TestNode_GetTransaction_ShouldDecodeTheDataOfContractCalls(t *testing.T) {
+	t.Parallel()
+
+	n, _, dataPool, _ := createNode(t, 42, false)
+
+	txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("contract"), Data: []byte("add@01")}
+	dataPool.Transactions().AddData([]byte("a"), txA, 42, "1")
+	txB := &transaction.Transaction{Nonce: 8, SndAddr: []byte("alice"), RcvAddr: []byte("bob"), Data: []byte("hello")}
+	dataPool.Transactions().AddData([]byte("b"), txB, 42, "1")
+
+	actualA, err := n.GetTransaction(hex.EncodeToString([]byte("a")), false)
+	require.Nil(t, err)
+	require.Nil(t, actualA.DecodedData)
+
+	expectedDecodedData := &transaction.DecodedCallDataApi{Function: "add", Arguments: []interface{}{"1"}}
+	n.abiRegistry = &mock.AbiRegistryStub{
+		DecodeCallDataCalled: func(address []byte, data []byte) (*transaction.DecodedCallDataApi, error) {
+			if bytes.Equal(address, txA.RcvAddr) && bytes.Equal(data, txA.Data) {
+				return expectedDecodedData, nil
+			}
+			return nil, ErrContractAbiNotFound
+		},
+	}
+
+	actualA, err = n.GetTransaction(hex.EncodeToString([]byte("a")), false)
+	require.Nil(t, err)
+	require.Equal(t, expectedDecodedData, actualA.DecodedData)
+
+	actualB, err := n.GetTransaction(hex.EncodeToString([]byte("b")), false)
+	require.Nil(t, err)
+	require.Equal(t, txB.Nonce, actualB.Nonce)
+	require.Nil(t, actualB.DecodedData)
+}
+
 func TestNode_GetTransaction_FromStorage(t *testing.T) {
 	t.Parallel()
 
@@ -2820,3 +2820,55 @@ func TestNode_ShouldWork(t *testing.T) {
 
 	assert.Equal(t, expected, vals)
 }
+
+func TestNode_GetPeerInfoShouldReturnReputation(t *testing.T) {
+	t.Parallel()
+
+	pid := "pid1"
+	pidReputation := core.P2PPeerReputation{
+		Score:       1.5,
+		NumOffences: 2,
+		BannedUntil: 100,
+	}
+	pkReputation := core.P2PPeerReputation{
+		Score:       0.5,
+		NumOffences: 1,
+		BannedUntil: 200,
+	}
+	n, _ := node.NewNode(
+		node.WithMessenger(&mock.MessengerStub{
+			PeersCalled: func() []core.PeerID {
+				return []core.PeerID{core.PeerID(pid)}
+			},
+			PeerAddressesCalled: func(pid core.PeerID) []string {
+				return make([]string, 0)
+			},
+		}),
+		node.WithNetworkShardingCollector(&mock.NetworkShardingCollectorStub{
+			GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+				return core.P2PPeerInfo{
+					PkBytes: []byte("pk"),
+				}
+			},
+		}),
+		node.WithValidatorPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithPeerDenialEvaluator(&mock.PeerDenialEvaluatorStub{}),
+		node.WithPeerReputationHandler(&mock.PeerReputationHandlerStub{
+			PeerIDReputationCalled: func(providedPid core.PeerID) core.P2PPeerReputation {
+				assert.Equal(t, core.PeerID(pid), providedPid)
+				return pidReputation
+			},
+			PublicKeyReputationCalled: func(pk string) core.P2PPeerReputation {
+				assert.Equal(t, "pk", pk)
+				return pkReputation
+			},
+		}),
+	)
+
+	vals, err := n.GetPeerInfo(core.PeerID(pid).Pretty())
+
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(vals))
+	assert.Equal(t, pidReputation, vals[0].PidReputation)
+	assert.Equal(t, pkReputation, vals[0].PkReputation)
+}
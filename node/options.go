@@ -459,6 +459,17 @@ func WithPeerDenialEvaluator(handler p2p.PeerDenialEvaluator) Option {
 	}
 }
 
+// WithPeerReputationHandler sets up a peer reputation handler for the Node
+func WithPeerReputationHandler(handler process.PeerReputationHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(handler) {
+			return ErrNilPeerReputationHandler
+		}
+		n.peerReputationHandler = handler
+		return nil
+	}
+}
+
 // WithBootStorer sets up a boot storer for the Node
 func WithBootStorer(bootStorer process.BootStorer) Option {
 	return func(n *Node) error {
@@ -754,6 +765,39 @@ func WithHistoryRepository(historyRepo dblookupext.HistoryRepository) Option {
 	}
 }
 
+// WithTransactionTracer sets up the transaction tracer for the Node
+func WithTransactionTracer(txTracer TransactionTracer) Option {
+	return func(n *Node) error {
+		if check.IfNil(txTracer) {
+			return ErrNilTransactionTracer
+		}
+		n.txTracer = txTracer
+		return nil
+	}
+}
+
+// WithAbiRegistry sets up the contracts ABI registry for the Node
+func WithAbiRegistry(abiRegistry AbiRegistry) Option {
+	return func(n *Node) error {
+		if check.IfNil(abiRegistry) {
+			return ErrNilAbiRegistry
+		}
+		n.abiRegistry = abiRegistry
+		return nil
+	}
+}
+
+// WithMaxNumStorageKeys sets up the maximum number of storage keys an account storage query can collect
+func WithMaxNumStorageKeys(maxNumStorageKeys uint32) Option {
+	return func(n *Node) error {
+		if maxNumStorageKeys == 0 {
+			return fmt.Errorf("%w for max number of storage keys", ErrInvalidValue)
+		}
+		n.maxNumStorageKeys = maxNumStorageKeys
+		return nil
+	}
+}
+
 // WithEnableSignTxWithHashEpoch sets up enableSignTxWithHashEpoch for the node
 func WithEnableSignTxWithHashEpoch(enableSignTxWithHashEpoch uint32) Option {
 	return func(n *Node) error {
@@ -1159,6 +1159,30 @@ func TestWithPeerDenialEvaluator_OkHandlerShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithPeerReputationHandler_NilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithPeerReputationHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilPeerReputationHandler, err)
+}
+
+func TestWithPeerReputationHandler_OkHandlerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	handler := &mock.PeerReputationHandlerStub{}
+	opt := WithPeerReputationHandler(handler)
+	err := opt(node)
+
+	assert.True(t, node.peerReputationHandler == handler)
+	assert.Nil(t, err)
+}
+
 func TestWithNetworkShardingCollector_NilNetworkShardingCollectorShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1509,3 +1533,40 @@ func TestWithTxVersionChecker_OkTxVersionCheckerShoulWork(t *testing.T) {
 	assert.Equal(t, txVersionChecker, node.txVersionChecker)
 	assert.Nil(t, err)
 }
+
+func TestWithAbiRegistry_NilAbiRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithAbiRegistry(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilAbiRegistry, err)
+}
+
+func TestWithAbiRegistry_OkAbiRegistryShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	abiRegistry := &mock.AbiRegistryStub{}
+	opt := WithAbiRegistry(abiRegistry)
+	err := opt(node)
+
+	assert.Equal(t, abiRegistry, node.abiRegistry)
+	assert.Nil(t, err)
+}
+
+func TestWithMaxNumStorageKeys(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	err := WithMaxNumStorageKeys(0)(node)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	err = WithMaxNumStorageKeys(10)(node)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(10), node.maxNumStorageKeys)
+}
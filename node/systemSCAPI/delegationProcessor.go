@@ -0,0 +1,241 @@
+package systemSCAPI
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+// the markers that precede each group of BLS keys in the getAllNodeStates response
+const (
+	stakedNodesMarker    = "staked"
+	notStakedNodesMarker = "notStaked"
+	unStakedNodesMarker  = "unStaked"
+)
+
+const numContractConfigValues = 9
+
+type delegationProcessor struct {
+	scQueryService  process.SCQueryService
+	pubkeyConverter core.PubkeyConverter
+}
+
+// NewDelegationProcessor will create a new instance of delegationProcessor. Unlike the other system SC processors,
+// it runs the view functions of the delegation contracts, as the claimable rewards are computed by the contract
+// from the rewards history of every epoch
+func NewDelegationProcessor(
+	scQueryService process.SCQueryService,
+	pubkeyConverter core.PubkeyConverter,
+) (*delegationProcessor, error) {
+	if check.IfNil(scQueryService) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(pubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	return &delegationProcessor{
+		scQueryService:  scQueryService,
+		pubkeyConverter: pubkeyConverter,
+	}, nil
+}
+
+// GetDelegationContracts returns the addresses of all the delegation contracts created by the delegation manager
+func (dp *delegationProcessor) GetDelegationContracts() ([]string, error) {
+	returnData, err := dp.executeQuery(vm.DelegationManagerSCAddress, "getAllContractAddresses")
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := make([]string, 0, len(returnData))
+	for _, address := range returnData {
+		contracts = append(contracts, dp.pubkeyConverter.Encode(address))
+	}
+
+	return contracts, nil
+}
+
+// GetDelegationContract returns the configuration, the total stake and the nodes of a delegation contract
+func (dp *delegationProcessor) GetDelegationContract(contract string) (*api.DelegationContract, error) {
+	contractAddress, err := dp.pubkeyConverter.Decode(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationContract, err := dp.getContractConfig(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegationContract.Address = contract
+
+	totalActiveStake, err := dp.executeBigIntQuery(contractAddress, "getTotalActiveStake")
+	if err != nil {
+		return nil, err
+	}
+	delegationContract.TotalActiveStake = totalActiveStake.String()
+
+	totalUnStaked, err := dp.executeBigIntQuery(contractAddress, "getTotalUnStaked")
+	if err != nil {
+		return nil, err
+	}
+	delegationContract.TotalUnStaked = totalUnStaked.String()
+
+	numDelegators, err := dp.executeBigIntQuery(contractAddress, "getNumUsers")
+	if err != nil {
+		return nil, err
+	}
+	delegationContract.NumDelegators = numDelegators.Uint64()
+
+	err = dp.setNodeStates(contractAddress, delegationContract)
+	if err != nil {
+		return nil, err
+	}
+
+	return delegationContract, nil
+}
+
+// GetDelegator returns the funds, the claimable rewards and the undelegated funds of a delegator
+func (dp *delegationProcessor) GetDelegator(contract string, address string) (*api.DelegatorFunds, error) {
+	contractAddress, err := dp.pubkeyConverter.Decode(contract)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := dp.pubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	returnData, err := dp.executeQuery(contractAddress, "getDelegatorFundsData", delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != 4 {
+		return nil, fmt.Errorf("%w for getDelegatorFundsData: expected 4 values, got %d", ErrInvalidQueryResponse, len(returnData))
+	}
+
+	unDelegated, err := dp.getUnDelegatedFunds(contractAddress, delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.DelegatorFunds{
+		Contract:         contract,
+		Address:          address,
+		ActiveStake:      big.NewInt(0).SetBytes(returnData[0]).String(),
+		ClaimableRewards: big.NewInt(0).SetBytes(returnData[1]).String(),
+		TotalUnStaked:    big.NewInt(0).SetBytes(returnData[2]).String(),
+		TotalUnBondable:  big.NewInt(0).SetBytes(returnData[3]).String(),
+		UnDelegated:      unDelegated,
+	}, nil
+}
+
+func (dp *delegationProcessor) getContractConfig(contractAddress []byte) (*api.DelegationContract, error) {
+	returnData, err := dp.executeQuery(contractAddress, "getContractConfig")
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != numContractConfigValues {
+		return nil, fmt.Errorf("%w for getContractConfig: expected %d values, got %d",
+			ErrInvalidQueryResponse, numContractConfigValues, len(returnData))
+	}
+
+	return &api.DelegationContract{
+		Owner:                dp.pubkeyConverter.Encode(returnData[0]),
+		ServiceFee:           big.NewInt(0).SetBytes(returnData[1]).Uint64(),
+		MaxDelegationCap:     big.NewInt(0).SetBytes(returnData[2]).String(),
+		InitialOwnerFunds:    big.NewInt(0).SetBytes(returnData[3]).String(),
+		AutomaticActivation:  string(returnData[4]) == "true",
+		WithDelegationCap:    string(returnData[5]) == "true",
+		ChangeableServiceFee: string(returnData[6]) == "true",
+		CreatedNonce:         big.NewInt(0).SetBytes(returnData[7]).Uint64(),
+		UnBondPeriod:         big.NewInt(0).SetBytes(returnData[8]).Uint64(),
+	}, nil
+}
+
+func (dp *delegationProcessor) setNodeStates(contractAddress []byte, delegationContract *api.DelegationContract) error {
+	returnData, err := dp.executeQuery(contractAddress, "getAllNodeStates")
+	if err != nil {
+		return err
+	}
+
+	delegationContract.StakedNodes = make([]string, 0)
+	delegationContract.NotStakedNodes = make([]string, 0)
+	delegationContract.UnStakedNodes = make([]string, 0)
+
+	var currentGroup *[]string
+	for _, value := range returnData {
+		switch string(value) {
+		case stakedNodesMarker:
+			currentGroup = &delegationContract.StakedNodes
+		case notStakedNodesMarker:
+			currentGroup = &delegationContract.NotStakedNodes
+		case unStakedNodesMarker:
+			currentGroup = &delegationContract.UnStakedNodes
+		default:
+			if currentGroup == nil {
+				return fmt.Errorf("%w for getAllNodeStates: BLS key without state", ErrInvalidQueryResponse)
+			}
+			*currentGroup = append(*currentGroup, hex.EncodeToString(value))
+		}
+	}
+
+	return nil
+}
+
+func (dp *delegationProcessor) getUnDelegatedFunds(contractAddress []byte, delegatorAddress []byte) ([]*api.UnDelegatedFund, error) {
+	returnData, err := dp.executeQuery(contractAddress, "getUserUnDelegatedList", delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData)%2 != 0 {
+		return nil, fmt.Errorf("%w for getUserUnDelegatedList: odd number of values", ErrInvalidQueryResponse)
+	}
+
+	unDelegated := make([]*api.UnDelegatedFund, 0, len(returnData)/2)
+	for i := 0; i < len(returnData); i += 2 {
+		unDelegated = append(unDelegated, &api.UnDelegatedFund{
+			Value:           big.NewInt(0).SetBytes(returnData[i]).String(),
+			RemainingNonces: big.NewInt(0).SetBytes(returnData[i+1]).Uint64(),
+		})
+	}
+
+	return unDelegated, nil
+}
+
+func (dp *delegationProcessor) executeBigIntQuery(scAddress []byte, funcName string) (*big.Int, error) {
+	returnData, err := dp.executeQuery(scAddress, funcName)
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != 1 {
+		return nil, fmt.Errorf("%w for %s: expected 1 value, got %d", ErrInvalidQueryResponse, funcName, len(returnData))
+	}
+
+	return big.NewInt(0).SetBytes(returnData[0]), nil
+}
+
+func (dp *delegationProcessor) executeQuery(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+	query := &process.SCQuery{
+		ScAddress: scAddress,
+		FuncName:  funcName,
+		Arguments: args,
+	}
+
+	vmOutput, err := dp.scQueryService.ExecuteQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dp *delegationProcessor) IsInterfaceNil() bool {
+	return dp == nil
+}
@@ -0,0 +1,193 @@
+package systemSCAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSCQueryServiceStub(t *testing.T, responses map[string][][]byte) *mock.SCQueryServiceStub {
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			returnData, ok := responses[query.FuncName]
+			require.True(t, ok, "unexpected query "+query.FuncName)
+
+			return &vmcommon.VMOutput{ReturnData: returnData}, nil
+		},
+	}
+}
+
+func TestNewDelegationProcessor(t *testing.T) {
+	t.Parallel()
+
+	dp, err := NewDelegationProcessor(nil, mock.NewPubkeyConverterMock(32))
+	assert.True(t, check.IfNil(dp))
+	assert.Equal(t, ErrNilSCQueryService, err)
+
+	dp, err = NewDelegationProcessor(&mock.SCQueryServiceStub{}, nil)
+	assert.True(t, check.IfNil(dp))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	dp, err = NewDelegationProcessor(&mock.SCQueryServiceStub{}, mock.NewPubkeyConverterMock(32))
+	assert.False(t, check.IfNil(dp))
+	assert.Nil(t, err)
+}
+
+func TestDelegationProcessor_GetDelegationContracts(t *testing.T) {
+	t.Parallel()
+
+	scQueryService := &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, vm.DelegationManagerSCAddress, query.ScAddress)
+			assert.Equal(t, "getAllContractAddresses", query.FuncName)
+
+			return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("contract1"), []byte("contract2")}}, nil
+		},
+	}
+	dp, _ := NewDelegationProcessor(scQueryService, mock.NewPubkeyConverterMock(32))
+
+	contracts, err := dp.GetDelegationContracts()
+	require.Nil(t, err)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("contract1")), hex.EncodeToString([]byte("contract2"))}, contracts)
+}
+
+func TestDelegationProcessor_GetDelegationContractQueryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	scQueryService := &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(_ *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}
+	dp, _ := NewDelegationProcessor(scQueryService, mock.NewPubkeyConverterMock(32))
+
+	contract, err := dp.GetDelegationContract(hex.EncodeToString([]byte("contract")))
+	assert.Nil(t, contract)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestDelegationProcessor_GetDelegationContractInvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	scQueryService := createSCQueryServiceStub(t, map[string][][]byte{
+		"getContractConfig": {[]byte("owner")},
+	})
+	dp, _ := NewDelegationProcessor(scQueryService, mock.NewPubkeyConverterMock(32))
+
+	contract, err := dp.GetDelegationContract(hex.EncodeToString([]byte("contract")))
+	assert.Nil(t, contract)
+	assert.True(t, errors.Is(err, ErrInvalidQueryResponse))
+}
+
+func TestDelegationProcessor_GetDelegationContract(t *testing.T) {
+	t.Parallel()
+
+	scQueryService := createSCQueryServiceStub(t, map[string][][]byte{
+		"getContractConfig": {
+			[]byte("owner"),
+			big.NewInt(1000).Bytes(),
+			big.NewInt(0).Bytes(),
+			big.NewInt(2500).Bytes(),
+			[]byte("true"),
+			[]byte("false"),
+			[]byte("true"),
+			big.NewInt(7).Bytes(),
+			big.NewInt(144).Bytes(),
+		},
+		"getTotalActiveStake": {big.NewInt(5000).Bytes()},
+		"getTotalUnStaked":    {big.NewInt(300).Bytes()},
+		"getNumUsers":         {big.NewInt(3).Bytes()},
+		"getAllNodeStates": {
+			[]byte(stakedNodesMarker), []byte("bls1"), []byte("bls2"),
+			[]byte(unStakedNodesMarker), []byte("bls3"),
+		},
+	})
+	dp, _ := NewDelegationProcessor(scQueryService, mock.NewPubkeyConverterMock(32))
+
+	contractAddress := hex.EncodeToString([]byte("contract"))
+	contract, err := dp.GetDelegationContract(contractAddress)
+	require.Nil(t, err)
+
+	assert.Equal(t, contractAddress, contract.Address)
+	assert.Equal(t, hex.EncodeToString([]byte("owner")), contract.Owner)
+	assert.Equal(t, uint64(1000), contract.ServiceFee)
+	assert.Equal(t, "0", contract.MaxDelegationCap)
+	assert.Equal(t, "2500", contract.InitialOwnerFunds)
+	assert.True(t, contract.AutomaticActivation)
+	assert.False(t, contract.WithDelegationCap)
+	assert.True(t, contract.ChangeableServiceFee)
+	assert.Equal(t, uint64(7), contract.CreatedNonce)
+	assert.Equal(t, uint64(144), contract.UnBondPeriod)
+	assert.Equal(t, "5000", contract.TotalActiveStake)
+	assert.Equal(t, "300", contract.TotalUnStaked)
+	assert.Equal(t, uint64(3), contract.NumDelegators)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("bls1")), hex.EncodeToString([]byte("bls2"))}, contract.StakedNodes)
+	assert.Equal(t, []string{}, contract.NotStakedNodes)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("bls3"))}, contract.UnStakedNodes)
+}
+
+func TestDelegationProcessor_GetDelegator(t *testing.T) {
+	t.Parallel()
+
+	delegatorAddress := []byte("delegator")
+	scQueryService := &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, []byte("contract"), query.ScAddress)
+			assert.Equal(t, [][]byte{delegatorAddress}, query.Arguments)
+
+			switch query.FuncName {
+			case "getDelegatorFundsData":
+				return &vmcommon.VMOutput{ReturnData: [][]byte{
+					big.NewInt(100).Bytes(),
+					big.NewInt(5).Bytes(),
+					big.NewInt(30).Bytes(),
+					big.NewInt(10).Bytes(),
+				}}, nil
+			case "getUserUnDelegatedList":
+				return &vmcommon.VMOutput{ReturnData: [][]byte{
+					big.NewInt(10).Bytes(), big.NewInt(0).Bytes(),
+					big.NewInt(20).Bytes(), big.NewInt(50).Bytes(),
+				}}, nil
+			}
+
+			assert.Fail(t, "unexpected query "+query.FuncName)
+			return nil, nil
+		},
+	}
+	dp, _ := NewDelegationProcessor(scQueryService, mock.NewPubkeyConverterMock(32))
+
+	contractAddress := hex.EncodeToString([]byte("contract"))
+	delegator, err := dp.GetDelegator(contractAddress, hex.EncodeToString(delegatorAddress))
+	require.Nil(t, err)
+
+	assert.Equal(t, contractAddress, delegator.Contract)
+	assert.Equal(t, "100", delegator.ActiveStake)
+	assert.Equal(t, "5", delegator.ClaimableRewards)
+	assert.Equal(t, "30", delegator.TotalUnStaked)
+	assert.Equal(t, "10", delegator.TotalUnBondable)
+	require.Equal(t, 2, len(delegator.UnDelegated))
+	assert.Equal(t, "10", delegator.UnDelegated[0].Value)
+	assert.Equal(t, uint64(0), delegator.UnDelegated[0].RemainingNonces)
+	assert.Equal(t, "20", delegator.UnDelegated[1].Value)
+	assert.Equal(t, uint64(50), delegator.UnDelegated[1].RemainingNonces)
+}
+
+func TestDelegationProcessor_GetDelegatorInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dp, _ := NewDelegationProcessor(&mock.SCQueryServiceStub{}, mock.NewPubkeyConverterMock(32))
+
+	delegator, err := dp.GetDelegator(hex.EncodeToString([]byte("contract")), "not hex")
+	assert.Nil(t, delegator)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,30 @@
+package systemSCAPI
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+type disabledDelegationProcessor struct{}
+
+// NewDisabledDelegationProcessor -
+func NewDisabledDelegationProcessor() *disabledDelegationProcessor {
+	return new(disabledDelegationProcessor)
+}
+
+// GetDelegationContracts -
+func (d *disabledDelegationProcessor) GetDelegationContracts() ([]string, error) {
+	return nil, ErrCannotReturnSystemSCDataFromShardNode
+}
+
+// GetDelegationContract -
+func (d *disabledDelegationProcessor) GetDelegationContract(_ string) (*api.DelegationContract, error) {
+	return nil, ErrCannotReturnSystemSCDataFromShardNode
+}
+
+// GetDelegator -
+func (d *disabledDelegationProcessor) GetDelegator(_ string, _ string) (*api.DelegatorFunds, error) {
+	return nil, ErrCannotReturnSystemSCDataFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledDelegationProcessor) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,25 @@
+package systemSCAPI
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+type disabledESDTTokensProcessor struct{}
+
+// NewDisabledESDTTokensProcessor -
+func NewDisabledESDTTokensProcessor() *disabledESDTTokensProcessor {
+	return new(disabledESDTTokensProcessor)
+}
+
+// GetESDTTokenIdentifiers -
+func (d *disabledESDTTokensProcessor) GetESDTTokenIdentifiers() ([]string, error) {
+	return nil, ErrCannotReturnSystemSCDataFromShardNode
+}
+
+// GetESDTToken -
+func (d *disabledESDTTokensProcessor) GetESDTToken(_ string) (*api.ESDTToken, error) {
+	return nil, ErrCannotReturnSystemSCDataFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledESDTTokensProcessor) IsInterfaceNil() bool {
+	return d == nil
+}
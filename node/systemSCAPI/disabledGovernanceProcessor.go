@@ -0,0 +1,35 @@
+package systemSCAPI
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+type disabledGovernanceProcessor struct{}
+
+// NewDisabledGovernanceProcessor -
+func NewDisabledGovernanceProcessor() *disabledGovernanceProcessor {
+	return new(disabledGovernanceProcessor)
+}
+
+// GetGovernanceProposals -
+func (d *disabledGovernanceProcessor) GetGovernanceProposals() ([]*api.GovernanceProposal, error) {
+	return nil, ErrCannotReturnSystemSCDataFromShardNode
+}
+
+// GetGovernanceProposal -
+func (d *disabledGovernanceProcessor) GetGovernanceProposal(_ string) (*api.GovernanceProposal, error) {
+	return nil, ErrCannotReturnSystemSCDataFromShardNode
+}
+
+// GetGovernanceProposalVotes -
+func (d *disabledGovernanceProcessor) GetGovernanceProposalVotes(_ string) ([]*api.GovernanceVote, error) {
+	return nil, ErrCannotReturnSystemSCDataFromShardNode
+}
+
+// GetGovernanceVoteTransaction -
+func (d *disabledGovernanceProcessor) GetGovernanceVoteTransaction(_ string, _ string, _ string) (*api.GovernanceVoteTransaction, error) {
+	return nil, ErrCannotReturnSystemSCDataFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledGovernanceProcessor) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,30 @@
+package systemSCAPI
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+type disabledValidatorProcessor struct{}
+
+// NewDisabledValidatorProcessor -
+func NewDisabledValidatorProcessor() *disabledValidatorProcessor {
+	return new(disabledValidatorProcessor)
+}
+
+// GetValidatorQueue -
+func (d *disabledValidatorProcessor) GetValidatorQueue() ([]*api.ValidatorQueueEntry, error) {
+	return nil, ErrCannotReturnSystemSCDataFromShardNode
+}
+
+// GetValidatorKeyStatus -
+func (d *disabledValidatorProcessor) GetValidatorKeyStatus(_ string) (*api.ValidatorKeyStatus, error) {
+	return nil, ErrCannotReturnSystemSCDataFromShardNode
+}
+
+// GetValidatorOwner -
+func (d *disabledValidatorProcessor) GetValidatorOwner(_ string) (*api.ValidatorOwner, error) {
+	return nil, ErrCannotReturnSystemSCDataFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledValidatorProcessor) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,52 @@
+package systemSCAPI
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrCannotReturnSystemSCDataFromShardNode signals that the system smart contracts data can only be returned by a
+// metachain node
+var ErrCannotReturnSystemSCDataFromShardNode = errors.New("system smart contracts data cannot be returned by a shard node")
+
+// ErrCannotCastAccountHandlerToUserAccount signals that the returned account is not an user account
+var ErrCannotCastAccountHandlerToUserAccount = errors.New("cannot cast AccountHandler to UserAccount")
+
+// ErrTokenNotFound signals that the requested ESDT token was not issued
+var ErrTokenNotFound = errors.New("token not found")
+
+// ErrInvalidTokenIdentifier signals that the provided string does not have the format of an ESDT token identifier
+var ErrInvalidTokenIdentifier = errors.New("invalid token identifier")
+
+// ErrNilBlockChain signals that a nil block chain handler has been provided
+var ErrNilBlockChain = errors.New("nil block chain")
+
+// ErrProposalNotFound signals that the requested governance proposal does not exist
+var ErrProposalNotFound = errors.New("proposal not found")
+
+// ErrInvalidVote signals that an invalid vote value has been provided
+var ErrInvalidVote = errors.New("invalid vote, expected one of yes, no, veto or dontCare")
+
+// ErrNilSCQueryService signals that a nil smart contract query service has been provided
+var ErrNilSCQueryService = errors.New("nil smart contract query service")
+
+// ErrInvalidQueryResponse signals that a system smart contract view function returned an unexpected response
+var ErrInvalidQueryResponse = errors.New("invalid query response")
+
+// ErrNilValidatorAccountsAdapter signals that a nil validator accounts adapter has been provided
+var ErrNilValidatorAccountsAdapter = errors.New("nil validator accounts adapter")
+
+// ErrInvalidNodePrice signals that an invalid node price has been provided
+var ErrInvalidNodePrice = errors.New("invalid node price")
+
+// ErrValidatorKeyNotFound signals that the requested BLS key is not registered in the staking system SC
+var ErrValidatorKeyNotFound = errors.New("BLS key not registered")
+
+// ErrValidatorOwnerNotFound signals that the requested address does not own any validator
+var ErrValidatorOwnerNotFound = errors.New("address is not registered as a validator owner")
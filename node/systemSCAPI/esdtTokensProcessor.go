@@ -0,0 +1,141 @@
+package systemSCAPI
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+// allIssuedTokensKey is the key under which the ESDT system SC saves the @ separated list of the issued tokens
+const allIssuedTokensKey = "allIssuedTokens"
+
+// the identifiers are created by the ESDT system SC as the ticker, made of 3 to 10 upper case letters and digits,
+// followed by a dash and the hex encoded random suffix
+const tokenIdentifierSeparator = "-"
+const minLengthForTicker = 3
+const maxLengthForTicker = 10
+
+type esdtTokensProcessor struct {
+	*storageReader
+	pubkeyConverter core.PubkeyConverter
+}
+
+// NewESDTTokensProcessor will create a new instance of esdtTokensProcessor
+func NewESDTTokensProcessor(
+	marshalizer marshal.Marshalizer,
+	accounts state.AccountsAdapter,
+	pubkeyConverter core.PubkeyConverter,
+) (*esdtTokensProcessor, error) {
+	if check.IfNil(pubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	reader, err := newStorageReader(marshalizer, accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &esdtTokensProcessor{
+		storageReader:   reader,
+		pubkeyConverter: pubkeyConverter,
+	}, nil
+}
+
+// GetESDTTokenIdentifiers returns the identifiers of all the issued ESDT tokens, in the issuing order
+func (etp *esdtTokensProcessor) GetESDTTokenIdentifiers() ([]string, error) {
+	allTokens, err := etp.getStorage(vm.ESDTSCAddress, []byte(allIssuedTokensKey))
+	if err != nil {
+		return nil, err
+	}
+	if len(allTokens) == 0 {
+		return make([]string, 0), nil
+	}
+
+	return strings.Split(string(allTokens), "@"), nil
+}
+
+// GetESDTToken returns the decoded properties of the provided ESDT token. The circulating supply is the minted value,
+// including the initial supply, minus the burnt value
+func (etp *esdtTokensProcessor) GetESDTToken(identifier string) (*api.ESDTToken, error) {
+	// the ESDT system SC storage holds other records as well, which must not be decoded as tokens
+	if !isTokenIdentifierValid(identifier) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTokenIdentifier, identifier)
+	}
+
+	token := &systemSmartContracts.ESDTData{}
+	found, err := etp.unmarshalStorage(vm.ESDTSCAddress, []byte(identifier), token)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrTokenNotFound, identifier)
+	}
+
+	mintedValue := big.NewInt(0)
+	if token.MintedValue != nil {
+		mintedValue.Set(token.MintedValue)
+	}
+	burntValue := big.NewInt(0)
+	if token.BurntValue != nil {
+		burntValue.Set(token.BurntValue)
+	}
+
+	return &api.ESDTToken{
+		Identifier:        identifier,
+		Name:              string(token.TokenName),
+		Ticker:            string(token.TickerName),
+		Owner:             etp.pubkeyConverter.Encode(token.OwnerAddress),
+		NumDecimals:       token.NumDecimals,
+		MintedValue:       mintedValue.String(),
+		BurntValue:        burntValue.String(),
+		CirculatingSupply: big.NewInt(0).Sub(mintedValue, burntValue).String(),
+		IsPaused:          token.IsPaused,
+		CanUpgrade:        token.Upgradable,
+		CanMint:           token.Mintable,
+		CanBurn:           token.Burnable,
+		CanChangeOwner:    token.CanChangeOwner,
+		CanPause:          token.CanPause,
+		CanFreeze:         token.CanFreeze,
+		CanWipe:           token.CanWipe,
+	}, nil
+}
+
+func isTokenIdentifierValid(identifier string) bool {
+	parts := strings.Split(identifier, tokenIdentifierSeparator)
+	if len(parts) != 2 {
+		return false
+	}
+
+	ticker, suffix := parts[0], parts[1]
+	if len(ticker) < minLengthForTicker || len(ticker) > maxLengthForTicker {
+		return false
+	}
+	for _, ch := range ticker {
+		isBigCharacter := ch >= 'A' && ch <= 'Z'
+		isNumber := ch >= '0' && ch <= '9'
+		if !isBigCharacter && !isNumber {
+			return false
+		}
+	}
+
+	if len(suffix) == 0 || strings.ToLower(suffix) != suffix {
+		return false
+	}
+	_, err := hex.DecodeString(suffix)
+
+	return err == nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (etp *esdtTokensProcessor) IsInterfaceNil() bool {
+	return etp == nil
+}
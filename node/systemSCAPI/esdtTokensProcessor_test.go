@@ -0,0 +1,209 @@
+package systemSCAPI
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSystemSCAccounts(t *testing.T, scAddress []byte, storage map[string]interface{}) *mock.AccountsStub {
+	return createAccountsStub(createSystemSCAccount(t, scAddress, storage))
+}
+
+func createSystemSCAccount(t *testing.T, scAddress []byte, storage map[string]interface{}) state.UserAccountHandler {
+	account, _ := state.NewUserAccount(scAddress)
+	leaves := make([]core.KeyValueHolder, 0, len(storage))
+	account.DataTrieTracker().SetDataTrie(&mock.TrieStub{
+		GetCalled: func(_ []byte) ([]byte, error) {
+			return nil, nil
+		},
+		GetAllLeavesOnChannelCalled: func(_ []byte) (chan core.KeyValueHolder, error) {
+			ch := make(chan core.KeyValueHolder, len(leaves))
+			for _, leaf := range leaves {
+				ch <- leaf
+			}
+			close(ch)
+
+			return ch, nil
+		},
+	})
+	for key, value := range storage {
+		buff, ok := value.([]byte)
+		if !ok {
+			var err error
+			buff, err = (&mock.MarshalizerFake{}).Marshal(value)
+			require.Nil(t, err)
+		}
+
+		err := account.DataTrieTracker().SaveKeyValue([]byte(key), buff)
+		require.Nil(t, err)
+
+		valueWithSuffix := append(append(append(make([]byte, 0), buff...), key...), scAddress...)
+		leaves = append(leaves, keyValStorage.NewKeyValStorage([]byte(key), valueWithSuffix))
+	}
+
+	return account
+}
+
+func createAccountsStub(accounts ...state.UserAccountHandler) *mock.AccountsStub {
+	return &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			for _, account := range accounts {
+				if bytes.Equal(address, account.AddressBytes()) {
+					return account, nil
+				}
+			}
+
+			return nil, errors.New("account not found")
+		},
+	}
+}
+
+func TestNewESDTTokensProcessor(t *testing.T) {
+	t.Parallel()
+
+	etp, err := NewESDTTokensProcessor(nil, &mock.AccountsStub{}, mock.NewPubkeyConverterMock(32))
+	assert.True(t, check.IfNil(etp))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	etp, err = NewESDTTokensProcessor(&mock.MarshalizerMock{}, nil, mock.NewPubkeyConverterMock(32))
+	assert.True(t, check.IfNil(etp))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	etp, err = NewESDTTokensProcessor(&mock.MarshalizerMock{}, &mock.AccountsStub{}, nil)
+	assert.True(t, check.IfNil(etp))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	etp, err = NewESDTTokensProcessor(&mock.MarshalizerMock{}, &mock.AccountsStub{}, mock.NewPubkeyConverterMock(32))
+	assert.False(t, check.IfNil(etp))
+	assert.Nil(t, err)
+}
+
+func TestEsdtTokensProcessor_GetESDTTokenIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	accounts := createSystemSCAccounts(t, vm.ESDTSCAddress, map[string]interface{}{
+		allIssuedTokensKey: []byte("AAA-aaaaaa@BBB-bbbbbb"),
+	})
+	etp, _ := NewESDTTokensProcessor(&mock.MarshalizerFake{}, accounts, mock.NewPubkeyConverterMock(32))
+
+	identifiers, err := etp.GetESDTTokenIdentifiers()
+	require.Nil(t, err)
+	assert.Equal(t, []string{"AAA-aaaaaa", "BBB-bbbbbb"}, identifiers)
+}
+
+func TestEsdtTokensProcessor_GetESDTTokenIdentifiersNoTokenShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	accounts := createSystemSCAccounts(t, vm.ESDTSCAddress, nil)
+	etp, _ := NewESDTTokensProcessor(&mock.MarshalizerFake{}, accounts, mock.NewPubkeyConverterMock(32))
+
+	identifiers, err := etp.GetESDTTokenIdentifiers()
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(identifiers))
+}
+
+func TestEsdtTokensProcessor_GetESDTToken(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner-address-of-32-bytes-length")
+	accounts := createSystemSCAccounts(t, vm.ESDTSCAddress, map[string]interface{}{
+		"AAA-aaaaaa": &systemSmartContracts.ESDTData{
+			OwnerAddress: owner,
+			TokenName:    []byte("TokenA"),
+			TickerName:   []byte("AAA"),
+			Burnable:     true,
+			CanPause:     true,
+			IsPaused:     true,
+			MintedValue:  big.NewInt(1000),
+			BurntValue:   big.NewInt(300),
+			NumDecimals:  6,
+		},
+	})
+	etp, _ := NewESDTTokensProcessor(&mock.MarshalizerFake{}, accounts, mock.NewPubkeyConverterMock(32))
+
+	token, err := etp.GetESDTToken("AAA-aaaaaa")
+	require.Nil(t, err)
+	assert.Equal(t, "AAA-aaaaaa", token.Identifier)
+	assert.Equal(t, "TokenA", token.Name)
+	assert.Equal(t, "AAA", token.Ticker)
+	assert.Equal(t, hex.EncodeToString(owner), token.Owner)
+	assert.Equal(t, uint32(6), token.NumDecimals)
+	assert.Equal(t, "1000", token.MintedValue)
+	assert.Equal(t, "300", token.BurntValue)
+	assert.Equal(t, "700", token.CirculatingSupply)
+	assert.True(t, token.IsPaused)
+	assert.True(t, token.CanBurn)
+	assert.True(t, token.CanPause)
+	assert.False(t, token.CanMint)
+	assert.False(t, token.CanFreeze)
+}
+
+func TestEsdtTokensProcessor_GetESDTTokenMissingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := createSystemSCAccounts(t, vm.ESDTSCAddress, nil)
+	etp, _ := NewESDTTokensProcessor(&mock.MarshalizerFake{}, accounts, mock.NewPubkeyConverterMock(32))
+
+	token, err := etp.GetESDTToken("AAA-aaaaaa")
+	assert.Nil(t, token)
+	assert.True(t, errors.Is(err, ErrTokenNotFound))
+}
+
+func TestEsdtTokensProcessor_GetESDTTokenInvalidIdentifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	unmarshalled := false
+	accounts := createSystemSCAccounts(t, vm.ESDTSCAddress, map[string]interface{}{
+		"allIssuedTokens": []byte("AAA-aaaaaa"),
+	})
+	marshalizer := &mock.MarshalizerMock{
+		UnmarshalHandler: func(obj interface{}, buff []byte) error {
+			unmarshalled = true
+			return nil
+		},
+	}
+	etp, _ := NewESDTTokensProcessor(marshalizer, accounts, mock.NewPubkeyConverterMock(32))
+
+	invalidIdentifiers := []string{"allIssuedTokens", "esdtConfig", "AA-aaaaaa", "AAAAAAAAAAA-aaaaaa", "aaa-aaaaaa",
+		"AAA-", "AAA-AAAAAA", "AAA-aaaaaz", "AAA-aaa", "AAA-aaaaaa-aa"}
+	for _, identifier := range invalidIdentifiers {
+		token, err := etp.GetESDTToken(identifier)
+		assert.Nil(t, token)
+		assert.True(t, errors.Is(err, ErrInvalidTokenIdentifier), identifier)
+	}
+	assert.False(t, unmarshalled)
+}
+
+func TestCreateESDTTokensHandler(t *testing.T) {
+	t.Parallel()
+
+	args := &ArgsSystemSCAPIHandlers{
+		ShardID:                0,
+		InternalMarshalizer:    &mock.MarshalizerMock{},
+		Accounts:               &mock.AccountsStub{},
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+	}
+	handler, err := CreateESDTTokensHandler(args)
+	require.Nil(t, err)
+	_, ok := handler.(*disabledESDTTokensProcessor)
+	assert.True(t, ok)
+
+	args.ShardID = core.MetachainShardId
+	handler, err = CreateESDTTokensHandler(args)
+	require.Nil(t, err)
+	_, ok = handler.(*esdtTokensProcessor)
+	assert.True(t, ok)
+}
@@ -0,0 +1,269 @@
+package systemSCAPI
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+var log = logger.GetOrCreate("node/systemSCAPI")
+
+// proposalPrefix is the prefix of the keys under which the governance system SC saves the proposals, followed by the
+// proposal reference: the github commit or the address of the whitelisted account
+const proposalPrefix = "proposal"
+
+const (
+	proposalStatusPending  = "pending"
+	proposalStatusActive   = "active"
+	proposalStatusEnded    = "ended"
+	proposalStatusPassed   = "passed"
+	proposalStatusRejected = "rejected"
+)
+
+const voteFunctionName = "vote"
+
+var validVotes = map[string]struct{}{
+	"yes":      {},
+	"no":       {},
+	"veto":     {},
+	"dontCare": {},
+}
+
+type governanceProcessor struct {
+	*storageReader
+	pubkeyConverter core.PubkeyConverter
+	blockChain      data.ChainHandler
+}
+
+// NewGovernanceProcessor will create a new instance of governanceProcessor
+func NewGovernanceProcessor(
+	marshalizer marshal.Marshalizer,
+	accounts state.AccountsAdapter,
+	pubkeyConverter core.PubkeyConverter,
+	blockChain data.ChainHandler,
+) (*governanceProcessor, error) {
+	if check.IfNil(pubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(blockChain) {
+		return nil, ErrNilBlockChain
+	}
+
+	reader, err := newStorageReader(marshalizer, accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &governanceProcessor{
+		storageReader:   reader,
+		pubkeyConverter: pubkeyConverter,
+		blockChain:      blockChain,
+	}, nil
+}
+
+// GetGovernanceProposals returns all the governance proposals, sorted by their voting start nonce
+func (gp *governanceProcessor) GetGovernanceProposals() ([]*api.GovernanceProposal, error) {
+	values, err := gp.getAllStorageWithPrefix(vm.GovernanceSCAddress, []byte(proposalPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	currentNonce := gp.getCurrentNonce()
+	proposals := make([]*api.GovernanceProposal, 0, len(values))
+	for key, value := range values {
+		generalProposal := &systemSmartContracts.GeneralProposal{}
+		err = gp.marshalizer.Unmarshal(generalProposal, value)
+		if err != nil {
+			log.Debug("GetGovernanceProposals: cannot unmarshal proposal", "key", hex.EncodeToString([]byte(key)), "error", err)
+			continue
+		}
+
+		reference := []byte(key)[len(proposalPrefix):]
+		proposals = append(proposals, gp.convertProposal(reference, generalProposal, currentNonce))
+	}
+
+	sort.Slice(proposals, func(i, j int) bool {
+		if proposals[i].StartVoteNonce == proposals[j].StartVoteNonce {
+			return proposals[i].ID < proposals[j].ID
+		}
+		return proposals[i].StartVoteNonce < proposals[j].StartVoteNonce
+	})
+
+	return proposals, nil
+}
+
+// GetGovernanceProposal returns the governance proposal with the provided identifier
+func (gp *governanceProcessor) GetGovernanceProposal(id string) (*api.GovernanceProposal, error) {
+	reference := gp.decodeProposalID(id)
+	generalProposal, err := gp.getGeneralProposal(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return gp.convertProposal(reference, generalProposal, gp.getCurrentNonce()), nil
+}
+
+// GetGovernanceProposalVotes returns the votes cast on the provided proposal. The governance system SC removes the
+// individual votes when the proposal is closed, so only the tallies of the closed proposals are available
+func (gp *governanceProcessor) GetGovernanceProposalVotes(id string) ([]*api.GovernanceVote, error) {
+	reference := gp.decodeProposalID(id)
+	generalProposal, err := gp.getGeneralProposal(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make([]*api.GovernanceVote, 0, len(generalProposal.Voters))
+	seenVoters := make(map[string]struct{})
+	for _, voter := range generalProposal.Voters {
+		_, seen := seenVoters[string(voter)]
+		if seen {
+			continue
+		}
+		seenVoters[string(voter)] = struct{}{}
+
+		voteKey := append(append(make([]byte, 0, len(reference)+len(voter)), reference...), voter...)
+		voteData := &systemSmartContracts.VoteData{}
+		found, errUnmarshal := gp.unmarshalStorage(vm.GovernanceSCAddress, voteKey, voteData)
+		if errUnmarshal != nil {
+			return nil, errUnmarshal
+		}
+		if !found {
+			continue
+		}
+
+		votes = append(votes, &api.GovernanceVote{
+			Voter:    gp.pubkeyConverter.Encode(voter),
+			Vote:     voteData.VoteValue,
+			NumVotes: voteData.NumVotes,
+		})
+	}
+
+	return votes, nil
+}
+
+// GetGovernanceVoteTransaction returns the receiver, the value and the data field of a transaction that casts the
+// provided vote on a proposal. The optional validator address is used when voting with the power delegated by it
+func (gp *governanceProcessor) GetGovernanceVoteTransaction(id string, vote string, validator string) (*api.GovernanceVoteTransaction, error) {
+	_, isValidVote := validVotes[vote]
+	if !isValidVote {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidVote, vote)
+	}
+
+	reference := gp.decodeProposalID(id)
+	_, err := gp.getGeneralProposal(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	txData := voteFunctionName + "@" + hex.EncodeToString(reference) + "@" + hex.EncodeToString([]byte(vote))
+	if len(validator) > 0 {
+		validatorAddress, errDecode := gp.pubkeyConverter.Decode(validator)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		txData += "@" + hex.EncodeToString(validatorAddress)
+	}
+
+	return &api.GovernanceVoteTransaction{
+		Receiver: gp.pubkeyConverter.Encode(vm.GovernanceSCAddress),
+		Value:    "0",
+		Data:     txData,
+	}, nil
+}
+
+func (gp *governanceProcessor) getGeneralProposal(reference []byte) (*systemSmartContracts.GeneralProposal, error) {
+	generalProposal := &systemSmartContracts.GeneralProposal{}
+	key := append([]byte(proposalPrefix), reference...)
+	found, err := gp.unmarshalStorage(vm.GovernanceSCAddress, key, generalProposal)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrProposalNotFound, gp.encodeProposalID(reference))
+	}
+
+	return generalProposal, nil
+}
+
+func (gp *governanceProcessor) convertProposal(
+	reference []byte,
+	generalProposal *systemSmartContracts.GeneralProposal,
+	currentNonce uint64,
+) *api.GovernanceProposal {
+	return &api.GovernanceProposal{
+		ID:             gp.encodeProposalID(reference),
+		Issuer:         gp.pubkeyConverter.Encode(generalProposal.IssuerAddress),
+		GitHubCommit:   string(generalProposal.GitHubCommit),
+		StartVoteNonce: generalProposal.StartVoteNonce,
+		EndVoteNonce:   generalProposal.EndVoteNonce,
+		Status:         getProposalStatus(generalProposal, currentNonce),
+		Yes:            generalProposal.Yes,
+		No:             generalProposal.No,
+		Veto:           generalProposal.Veto,
+		DontCare:       generalProposal.DontCare,
+		NumVoters:      len(generalProposal.Voters),
+		Closed:         generalProposal.Closed,
+		Passed:         generalProposal.Voted,
+	}
+}
+
+func getProposalStatus(generalProposal *systemSmartContracts.GeneralProposal, currentNonce uint64) string {
+	if generalProposal.Voted {
+		return proposalStatusPassed
+	}
+	if generalProposal.Closed {
+		return proposalStatusRejected
+	}
+	if currentNonce < generalProposal.StartVoteNonce {
+		return proposalStatusPending
+	}
+	if currentNonce <= generalProposal.EndVoteNonce {
+		return proposalStatusActive
+	}
+
+	return proposalStatusEnded
+}
+
+// encodeProposalID returns the whitelisting proposals, which are referenced by the address of the proposed account,
+// as an encoded address and the rest of the proposals, referenced by the github commit, as they are
+func (gp *governanceProcessor) encodeProposalID(reference []byte) string {
+	if len(reference) == gp.pubkeyConverter.Len() {
+		return gp.pubkeyConverter.Encode(reference)
+	}
+
+	return string(reference)
+}
+
+func (gp *governanceProcessor) decodeProposalID(id string) []byte {
+	address, err := gp.pubkeyConverter.Decode(id)
+	if err == nil && len(address) == gp.pubkeyConverter.Len() {
+		return address
+	}
+
+	return []byte(id)
+}
+
+func (gp *governanceProcessor) getCurrentNonce() uint64 {
+	currentHeader := gp.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return 0
+	}
+
+	return currentHeader.GetNonce()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
@@ -0,0 +1,188 @@
+package systemSCAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBlockChainWithNonce(nonce uint64) *mock.BlockChainMock {
+	return &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.MetaBlock{Nonce: nonce}
+		},
+	}
+}
+
+func createGovernanceStorage() map[string]interface{} {
+	voter1 := []byte("voter1")
+	voter2 := []byte("voter2")
+
+	return map[string]interface{}{
+		proposalPrefix + "commit-2": &systemSmartContracts.GeneralProposal{
+			IssuerAddress:  []byte("issuer"),
+			GitHubCommit:   []byte("commit-2"),
+			StartVoteNonce: 20,
+			EndVoteNonce:   30,
+		},
+		proposalPrefix + "commit-1": &systemSmartContracts.GeneralProposal{
+			IssuerAddress:  []byte("issuer"),
+			GitHubCommit:   []byte("commit-1"),
+			StartVoteNonce: 5,
+			EndVoteNonce:   15,
+			Yes:            3,
+			No:             1,
+			Voters:         [][]byte{voter1, voter2, voter1},
+		},
+		"commit-1" + string(voter1): &systemSmartContracts.VoteData{
+			NumVotes:  3,
+			VoteValue: "yes",
+		},
+		"commit-1" + string(voter2): &systemSmartContracts.VoteData{
+			NumVotes:  1,
+			VoteValue: "no",
+		},
+		"unrelated-key": []byte("value"),
+	}
+}
+
+func TestNewGovernanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	gp, err := NewGovernanceProcessor(nil, &mock.AccountsStub{}, mock.NewPubkeyConverterMock(32), &mock.BlockChainMock{})
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	gp, err = NewGovernanceProcessor(&mock.MarshalizerFake{}, nil, mock.NewPubkeyConverterMock(32), &mock.BlockChainMock{})
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	gp, err = NewGovernanceProcessor(&mock.MarshalizerFake{}, &mock.AccountsStub{}, nil, &mock.BlockChainMock{})
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	gp, err = NewGovernanceProcessor(&mock.MarshalizerFake{}, &mock.AccountsStub{}, mock.NewPubkeyConverterMock(32), nil)
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrNilBlockChain, err)
+
+	gp, err = NewGovernanceProcessor(&mock.MarshalizerFake{}, &mock.AccountsStub{}, mock.NewPubkeyConverterMock(32), &mock.BlockChainMock{})
+	assert.False(t, check.IfNil(gp))
+	assert.Nil(t, err)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	accounts := createSystemSCAccounts(t, vm.GovernanceSCAddress, createGovernanceStorage())
+	gp, _ := NewGovernanceProcessor(&mock.MarshalizerFake{}, accounts, mock.NewPubkeyConverterMock(32), createBlockChainWithNonce(10))
+
+	proposals, err := gp.GetGovernanceProposals()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(proposals))
+
+	assert.Equal(t, "commit-1", proposals[0].ID)
+	assert.Equal(t, hex.EncodeToString([]byte("issuer")), proposals[0].Issuer)
+	assert.Equal(t, proposalStatusActive, proposals[0].Status)
+	assert.Equal(t, int32(3), proposals[0].Yes)
+	assert.Equal(t, int32(1), proposals[0].No)
+	assert.Equal(t, 3, proposals[0].NumVoters)
+
+	assert.Equal(t, "commit-2", proposals[1].ID)
+	assert.Equal(t, proposalStatusPending, proposals[1].Status)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposalNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := createSystemSCAccounts(t, vm.GovernanceSCAddress, createGovernanceStorage())
+	gp, _ := NewGovernanceProcessor(&mock.MarshalizerFake{}, accounts, mock.NewPubkeyConverterMock(32), createBlockChainWithNonce(10))
+
+	proposal, err := gp.GetGovernanceProposal("missing")
+	assert.Nil(t, proposal)
+	assert.True(t, errors.Is(err, ErrProposalNotFound))
+}
+
+func TestGovernanceProcessor_GetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	accounts := createSystemSCAccounts(t, vm.GovernanceSCAddress, createGovernanceStorage())
+	gp, _ := NewGovernanceProcessor(&mock.MarshalizerFake{}, accounts, mock.NewPubkeyConverterMock(32), createBlockChainWithNonce(16))
+
+	proposal, err := gp.GetGovernanceProposal("commit-1")
+	require.Nil(t, err)
+	assert.Equal(t, "commit-1", proposal.GitHubCommit)
+	assert.Equal(t, uint64(5), proposal.StartVoteNonce)
+	assert.Equal(t, uint64(15), proposal.EndVoteNonce)
+	assert.Equal(t, proposalStatusEnded, proposal.Status)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposalVotes(t *testing.T) {
+	t.Parallel()
+
+	accounts := createSystemSCAccounts(t, vm.GovernanceSCAddress, createGovernanceStorage())
+	gp, _ := NewGovernanceProcessor(&mock.MarshalizerFake{}, accounts, mock.NewPubkeyConverterMock(32), createBlockChainWithNonce(10))
+
+	votes, err := gp.GetGovernanceProposalVotes("commit-1")
+	require.Nil(t, err)
+	require.Equal(t, 2, len(votes))
+	assert.Equal(t, hex.EncodeToString([]byte("voter1")), votes[0].Voter)
+	assert.Equal(t, "yes", votes[0].Vote)
+	assert.Equal(t, int32(3), votes[0].NumVotes)
+	assert.Equal(t, hex.EncodeToString([]byte("voter2")), votes[1].Voter)
+	assert.Equal(t, "no", votes[1].Vote)
+	assert.Equal(t, int32(1), votes[1].NumVotes)
+}
+
+func TestGovernanceProcessor_GetGovernanceVoteTransactionInvalidVoteShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accounts := createSystemSCAccounts(t, vm.GovernanceSCAddress, createGovernanceStorage())
+	gp, _ := NewGovernanceProcessor(&mock.MarshalizerFake{}, accounts, mock.NewPubkeyConverterMock(32), createBlockChainWithNonce(10))
+
+	tx, err := gp.GetGovernanceVoteTransaction("commit-1", "maybe", "")
+	assert.Nil(t, tx)
+	assert.True(t, errors.Is(err, ErrInvalidVote))
+}
+
+func TestGovernanceProcessor_GetGovernanceVoteTransaction(t *testing.T) {
+	t.Parallel()
+
+	accounts := createSystemSCAccounts(t, vm.GovernanceSCAddress, createGovernanceStorage())
+	gp, _ := NewGovernanceProcessor(&mock.MarshalizerFake{}, accounts, mock.NewPubkeyConverterMock(32), createBlockChainWithNonce(10))
+
+	tx, err := gp.GetGovernanceVoteTransaction("commit-1", "yes", "")
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString(vm.GovernanceSCAddress), tx.Receiver)
+	assert.Equal(t, "0", tx.Value)
+	assert.Equal(t, "vote@"+hex.EncodeToString([]byte("commit-1"))+"@"+hex.EncodeToString([]byte("yes")), tx.Data)
+
+	validator := hex.EncodeToString([]byte("validator"))
+	tx, err = gp.GetGovernanceVoteTransaction("commit-1", "veto", validator)
+	require.Nil(t, err)
+	assert.Equal(t, "vote@"+hex.EncodeToString([]byte("commit-1"))+"@"+hex.EncodeToString([]byte("veto"))+"@"+validator, tx.Data)
+}
+
+func TestGetProposalStatus(t *testing.T) {
+	t.Parallel()
+
+	proposal := &systemSmartContracts.GeneralProposal{StartVoteNonce: 5, EndVoteNonce: 10}
+	assert.Equal(t, proposalStatusPending, getProposalStatus(proposal, 4))
+	assert.Equal(t, proposalStatusActive, getProposalStatus(proposal, 5))
+	assert.Equal(t, proposalStatusActive, getProposalStatus(proposal, 10))
+	assert.Equal(t, proposalStatusEnded, getProposalStatus(proposal, 11))
+
+	proposal.Closed = true
+	assert.Equal(t, proposalStatusRejected, getProposalStatus(proposal, 11))
+
+	proposal.Voted = true
+	assert.Equal(t, proposalStatusPassed, getProposalStatus(proposal, 11))
+}
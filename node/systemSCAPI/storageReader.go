@@ -0,0 +1,112 @@
+package systemSCAPI
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// storageReader reads and decodes the values saved by the system smart contracts in their accounts' data tries
+type storageReader struct {
+	marshalizer marshal.Marshalizer
+	accounts    state.AccountsAdapter
+}
+
+func newStorageReader(marshalizer marshal.Marshalizer, accounts state.AccountsAdapter) (*storageReader, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	return &storageReader{
+		marshalizer: marshalizer,
+		accounts:    accounts,
+	}, nil
+}
+
+// getStorage returns the value saved under the provided key or an empty slice if the key is missing
+func (sr *storageReader) getStorage(scAddress []byte, key []byte) ([]byte, error) {
+	account, err := sr.getAccount(scAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := account.DataTrieTracker().RetrieveValue(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// unmarshalStorage decodes the value saved under the provided key and returns false if the key is missing
+func (sr *storageReader) unmarshalStorage(scAddress []byte, key []byte, obj interface{}) (bool, error) {
+	value, err := sr.getStorage(scAddress, key)
+	if err != nil {
+		return false, err
+	}
+	if len(value) == 0 {
+		return false, nil
+	}
+
+	return true, sr.marshalizer.Unmarshal(obj, value)
+}
+
+// getAllStorageWithPrefix returns all the committed values saved under keys starting with the provided prefix. As the
+// whole data trie is read, it should only be used for the keys that are not indexed by the system smart contract
+func (sr *storageReader) getAllStorageWithPrefix(scAddress []byte, prefix []byte) (map[string][]byte, error) {
+	account, err := sr.getAccount(scAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash, err := account.DataTrie().Root()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chLeaves, err := account.DataTrie().GetAllLeavesOnChannel(rootHash, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string][]byte)
+	for leaf := range chLeaves {
+		if !bytes.HasPrefix(leaf.Key(), prefix) {
+			continue
+		}
+
+		value, errTrim := leaf.ValueWithoutSuffix(append(leaf.Key(), scAddress...))
+		if errTrim != nil {
+			return nil, fmt.Errorf("%w for key %s", errTrim, hex.EncodeToString(leaf.Key()))
+		}
+
+		values[string(leaf.Key())] = value
+	}
+
+	return values, nil
+}
+
+func (sr *storageReader) getAccount(scAddress []byte) (state.UserAccountHandler, error) {
+	accountHandler, err := sr.accounts.GetExistingAccount(scAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return nil, ErrCannotCastAccountHandlerToUserAccount
+	}
+
+	return account, nil
+}
@@ -0,0 +1,73 @@
+package systemSCAPI
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ArgsSystemSCAPIHandlers is the struct that contains the components needed to create the system SC API handlers
+type ArgsSystemSCAPIHandlers struct {
+	ShardID                uint32
+	InternalMarshalizer    marshal.Marshalizer
+	Accounts               state.AccountsAdapter
+	AddressPubkeyConverter core.PubkeyConverter
+	BlockChain             data.ChainHandler
+	SCQueryService         process.SCQueryService
+	ValidatorAccounts      state.AccountsAdapter
+	StakingSystemSCConfig  config.StakingSystemSCConfig
+}
+
+// CreateESDTTokensHandler will create a new instance of ESDTTokensHandler. The ESDT system SC only lives in the
+// metachain so a disabled handler is returned on shard nodes
+func CreateESDTTokensHandler(args *ArgsSystemSCAPIHandlers) (external.ESDTTokensHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return NewDisabledESDTTokensProcessor(), nil
+	}
+
+	return NewESDTTokensProcessor(args.InternalMarshalizer, args.Accounts, args.AddressPubkeyConverter)
+}
+
+// CreateGovernanceHandler will create a new instance of GovernanceHandler. The governance system SC only lives in the
+// metachain so a disabled handler is returned on shard nodes
+func CreateGovernanceHandler(args *ArgsSystemSCAPIHandlers) (external.GovernanceHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return NewDisabledGovernanceProcessor(), nil
+	}
+
+	return NewGovernanceProcessor(args.InternalMarshalizer, args.Accounts, args.AddressPubkeyConverter, args.BlockChain)
+}
+
+// CreateDelegationHandler will create a new instance of DelegationHandler. The delegation system SCs only live in the
+// metachain so a disabled handler is returned on shard nodes
+func CreateDelegationHandler(args *ArgsSystemSCAPIHandlers) (external.DelegationHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return NewDisabledDelegationProcessor(), nil
+	}
+
+	return NewDelegationProcessor(args.SCQueryService, args.AddressPubkeyConverter)
+}
+
+// CreateValidatorHandler will create a new instance of ValidatorHandler. The staking and validator system SCs only live
+// in the metachain so a disabled handler is returned on shard nodes
+func CreateValidatorHandler(args *ArgsSystemSCAPIHandlers) (external.ValidatorHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return NewDisabledValidatorProcessor(), nil
+	}
+
+	argsValidatorProcessor := ArgsValidatorProcessor{
+		Marshalizer:       args.InternalMarshalizer,
+		Accounts:          args.Accounts,
+		ValidatorAccounts: args.ValidatorAccounts,
+		PubkeyConverter:   args.AddressPubkeyConverter,
+		BlockChain:        args.BlockChain,
+		GenesisNodePrice:  args.StakingSystemSCConfig.GenesisNodePrice,
+		UnBondPeriod:      args.StakingSystemSCConfig.UnBondPeriod,
+	}
+
+	return NewValidatorProcessor(argsValidatorProcessor)
+}
@@ -0,0 +1,349 @@
+package systemSCAPI
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+// waitingListHeadKey is the key under which the staking system SC saves the head of the waiting list. The elements of
+// the list are linked through their keys, which are the BLS keys prefixed with w_
+const waitingListHeadKey = "waitingList"
+
+const (
+	keyStatusJailed    = "jailed"
+	keyStatusQueued    = "queued"
+	keyStatusStaked    = "staked"
+	keyStatusUnBonding = "unBonding"
+	keyStatusUnStaked  = "unStaked"
+)
+
+// ArgsValidatorProcessor is the struct that contains the components needed to create a validatorProcessor
+type ArgsValidatorProcessor struct {
+	Marshalizer       marshal.Marshalizer
+	Accounts          state.AccountsAdapter
+	ValidatorAccounts state.AccountsAdapter
+	PubkeyConverter   core.PubkeyConverter
+	BlockChain        data.ChainHandler
+	GenesisNodePrice  string
+	UnBondPeriod      uint64
+}
+
+type validatorProcessor struct {
+	*storageReader
+	validatorAccounts state.AccountsAdapter
+	pubkeyConverter   core.PubkeyConverter
+	blockChain        data.ChainHandler
+	genesisNodePrice  *big.Int
+	unBondPeriod      uint64
+}
+
+// NewValidatorProcessor will create a new instance of validatorProcessor
+func NewValidatorProcessor(args ArgsValidatorProcessor) (*validatorProcessor, error) {
+	if check.IfNil(args.ValidatorAccounts) {
+		return nil, ErrNilValidatorAccountsAdapter
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, ErrNilBlockChain
+	}
+	genesisNodePrice, ok := big.NewInt(0).SetString(args.GenesisNodePrice, 10)
+	if !ok || genesisNodePrice.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidNodePrice, args.GenesisNodePrice)
+	}
+
+	reader, err := newStorageReader(args.Marshalizer, args.Accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &validatorProcessor{
+		storageReader:     reader,
+		validatorAccounts: args.ValidatorAccounts,
+		pubkeyConverter:   args.PubkeyConverter,
+		blockChain:        args.BlockChain,
+		genesisNodePrice:  genesisNodePrice,
+		unBondPeriod:      args.UnBondPeriod,
+	}, nil
+}
+
+// GetValidatorQueue returns the BLS keys waiting in the staking queue, in the order they will be staked
+func (vp *validatorProcessor) GetValidatorQueue() ([]*api.ValidatorQueueEntry, error) {
+	queue, err := vp.getQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*api.ValidatorQueueEntry, 0, len(queue))
+	for i, blsKey := range queue {
+		stakedData, errGet := vp.getStakedData(blsKey)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		entries = append(entries, &api.ValidatorQueueEntry{
+			Position:      uint32(i + 1),
+			BLSKey:        hex.EncodeToString(blsKey),
+			Owner:         vp.encodeAddress(stakedData.OwnerAddress),
+			RewardAddress: vp.encodeAddress(stakedData.RewardAddress),
+			RegisterNonce: stakedData.RegisterNonce,
+		})
+	}
+
+	return entries, nil
+}
+
+// GetValidatorKeyStatus returns the staking status of the provided hex encoded BLS key
+func (vp *validatorProcessor) GetValidatorKeyStatus(blsKey string) (*api.ValidatorKeyStatus, error) {
+	blsKeyBytes, err := hex.DecodeString(blsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	queuePositions, err := vp.getQueuePositions()
+	if err != nil {
+		return nil, err
+	}
+
+	return vp.getKeyStatus(blsKeyBytes, queuePositions, vp.getCurrentNonce())
+}
+
+// GetValidatorOwner returns the stake, the top-up and the status of every BLS key of the provided owner. The top-up
+// is computed the same way the validator system SC does it: the total stake minus the node price for every staked,
+// queued or jailed node
+func (vp *validatorProcessor) GetValidatorOwner(address string) (*api.ValidatorOwner, error) {
+	ownerAddress, err := vp.pubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorData := &systemSmartContracts.ValidatorDataV2{}
+	found, err := vp.unmarshalStorage(vm.ValidatorSCAddress, ownerAddress, validatorData)
+	if err != nil {
+		return nil, err
+	}
+	if !found || len(validatorData.RewardAddress) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrValidatorOwnerNotFound, address)
+	}
+
+	queuePositions, err := vp.getQueuePositions()
+	if err != nil {
+		return nil, err
+	}
+
+	currentNonce := vp.getCurrentNonce()
+	keys := make([]*api.ValidatorKeyStatus, 0, len(validatorData.BlsPubKeys))
+	numActiveNodes := uint64(0)
+	for _, blsKey := range validatorData.BlsPubKeys {
+		keyStatus, errStatus := vp.getKeyStatus(blsKey, queuePositions, currentNonce)
+		if errStatus != nil {
+			return nil, errStatus
+		}
+
+		switch keyStatus.Status {
+		case keyStatusStaked, keyStatusQueued, keyStatusJailed:
+			numActiveNodes++
+		}
+		keys = append(keys, keyStatus)
+	}
+
+	nodePrice, err := vp.getNodePrice()
+	if err != nil {
+		return nil, err
+	}
+
+	totalStaked := getOrZero(validatorData.TotalStakeValue)
+	topUp := big.NewInt(0).Mul(nodePrice, big.NewInt(0).SetUint64(numActiveNodes))
+	topUp.Sub(totalStaked, topUp)
+	if topUp.Sign() < 0 {
+		topUp.SetInt64(0)
+	}
+	topUpPerNode := big.NewInt(0)
+	if numActiveNodes > 0 {
+		topUpPerNode.Div(topUp, big.NewInt(0).SetUint64(numActiveNodes))
+	}
+
+	return &api.ValidatorOwner{
+		Address:        address,
+		RewardAddress:  vp.encodeAddress(validatorData.RewardAddress),
+		TotalStaked:    totalStaked.String(),
+		TotalUnStaked:  getOrZero(validatorData.TotalUnstaked).String(),
+		TopUp:          topUp.String(),
+		TopUpPerNode:   topUpPerNode.String(),
+		NumRegistered:  validatorData.NumRegistered,
+		NumActiveNodes: numActiveNodes,
+		Keys:           keys,
+	}, nil
+}
+
+func (vp *validatorProcessor) getKeyStatus(
+	blsKey []byte,
+	queuePositions map[string]uint32,
+	currentNonce uint64,
+) (*api.ValidatorKeyStatus, error) {
+	stakedData, err := vp.getStakedData(blsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keyStatus := &api.ValidatorKeyStatus{
+		BLSKey:        hex.EncodeToString(blsKey),
+		Owner:         vp.encodeAddress(stakedData.OwnerAddress),
+		RewardAddress: vp.encodeAddress(stakedData.RewardAddress),
+		StakeValue:    getOrZero(stakedData.StakeValue).String(),
+		RegisterNonce: stakedData.RegisterNonce,
+		StakedNonce:   stakedData.StakedNonce,
+		UnStakedNonce: stakedData.UnStakedNonce,
+		UnStakedEpoch: stakedData.UnStakedEpoch,
+		QueueSize:     uint32(len(queuePositions)),
+		JailedNonce:   stakedData.JailedNonce,
+		NumJailed:     stakedData.NumJailed,
+	}
+
+	switch {
+	case stakedData.Jailed || vp.isJailedInPeerState(blsKey):
+		keyStatus.Status = keyStatusJailed
+	case stakedData.Waiting:
+		keyStatus.Status = keyStatusQueued
+		keyStatus.QueuePosition = queuePositions[string(blsKey)]
+	case stakedData.Staked:
+		keyStatus.Status = keyStatusStaked
+	case stakedData.UnStakedNonce > 0 && currentNonce-stakedData.UnStakedNonce < vp.unBondPeriod:
+		keyStatus.Status = keyStatusUnBonding
+		keyStatus.RemainingUnBondPeriod = vp.unBondPeriod - (currentNonce - stakedData.UnStakedNonce)
+	default:
+		keyStatus.Status = keyStatusUnStaked
+	}
+
+	return keyStatus, nil
+}
+
+func (vp *validatorProcessor) getStakedData(blsKey []byte) (*systemSmartContracts.StakedDataV2_0, error) {
+	stakedData := &systemSmartContracts.StakedDataV2_0{}
+	found, err := vp.unmarshalStorage(vm.StakingSCAddress, blsKey, stakedData)
+	if err != nil {
+		return nil, err
+	}
+	if !found || len(stakedData.RewardAddress) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrValidatorKeyNotFound, hex.EncodeToString(blsKey))
+	}
+
+	return stakedData, nil
+}
+
+// getQueue walks the waiting list of the staking system SC from its head and returns the queued BLS keys
+func (vp *validatorProcessor) getQueue() ([][]byte, error) {
+	waitingList := &systemSmartContracts.WaitingList{}
+	_, err := vp.unmarshalStorage(vm.StakingSCAddress, []byte(waitingListHeadKey), waitingList)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := make([][]byte, 0, waitingList.Length)
+	nextKey := waitingList.FirstKey
+	for len(nextKey) != 0 && uint32(len(queue)) < waitingList.Length {
+		element := &systemSmartContracts.ElementInList{}
+		found, errGet := vp.unmarshalStorage(vm.StakingSCAddress, nextKey, element)
+		if errGet != nil {
+			return nil, errGet
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: waiting list element %s", vm.ErrElementNotFound, hex.EncodeToString(nextKey))
+		}
+
+		queue = append(queue, element.BLSPublicKey)
+		nextKey = element.NextKey
+	}
+
+	return queue, nil
+}
+
+func (vp *validatorProcessor) getQueuePositions() (map[string]uint32, error) {
+	queue, err := vp.getQueue()
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make(map[string]uint32, len(queue))
+	for i, blsKey := range queue {
+		positions[string(blsKey)] = uint32(i + 1)
+	}
+
+	return positions, nil
+}
+
+// getNodePrice returns the node price of the current epoch, as saved by the validator system SC, or the genesis node
+// price if the configuration was never changed
+func (vp *validatorProcessor) getNodePrice() (*big.Int, error) {
+	epoch := uint32(0)
+	currentHeader := vp.blockChain.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		epoch = currentHeader.GetEpoch()
+	}
+
+	validatorConfig := &systemSmartContracts.ValidatorConfig{}
+	found, err := vp.unmarshalStorage(vm.ValidatorSCAddress, big.NewInt(int64(epoch)).Bytes(), validatorConfig)
+	if err != nil {
+		return nil, err
+	}
+	if !found || validatorConfig.NodePrice == nil || validatorConfig.NodePrice.Sign() <= 0 {
+		return vp.genesisNodePrice, nil
+	}
+
+	return validatorConfig.NodePrice, nil
+}
+
+func (vp *validatorProcessor) isJailedInPeerState(blsKey []byte) bool {
+	account, err := vp.validatorAccounts.GetExistingAccount(blsKey)
+	if err != nil {
+		return false
+	}
+
+	peerAccount, ok := account.(state.PeerAccountHandler)
+	if !ok {
+		return false
+	}
+
+	return peerAccount.GetList() == string(core.JailedList)
+}
+
+func (vp *validatorProcessor) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return vp.pubkeyConverter.Encode(address)
+}
+
+func (vp *validatorProcessor) getCurrentNonce() uint64 {
+	currentHeader := vp.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return 0
+	}
+
+	return currentHeader.GetNonce()
+}
+
+func getOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vp *validatorProcessor) IsInterfaceNil() bool {
+	return vp == nil
+}
@@ -0,0 +1,231 @@
+package systemSCAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsValidatorProcessor() ArgsValidatorProcessor {
+	return ArgsValidatorProcessor{
+		Marshalizer:       &mock.MarshalizerFake{},
+		Accounts:          &mock.AccountsStub{},
+		ValidatorAccounts: &mock.AccountsStub{},
+		PubkeyConverter:   mock.NewPubkeyConverterMock(32),
+		BlockChain:        &mock.BlockChainMock{},
+		GenesisNodePrice:  "1000",
+		UnBondPeriod:      10,
+	}
+}
+
+func createStakingStorage() map[string]interface{} {
+	owner := []byte("owner")
+	reward := []byte("reward")
+
+	return map[string]interface{}{
+		waitingListHeadKey: &systemSmartContracts.WaitingList{
+			FirstKey: []byte("w_bls2"),
+			LastKey:  []byte("w_bls3"),
+			Length:   2,
+		},
+		"w_bls2": &systemSmartContracts.ElementInList{
+			BLSPublicKey: []byte("bls2"),
+			NextKey:      []byte("w_bls3"),
+		},
+		"w_bls3": &systemSmartContracts.ElementInList{
+			BLSPublicKey: []byte("bls3"),
+			PreviousKey:  []byte("w_bls2"),
+		},
+		"bls1": &systemSmartContracts.StakedDataV2_0{RewardAddress: reward, OwnerAddress: owner, Staked: true, StakedNonce: 3},
+		"bls2": &systemSmartContracts.StakedDataV2_0{RewardAddress: reward, OwnerAddress: owner, Waiting: true, RegisterNonce: 4},
+		"bls3": &systemSmartContracts.StakedDataV2_0{RewardAddress: []byte("other"), OwnerAddress: []byte("other"), Waiting: true, RegisterNonce: 5},
+		"bls4": &systemSmartContracts.StakedDataV2_0{RewardAddress: reward, OwnerAddress: owner, UnStakedNonce: 95},
+		"bls5": &systemSmartContracts.StakedDataV2_0{RewardAddress: reward, OwnerAddress: owner, UnStakedNonce: 50},
+		"bls6": &systemSmartContracts.StakedDataV2_0{RewardAddress: reward, OwnerAddress: owner, Staked: true},
+	}
+}
+
+func createValidatorProcessorForTests(t *testing.T, validatorStorage map[string]interface{}) *validatorProcessor {
+	jailedPeer, _ := state.NewPeerAccount([]byte("bls6"))
+	jailedPeer.SetListAndIndex(0, string(core.JailedList), 0)
+
+	args := createMockArgsValidatorProcessor()
+	args.Accounts = createAccountsStub(
+		createSystemSCAccount(t, vm.StakingSCAddress, createStakingStorage()),
+		createSystemSCAccount(t, vm.ValidatorSCAddress, validatorStorage),
+	)
+	args.ValidatorAccounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			if string(address) == "bls6" {
+				return jailedPeer, nil
+			}
+			return nil, errors.New("account not found")
+		},
+	}
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.MetaBlock{Nonce: 100, Epoch: 2}
+		},
+	}
+
+	vp, err := NewValidatorProcessor(args)
+	require.Nil(t, err)
+
+	return vp
+}
+
+func TestNewValidatorProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsValidatorProcessor()
+	args.ValidatorAccounts = nil
+	vp, err := NewValidatorProcessor(args)
+	assert.True(t, check.IfNil(vp))
+	assert.Equal(t, ErrNilValidatorAccountsAdapter, err)
+
+	args = createMockArgsValidatorProcessor()
+	args.PubkeyConverter = nil
+	vp, err = NewValidatorProcessor(args)
+	assert.True(t, check.IfNil(vp))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	args = createMockArgsValidatorProcessor()
+	args.BlockChain = nil
+	vp, err = NewValidatorProcessor(args)
+	assert.True(t, check.IfNil(vp))
+	assert.Equal(t, ErrNilBlockChain, err)
+
+	args = createMockArgsValidatorProcessor()
+	args.GenesisNodePrice = "-1"
+	vp, err = NewValidatorProcessor(args)
+	assert.True(t, check.IfNil(vp))
+	assert.True(t, errors.Is(err, ErrInvalidNodePrice))
+
+	args = createMockArgsValidatorProcessor()
+	args.Marshalizer = nil
+	vp, err = NewValidatorProcessor(args)
+	assert.True(t, check.IfNil(vp))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	vp, err = NewValidatorProcessor(createMockArgsValidatorProcessor())
+	assert.False(t, check.IfNil(vp))
+	assert.Nil(t, err)
+}
+
+func TestValidatorProcessor_GetValidatorQueue(t *testing.T) {
+	t.Parallel()
+
+	vp := createValidatorProcessorForTests(t, map[string]interface{}{})
+
+	queue, err := vp.GetValidatorQueue()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(queue))
+
+	assert.Equal(t, uint32(1), queue[0].Position)
+	assert.Equal(t, hex.EncodeToString([]byte("bls2")), queue[0].BLSKey)
+	assert.Equal(t, hex.EncodeToString([]byte("owner")), queue[0].Owner)
+	assert.Equal(t, uint64(4), queue[0].RegisterNonce)
+	assert.Equal(t, uint32(2), queue[1].Position)
+	assert.Equal(t, hex.EncodeToString([]byte("bls3")), queue[1].BLSKey)
+	assert.Equal(t, hex.EncodeToString([]byte("other")), queue[1].Owner)
+}
+
+type apiKeyStatus struct {
+	status        string
+	queuePosition uint32
+	remaining     uint64
+}
+
+func TestValidatorProcessor_GetValidatorKeyStatus(t *testing.T) {
+	t.Parallel()
+
+	vp := createValidatorProcessorForTests(t, map[string]interface{}{})
+
+	getStatus := func(blsKey string) *apiKeyStatus {
+		keyStatus, err := vp.GetValidatorKeyStatus(hex.EncodeToString([]byte(blsKey)))
+		require.Nil(t, err)
+		return &apiKeyStatus{status: keyStatus.Status, queuePosition: keyStatus.QueuePosition, remaining: keyStatus.RemainingUnBondPeriod}
+	}
+
+	assert.Equal(t, &apiKeyStatus{status: keyStatusStaked}, getStatus("bls1"))
+	assert.Equal(t, &apiKeyStatus{status: keyStatusQueued, queuePosition: 1}, getStatus("bls2"))
+	assert.Equal(t, &apiKeyStatus{status: keyStatusQueued, queuePosition: 2}, getStatus("bls3"))
+	assert.Equal(t, &apiKeyStatus{status: keyStatusUnBonding, remaining: 5}, getStatus("bls4"))
+	assert.Equal(t, &apiKeyStatus{status: keyStatusUnStaked}, getStatus("bls5"))
+	assert.Equal(t, &apiKeyStatus{status: keyStatusJailed}, getStatus("bls6"))
+}
+
+func TestValidatorProcessor_GetValidatorKeyStatusNotRegisteredShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vp := createValidatorProcessorForTests(t, map[string]interface{}{})
+
+	keyStatus, err := vp.GetValidatorKeyStatus(hex.EncodeToString([]byte("missing")))
+	assert.Nil(t, keyStatus)
+	assert.True(t, errors.Is(err, ErrValidatorKeyNotFound))
+}
+
+func TestValidatorProcessor_GetValidatorOwner(t *testing.T) {
+	t.Parallel()
+
+	vp := createValidatorProcessorForTests(t, map[string]interface{}{
+		"owner": &systemSmartContracts.ValidatorDataV2{
+			RewardAddress:   []byte("reward"),
+			TotalStakeValue: big.NewInt(5000),
+			TotalUnstaked:   big.NewInt(1000),
+			BlsPubKeys:      [][]byte{[]byte("bls1"), []byte("bls2"), []byte("bls4"), []byte("bls6")},
+			NumRegistered:   4,
+		},
+	})
+
+	owner, err := vp.GetValidatorOwner(hex.EncodeToString([]byte("owner")))
+	require.Nil(t, err)
+
+	assert.Equal(t, hex.EncodeToString([]byte("reward")), owner.RewardAddress)
+	assert.Equal(t, "5000", owner.TotalStaked)
+	assert.Equal(t, "1000", owner.TotalUnStaked)
+	assert.Equal(t, uint64(3), owner.NumActiveNodes)
+	assert.Equal(t, "2000", owner.TopUp)
+	assert.Equal(t, "666", owner.TopUpPerNode)
+	require.Equal(t, 4, len(owner.Keys))
+	assert.Equal(t, keyStatusUnBonding, owner.Keys[2].Status)
+}
+
+func TestValidatorProcessor_GetValidatorOwnerUsesEpochNodePrice(t *testing.T) {
+	t.Parallel()
+
+	vp := createValidatorProcessorForTests(t, map[string]interface{}{
+		"owner": &systemSmartContracts.ValidatorDataV2{
+			RewardAddress:   []byte("reward"),
+			TotalStakeValue: big.NewInt(5000),
+			BlsPubKeys:      [][]byte{[]byte("bls1"), []byte("bls2"), []byte("bls6")},
+		},
+		string(big.NewInt(2).Bytes()): &systemSmartContracts.ValidatorConfig{NodePrice: big.NewInt(1500)},
+	})
+
+	owner, err := vp.GetValidatorOwner(hex.EncodeToString([]byte("owner")))
+	require.Nil(t, err)
+	assert.Equal(t, "500", owner.TopUp)
+}
+
+func TestValidatorProcessor_GetValidatorOwnerNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vp := createValidatorProcessorForTests(t, map[string]interface{}{})
+
+	owner, err := vp.GetValidatorOwner(hex.EncodeToString([]byte("missing")))
+	assert.Nil(t, owner)
+	assert.True(t, errors.Is(err, ErrValidatorOwnerNotFound))
+}
@@ -2,7 +2,9 @@ package txsimulator
 
 import (
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
 )
 
 // TransactionProcessor defines the operations needed do be done by a transaction processor
@@ -10,3 +12,33 @@ type TransactionProcessor interface {
 	ProcessTransaction(transaction *transaction.Transaction) (vmcommon.ReturnCode, error)
 	IsInterfaceNil() bool
 }
+
+// AccountsJournal defines the operations needed to inspect the account changes of a simulated execution
+type AccountsJournal interface {
+	GetJournal() []*AccountChange
+	CleanJournal()
+	ApplyJournal()
+	Reset()
+	GetAccountBeforeChanges(address []byte) (state.AccountHandler, error)
+	IsInterfaceNil() bool
+}
+
+// LogsCollector defines a transaction logs processor which keeps the logs of a simulated execution in memory
+type LogsCollector interface {
+	process.TransactionLogProcessor
+	GetLogEntries() []*vmcommon.LogEntry
+	Clean()
+}
+
+// GasHandler defines the gas related operations needed by the transaction simulator
+type GasHandler interface {
+	Init()
+	TotalGasRefunded() uint64
+	IsInterfaceNil() bool
+}
+
+// EconomicsHandler defines the economics related operations needed by the transaction simulator
+type EconomicsHandler interface {
+	SplitTxGasInCategories(tx process.TransactionWithFeeHandler) (uint64, uint64)
+	IsInterfaceNil() bool
+}
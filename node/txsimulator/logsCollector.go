@@ -0,0 +1,59 @@
+package txsimulator
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// logsCollector is a transaction logs processor which keeps the generated logs only in memory
+type logsCollector struct {
+	mutLogs    sync.RWMutex
+	logEntries []*vmcommon.LogEntry
+}
+
+// NewLogsCollector returns a new instance of logsCollector
+func NewLogsCollector() *logsCollector {
+	return &logsCollector{
+		logEntries: make([]*vmcommon.LogEntry, 0),
+	}
+}
+
+// GetLog returns an error as the collected logs are not indexed by transaction hash
+func (lc *logsCollector) GetLog(_ []byte) (data.LogHandler, error) {
+	return nil, process.ErrLogNotFound
+}
+
+// SaveLog appends the provided log entries to the collected ones
+func (lc *logsCollector) SaveLog(_ []byte, _ data.TransactionHandler, logEntries []*vmcommon.LogEntry) error {
+	lc.mutLogs.Lock()
+	lc.logEntries = append(lc.logEntries, logEntries...)
+	lc.mutLogs.Unlock()
+
+	return nil
+}
+
+// GetLogEntries returns the log entries collected since the last clean
+func (lc *logsCollector) GetLogEntries() []*vmcommon.LogEntry {
+	lc.mutLogs.RLock()
+	defer lc.mutLogs.RUnlock()
+
+	logEntries := make([]*vmcommon.LogEntry, len(lc.logEntries))
+	copy(logEntries, lc.logEntries)
+
+	return logEntries
+}
+
+// Clean removes all the collected log entries
+func (lc *logsCollector) Clean() {
+	lc.mutLogs.Lock()
+	lc.logEntries = make([]*vmcommon.LogEntry, 0)
+	lc.mutLogs.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lc *logsCollector) IsInterfaceNil() bool {
+	return lc == nil
+}
@@ -0,0 +1,45 @@
+package txsimulator
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogsCollector(t *testing.T) {
+	t.Parallel()
+
+	lc := NewLogsCollector()
+	require.False(t, check.IfNil(lc))
+	require.Equal(t, 0, len(lc.GetLogEntries()))
+}
+
+func TestLogsCollector_GetLogShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lc := NewLogsCollector()
+	txLog, err := lc.GetLog([]byte("hash"))
+	require.Nil(t, txLog)
+	require.Equal(t, process.ErrLogNotFound, err)
+}
+
+func TestLogsCollector_SaveLogAndClean(t *testing.T) {
+	t.Parallel()
+
+	lc := NewLogsCollector()
+	firstEntry := &vmcommon.LogEntry{Identifier: []byte("first")}
+	secondEntry := &vmcommon.LogEntry{Identifier: []byte("second")}
+
+	err := lc.SaveLog([]byte("hash"), &transaction.Transaction{}, []*vmcommon.LogEntry{firstEntry})
+	require.NoError(t, err)
+	err = lc.SaveLog([]byte("hash"), &transaction.Transaction{}, []*vmcommon.LogEntry{secondEntry})
+	require.NoError(t, err)
+	require.Equal(t, []*vmcommon.LogEntry{firstEntry, secondEntry}, lc.GetLogEntries())
+
+	lc.Clean()
+	require.Equal(t, 0, len(lc.GetLogEntries()))
+}
@@ -1,7 +1,10 @@
 package txsimulator
 
 import (
+	"bytes"
 	"encoding/hex"
+	"math/big"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -9,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -21,13 +25,22 @@ type ArgsTxSimulator struct {
 	IntermmediateProcContainer process.IntermediateProcessorContainer
 	AddressPubKeyConverter     core.PubkeyConverter
 	ShardCoordinator           sharding.Coordinator
+	AccountsJournal            AccountsJournal
+	LogsCollector              LogsCollector
+	GasHandler                 GasHandler
+	EconomicsHandler           EconomicsHandler
 }
 
 type transactionSimulator struct {
+	mutSimulation          sync.Mutex
 	txProcessor            TransactionProcessor
 	intermProcContainer    process.IntermediateProcessorContainer
 	addressPubKeyConverter core.PubkeyConverter
 	shardCoordinator       sharding.Coordinator
+	accountsJournal        AccountsJournal
+	logsCollector          LogsCollector
+	gasHandler             GasHandler
+	economicsHandler       EconomicsHandler
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -44,17 +57,73 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, node.ErrNilShardCoordinator
 	}
+	if check.IfNil(args.AccountsJournal) {
+		return nil, node.ErrNilAccountsJournal
+	}
+	if check.IfNil(args.LogsCollector) {
+		return nil, node.ErrNilLogsCollector
+	}
+	if check.IfNil(args.GasHandler) {
+		return nil, node.ErrNilGasHandler
+	}
+	if check.IfNil(args.EconomicsHandler) {
+		return nil, node.ErrNilEconomicsHandler
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
 		intermProcContainer:    args.IntermmediateProcContainer,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 		shardCoordinator:       args.ShardCoordinator,
+		accountsJournal:        args.AccountsJournal,
+		logsCollector:          args.LogsCollector,
+		gasHandler:             args.GasHandler,
+		economicsHandler:       args.EconomicsHandler,
 	}, nil
 }
 
 // ProcessTx will process the transaction in a special environment, where state-writing is not allowed
 func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	ts.mutSimulation.Lock()
+	defer ts.mutSimulation.Unlock()
+
+	ts.accountsJournal.Reset()
+
+	return ts.processStep(tx)
+}
+
+// ProcessBatch will process the provided transactions, in order, in the same special environment used by ProcessTx.
+// Each transaction sees the state changes produced by the previous ones, none of them being written in the real state
+func (ts *transactionSimulator) ProcessBatch(txs []*transaction.Transaction) ([]*transaction.SimulationResults, error) {
+	if len(txs) == 0 {
+		return nil, node.ErrEmptyTransactionsBatch
+	}
+
+	ts.mutSimulation.Lock()
+	defer ts.mutSimulation.Unlock()
+
+	ts.accountsJournal.Reset()
+	defer ts.accountsJournal.Reset()
+
+	results := make([]*transaction.SimulationResults, 0, len(txs))
+	for _, tx := range txs {
+		stepResults, err := ts.processStep(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, stepResults)
+		ts.accountsJournal.ApplyJournal()
+	}
+
+	return results, nil
+}
+
+func (ts *transactionSimulator) processStep(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	ts.accountsJournal.CleanJournal()
+	ts.logsCollector.Clean()
+	ts.gasHandler.Init()
+
 	txStatus := transaction.TxStatusPending
 	failReason := ""
 
@@ -78,9 +147,146 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*transac
 		return nil, err
 	}
 
+	err = ts.addAccountsChangesToResult(results)
+	if err != nil {
+		return nil, err
+	}
+
+	results.Logs = ts.adaptLogEntries(ts.logsCollector.GetLogEntries())
+	results.GasUsage = ts.computeGasUsage(tx)
+
 	return results, nil
 }
 
+func (ts *transactionSimulator) addAccountsChangesToResult(result *transaction.SimulationResults) error {
+	changesByAddress := make(map[string]*AccountChange)
+	addresses := make([]string, 0)
+	for _, change := range ts.accountsJournal.GetJournal() {
+		address := string(change.Address)
+		existing, found := changesByAddress[address]
+		if !found {
+			changesByAddress[address] = change
+			addresses = append(addresses, address)
+			continue
+		}
+
+		storageWrites := make(map[string][]byte, len(existing.StorageWrites)+len(change.StorageWrites))
+		for key, value := range existing.StorageWrites {
+			storageWrites[key] = value
+		}
+		for key, value := range change.StorageWrites {
+			storageWrites[key] = value
+		}
+
+		changesByAddress[address] = &AccountChange{
+			Address:       change.Address,
+			Balance:       change.Balance,
+			Nonce:         change.Nonce,
+			StorageWrites: storageWrites,
+			Removed:       change.Removed,
+		}
+	}
+
+	accounts := make([]*transaction.SimulatedAccountApi, 0, len(addresses))
+	for _, address := range addresses {
+		apiAccount, err := ts.adaptAccountChange(changesByAddress[address])
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, apiAccount)
+	}
+	result.Accounts = accounts
+
+	return nil
+}
+
+func (ts *transactionSimulator) adaptAccountChange(change *AccountChange) (*transaction.SimulatedAccountApi, error) {
+	initialBalance := big.NewInt(0)
+	initialNonce := uint64(0)
+	initialStorage := make(map[string][]byte)
+	initialAccount, err := ts.accountsJournal.GetAccountBeforeChanges(change.Address)
+	if err == nil && !check.IfNil(initialAccount) {
+		initialNonce = initialAccount.GetNonce()
+		userAccount, ok := initialAccount.(state.UserAccountHandler)
+		if ok && userAccount.GetBalance() != nil {
+			initialBalance = userAccount.GetBalance()
+		}
+		if ok && !check.IfNil(userAccount.DataTrieTracker()) {
+			initialStorage = copyDirtyData(change.Address, userAccount.DataTrieTracker().DirtyData())
+		}
+	}
+
+	finalBalance := change.Balance
+	finalNonce := change.Nonce
+	if change.Removed || finalBalance == nil {
+		finalBalance = big.NewInt(0)
+	}
+	if change.Removed {
+		finalNonce = initialNonce
+	}
+
+	storageWrites := make(map[string]string, len(change.StorageWrites))
+	for key, value := range change.StorageWrites {
+		initialValue, found := initialStorage[key]
+		if found && bytes.Equal(initialValue, value) {
+			continue
+		}
+
+		storageWrites[hex.EncodeToString([]byte(key))] = hex.EncodeToString(value)
+	}
+
+	nonceDelta := uint64(0)
+	if finalNonce > initialNonce {
+		nonceDelta = finalNonce - initialNonce
+	}
+
+	return &transaction.SimulatedAccountApi{
+		Address:       ts.addressPubKeyConverter.Encode(change.Address),
+		Balance:       finalBalance.String(),
+		BalanceDelta:  big.NewInt(0).Sub(finalBalance, initialBalance).String(),
+		Nonce:         finalNonce,
+		NonceDelta:    nonceDelta,
+		Removed:       change.Removed,
+		StorageWrites: storageWrites,
+	}, nil
+}
+
+func (ts *transactionSimulator) adaptLogEntries(logEntries []*vmcommon.LogEntry) []*transaction.LogEventApi {
+	logs := make([]*transaction.LogEventApi, 0, len(logEntries))
+	for _, logEntry := range logEntries {
+		if logEntry == nil {
+			continue
+		}
+
+		logs = append(logs, &transaction.LogEventApi{
+			Address:    ts.addressPubKeyConverter.Encode(logEntry.Address),
+			Identifier: string(logEntry.Identifier),
+			Topics:     logEntry.Topics,
+			Data:       logEntry.Data,
+		})
+	}
+
+	return logs
+}
+
+func (ts *transactionSimulator) computeGasUsage(tx *transaction.Transaction) *transaction.SimulationGasUsageApi {
+	gasForMoveBalance, gasForProcessing := ts.economicsHandler.SplitTxGasInCategories(tx)
+
+	gasRefunded := ts.gasHandler.TotalGasRefunded()
+	if gasRefunded > gasForProcessing {
+		gasRefunded = gasForProcessing
+	}
+	gasForProcessing -= gasRefunded
+
+	return &transaction.SimulationGasUsageApi{
+		MoveBalance: gasForMoveBalance,
+		Processing:  gasForProcessing,
+		Refunded:    gasRefunded,
+		Total:       gasForMoveBalance + gasForProcessing,
+	}
+}
+
 func (ts *transactionSimulator) addIntermediateTxsToResult(result *transaction.SimulationResults) error {
 	defer func() {
 		processorsKeys := ts.intermProcContainer.Keys()
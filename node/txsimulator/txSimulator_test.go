@@ -1,8 +1,10 @@
 package txsimulator
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
@@ -10,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
@@ -50,6 +53,42 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: node.ErrNilIntermediateProcessorContainer,
 		},
+		{
+			name: "NilAccountsJournal",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.AccountsJournal = nil
+				return args
+			},
+			exError: node.ErrNilAccountsJournal,
+		},
+		{
+			name: "NilLogsCollector",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.LogsCollector = nil
+				return args
+			},
+			exError: node.ErrNilLogsCollector,
+		},
+		{
+			name: "NilGasHandler",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.GasHandler = nil
+				return args
+			},
+			exError: node.ErrNilGasHandler,
+		},
+		{
+			name: "NilEconomicsHandler",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.EconomicsHandler = nil
+				return args
+			},
+			exError: node.ErrNilEconomicsHandler,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -126,11 +165,241 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	)
 }
 
+func TestTransactionSimulator_ProcessTxShouldIncludeAccountsChanges(t *testing.T) {
+	t.Parallel()
+
+	senderAddress := []byte("sender")
+	receiverAddress := []byte("receiver")
+	originalAccounts := &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			if bytes.Equal(address, senderAddress) {
+				account, _ := state.NewUserAccount(senderAddress)
+				_ = account.AddToBalance(big.NewInt(100))
+				account.IncreaseNonce(5)
+				return account, nil
+			}
+			return nil, state.ErrAccNotFound
+		},
+	}
+	accountsJournal, _ := NewReadOnlyAccountsDB(originalAccounts)
+
+	args := getTxSimulatorArgs()
+	args.AccountsJournal = accountsJournal
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			sender, _ := state.NewUserAccount(senderAddress)
+			_ = sender.AddToBalance(big.NewInt(60))
+			sender.IncreaseNonce(6)
+			_ = accountsJournal.SaveAccount(sender)
+
+			receiver, _ := state.NewUserAccount(receiverAddress)
+			_ = receiver.AddToBalance(big.NewInt(40))
+			_ = receiver.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+			_ = accountsJournal.SaveAccount(receiver)
+
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 5})
+	require.NoError(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Equal(t, 2, len(results.Accounts))
+
+	sender := results.Accounts[0]
+	require.Equal(t, hex.EncodeToString(senderAddress), sender.Address)
+	require.Equal(t, "60", sender.Balance)
+	require.Equal(t, "-40", sender.BalanceDelta)
+	require.Equal(t, uint64(6), sender.Nonce)
+	require.Equal(t, uint64(1), sender.NonceDelta)
+	require.Equal(t, 0, len(sender.StorageWrites))
+
+	receiver := results.Accounts[1]
+	require.Equal(t, hex.EncodeToString(receiverAddress), receiver.Address)
+	require.Equal(t, "40", receiver.Balance)
+	require.Equal(t, "40", receiver.BalanceDelta)
+	require.Equal(t, hex.EncodeToString([]byte("value")), receiver.StorageWrites[hex.EncodeToString([]byte("key"))])
+}
+
+func TestTransactionSimulator_ProcessTxRevertedChangesShouldNotBeIncluded(t *testing.T) {
+	t.Parallel()
+
+	accountsJournal, _ := NewReadOnlyAccountsDB(&mock.AccountsStub{})
+
+	args := getTxSimulatorArgs()
+	args.AccountsJournal = accountsJournal
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			sender, _ := state.NewUserAccount([]byte("sender"))
+			_ = accountsJournal.SaveAccount(sender)
+
+			snapshot := accountsJournal.JournalLen()
+			contract, _ := state.NewUserAccount([]byte("contract"))
+			_ = contract.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+			_ = accountsJournal.SaveAccount(contract)
+			_ = accountsJournal.RevertToSnapshot(snapshot)
+
+			return vmcommon.UserError, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results.Accounts))
+	require.Equal(t, hex.EncodeToString([]byte("sender")), results.Accounts[0].Address)
+}
+
+func TestTransactionSimulator_ProcessTxShouldIncludeLogsAndGasUsage(t *testing.T) {
+	t.Parallel()
+
+	logsCollector := NewLogsCollector()
+	gasHandlerInitialized := false
+	args := getTxSimulatorArgs()
+	args.LogsCollector = logsCollector
+	args.GasHandler = &mock.GasHandlerStub{
+		InitCalled: func() {
+			gasHandlerInitialized = true
+		},
+		TotalGasRefundedCalled: func() uint64 {
+			return 300
+		},
+	}
+	args.EconomicsHandler = &mock.EconomicsHandlerStub{
+		SplitTxGasInCategoriesCalled: func(_ process.TransactionWithFeeHandler) (uint64, uint64) {
+			return 50000, 1000
+		},
+	}
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			_ = logsCollector.SaveLog([]byte("hash"), tx, []*vmcommon.LogEntry{
+				{
+					Identifier: []byte("transfer"),
+					Address:    []byte("contract"),
+					Topics:     [][]byte{[]byte("topic")},
+					Data:       []byte("data"),
+				},
+			})
+
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{})
+	require.NoError(t, err)
+	require.True(t, gasHandlerInitialized)
+
+	require.Equal(t, 1, len(results.Logs))
+	require.Equal(t, hex.EncodeToString([]byte("contract")), results.Logs[0].Address)
+	require.Equal(t, "transfer", results.Logs[0].Identifier)
+	require.Equal(t, [][]byte{[]byte("topic")}, results.Logs[0].Topics)
+	require.Equal(t, []byte("data"), results.Logs[0].Data)
+
+	expectedGasUsage := &transaction.SimulationGasUsageApi{
+		MoveBalance: 50000,
+		Processing:  700,
+		Refunded:    300,
+		Total:       50700,
+	}
+	require.Equal(t, expectedGasUsage, results.GasUsage)
+
+	results, err = ts.ProcessTx(&transaction.Transaction{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(results.Logs))
+}
+
+func TestTransactionSimulator_ProcessBatchEmptyBatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+
+	results, err := ts.ProcessBatch(nil)
+	require.Nil(t, results)
+	require.Equal(t, node.ErrEmptyTransactionsBatch, err)
+}
+
+func TestTransactionSimulator_ProcessBatchShouldChainStateChanges(t *testing.T) {
+	t.Parallel()
+
+	senderAddress := []byte("sender")
+	originalAccounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			account, _ := state.NewUserAccount(address)
+			if bytes.Equal(address, senderAddress) {
+				_ = account.AddToBalance(big.NewInt(100))
+			}
+			return account, nil
+		},
+		GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			if bytes.Equal(address, senderAddress) {
+				account, _ := state.NewUserAccount(address)
+				_ = account.AddToBalance(big.NewInt(100))
+				return account, nil
+			}
+			return nil, state.ErrAccNotFound
+		},
+	}
+	accountsJournal, _ := NewReadOnlyAccountsDB(originalAccounts)
+
+	args := getTxSimulatorArgs()
+	args.AccountsJournal = accountsJournal
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			acc, _ := accountsJournal.LoadAccount(senderAddress)
+			sender := acc.(state.UserAccountHandler)
+			if sender.GetNonce() != tx.Nonce {
+				return vmcommon.UserError, errors.New("invalid nonce")
+			}
+
+			err := sender.SubFromBalance(big.NewInt(30))
+			if err != nil {
+				return vmcommon.UserError, err
+			}
+			sender.IncreaseNonce(1)
+			_ = sender.DataTrieTracker().SaveKeyValue([]byte{byte(tx.Nonce)}, []byte("step"))
+
+			return vmcommon.Ok, accountsJournal.SaveAccount(sender)
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	txs := []*transaction.Transaction{{Nonce: 0}, {Nonce: 1}, {Nonce: 2}, {Nonce: 3}}
+	results, err := ts.ProcessBatch(txs)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(results))
+
+	for i := 0; i < 3; i++ {
+		require.Equal(t, transaction.TxStatusSuccess, results[i].Status)
+		require.Equal(t, 1, len(results[i].Accounts))
+		require.Equal(t, "-30", results[i].Accounts[0].BalanceDelta)
+		require.Equal(t, uint64(i+1), results[i].Accounts[0].Nonce)
+		require.Equal(t, uint64(1), results[i].Accounts[0].NonceDelta)
+		require.Equal(t, 1, len(results[i].Accounts[0].StorageWrites))
+		require.Equal(t, hex.EncodeToString([]byte("step")), results[i].Accounts[0].StorageWrites[hex.EncodeToString([]byte{byte(i)})])
+	}
+	require.Equal(t, "10", results[2].Accounts[0].Balance)
+
+	require.Equal(t, transaction.TxStatusFail, results[3].Status)
+	require.Equal(t, 0, len(results[3].Accounts))
+
+	single, err := ts.ProcessTx(&transaction.Transaction{Nonce: 0})
+	require.NoError(t, err)
+	require.Equal(t, "70", single.Accounts[0].Balance)
+}
+
 func getTxSimulatorArgs() ArgsTxSimulator {
+	accountsJournal, _ := NewReadOnlyAccountsDB(&mock.AccountsStub{})
+
 	return ArgsTxSimulator{
 		TransactionProcessor:       &mock.TxProcessorStub{},
 		IntermmediateProcContainer: &mock.IntermProcessorContainerStub{},
 		AddressPubKeyConverter:     &mock.PubkeyConverterMock{},
 		ShardCoordinator:           mock.NewMultiShardsCoordinatorMock(2),
+		AccountsJournal:            accountsJournal,
+		LogsCollector:              NewLogsCollector(),
+		GasHandler:                 &mock.GasHandlerStub{},
+		EconomicsHandler:           &mock.EconomicsHandlerStub{},
 	}
 }
@@ -1,7 +1,10 @@
 package txsimulator
 
 import (
+	"bytes"
 	"context"
+	"math/big"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -10,9 +13,30 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node"
 )
 
+// AccountChange holds the state of an account as it was saved during a simulated execution
+type AccountChange struct {
+	Address         []byte
+	Balance         *big.Int
+	Nonce           uint64
+	StorageWrites   map[string][]byte
+	CodeHash        []byte
+	CodeMetadata    []byte
+	OwnerAddress    []byte
+	UserName        []byte
+	DeveloperReward *big.Int
+	Removed         bool
+}
+
 // readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled
+// but are recorded in a journal so the effects of a simulated transaction can be inspected. Journalled changes
+// can be applied on an in-memory layer, so that subsequent simulated transactions see them, without ever touching
+// the original accounts db. Code deployed during a simulation is not kept as it can not be read back from the account
 type readOnlyAccountsDB struct {
 	originalAccounts state.AccountsAdapter
+	mutJournal       sync.RWMutex
+	journal          []*AccountChange
+	appliedChanges   map[string]*AccountChange
+	baseJournalLen   int
 }
 
 // NewReadOnlyAccountsDB returns a new instance of readOnlyAccountsDB
@@ -21,7 +45,12 @@ func NewReadOnlyAccountsDB(accountsDB state.AccountsAdapter) (*readOnlyAccountsD
 		return nil, node.ErrNilAccountsAdapter
 	}
 
-	return &readOnlyAccountsDB{originalAccounts: accountsDB}, nil
+	return &readOnlyAccountsDB{
+		originalAccounts: accountsDB,
+		journal:          make([]*AccountChange, 0),
+		appliedChanges:   make(map[string]*AccountChange),
+		baseJournalLen:   accountsDB.JournalLen(),
+	}, nil
 }
 
 // GetCode returns the code for the given account
@@ -29,38 +58,231 @@ func (w *readOnlyAccountsDB) GetCode(codeHash []byte) []byte {
 	return w.originalAccounts.GetCode(codeHash)
 }
 
-// GetExistingAccount will call the original accounts' function with the same name
+// GetExistingAccount will call the original accounts' function with the same name and will apply the simulated changes
 func (w *readOnlyAccountsDB) GetExistingAccount(address []byte) (state.AccountHandler, error) {
-	return w.originalAccounts.GetExistingAccount(address)
+	change := w.getLatestChange(address)
+	if change == nil {
+		return w.originalAccounts.GetExistingAccount(address)
+	}
+	if change.Removed {
+		return nil, state.ErrAccNotFound
+	}
+
+	return w.loadAccountWithChange(address, change)
 }
 
-// LoadAccount will call the original accounts' function with the same name
+// LoadAccount will call the original accounts' function with the same name and will apply the simulated changes
 func (w *readOnlyAccountsDB) LoadAccount(address []byte) (state.AccountHandler, error) {
-	return w.originalAccounts.LoadAccount(address)
+	change := w.getLatestChange(address)
+	if change == nil || change.Removed {
+		return w.originalAccounts.LoadAccount(address)
+	}
+
+	return w.loadAccountWithChange(address, change)
 }
 
-// SaveAccount won't do anything as write operations are disabled on this component
-func (w *readOnlyAccountsDB) SaveAccount(_ state.AccountHandler) error {
+// GetAccountBeforeChanges returns the account as it was before the changes recorded in the journal, which means
+// the original account with the applied changes on top
+func (w *readOnlyAccountsDB) GetAccountBeforeChanges(address []byte) (state.AccountHandler, error) {
+	w.mutJournal.RLock()
+	change, found := w.appliedChanges[string(address)]
+	w.mutJournal.RUnlock()
+
+	if !found {
+		return w.originalAccounts.GetExistingAccount(address)
+	}
+	if change.Removed {
+		return nil, state.ErrAccNotFound
+	}
+
+	return w.loadAccountWithChange(address, change)
+}
+
+func (w *readOnlyAccountsDB) getLatestChange(address []byte) *AccountChange {
+	w.mutJournal.RLock()
+	defer w.mutJournal.RUnlock()
+
+	for i := len(w.journal) - 1; i >= 0; i-- {
+		if bytes.Equal(w.journal[i].Address, address) {
+			return w.journal[i]
+		}
+	}
+
+	return w.appliedChanges[string(address)]
+}
+
+func (w *readOnlyAccountsDB) loadAccountWithChange(address []byte, change *AccountChange) (state.AccountHandler, error) {
+	account, err := w.originalAccounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if change.Nonce > account.GetNonce() {
+		account.IncreaseNonce(change.Nonce - account.GetNonce())
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return account, nil
+	}
+
+	if change.Balance != nil {
+		err = userAccount.AddToBalance(big.NewInt(0).Sub(change.Balance, userAccount.GetBalance()))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if change.DeveloperReward != nil {
+		userAccount.AddToDeveloperReward(big.NewInt(0).Sub(change.DeveloperReward, userAccount.GetDeveloperReward()))
+	}
+	userAccount.SetCodeHash(change.CodeHash)
+	userAccount.SetCodeMetadata(change.CodeMetadata)
+	userAccount.SetOwnerAddress(change.OwnerAddress)
+	userAccount.SetUserName(change.UserName)
+
+	if check.IfNil(userAccount.DataTrieTracker()) {
+		return userAccount, nil
+	}
+	for key, value := range change.StorageWrites {
+		err = userAccount.DataTrieTracker().SaveKeyValue([]byte(key), append(make([]byte, 0, len(value)), value...))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return userAccount, nil
+}
+
+// SaveAccount won't write anything in the original accounts db, it will only record the account's state in the journal
+func (w *readOnlyAccountsDB) SaveAccount(account state.AccountHandler) error {
+	if check.IfNil(account) {
+		return nil
+	}
+
+	change := &AccountChange{
+		Address:       account.AddressBytes(),
+		Nonce:         account.GetNonce(),
+		StorageWrites: make(map[string][]byte),
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if ok {
+		change.Balance = big.NewInt(0).Set(userAccount.GetBalance())
+		change.DeveloperReward = big.NewInt(0).Set(userAccount.GetDeveloperReward())
+		change.CodeHash = userAccount.GetCodeHash()
+		change.CodeMetadata = userAccount.GetCodeMetadata()
+		change.OwnerAddress = userAccount.GetOwnerAddress()
+		change.UserName = userAccount.GetUserName()
+		if !check.IfNil(userAccount.DataTrieTracker()) {
+			change.StorageWrites = copyDirtyData(userAccount.AddressBytes(), userAccount.DataTrieTracker().DirtyData())
+		}
+	}
+
+	w.appendToJournal(change)
+
 	return nil
 }
 
-// RemoveAccount won't do anything as write operations are disabled on this component
-func (w *readOnlyAccountsDB) RemoveAccount(_ []byte) error {
+// RemoveAccount won't remove anything from the original accounts db, it will only record the removal in the journal
+func (w *readOnlyAccountsDB) RemoveAccount(address []byte) error {
+	if len(address) == 0 {
+		return nil
+	}
+
+	w.appendToJournal(&AccountChange{
+		Address:       address,
+		Removed:       true,
+		StorageWrites: make(map[string][]byte),
+	})
+
 	return nil
 }
 
+func (w *readOnlyAccountsDB) appendToJournal(change *AccountChange) {
+	w.mutJournal.Lock()
+	w.journal = append(w.journal, change)
+	w.mutJournal.Unlock()
+}
+
+// copyDirtyData returns the dirty data of an account with the key and address suffix removed from the values
+func copyDirtyData(address []byte, dirtyData map[string][]byte) map[string][]byte {
+	storage := make(map[string][]byte, len(dirtyData))
+	for key, value := range dirtyData {
+		tailLength := len(key) + len(address)
+		if len(value) < tailLength {
+			storage[key] = make([]byte, 0)
+			continue
+		}
+
+		storage[key] = append(make([]byte, 0, len(value)-tailLength), value[:len(value)-tailLength]...)
+	}
+
+	return storage
+}
+
+// GetJournal returns the account changes recorded since the journal was last cleaned
+func (w *readOnlyAccountsDB) GetJournal() []*AccountChange {
+	w.mutJournal.RLock()
+	defer w.mutJournal.RUnlock()
+
+	journal := make([]*AccountChange, len(w.journal))
+	copy(journal, w.journal)
+
+	return journal
+}
+
+// CleanJournal removes all the recorded account changes
+func (w *readOnlyAccountsDB) CleanJournal() {
+	w.mutJournal.Lock()
+	w.journal = make([]*AccountChange, 0)
+	w.mutJournal.Unlock()
+}
+
+// ApplyJournal moves the recorded account changes on the in-memory layer so that they will be seen by
+// all subsequent reads. The journal is emptied afterwards
+func (w *readOnlyAccountsDB) ApplyJournal() {
+	w.mutJournal.Lock()
+	defer w.mutJournal.Unlock()
+
+	for _, change := range w.journal {
+		w.appliedChanges[string(change.Address)] = change
+	}
+	w.journal = make([]*AccountChange, 0)
+}
+
+// Reset removes both the recorded and the applied account changes
+func (w *readOnlyAccountsDB) Reset() {
+	w.mutJournal.Lock()
+	w.journal = make([]*AccountChange, 0)
+	w.appliedChanges = make(map[string]*AccountChange)
+	w.mutJournal.Unlock()
+}
+
 // Commit won't do anything as write operations are disabled on this component
 func (w *readOnlyAccountsDB) Commit() ([]byte, error) {
 	return nil, nil
 }
 
-// JournalLen will call the original accounts' function with the same name
+// JournalLen returns the original accounts' journal length, as seen at construction time, plus the number of recorded changes
 func (w *readOnlyAccountsDB) JournalLen() int {
-	return w.originalAccounts.JournalLen()
+	w.mutJournal.RLock()
+	defer w.mutJournal.RUnlock()
+
+	return w.baseJournalLen + len(w.journal)
 }
 
-// RevertToSnapshot won't do anything as write operations are disabled on this component
-func (w *readOnlyAccountsDB) RevertToSnapshot(_ int) error {
+// RevertToSnapshot will only drop the recorded changes made after the provided snapshot
+func (w *readOnlyAccountsDB) RevertToSnapshot(snapshot int) error {
+	w.mutJournal.Lock()
+	defer w.mutJournal.Unlock()
+
+	index := snapshot - w.baseJournalLen
+	if index < 0 || index >= len(w.journal) {
+		return nil
+	}
+
+	w.journal = w.journal[:index]
+
 	return nil
 }
 
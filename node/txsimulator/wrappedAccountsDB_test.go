@@ -1,6 +1,7 @@
 package txsimulator
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -162,3 +163,107 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	actualNumCheckpoints := roAccDb.GetNumCheckpoints()
 	require.Equal(t, expectedNumCheckpoints, actualNumCheckpoints)
 }
+
+func TestReadOnlyAccountsDB_SaveAccountShouldRecordChanges(t *testing.T) {
+	t.Parallel()
+
+	roAccDb, _ := NewReadOnlyAccountsDB(&mock.AccountsStub{})
+
+	account, _ := state.NewUserAccount([]byte("address"))
+	_ = account.AddToBalance(big.NewInt(10))
+	account.IncreaseNonce(2)
+	_ = account.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+	_ = account.DataTrieTracker().SaveKeyValue([]byte("deleted"), nil)
+
+	err := roAccDb.SaveAccount(account)
+	require.NoError(t, err)
+	err = roAccDb.RemoveAccount([]byte("removed"))
+	require.NoError(t, err)
+
+	journal := roAccDb.GetJournal()
+	require.Equal(t, 2, len(journal))
+	require.Equal(t, []byte("address"), journal[0].Address)
+	require.Equal(t, big.NewInt(10), journal[0].Balance)
+	require.Equal(t, uint64(2), journal[0].Nonce)
+	require.Equal(t, []byte("value"), journal[0].StorageWrites["key"])
+	require.Equal(t, []byte{}, journal[0].StorageWrites["deleted"])
+	require.Equal(t, []byte("removed"), journal[1].Address)
+	require.True(t, journal[1].Removed)
+
+	_ = account.AddToBalance(big.NewInt(5))
+	require.Equal(t, big.NewInt(10), roAccDb.GetJournal()[0].Balance)
+
+	roAccDb.CleanJournal()
+	require.Equal(t, 0, len(roAccDb.GetJournal()))
+}
+
+func TestReadOnlyAccountsDB_RevertToSnapshotShouldDropLaterChanges(t *testing.T) {
+	t.Parallel()
+
+	roAccDb, _ := NewReadOnlyAccountsDB(&mock.AccountsStub{
+		JournalLenCalled: func() int {
+			return 3
+		},
+	})
+
+	account, _ := state.NewUserAccount([]byte("address"))
+	_ = roAccDb.SaveAccount(account)
+
+	snapshot := roAccDb.JournalLen()
+	require.Equal(t, 4, snapshot)
+
+	_ = roAccDb.SaveAccount(account)
+	_ = roAccDb.RemoveAccount([]byte("address"))
+	require.Equal(t, 6, roAccDb.JournalLen())
+
+	err := roAccDb.RevertToSnapshot(snapshot)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, roAccDb.JournalLen())
+	require.Equal(t, 1, len(roAccDb.GetJournal()))
+}
+
+func TestReadOnlyAccountsDB_LoadAccountShouldApplyChanges(t *testing.T) {
+	t.Parallel()
+
+	roAccDb, _ := NewReadOnlyAccountsDB(&mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return state.NewUserAccount(address)
+		},
+		GetExistingAccountCalled: func(_ []byte) (state.AccountHandler, error) {
+			return nil, state.ErrAccNotFound
+		},
+	})
+
+	account, _ := state.NewUserAccount([]byte("address"))
+	_ = account.AddToBalance(big.NewInt(10))
+	account.IncreaseNonce(3)
+	account.SetOwnerAddress([]byte("owner"))
+	_ = account.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+	_ = roAccDb.SaveAccount(account)
+	roAccDb.ApplyJournal()
+	require.Equal(t, 0, len(roAccDb.GetJournal()))
+
+	loadedAccount, err := roAccDb.LoadAccount([]byte("address"))
+	require.NoError(t, err)
+	userAccount := loadedAccount.(state.UserAccountHandler)
+	require.Equal(t, big.NewInt(10), userAccount.GetBalance())
+	require.Equal(t, uint64(3), userAccount.GetNonce())
+	require.Equal(t, []byte("owner"), userAccount.GetOwnerAddress())
+	value, err := userAccount.DataTrieTracker().RetrieveValue([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	existingAccount, err := roAccDb.GetAccountBeforeChanges([]byte("address"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), existingAccount.GetNonce())
+
+	_ = roAccDb.RemoveAccount([]byte("address"))
+	_, err = roAccDb.GetExistingAccount([]byte("address"))
+	require.Equal(t, state.ErrAccNotFound, err)
+
+	roAccDb.Reset()
+	_, err = roAccDb.GetExistingAccount([]byte("address"))
+	require.Equal(t, state.ErrAccNotFound, err)
+	loadedAccount, _ = roAccDb.LoadAccount([]byte("address"))
+	require.Equal(t, uint64(0), loadedAccount.GetNonce())
+}
@@ -0,0 +1,310 @@
+package txtracer
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+const (
+	callStepType            = "call"
+	deployStepType          = "deploy"
+	builtInFunctionStepType = "builtInFunction"
+)
+
+var _ process.SCExecutionTracer = (*callTracer)(nil)
+
+type tracedCall struct {
+	address    []byte
+	codeLoaded bool
+	step       *transaction.ExecutionStepApi
+}
+
+// callTracer records the steps of the smart contract executions it is notified about and arranges them in a call tree.
+// The virtual machines neither report the nested calls nor when they return, so the nesting is a best effort inferred
+// from the order of the blockchain hook accesses: a code load opens a nested call of the current one, while a storage
+// read or a built-in function call made on behalf of a contract already on the call stack returns to that contract.
+// The VM output only holds the gas used and the storage updates of each contract for the whole execution, so the
+// nested contract calls carry no gas and the storage writes of a contract are attached to its first call. The gas used
+// is only reported for the top-level steps, covering the nested calls, and for the built-in function calls
+type callTracer struct {
+	mutSteps       sync.Mutex
+	pubkeyConv     core.PubkeyConverter
+	steps          []*transaction.ExecutionStepApi
+	callStack      []*tracedCall
+	executionCalls []*tracedCall
+}
+
+// NewCallTracer returns a new instance of callTracer
+func NewCallTracer(pubkeyConv core.PubkeyConverter) (*callTracer, error) {
+	if check.IfNil(pubkeyConv) {
+		return nil, node.ErrNilPubkeyConverter
+	}
+
+	return &callTracer{
+		pubkeyConv: pubkeyConv,
+		steps:      make([]*transaction.ExecutionStepApi, 0),
+	}, nil
+}
+
+// OnExecutionStart opens a new top-level call. An empty contract address marks a contract deployment
+func (ct *callTracer) OnExecutionStart(contractAddress []byte, function string, input *vmcommon.VMInput) {
+	if input == nil {
+		return
+	}
+
+	ct.mutSteps.Lock()
+	defer ct.mutSteps.Unlock()
+
+	call := &tracedCall{
+		address: contractAddress,
+		step: &transaction.ExecutionStepApi{
+			Type:        callStepType,
+			Caller:      ct.encodeAddress(input.CallerAddr),
+			Contract:    ct.encodeAddress(contractAddress),
+			Function:    function,
+			CallValue:   bigIntToString(input.CallValue),
+			GasProvided: input.GasProvided,
+		},
+	}
+	if len(contractAddress) == 0 {
+		call.step.Type = deployStepType
+		call.codeLoaded = true
+	}
+
+	ct.steps = append(ct.steps, call.step)
+	ct.callStack = []*tracedCall{call}
+	ct.executionCalls = []*tracedCall{call}
+}
+
+// OnCodeLoad opens a nested call of the current one unless the code belongs to the call which was just opened
+func (ct *callTracer) OnCodeLoad(address []byte) {
+	ct.mutSteps.Lock()
+	defer ct.mutSteps.Unlock()
+
+	if len(ct.callStack) == 0 {
+		return
+	}
+
+	current := ct.callStack[len(ct.callStack)-1]
+	if !current.codeLoaded && bytes.Equal(current.address, address) {
+		current.codeLoaded = true
+		return
+	}
+
+	nested := &tracedCall{
+		address:    address,
+		codeLoaded: true,
+		step: &transaction.ExecutionStepApi{
+			Type:     callStepType,
+			Caller:   ct.encodeAddress(current.address),
+			Contract: ct.encodeAddress(address),
+		},
+	}
+	current.step.Steps = append(current.step.Steps, nested.step)
+	ct.callStack = append(ct.callStack, nested)
+	ct.executionCalls = append(ct.executionCalls, nested)
+}
+
+// OnStorageRead records a storage read on the call of the contract owning the storage
+func (ct *callTracer) OnStorageRead(address []byte, key []byte, value []byte) {
+	ct.mutSteps.Lock()
+	defer ct.mutSteps.Unlock()
+
+	if len(ct.callStack) == 0 {
+		return
+	}
+
+	call := ct.returnTo(address)
+	call.step.StorageReads = append(call.step.StorageReads, &transaction.StorageAccessApi{
+		Key:   hex.EncodeToString(key),
+		Value: hex.EncodeToString(value),
+	})
+}
+
+// OnBuiltInFunctionCall records a built-in function call, either as a top-level step or as a step of its caller
+func (ct *callTracer) OnBuiltInFunctionCall(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error) {
+	if input == nil {
+		return
+	}
+
+	ct.mutSteps.Lock()
+	defer ct.mutSteps.Unlock()
+
+	step := &transaction.ExecutionStepApi{
+		Type:        builtInFunctionStepType,
+		Caller:      ct.encodeAddress(input.CallerAddr),
+		Contract:    ct.encodeAddress(input.RecipientAddr),
+		Function:    input.Function,
+		CallValue:   bigIntToString(input.CallValue),
+		GasProvided: input.GasProvided,
+	}
+	setStepResult(step, vmOutput, err)
+
+	if len(ct.callStack) == 0 {
+		ct.steps = append(ct.steps, step)
+		return
+	}
+
+	caller := ct.returnTo(input.CallerAddr)
+	caller.step.Steps = append(caller.step.Steps, step)
+}
+
+// OnExecutionEnd closes the current top-level call, filling the storage writes of each contract from the provided
+// output
+func (ct *callTracer) OnExecutionEnd(vmOutput *vmcommon.VMOutput, err error) {
+	ct.mutSteps.Lock()
+	defer ct.mutSteps.Unlock()
+
+	if len(ct.executionCalls) == 0 {
+		return
+	}
+
+	root := ct.executionCalls[0]
+	setStepResult(root.step, vmOutput, err)
+	ct.callStack = nil
+	if err != nil || vmOutput == nil {
+		ct.executionCalls = nil
+		return
+	}
+
+	if len(root.address) == 0 {
+		root.address = deployedContractAddress(vmOutput)
+		root.step.Contract = ct.encodeAddress(root.address)
+	}
+
+	processedAddresses := make(map[string]struct{})
+	for i, call := range ct.executionCalls {
+		if i > 0 && vmOutput.ReturnCode == vmcommon.Ok {
+			call.step.ReturnCode = vmOutput.ReturnCode.String()
+		}
+
+		_, processed := processedAddresses[string(call.address)]
+		if processed {
+			continue
+		}
+		processedAddresses[string(call.address)] = struct{}{}
+
+		outputAccount, found := vmOutput.OutputAccounts[string(call.address)]
+		if !found || outputAccount == nil {
+			continue
+		}
+
+		call.step.StorageWrites = adaptStorageUpdates(outputAccount.StorageUpdates)
+	}
+
+	ct.executionCalls = nil
+}
+
+// returnTo unwinds the call stack up to the innermost call of the provided address, if there is one, and returns
+// the current call
+func (ct *callTracer) returnTo(address []byte) *tracedCall {
+	for i := len(ct.callStack) - 1; i >= 0; i-- {
+		if bytes.Equal(ct.callStack[i].address, address) {
+			ct.callStack = ct.callStack[:i+1]
+			break
+		}
+	}
+
+	return ct.callStack[len(ct.callStack)-1]
+}
+
+// GetSteps returns the recorded top-level steps
+func (ct *callTracer) GetSteps() []*transaction.ExecutionStepApi {
+	ct.mutSteps.Lock()
+	defer ct.mutSteps.Unlock()
+
+	return ct.steps
+}
+
+// Reset removes all the recorded steps
+func (ct *callTracer) Reset() {
+	ct.mutSteps.Lock()
+	ct.steps = make([]*transaction.ExecutionStepApi, 0)
+	ct.callStack = nil
+	ct.executionCalls = nil
+	ct.mutSteps.Unlock()
+}
+
+func (ct *callTracer) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return ct.pubkeyConv.Encode(address)
+}
+
+func setStepResult(step *transaction.ExecutionStepApi, vmOutput *vmcommon.VMOutput, err error) {
+	if err != nil {
+		step.ReturnCode = vmcommon.UserError.String()
+		step.ReturnMessage = err.Error()
+		return
+	}
+	if vmOutput == nil {
+		step.ReturnCode = vmcommon.UserError.String()
+		step.ReturnMessage = process.ErrNilVMOutput.Error()
+		return
+	}
+
+	step.ReturnCode = vmOutput.ReturnCode.String()
+	step.ReturnMessage = vmOutput.ReturnMessage
+	if step.GasProvided > vmOutput.GasRemaining {
+		step.GasUsed = step.GasProvided - vmOutput.GasRemaining
+	}
+}
+
+func deployedContractAddress(vmOutput *vmcommon.VMOutput) []byte {
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		if outputAccount != nil && len(outputAccount.Code) > 0 {
+			return outputAccount.Address
+		}
+	}
+
+	return nil
+}
+
+func adaptStorageUpdates(storageUpdates map[string]*vmcommon.StorageUpdate) []*transaction.StorageAccessApi {
+	if len(storageUpdates) == 0 {
+		return nil
+	}
+
+	writes := make([]*transaction.StorageAccessApi, 0, len(storageUpdates))
+	for _, update := range storageUpdates {
+		if update == nil {
+			continue
+		}
+
+		writes = append(writes, &transaction.StorageAccessApi{
+			Key:   hex.EncodeToString(update.Offset),
+			Value: hex.EncodeToString(update.Data),
+		})
+	}
+
+	sort.Slice(writes, func(i, j int) bool {
+		return writes[i].Key < writes[j].Key
+	})
+
+	return writes
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ct *callTracer) IsInterfaceNil() bool {
+	return ct == nil
+}
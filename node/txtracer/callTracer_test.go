@@ -0,0 +1,218 @@
+package txtracer
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	callerAddress = []byte("caller")
+	contractA     = []byte("contractA")
+	contractB     = []byte("contractB")
+	contractC     = []byte("contractC")
+)
+
+func createCallTracer(t *testing.T) *callTracer {
+	ct, err := NewCallTracer(mock.NewPubkeyConverterMock(32))
+	require.Nil(t, err)
+
+	return ct
+}
+
+func createVMInput(gasProvided uint64) *vmcommon.VMInput {
+	return &vmcommon.VMInput{
+		CallerAddr:  callerAddress,
+		CallValue:   big.NewInt(10),
+		GasProvided: gasProvided,
+	}
+}
+
+func TestNewCallTracer(t *testing.T) {
+	t.Parallel()
+
+	ct, err := NewCallTracer(nil)
+	require.Nil(t, ct)
+	require.Equal(t, node.ErrNilPubkeyConverter, err)
+
+	ct, err = NewCallTracer(mock.NewPubkeyConverterMock(32))
+	require.Nil(t, err)
+	require.False(t, ct.IsInterfaceNil())
+	require.Empty(t, ct.GetSteps())
+}
+
+func TestCallTracer_NestedCallsShouldBuildTheCallTree(t *testing.T) {
+	t.Parallel()
+
+	ct := createCallTracer(t)
+
+	ct.OnExecutionStart(contractA, "first", createVMInput(1000))
+	ct.OnCodeLoad(contractA)
+	ct.OnStorageRead(contractA, []byte("keyA"), []byte("valueA"))
+	ct.OnCodeLoad(contractB)
+	ct.OnStorageRead(contractB, []byte("keyB"), []byte("valueB"))
+	ct.OnCodeLoad(contractC)
+	ct.OnStorageRead(contractA, []byte("keyA2"), []byte("valueA2"))
+	ct.OnExecutionEnd(&vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 400,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(contractA): {
+				Address: contractA,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"key2": {Offset: []byte("key2"), Data: []byte("value2")},
+					"key1": {Offset: []byte("key1"), Data: []byte("value1")},
+				},
+			},
+			string(contractB): {Address: contractB, GasUsed: 200},
+			string(contractC): {Address: contractC, GasUsed: 50},
+		},
+	}, nil)
+
+	steps := ct.GetSteps()
+	require.Len(t, steps, 1)
+
+	root := steps[0]
+	require.Equal(t, callStepType, root.Type)
+	require.Equal(t, hex.EncodeToString(callerAddress), root.Caller)
+	require.Equal(t, hex.EncodeToString(contractA), root.Contract)
+	require.Equal(t, "first", root.Function)
+	require.Equal(t, "10", root.CallValue)
+	require.Equal(t, uint64(600), root.GasUsed)
+	require.Equal(t, vmcommon.Ok.String(), root.ReturnCode)
+	require.Len(t, root.StorageReads, 2)
+	require.Equal(t, hex.EncodeToString([]byte("keyA2")), root.StorageReads[1].Key)
+	require.Len(t, root.StorageWrites, 2)
+	require.Equal(t, hex.EncodeToString([]byte("key1")), root.StorageWrites[0].Key)
+	require.Equal(t, hex.EncodeToString([]byte("value1")), root.StorageWrites[0].Value)
+
+	require.Len(t, root.Steps, 1)
+	nestedB := root.Steps[0]
+	require.Equal(t, hex.EncodeToString(contractA), nestedB.Caller)
+	require.Equal(t, hex.EncodeToString(contractB), nestedB.Contract)
+	require.Equal(t, uint64(0), nestedB.GasUsed, "the VM output does not hold the gas used by a nested call")
+	require.Equal(t, vmcommon.Ok.String(), nestedB.ReturnCode)
+	require.Len(t, nestedB.StorageReads, 1)
+
+	require.Len(t, nestedB.Steps, 1)
+	nestedC := nestedB.Steps[0]
+	require.Equal(t, hex.EncodeToString(contractB), nestedC.Caller)
+	require.Equal(t, hex.EncodeToString(contractC), nestedC.Contract)
+	require.Equal(t, uint64(0), nestedC.GasUsed)
+}
+
+func TestCallTracer_BuiltInFunctionCalls(t *testing.T) {
+	t.Parallel()
+
+	ct := createCallTracer(t)
+
+	builtInErr := errors.New("built-in function error")
+	ct.OnBuiltInFunctionCall(&vmcommon.ContractCallInput{
+		VMInput:       vmcommon.VMInput{CallerAddr: callerAddress, GasProvided: 100},
+		RecipientAddr: contractA,
+		Function:      "ESDTTransfer",
+	}, nil, builtInErr)
+
+	ct.OnExecutionStart(contractA, "call", createVMInput(1000))
+	ct.OnCodeLoad(contractA)
+	ct.OnCodeLoad(contractB)
+	ct.OnBuiltInFunctionCall(&vmcommon.ContractCallInput{
+		VMInput:       vmcommon.VMInput{CallerAddr: contractA, GasProvided: 100},
+		RecipientAddr: contractC,
+		Function:      "ClaimDeveloperRewards",
+	}, &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 70}, nil)
+	ct.OnExecutionEnd(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil)
+
+	steps := ct.GetSteps()
+	require.Len(t, steps, 2)
+
+	topLevelBuiltIn := steps[0]
+	require.Equal(t, builtInFunctionStepType, topLevelBuiltIn.Type)
+	require.Equal(t, "ESDTTransfer", topLevelBuiltIn.Function)
+	require.Equal(t, vmcommon.UserError.String(), topLevelBuiltIn.ReturnCode)
+	require.Equal(t, builtInErr.Error(), topLevelBuiltIn.ReturnMessage)
+
+	root := steps[1]
+	require.Len(t, root.Steps, 2)
+	require.Equal(t, hex.EncodeToString(contractB), root.Steps[0].Contract)
+
+	nestedBuiltIn := root.Steps[1]
+	require.Equal(t, builtInFunctionStepType, nestedBuiltIn.Type)
+	require.Equal(t, hex.EncodeToString(contractC), nestedBuiltIn.Contract)
+	require.Equal(t, uint64(30), nestedBuiltIn.GasUsed)
+	require.Equal(t, vmcommon.Ok.String(), nestedBuiltIn.ReturnCode)
+}
+
+func TestCallTracer_DeployShouldResolveTheContractAddress(t *testing.T) {
+	t.Parallel()
+
+	ct := createCallTracer(t)
+
+	ct.OnExecutionStart(nil, "init", createVMInput(1000))
+	ct.OnExecutionEnd(&vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 100,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(callerAddress): {Address: callerAddress},
+			string(contractA):     {Address: contractA, Code: []byte("code")},
+		},
+	}, nil)
+
+	steps := ct.GetSteps()
+	require.Len(t, steps, 1)
+	require.Equal(t, deployStepType, steps[0].Type)
+	require.Equal(t, hex.EncodeToString(contractA), steps[0].Contract)
+	require.Equal(t, uint64(900), steps[0].GasUsed)
+}
+
+func TestCallTracer_FailedExecutionShouldRecordTheError(t *testing.T) {
+	t.Parallel()
+
+	ct := createCallTracer(t)
+
+	ct.OnExecutionStart(contractA, "call", createVMInput(1000))
+	ct.OnCodeLoad(contractA)
+	ct.OnCodeLoad(contractB)
+	ct.OnExecutionEnd(&vmcommon.VMOutput{
+		ReturnCode:    vmcommon.UserError,
+		ReturnMessage: "execution failed",
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(contractB): {Address: contractB, GasUsed: 200},
+		},
+	}, nil)
+
+	root := ct.GetSteps()[0]
+	require.Equal(t, vmcommon.UserError.String(), root.ReturnCode)
+	require.Equal(t, "execution failed", root.ReturnMessage)
+	require.Equal(t, uint64(1000), root.GasUsed)
+	require.Equal(t, "", root.Steps[0].ReturnCode)
+
+	ct.Reset()
+	ct.OnExecutionStart(contractA, "call", createVMInput(1000))
+	ct.OnExecutionEnd(nil, errors.New("vm error"))
+
+	steps := ct.GetSteps()
+	require.Len(t, steps, 1)
+	require.Equal(t, vmcommon.UserError.String(), steps[0].ReturnCode)
+	require.Equal(t, "vm error", steps[0].ReturnMessage)
+}
+
+func TestCallTracer_NotificationsWithoutAnExecutionShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	ct := createCallTracer(t)
+
+	ct.OnExecutionStart(contractA, "call", nil)
+	ct.OnCodeLoad(contractA)
+	ct.OnStorageRead(contractA, []byte("key"), []byte("value"))
+	ct.OnBuiltInFunctionCall(nil, nil, nil)
+	ct.OnExecutionEnd(&vmcommon.VMOutput{}, nil)
+
+	require.Empty(t, ct.GetSteps())
+}
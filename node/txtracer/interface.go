@@ -0,0 +1,25 @@
+package txtracer
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// CallTracer defines a smart contract execution tracer able to return the call tree it has recorded
+type CallTracer interface {
+	process.SCExecutionTracer
+	GetSteps() []*transaction.ExecutionStepApi
+	Reset()
+}
+
+// GasHandler defines the gas related operations needed by the transaction tracer
+type GasHandler interface {
+	Init()
+	IsInterfaceNil() bool
+}
+
+// LogsCleaner defines a transaction logs processor which can drop the logs it has collected
+type LogsCleaner interface {
+	Clean()
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,207 @@
+package txtracer
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("node/txtracer")
+
+// ArgsTxTracer holds the arguments required for creating a new transaction tracer
+type ArgsTxTracer struct {
+	TransactionProcessor       process.TransactionProcessor
+	SCResultsProcessor         process.SmartContractResultProcessor
+	Accounts                   state.AccountsAdapter
+	ValidatorAccounts          state.AccountsAdapter
+	BlockChainHook             process.BlockChainHookHandler
+	CallTracer                 CallTracer
+	IntermmediateProcContainer process.IntermediateProcessorContainer
+	GasHandler                 GasHandler
+	LogsCleaner                LogsCleaner
+}
+
+type transactionTracer struct {
+	mutTrace            sync.Mutex
+	txProcessor         process.TransactionProcessor
+	scResultsProcessor  process.SmartContractResultProcessor
+	accounts            state.AccountsAdapter
+	validatorAccounts   state.AccountsAdapter
+	blockChainHook      process.BlockChainHookHandler
+	callTracer          CallTracer
+	intermProcContainer process.IntermediateProcessorContainer
+	gasHandler          GasHandler
+	logsCleaner         LogsCleaner
+}
+
+// NewTransactionTracer returns a new instance of a transactionTracer. The provided accounts adapters must not be
+// shared with the block processing as their tries are recreated on every trace. The validator accounts are optional
+// and only used on the metachain
+func NewTransactionTracer(args ArgsTxTracer) (*transactionTracer, error) {
+	if check.IfNil(args.TransactionProcessor) {
+		return nil, node.ErrNilTransactionProcessor
+	}
+	if check.IfNil(args.SCResultsProcessor) {
+		return nil, node.ErrNilSCResultsProcessor
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, node.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.BlockChainHook) {
+		return nil, node.ErrNilBlockChainHook
+	}
+	if check.IfNil(args.CallTracer) {
+		return nil, node.ErrNilCallTracer
+	}
+	if check.IfNil(args.IntermmediateProcContainer) {
+		return nil, node.ErrNilIntermediateProcessorContainer
+	}
+	if check.IfNil(args.GasHandler) {
+		return nil, node.ErrNilGasHandler
+	}
+	if check.IfNil(args.LogsCleaner) {
+		return nil, node.ErrNilLogsCollector
+	}
+
+	return &transactionTracer{
+		txProcessor:         args.TransactionProcessor,
+		scResultsProcessor:  args.SCResultsProcessor,
+		accounts:            args.Accounts,
+		validatorAccounts:   args.ValidatorAccounts,
+		blockChainHook:      args.BlockChainHook,
+		callTracer:          args.CallTracer,
+		intermProcContainer: args.IntermmediateProcContainer,
+		gasHandler:          args.GasHandler,
+		logsCleaner:         args.LogsCleaner,
+	}, nil
+}
+
+// TraceTransaction re-executes the provided transaction or smart contract result on top of the state the parent
+// header has committed, recording the call tree of the smart contract executions. The transactions the block executed
+// before the traced one have to be provided in their processing order, as they are replayed first so the trace sees
+// the same state the block did
+func (tt *transactionTracer) TraceTransaction(
+	tx data.TransactionHandler,
+	precedingTxs []data.TransactionHandler,
+	header data.HeaderHandler,
+	parentHeader data.HeaderHandler,
+) (*transaction.TraceResults, error) {
+	if check.IfNil(tx) {
+		return nil, process.ErrNilTransaction
+	}
+	if check.IfNil(header) || check.IfNil(parentHeader) {
+		return nil, process.ErrNilHeaderHandler
+	}
+
+	tt.mutTrace.Lock()
+	defer tt.mutTrace.Unlock()
+
+	err := tt.recreateState(parentHeader)
+	if err != nil {
+		return nil, err
+	}
+	defer tt.cleanUp()
+
+	tt.blockChainHook.SetCurrentHeader(header)
+	tt.gasHandler.Init()
+
+	err = tt.replayPrecedingTransactions(precedingTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	tt.callTracer.Reset()
+	tt.logsCleaner.Clean()
+
+	retCode, err := tt.processTransaction(tx)
+	if err == node.ErrTransactionNotTraceable {
+		return nil, err
+	}
+
+	results := &transaction.TraceResults{
+		Status: transaction.TxStatusPending,
+		Steps:  tt.callTracer.GetSteps(),
+	}
+	if err != nil {
+		results.Status = transaction.TxStatusFail
+		results.FailReason = err.Error()
+	} else if retCode == vmcommon.Ok {
+		results.Status = transaction.TxStatusSuccess
+	}
+
+	return results, nil
+}
+
+func (tt *transactionTracer) recreateState(parentHeader data.HeaderHandler) error {
+	err := tt.accounts.RecreateTrie(parentHeader.GetRootHash())
+	if err != nil {
+		return err
+	}
+
+	if check.IfNil(tt.validatorAccounts) {
+		return nil
+	}
+
+	return tt.validatorAccounts.RecreateTrie(parentHeader.GetValidatorStatsRootHash())
+}
+
+// replayPrecedingTransactions re-executes the provided transactions the same way the block processing did: failed
+// transactions still consume their gas and are part of the block, any other error means the block can not be replayed
+func (tt *transactionTracer) replayPrecedingTransactions(precedingTxs []data.TransactionHandler) error {
+	for _, precedingTx := range precedingTxs {
+		_, err := tt.processTransaction(precedingTx)
+		if err != nil && !errors.Is(err, process.ErrFailedTransaction) {
+			return fmt.Errorf("%w: nonce %d: %s", node.ErrCannotReplayBlockTransaction, precedingTx.GetNonce(), err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (tt *transactionTracer) processTransaction(tx data.TransactionHandler) (vmcommon.ReturnCode, error) {
+	switch txHandler := tx.(type) {
+	case *transaction.Transaction:
+		return tt.txProcessor.ProcessTransaction(txHandler)
+	case *smartContractResult.SmartContractResult:
+		return tt.scResultsProcessor.ProcessSmartContractResult(txHandler)
+	default:
+		return vmcommon.UserError, node.ErrTransactionNotTraceable
+	}
+}
+
+func (tt *transactionTracer) cleanUp() {
+	for _, key := range tt.intermProcContainer.Keys() {
+		processor, err := tt.intermProcContainer.Get(key)
+		if err != nil || check.IfNil(processor) {
+			continue
+		}
+
+		processor.CreateBlockStarted()
+	}
+
+	err := tt.accounts.RevertToSnapshot(0)
+	if err != nil {
+		log.Debug("transactionTracer.cleanUp", "error", err.Error())
+	}
+	if !check.IfNil(tt.validatorAccounts) {
+		err = tt.validatorAccounts.RevertToSnapshot(0)
+		if err != nil {
+			log.Debug("transactionTracer.cleanUp", "error", err.Error())
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tt *transactionTracer) IsInterfaceNil() bool {
+	return tt == nil
+}
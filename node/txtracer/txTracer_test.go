@@ -0,0 +1,320 @@
+package txtracer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func getTxTracerArgs(t *testing.T) ArgsTxTracer {
+	return ArgsTxTracer{
+		TransactionProcessor: &mock.TxProcessorStub{},
+		SCResultsProcessor:   &mock.SmartContractResultsProcessorMock{},
+		Accounts: &mock.AccountsStub{
+			RecreateTrieCalled: func(_ []byte) error {
+				return nil
+			},
+			RevertToSnapshotCalled: func(_ int) error {
+				return nil
+			},
+		},
+		BlockChainHook:             &mock.BlockChainHookHandlerMock{},
+		CallTracer:                 createCallTracer(t),
+		IntermmediateProcContainer: &mock.IntermProcessorContainerStub{},
+		GasHandler:                 &mock.GasHandlerStub{},
+		LogsCleaner:                &mock.LogsCleanerStub{},
+	}
+}
+
+func TestNewTransactionTracer(t *testing.T) {
+	tests := []struct {
+		name     string
+		argsFunc func() ArgsTxTracer
+		exError  error
+	}{
+		{
+			name: "NilTransactionProcessor",
+			argsFunc: func() ArgsTxTracer {
+				args := getTxTracerArgs(t)
+				args.TransactionProcessor = nil
+				return args
+			},
+			exError: node.ErrNilTransactionProcessor,
+		},
+		{
+			name: "NilSCResultsProcessor",
+			argsFunc: func() ArgsTxTracer {
+				args := getTxTracerArgs(t)
+				args.SCResultsProcessor = nil
+				return args
+			},
+			exError: node.ErrNilSCResultsProcessor,
+		},
+		{
+			name: "NilAccounts",
+			argsFunc: func() ArgsTxTracer {
+				args := getTxTracerArgs(t)
+				args.Accounts = nil
+				return args
+			},
+			exError: node.ErrNilAccountsAdapter,
+		},
+		{
+			name: "NilBlockChainHook",
+			argsFunc: func() ArgsTxTracer {
+				args := getTxTracerArgs(t)
+				args.BlockChainHook = nil
+				return args
+			},
+			exError: node.ErrNilBlockChainHook,
+		},
+		{
+			name: "NilCallTracer",
+			argsFunc: func() ArgsTxTracer {
+				args := getTxTracerArgs(t)
+				args.CallTracer = nil
+				return args
+			},
+			exError: node.ErrNilCallTracer,
+		},
+		{
+			name: "NilIntermediateProcessorContainer",
+			argsFunc: func() ArgsTxTracer {
+				args := getTxTracerArgs(t)
+				args.IntermmediateProcContainer = nil
+				return args
+			},
+			exError: node.ErrNilIntermediateProcessorContainer,
+		},
+		{
+			name: "NilGasHandler",
+			argsFunc: func() ArgsTxTracer {
+				args := getTxTracerArgs(t)
+				args.GasHandler = nil
+				return args
+			},
+			exError: node.ErrNilGasHandler,
+		},
+		{
+			name: "NilLogsCleaner",
+			argsFunc: func() ArgsTxTracer {
+				args := getTxTracerArgs(t)
+				args.LogsCleaner = nil
+				return args
+			},
+			exError: node.ErrNilLogsCollector,
+		},
+		{
+			name: "Ok",
+			argsFunc: func() ArgsTxTracer {
+				return getTxTracerArgs(t)
+			},
+			exError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := NewTransactionTracer(tt.argsFunc())
+		require.Equal(t, tt.exError, err, tt.name)
+	}
+}
+
+func TestTransactionTracer_TraceTransactionNilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewTransactionTracer(getTxTracerArgs(t))
+
+	results, err := tracer.TraceTransaction(nil, nil, &block.Header{}, &block.Header{})
+	require.Nil(t, results)
+	require.Equal(t, process.ErrNilTransaction, err)
+
+	results, err = tracer.TraceTransaction(&transaction.Transaction{}, nil, nil, &block.Header{})
+	require.Nil(t, results)
+	require.Equal(t, process.ErrNilHeaderHandler, err)
+
+	results, err = tracer.TraceTransaction(&transaction.Transaction{}, nil, &block.Header{}, nil)
+	require.Nil(t, results)
+	require.Equal(t, process.ErrNilHeaderHandler, err)
+}
+
+func TestTransactionTracer_TraceTransactionRecreateTrieFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("root hash pruned")
+	args := getTxTracerArgs(t)
+	args.Accounts = &mock.AccountsStub{
+		RecreateTrieCalled: func(_ []byte) error {
+			return expectedErr
+		},
+	}
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			require.Fail(t, "should have not processed the transaction")
+			return vmcommon.Ok, nil
+		},
+	}
+	tracer, _ := NewTransactionTracer(args)
+
+	results, err := tracer.TraceTransaction(&transaction.Transaction{}, nil, &block.Header{}, &block.Header{})
+	require.Nil(t, results)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestTransactionTracer_TraceTransactionShouldReplayOnTheParentState(t *testing.T) {
+	t.Parallel()
+
+	parentRootHash := []byte("parent root hash")
+	header := &block.Header{Nonce: 2}
+	recreatedRootHash := make([]byte, 0)
+	currentHeader := data.HeaderHandler(nil)
+	revertedToSnapshot := -1
+	numCleanedInterimProcessors := 0
+
+	args := getTxTracerArgs(t)
+	args.Accounts = &mock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedRootHash = rootHash
+			return nil
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			revertedToSnapshot = snapshot
+			return nil
+		},
+	}
+	args.BlockChainHook = &mock.BlockChainHookHandlerMock{
+		SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+			currentHeader = hdr
+		},
+	}
+	args.IntermmediateProcContainer = &mock.IntermProcessorContainerStub{
+		KeysCalled: func() []block.Type {
+			return []block.Type{block.SmartContractResultBlock, block.ReceiptBlock}
+		},
+		GetCalled: func(_ block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{
+				CreateBlockStartedCalled: func() {
+					numCleanedInterimProcessors++
+				},
+			}, nil
+		},
+	}
+	callTracer := args.CallTracer
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			callTracer.OnExecutionStart(contractA, "call", createVMInput(100))
+			callTracer.OnExecutionEnd(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil)
+			return vmcommon.Ok, nil
+		},
+	}
+	tracer, _ := NewTransactionTracer(args)
+
+	results, err := tracer.TraceTransaction(&transaction.Transaction{}, nil, header, &block.Header{RootHash: parentRootHash})
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Len(t, results.Steps, 1)
+	require.Equal(t, parentRootHash, recreatedRootHash)
+	require.Equal(t, header, currentHeader)
+	require.Equal(t, 0, revertedToSnapshot)
+	require.Equal(t, 2, numCleanedInterimProcessors)
+}
+
+func TestTransactionTracer_TraceTransactionShouldDispatchOnTransactionType(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := getTxTracerArgs(t)
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			return vmcommon.UserError, nil
+		},
+	}
+	args.SCResultsProcessor = &mock.SmartContractResultsProcessorMock{
+		ProcessSmartContractResultCalled: func(_ *smartContractResult.SmartContractResult) (vmcommon.ReturnCode, error) {
+			return vmcommon.UserError, expectedErr
+		},
+	}
+	tracer, _ := NewTransactionTracer(args)
+
+	results, err := tracer.TraceTransaction(&transaction.Transaction{}, nil, &block.Header{}, &block.Header{})
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusPending, results.Status)
+
+	results, err = tracer.TraceTransaction(&smartContractResult.SmartContractResult{}, nil, &block.Header{}, &block.Header{})
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusFail, results.Status)
+	require.Equal(t, expectedErr.Error(), results.FailReason)
+
+	results, err = tracer.TraceTransaction(&receipt.Receipt{}, nil, &block.Header{}, &block.Header{})
+	require.Nil(t, results)
+	require.Equal(t, node.ErrTransactionNotTraceable, err)
+}
+
+func TestTransactionTracer_TraceTransactionShouldReplayThePrecedingTransactionsWithoutTracingThem(t *testing.T) {
+	t.Parallel()
+
+	processedNonces := make([]uint64, 0)
+	args := getTxTracerArgs(t)
+	callTracer := args.CallTracer
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			processedNonces = append(processedNonces, tx.Nonce)
+			callTracer.OnExecutionStart(contractA, "call", createVMInput(100))
+			callTracer.OnExecutionEnd(&vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil)
+			if tx.Nonce == 1 {
+				return vmcommon.UserError, process.ErrFailedTransaction
+			}
+
+			return vmcommon.Ok, nil
+		},
+	}
+	processedSCRNonces := make([]uint64, 0)
+	args.SCResultsProcessor = &mock.SmartContractResultsProcessorMock{
+		ProcessSmartContractResultCalled: func(scr *smartContractResult.SmartContractResult) (vmcommon.ReturnCode, error) {
+			processedSCRNonces = append(processedSCRNonces, scr.Nonce)
+			return vmcommon.Ok, nil
+		},
+	}
+	tracer, _ := NewTransactionTracer(args)
+
+	precedingTxs := []data.TransactionHandler{
+		&smartContractResult.SmartContractResult{Nonce: 5},
+		&transaction.Transaction{Nonce: 1},
+		&transaction.Transaction{Nonce: 2},
+	}
+	results, err := tracer.TraceTransaction(&transaction.Transaction{Nonce: 3}, precedingTxs, &block.Header{}, &block.Header{})
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Equal(t, []uint64{1, 2, 3}, processedNonces)
+	require.Equal(t, []uint64{5}, processedSCRNonces)
+	require.Len(t, results.Steps, 1)
+}
+
+func TestTransactionTracer_TraceTransactionPrecedingTransactionErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numProcessed := 0
+	args := getTxTracerArgs(t)
+	args.TransactionProcessor = &mock.TxProcessorStub{
+		ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			numProcessed++
+			return vmcommon.UserError, process.ErrHigherNonceInTransaction
+		},
+	}
+	tracer, _ := NewTransactionTracer(args)
+
+	precedingTxs := []data.TransactionHandler{&transaction.Transaction{Nonce: 1}}
+	results, err := tracer.TraceTransaction(&transaction.Transaction{Nonce: 2}, precedingTxs, &block.Header{}, &block.Header{})
+	require.Nil(t, results)
+	require.True(t, errors.Is(err, node.ErrCannotReplayBlockTransaction))
+	require.Equal(t, 1, numProcessed)
+}
@@ -0,0 +1,34 @@
+package usernamesAPI
+
+import "errors"
+
+// ErrNilSCQueryService signals that a nil smart contract query service has been provided
+var ErrNilSCQueryService = errors.New("nil smart contract query service")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrNoDNSContracts signals that no DNS smart contract was deployed at genesis
+var ErrNoDNSContracts = errors.New("no DNS smart contracts deployed")
+
+// ErrInvalidUsername signals that an invalid username has been provided
+var ErrInvalidUsername = errors.New("invalid username")
+
+// ErrDNSContractNotFound signals that the DNS smart contract responsible for a username does not exist
+var ErrDNSContractNotFound = errors.New("DNS smart contract not found")
+
+// ErrDNSContractInOtherShard signals that the DNS smart contract responsible for a username lives in another shard
+// than the one of the current node, so it can not be queried
+var ErrDNSContractInOtherShard = errors.New("DNS smart contract is in another shard")
+
+// ErrUsernameNotFound signals that the username is not registered
+var ErrUsernameNotFound = errors.New("username not found")
+
+// ErrInvalidQueryResponse signals that the DNS smart contract returned an unexpected response
+var ErrInvalidQueryResponse = errors.New("invalid query response")
@@ -0,0 +1,155 @@
+package usernamesAPI
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// usernameSuffix is the suffix of all the usernames registered in the DNS smart contracts. It is appended to the
+// requested username when missing, so both "alice" and "alice.elrond" resolve to the same account
+const usernameSuffix = ".elrond"
+
+// resolveFunctionName is the view function of the DNS smart contracts which returns the owner of a username
+const resolveFunctionName = "resolve"
+
+// ArgsUsernameProcessor holds the arguments needed for creating a new username processor
+type ArgsUsernameProcessor struct {
+	// DNSAddresses holds the addresses of the DNS smart contracts deployed at genesis
+	DNSAddresses     map[string]struct{}
+	ShardCoordinator sharding.Coordinator
+	SCQueryService   process.SCQueryService
+	// Hasher must be the keccak hasher used by the DNS smart contracts for hashing the usernames
+	Hasher          hashing.Hasher
+	PubkeyConverter core.PubkeyConverter
+}
+
+type usernameProcessor struct {
+	dnsAddressesByLastByte map[byte][]byte
+	shardCoordinator       sharding.Coordinator
+	scQueryService         process.SCQueryService
+	hasher                 hashing.Hasher
+	pubkeyConverter        core.PubkeyConverter
+}
+
+// NewUsernameProcessor will create a new instance of usernameProcessor
+func NewUsernameProcessor(args ArgsUsernameProcessor) (*usernameProcessor, error) {
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(args.SCQueryService) {
+		return nil, ErrNilSCQueryService
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	// the DNS contracts are deployed so that the last byte of each address is different, each contract being
+	// responsible for the usernames whose hash ends with the same byte
+	dnsAddressesByLastByte := make(map[byte][]byte, len(args.DNSAddresses))
+	for address := range args.DNSAddresses {
+		if len(address) == 0 {
+			continue
+		}
+		dnsAddressesByLastByte[address[len(address)-1]] = []byte(address)
+	}
+
+	return &usernameProcessor{
+		dnsAddressesByLastByte: dnsAddressesByLastByte,
+		shardCoordinator:       args.ShardCoordinator,
+		scQueryService:         args.SCQueryService,
+		hasher:                 args.Hasher,
+		pubkeyConverter:        args.PubkeyConverter,
+	}, nil
+}
+
+// ResolveUsername returns the address owning the provided username by querying the responsible DNS smart contract.
+// The DNS smart contract can only be queried if it lives in the shard of the current node, otherwise the returned
+// error contains the shard that can resolve the username, either through one of its nodes or through the proxy
+func (up *usernameProcessor) ResolveUsername(username string) (*api.UsernameResolution, error) {
+	if len(up.dnsAddressesByLastByte) == 0 {
+		return nil, ErrNoDNSContracts
+	}
+
+	username = normalizeUsername(username)
+	if len(username) == len(usernameSuffix) {
+		return nil, ErrInvalidUsername
+	}
+
+	dnsAddress, err := up.computeDNSAddress(username)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsShard := up.shardCoordinator.ComputeId(dnsAddress)
+	if dnsShard != up.shardCoordinator.SelfId() {
+		return nil, fmt.Errorf("%w: username %s is resolved by DNS contract %s from shard %d",
+			ErrDNSContractInOtherShard, username, up.pubkeyConverter.Encode(dnsAddress), dnsShard)
+	}
+
+	query := &process.SCQuery{
+		ScAddress: dnsAddress,
+		FuncName:  resolveFunctionName,
+		Arguments: [][]byte{[]byte(username)},
+	}
+	vmOutput, err := up.scQueryService.ExecuteQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w: %s (%s)", ErrInvalidQueryResponse, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+	if len(vmOutput.ReturnData) == 0 || len(vmOutput.ReturnData[0]) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUsernameNotFound, username)
+	}
+
+	owner := vmOutput.ReturnData[0]
+	if len(owner) != up.pubkeyConverter.Len() {
+		return nil, fmt.Errorf("%w: the owner address has %d bytes", ErrInvalidQueryResponse, len(owner))
+	}
+
+	return &api.UsernameResolution{
+		Username:    username,
+		Address:     up.pubkeyConverter.Encode(owner),
+		DNSContract: up.pubkeyConverter.Encode(dnsAddress),
+		DNSShard:    dnsShard,
+	}, nil
+}
+
+func (up *usernameProcessor) computeDNSAddress(username string) ([]byte, error) {
+	usernameHash := up.hasher.Compute(username)
+	if len(usernameHash) == 0 {
+		return nil, ErrInvalidUsername
+	}
+
+	dnsAddress, ok := up.dnsAddressesByLastByte[usernameHash[len(usernameHash)-1]]
+	if !ok {
+		return nil, fmt.Errorf("%w for username %s", ErrDNSContractNotFound, username)
+	}
+
+	return dnsAddress, nil
+}
+
+// normalizeUsername appends the DNS username suffix if it is missing
+func normalizeUsername(username string) string {
+	if strings.HasSuffix(username, usernameSuffix) {
+		return username
+	}
+
+	return username + usernameSuffix
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (up *usernameProcessor) IsInterfaceNil() bool {
+	return up == nil
+}
@@ -0,0 +1,196 @@
+package usernamesAPI
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const addressLength = 32
+
+func createDNSAddresses() map[string]struct{} {
+	dnsAddresses := make(map[string]struct{})
+	for i := 0; i < 256; i++ {
+		address := make([]byte, addressLength)
+		address[0] = 1
+		address[addressLength-1] = byte(i)
+		dnsAddresses[string(address)] = struct{}{}
+	}
+
+	return dnsAddresses
+}
+
+func createMockArgsUsernameProcessor() ArgsUsernameProcessor {
+	return ArgsUsernameProcessor{
+		DNSAddresses:     createDNSAddresses(),
+		ShardCoordinator: &mock.ShardCoordinatorMock{},
+		SCQueryService:   &mock.SCQueryServiceStub{},
+		Hasher:           keccak.Keccak{},
+		PubkeyConverter:  mock.NewPubkeyConverterMock(addressLength),
+	}
+}
+
+func expectedDNSAddress(username string) []byte {
+	usernameHash := keccak.Keccak{}.Compute(username)
+	address := make([]byte, addressLength)
+	address[0] = 1
+	address[addressLength-1] = usernameHash[len(usernameHash)-1]
+
+	return address
+}
+
+func TestNewUsernameProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsUsernameProcessor()
+	args.ShardCoordinator = nil
+	up, err := NewUsernameProcessor(args)
+	assert.True(t, check.IfNil(up))
+	assert.Equal(t, ErrNilShardCoordinator, err)
+
+	args = createMockArgsUsernameProcessor()
+	args.SCQueryService = nil
+	up, err = NewUsernameProcessor(args)
+	assert.True(t, check.IfNil(up))
+	assert.Equal(t, ErrNilSCQueryService, err)
+
+	args = createMockArgsUsernameProcessor()
+	args.Hasher = nil
+	up, err = NewUsernameProcessor(args)
+	assert.True(t, check.IfNil(up))
+	assert.Equal(t, ErrNilHasher, err)
+
+	args = createMockArgsUsernameProcessor()
+	args.PubkeyConverter = nil
+	up, err = NewUsernameProcessor(args)
+	assert.True(t, check.IfNil(up))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	up, err = NewUsernameProcessor(createMockArgsUsernameProcessor())
+	assert.False(t, check.IfNil(up))
+	assert.Nil(t, err)
+}
+
+func TestUsernameProcessor_ResolveUsernameShouldQueryTheResponsibleDNSContract(t *testing.T) {
+	t.Parallel()
+
+	owner := bytes.Repeat([]byte{2}, addressLength)
+	args := createMockArgsUsernameProcessor()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			assert.Equal(t, expectedDNSAddress("alice.elrond"), query.ScAddress)
+			assert.Equal(t, resolveFunctionName, query.FuncName)
+			assert.Equal(t, [][]byte{[]byte("alice.elrond")}, query.Arguments)
+
+			return &vmcommon.VMOutput{ReturnData: [][]byte{owner}}, nil
+		},
+	}
+	up, _ := NewUsernameProcessor(args)
+
+	for _, username := range []string{"alice", "alice.elrond"} {
+		resolution, err := up.ResolveUsername(username)
+		require.Nil(t, err)
+		assert.Equal(t, "alice.elrond", resolution.Username)
+		assert.Equal(t, args.PubkeyConverter.Encode(owner), resolution.Address)
+		assert.Equal(t, args.PubkeyConverter.Encode(expectedDNSAddress("alice.elrond")), resolution.DNSContract)
+		assert.Equal(t, uint32(0), resolution.DNSShard)
+	}
+}
+
+func TestUsernameProcessor_ResolveUsernameFromOtherShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsUsernameProcessor()
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{
+		SelfShardId: 0,
+		ComputeIdCalled: func(_ []byte) uint32 {
+			return 1
+		},
+	}
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(_ *process.SCQuery) (*vmcommon.VMOutput, error) {
+			require.Fail(t, "the DNS contract from another shard should not be queried")
+			return nil, nil
+		},
+	}
+	up, _ := NewUsernameProcessor(args)
+
+	resolution, err := up.ResolveUsername("alice")
+	assert.Nil(t, resolution)
+	assert.True(t, errors.Is(err, ErrDNSContractInOtherShard))
+	assert.Contains(t, err.Error(), "shard 1")
+}
+
+func TestUsernameProcessor_ResolveUsernameErrors(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsUsernameProcessor()
+	args.DNSAddresses = nil
+	up, _ := NewUsernameProcessor(args)
+	_, err := up.ResolveUsername("alice")
+	assert.Equal(t, ErrNoDNSContracts, err)
+
+	args = createMockArgsUsernameProcessor()
+	up, _ = NewUsernameProcessor(args)
+	_, err = up.ResolveUsername(".elrond")
+	assert.Equal(t, ErrInvalidUsername, err)
+
+	otherDNSAddress := expectedDNSAddress("alice.elrond")
+	otherDNSAddress[addressLength-1]++
+	args = createMockArgsUsernameProcessor()
+	args.DNSAddresses = map[string]struct{}{string(otherDNSAddress): {}}
+	up, _ = NewUsernameProcessor(args)
+	_, err = up.ResolveUsername("alice")
+	assert.True(t, errors.Is(err, ErrDNSContractNotFound))
+
+	expectedErr := errors.New("expected error")
+	returnedOutputs := []*vmcommon.VMOutput{
+		{ReturnCode: vmcommon.UserError, ReturnMessage: "function not found"},
+		{},
+		{ReturnData: [][]byte{{}}},
+		{ReturnData: [][]byte{[]byte("short address")}},
+	}
+	expectedErrors := []error{ErrInvalidQueryResponse, ErrUsernameNotFound, ErrUsernameNotFound, ErrInvalidQueryResponse}
+	for i := range returnedOutputs {
+		vmOutput := returnedOutputs[i]
+		args = createMockArgsUsernameProcessor()
+		args.SCQueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(_ *process.SCQuery) (*vmcommon.VMOutput, error) {
+				return vmOutput, nil
+			},
+		}
+		up, _ = NewUsernameProcessor(args)
+		_, err = up.ResolveUsername("alice")
+		assert.True(t, errors.Is(err, expectedErrors[i]), i)
+	}
+
+	args = createMockArgsUsernameProcessor()
+	args.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(_ *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return nil, expectedErr
+		},
+	}
+	up, _ = NewUsernameProcessor(args)
+	_, err = up.ResolveUsername("alice")
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestUsernameProcessor_DNSContractSelectionMatchesTheHashOfTheUsername(t *testing.T) {
+	t.Parallel()
+
+	usernames := []string{"alice.elrond", "bob.elrond", "carol.elrond", "dave.elrond"}
+	up, _ := NewUsernameProcessor(createMockArgsUsernameProcessor())
+	for _, username := range usernames {
+		dnsAddress, err := up.computeDNSAddress(username)
+		require.Nil(t, err)
+		assert.Equal(t, expectedDNSAddress(username), dnsAddress)
+	}
+}
@@ -46,6 +46,9 @@ var ErrMessageTooOld = errors.New("message is too old")
 // ErrNilDirectSendMessageHandler signals that the message handler for new message has not been wired
 var ErrNilDirectSendMessageHandler = errors.New("nil direct sender message handler")
 
+// ErrMessengerClosed signals that the messenger was closed before the data could be sent
+var ErrMessengerClosed = errors.New("messenger closed")
+
 // ErrPeerNotDirectlyConnected signals that the peer is not directly connected to self
 var ErrPeerNotDirectlyConnected = errors.New("peer is not directly connected")
 
@@ -143,3 +146,24 @@ var ErrUnsupportedMessageVersion = errors.New("unsupported message version")
 
 // ErrNilSyncTimer signals that a nil sync timer was provided
 var ErrNilSyncTimer = errors.New("nil sync timer")
+
+// ErrNilTrustedPeersHolder signals that a nil trusted peers holder was provided
+var ErrNilTrustedPeersHolder = errors.New("nil trusted peers holder")
+
+// ErrInvalidTrustedPeerAddress signals that an invalid trusted peer address was provided
+var ErrInvalidTrustedPeerAddress = errors.New("invalid trusted peer address")
+
+// ErrInvalidAllowedPeerID signals that an invalid allowed peer ID was provided
+var ErrInvalidAllowedPeerID = errors.New("invalid allowed peer ID")
+
+// ErrInvalidAnnouncedAddress signals that an invalid announced address was provided
+var ErrInvalidAnnouncedAddress = errors.New("invalid announced address")
+
+// ErrInvalidRelayPeerAddress signals that an invalid relay peer address was provided
+var ErrInvalidRelayPeerAddress = errors.New("invalid relay peer address")
+
+// ErrEmptyRelayPeersList signals that the relay fallback was enabled without providing any relay peer
+var ErrEmptyRelayPeersList = errors.New("empty relay peers list")
+
+// ErrInvalidChannelPriorityWeight signals that an invalid channel priority weight was provided
+var ErrInvalidChannelPriorityWeight = errors.New("invalid channel priority weight")
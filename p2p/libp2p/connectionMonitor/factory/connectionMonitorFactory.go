@@ -14,6 +14,7 @@ type ArgsConnectionMonitorFactory struct {
 	Sharder                    p2p.CommonSharder
 	ThresholdMinConnectedPeers uint32
 	TargetCount                int
+	TrustedPeersHolder         p2p.TrustedPeersHolder
 }
 
 // NewConnectionMonitor creates a new ConnectionMonitor instance
@@ -24,7 +25,12 @@ func NewConnectionMonitor(arg ArgsConnectionMonitorFactory) (ConnectionMonitor,
 
 	switch kadSharder := arg.Sharder.(type) {
 	case connectionMonitor.Sharder:
-		return connectionMonitor.NewLibp2pConnectionMonitorSimple(arg.Reconnecter, arg.ThresholdMinConnectedPeers, kadSharder)
+		return connectionMonitor.NewLibp2pConnectionMonitorSimple(
+			arg.Reconnecter,
+			arg.ThresholdMinConnectedPeers,
+			kadSharder,
+			arg.TrustedPeersHolder,
+		)
 	default:
 		return nil, fmt.Errorf("%w for connection monitor: invalid type %T", p2p.ErrInvalidValue, kadSharder)
 	}
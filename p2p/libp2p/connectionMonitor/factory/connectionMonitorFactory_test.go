@@ -17,6 +17,7 @@ func createMockArg() ArgsConnectionMonitorFactory {
 		Sharder:                    &mock.SharderStub{},
 		ThresholdMinConnectedPeers: 1,
 		TargetCount:                1,
+		TrustedPeersHolder:         &mock.TrustedPeersHolderStub{},
 	}
 }
 
@@ -40,7 +41,7 @@ func TestNewConnectionMonitor_ListSharderWithReconnecterShouldWork(t *testing.T)
 
 	assert.False(t, check.IfNil(cm))
 	assert.Nil(t, err)
-	cmExpected, _ := connectionMonitor.NewLibp2pConnectionMonitorSimple(nil, 0, nil)
+	cmExpected, _ := connectionMonitor.NewLibp2pConnectionMonitorSimple(nil, 0, nil, nil)
 	//this works even though cmExpected is nil because it checks only the type
 	assert.IsType(t, cmExpected, cm)
 }
@@ -3,6 +3,7 @@ package connectionMonitor
 import (
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/libp2p/go-libp2p-core/network"
@@ -18,6 +19,7 @@ type libp2pConnectionMonitorSimple struct {
 	reconnecter                p2p.Reconnecter
 	thresholdMinConnectedPeers int
 	sharder                    Sharder
+	trustedPeersHolder         p2p.TrustedPeersHolder
 }
 
 // NewLibp2pConnectionMonitorSimple creates a new connection monitor (version 2 that is more streamlined and does not care
@@ -26,6 +28,7 @@ func NewLibp2pConnectionMonitorSimple(
 	reconnecter p2p.Reconnecter,
 	thresholdMinConnectedPeers uint32,
 	sharder Sharder,
+	trustedPeersHolder p2p.TrustedPeersHolder,
 ) (*libp2pConnectionMonitorSimple, error) {
 	if check.IfNil(reconnecter) {
 		return nil, p2p.ErrNilReconnecter
@@ -33,12 +36,16 @@ func NewLibp2pConnectionMonitorSimple(
 	if check.IfNil(sharder) {
 		return nil, p2p.ErrNilSharder
 	}
+	if check.IfNil(trustedPeersHolder) {
+		return nil, p2p.ErrNilTrustedPeersHolder
+	}
 
 	cm := &libp2pConnectionMonitorSimple{
 		reconnecter:                reconnecter,
 		chDoReconnect:              make(chan struct{}),
 		thresholdMinConnectedPeers: int(thresholdMinConnectedPeers),
 		sharder:                    sharder,
+		trustedPeersHolder:         trustedPeersHolder,
 	}
 
 	if reconnecter != nil {
@@ -62,12 +69,16 @@ func (lcms *libp2pConnectionMonitorSimple) doReconn() {
 	}
 }
 
-// Connected is called when a connection opened
+// Connected is called when a connection opened. The trusted peers are never evicted
 func (lcms *libp2pConnectionMonitorSimple) Connected(netw network.Network, _ network.Conn) {
 	allPeers := netw.Peers()
 
 	evicted := lcms.sharder.ComputeEvictionList(allPeers)
 	for _, pid := range evicted {
+		if lcms.trustedPeersHolder.IsTrusted(core.PeerID(pid)) {
+			continue
+		}
+
 		_ = netw.ClosePeer(pid)
 	}
 }
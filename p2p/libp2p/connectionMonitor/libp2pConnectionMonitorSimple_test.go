@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
@@ -17,7 +18,7 @@ const durationStartGoRoutine = time.Second
 func TestNewLibp2pConnectionMonitorSimple_WithNilReconnecterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	lcms, err := NewLibp2pConnectionMonitorSimple(nil, 3, &mock.SharderStub{})
+	lcms, err := NewLibp2pConnectionMonitorSimple(nil, 3, &mock.SharderStub{}, &mock.TrustedPeersHolderStub{})
 
 	assert.Equal(t, p2p.ErrNilReconnecter, err)
 	assert.True(t, check.IfNil(lcms))
@@ -26,16 +27,25 @@ func TestNewLibp2pConnectionMonitorSimple_WithNilReconnecterShouldErr(t *testing
 func TestNewLibp2pConnectionMonitorSimple_WithNilSharderShouldErr(t *testing.T) {
 	t.Parallel()
 
-	lcms, err := NewLibp2pConnectionMonitorSimple(&mock.ReconnecterStub{}, 3, nil)
+	lcms, err := NewLibp2pConnectionMonitorSimple(&mock.ReconnecterStub{}, 3, nil, &mock.TrustedPeersHolderStub{})
 
 	assert.Equal(t, p2p.ErrNilSharder, err)
 	assert.True(t, check.IfNil(lcms))
 }
 
+func TestNewLibp2pConnectionMonitorSimple_WithNilTrustedPeersHolderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lcms, err := NewLibp2pConnectionMonitorSimple(&mock.ReconnecterStub{}, 3, &mock.SharderStub{}, nil)
+
+	assert.Equal(t, p2p.ErrNilTrustedPeersHolder, err)
+	assert.True(t, check.IfNil(lcms))
+}
+
 func TestNewLibp2pConnectionMonitorSimple_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	lcms, err := NewLibp2pConnectionMonitorSimple(&mock.ReconnecterStub{}, 3, &mock.SharderStub{})
+	lcms, err := NewLibp2pConnectionMonitorSimple(&mock.ReconnecterStub{}, 3, &mock.SharderStub{}, &mock.TrustedPeersHolderStub{})
 
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(lcms))
@@ -64,7 +74,7 @@ func TestNewLibp2pConnectionMonitorSimple_OnDisconnectedUnderThresholdShouldCall
 		},
 	}
 
-	lcms, _ := NewLibp2pConnectionMonitorSimple(&rs, 3, &mock.SharderStub{})
+	lcms, _ := NewLibp2pConnectionMonitorSimple(&rs, 3, &mock.SharderStub{}, &mock.TrustedPeersHolderStub{})
 	time.Sleep(durationStartGoRoutine)
 	lcms.Disconnected(&ns, nil)
 
@@ -90,6 +100,7 @@ func TestLibp2pConnectionMonitorSimple_ConnectedWithSharderShouldCallEvictAndClo
 				return evictedPid
 			},
 		},
+		&mock.TrustedPeersHolderStub{},
 	)
 
 	lcms.Connected(
@@ -113,6 +124,43 @@ func TestLibp2pConnectionMonitorSimple_ConnectedWithSharderShouldCallEvictAndClo
 	assert.Equal(t, 1, numComputeWasCalled)
 }
 
+func TestLibp2pConnectionMonitorSimple_ConnectedShouldNotEvictTrustedPeers(t *testing.T) {
+	t.Parallel()
+
+	trustedPid := peer.ID("trusted")
+	evictedPids := []peer.ID{"evicted", trustedPid}
+	closedPids := make([]peer.ID, 0)
+	lcms, _ := NewLibp2pConnectionMonitorSimple(
+		&mock.ReconnecterStub{},
+		3,
+		&mock.SharderStub{
+			ComputeEvictListCalled: func(pidList []peer.ID) []peer.ID {
+				return evictedPids
+			},
+		},
+		&mock.TrustedPeersHolderStub{
+			IsTrustedCalled: func(pid core.PeerID) bool {
+				return pid == core.PeerID(trustedPid)
+			},
+		},
+	)
+
+	lcms.Connected(
+		&mock.NetworkStub{
+			ClosePeerCall: func(id peer.ID) error {
+				closedPids = append(closedPids, id)
+				return nil
+			},
+			PeersCall: func() []peer.ID {
+				return nil
+			},
+		},
+		&mock.ConnStub{},
+	)
+
+	assert.Equal(t, []peer.ID{"evicted"}, closedPids)
+}
+
 func TestLibp2pConnectionMonitorSimple_EmptyFuncsShouldNotPanic(t *testing.T) {
 	t.Parallel()
 
@@ -129,7 +177,7 @@ func TestLibp2pConnectionMonitorSimple_EmptyFuncsShouldNotPanic(t *testing.T) {
 		},
 	}
 
-	lcms, _ := NewLibp2pConnectionMonitorSimple(&mock.ReconnecterStub{}, 3, &mock.SharderStub{})
+	lcms, _ := NewLibp2pConnectionMonitorSimple(&mock.ReconnecterStub{}, 3, &mock.SharderStub{}, &mock.TrustedPeersHolderStub{})
 
 	lcms.ClosedStream(netw, nil)
 	lcms.Disconnected(netw, nil)
@@ -141,7 +189,7 @@ func TestLibp2pConnectionMonitorSimple_EmptyFuncsShouldNotPanic(t *testing.T) {
 func TestLibp2pConnectionMonitorSimple_SetThresholdMinConnectedPeers(t *testing.T) {
 	t.Parallel()
 
-	lcms, _ := NewLibp2pConnectionMonitorSimple(&mock.ReconnecterStub{}, 3, &mock.SharderStub{})
+	lcms, _ := NewLibp2pConnectionMonitorSimple(&mock.ReconnecterStub{}, 3, &mock.SharderStub{}, &mock.TrustedPeersHolderStub{})
 
 	thr := 10
 	lcms.SetThresholdMinConnectedPeers(thr, &mock.NetworkStub{})
@@ -154,7 +202,7 @@ func TestLibp2pConnectionMonitorSimple_SetThresholdMinConnectedPeersNilNetwShoul
 	t.Parallel()
 
 	minConnPeers := uint32(3)
-	lcms, _ := NewLibp2pConnectionMonitorSimple(&mock.ReconnecterStub{}, minConnPeers, &mock.SharderStub{})
+	lcms, _ := NewLibp2pConnectionMonitorSimple(&mock.ReconnecterStub{}, minConnPeers, &mock.SharderStub{}, &mock.TrustedPeersHolderStub{})
 
 	thr := 10
 	lcms.SetThresholdMinConnectedPeers(thr, nil)
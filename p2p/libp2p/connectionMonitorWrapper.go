@@ -20,17 +20,20 @@ type connectionMonitorWrapper struct {
 	network             network.Network
 	mutPeerBlackList    sync.RWMutex
 	peerDenialEvaluator p2p.PeerDenialEvaluator
+	trustedPeersHolder  p2p.TrustedPeersHolder
 }
 
 func newConnectionMonitorWrapper(
 	network network.Network,
 	connMonitor ConnectionMonitor,
 	peerDenialEvaluator p2p.PeerDenialEvaluator,
+	trustedPeersHolder p2p.TrustedPeersHolder,
 ) *connectionMonitorWrapper {
 	return &connectionMonitorWrapper{
 		ConnectionMonitor:   connMonitor,
 		network:             network,
 		peerDenialEvaluator: peerDenialEvaluator,
+		trustedPeersHolder:  trustedPeersHolder,
 	}
 }
 
@@ -51,10 +54,7 @@ func (cmw *connectionMonitorWrapper) Connected(netw network.Network, conn networ
 	cmw.mutPeerBlackList.RUnlock()
 
 	pid := conn.RemotePeer()
-	if peerBlackList.IsDenied(core.PeerID(pid)) {
-		log.Trace("dropping connection to blacklisted peer",
-			"pid", pid.Pretty(),
-		)
+	if cmw.shouldDropConnection(peerBlackList, core.PeerID(pid)) {
 		_ = conn.Close()
 
 		return
@@ -63,6 +63,26 @@ func (cmw *connectionMonitorWrapper) Connected(netw network.Network, conn networ
 	cmw.ConnectionMonitor.Connected(netw, conn)
 }
 
+func (cmw *connectionMonitorWrapper) shouldDropConnection(peerDenialEvaluator p2p.PeerDenialEvaluator, pid core.PeerID) bool {
+	if !cmw.trustedPeersHolder.IsAllowed(pid) {
+		log.Trace("dropping connection to not allowed peer",
+			"pid", pid.Pretty(),
+		)
+		return true
+	}
+	if cmw.trustedPeersHolder.IsTrusted(pid) {
+		return false
+	}
+	if peerDenialEvaluator.IsDenied(pid) {
+		log.Trace("dropping connection to blacklisted peer",
+			"pid", pid.Pretty(),
+		)
+		return true
+	}
+
+	return false
+}
+
 // Disconnected is called when a connection closed
 func (cmw *connectionMonitorWrapper) Disconnected(netw network.Network, conn network.Conn) {
 	cmw.ConnectionMonitor.Disconnected(netw, conn)
@@ -78,7 +98,7 @@ func (cmw *connectionMonitorWrapper) ClosedStream(netw network.Network, stream n
 	cmw.ConnectionMonitor.ClosedStream(netw, stream)
 }
 
-// CheckConnectionsBlocking does a peer sweep, calling Close on those peers that are black listed
+// CheckConnectionsBlocking does a peer sweep, calling Close on those peers that are black listed or not allowed
 func (cmw *connectionMonitorWrapper) CheckConnectionsBlocking() {
 	peers := cmw.network.Peers()
 	cmw.mutPeerBlackList.RLock()
@@ -86,10 +106,7 @@ func (cmw *connectionMonitorWrapper) CheckConnectionsBlocking() {
 	cmw.mutPeerBlackList.RUnlock()
 
 	for _, pid := range peers {
-		if peerDenialEvaluator.IsDenied(core.PeerID(pid)) {
-			log.Trace("dropping connection to blacklisted peer",
-				"pid", pid.Pretty(),
-			)
+		if cmw.shouldDropConnection(peerDenialEvaluator, core.PeerID(pid)) {
 			_ = cmw.network.ClosePeer(pid)
 		}
 	}
@@ -29,6 +29,7 @@ func TestNewConnectionMonitorWrapper_ShouldWork(t *testing.T) {
 		&mock.NetworkStub{},
 		&mock.ConnectionMonitorStub{},
 		&mock.PeerDenialEvaluatorStub{},
+		&mock.TrustedPeersHolderStub{},
 	)
 
 	assert.False(t, check.IfNil(cmw))
@@ -54,6 +55,7 @@ func TestConnectionMonitorNotifier_ConnectedBlackListedShouldCallClose(t *testin
 				return true
 			},
 		},
+		&mock.TrustedPeersHolderStub{},
 	)
 
 	cmw.Connected(cmw.network, conn)
@@ -78,6 +80,71 @@ func TestConnectionMonitorNotifier_ConnectedNotBlackListedShouldCallConnected(t
 				return false
 			},
 		},
+		&mock.TrustedPeersHolderStub{},
+	)
+
+	cmw.Connected(cmw.network, conn)
+
+	assert.True(t, peerConnectedCalled)
+}
+
+func TestConnectionMonitorNotifier_ConnectedNotAllowedShouldCallClose(t *testing.T) {
+	t.Parallel()
+
+	peerCloseCalled := false
+	conn := createStubConn()
+	conn.CloseCalled = func() error {
+		peerCloseCalled = true
+
+		return nil
+	}
+	cmw := newConnectionMonitorWrapper(
+		&mock.NetworkStub{},
+		&mock.ConnectionMonitorStub{
+			ConnectedCalled: func(netw network.Network, conn network.Conn) {
+				assert.Fail(t, "should have not called connected")
+			},
+		},
+		&mock.PeerDenialEvaluatorStub{},
+		&mock.TrustedPeersHolderStub{
+			IsAllowedCalled: func(pid core.PeerID) bool {
+				return false
+			},
+		},
+	)
+
+	cmw.Connected(cmw.network, conn)
+
+	assert.True(t, peerCloseCalled)
+}
+
+func TestConnectionMonitorNotifier_ConnectedTrustedAndBlackListedShouldCallConnected(t *testing.T) {
+	t.Parallel()
+
+	peerConnectedCalled := false
+	conn := createStubConn()
+	conn.CloseCalled = func() error {
+		assert.Fail(t, "should have not called close")
+
+		return nil
+	}
+	cmw := newConnectionMonitorWrapper(
+		&mock.NetworkStub{},
+		&mock.ConnectionMonitorStub{
+			ConnectedCalled: func(netw network.Network, conn network.Conn) {
+				peerConnectedCalled = true
+			},
+		},
+		&mock.PeerDenialEvaluatorStub{
+			IsDeniedCalled: func(pid core.PeerID) bool {
+				return true
+			},
+		},
+		&mock.TrustedPeersHolderStub{
+			IsTrustedCalled: func(pid core.PeerID) bool {
+				return true
+			},
+		},
 	)
 
 	cmw.Connected(cmw.network, conn)
@@ -115,6 +182,7 @@ func TestConnectionMonitorNotifier_FunctionsShouldCallHandler(t *testing.T) {
 			},
 		},
 		&mock.PeerDenialEvaluatorStub{},
+		&mock.TrustedPeersHolderStub{},
 	)
 
 	cmw.Listen(nil, nil)
@@ -139,6 +207,7 @@ func TestConnectionMonitorWrapper_SetBlackListHandlerNilHandlerShouldErr(t *test
 		&mock.NetworkStub{},
 		&mock.ConnectionMonitorStub{},
 		&mock.PeerDenialEvaluatorStub{},
+		&mock.TrustedPeersHolderStub{},
 	)
 
 	err := cmw.SetPeerDenialEvaluator(nil)
@@ -153,6 +222,7 @@ func TestConnectionMonitorWrapper_SetBlackListHandlerShouldWork(t *testing.T) {
 		&mock.NetworkStub{},
 		&mock.ConnectionMonitorStub{},
 		&mock.PeerDenialEvaluatorStub{},
+		&mock.TrustedPeersHolderStub{},
 	)
 	newPeerDenialEvaluator := &mock.PeerDenialEvaluatorStub{}
 
@@ -193,6 +263,7 @@ func TestConnectionMonitorWrapper_CheckConnectionsBlockingShouldWork(t *testing.
 				return bytes.Equal(core.PeerID(blackListPeer).Bytes(), pid.Bytes())
 			},
 		},
+		&mock.TrustedPeersHolderStub{},
 	)
 
 	cmw.CheckConnectionsBlocking()
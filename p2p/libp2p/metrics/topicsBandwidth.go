@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// UnknownTopic groups the bytes received on topics without a registered message processor or in messages that failed
+// the validation, so the number of accounted topics can not be inflated by the senders
+const UnknownTopic = "unknown"
+
+// TopicsBandwidth is a metric that accumulates the number of bytes sent and received on each topic
+type TopicsBandwidth struct {
+	mut    sync.RWMutex
+	topics map[string]*p2p.TopicBandwidth
+}
+
+// NewTopicsBandwidth returns a new TopicsBandwidth instance
+func NewTopicsBandwidth() *TopicsBandwidth {
+	return &TopicsBandwidth{
+		topics: make(map[string]*p2p.TopicBandwidth),
+	}
+}
+
+// AddSent adds the provided number of bytes to the sent counter of the topic
+func (tb *TopicsBandwidth) AddSent(topic string, numBytes uint64) {
+	tb.mut.Lock()
+	tb.getOrCreate(topic).BytesSent += numBytes
+	tb.mut.Unlock()
+}
+
+// AddReceived adds the provided number of bytes to the received counter of the topic
+func (tb *TopicsBandwidth) AddReceived(topic string, numBytes uint64) {
+	tb.mut.Lock()
+	tb.getOrCreate(topic).BytesReceived += numBytes
+	tb.mut.Unlock()
+}
+
+func (tb *TopicsBandwidth) getOrCreate(topic string) *p2p.TopicBandwidth {
+	bandwidth, found := tb.topics[topic]
+	if !found {
+		bandwidth = &p2p.TopicBandwidth{}
+		tb.topics[topic] = bandwidth
+	}
+
+	return bandwidth
+}
+
+// Get returns a copy of the accumulated counters for all topics
+func (tb *TopicsBandwidth) Get() map[string]p2p.TopicBandwidth {
+	tb.mut.RLock()
+	defer tb.mut.RUnlock()
+
+	topics := make(map[string]p2p.TopicBandwidth, len(tb.topics))
+	for topic, bandwidth := range tb.topics {
+		topics[topic] = *bandwidth
+	}
+
+	return topics
+}
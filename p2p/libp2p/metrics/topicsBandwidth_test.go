@@ -0,0 +1,65 @@
+package metrics_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicsBandwidth_ShouldAccumulate(t *testing.T) {
+	t.Parallel()
+
+	tb := metrics.NewTopicsBandwidth()
+	tb.AddSent("topic1", 10)
+	tb.AddSent("topic1", 5)
+	tb.AddReceived("topic1", 7)
+	tb.AddReceived("topic2", 3)
+
+	expected := map[string]p2p.TopicBandwidth{
+		"topic1": {BytesSent: 15, BytesReceived: 7},
+		"topic2": {BytesSent: 0, BytesReceived: 3},
+	}
+	assert.Equal(t, expected, tb.Get())
+}
+
+func TestTopicsBandwidth_GetShouldReturnCopy(t *testing.T) {
+	t.Parallel()
+
+	tb := metrics.NewTopicsBandwidth()
+	tb.AddSent("topic", 10)
+
+	topics := tb.Get()
+	tb.AddSent("topic", 10)
+
+	assert.Equal(t, uint64(10), topics["topic"].BytesSent)
+	assert.Equal(t, uint64(20), tb.Get()["topic"].BytesSent)
+}
+
+func TestTopicsBandwidth_ConcurrentAccessShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tb := metrics.NewTopicsBandwidth()
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			switch idx % 3 {
+			case 0:
+				tb.AddSent("topic", 1)
+			case 1:
+				tb.AddReceived("topic", 1)
+			default:
+				_ = tb.Get()
+			}
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, uint64(34), tb.Get()["topic"].BytesSent)
+	assert.Equal(t, uint64(33), tb.Get()["topic"].BytesReceived)
+}
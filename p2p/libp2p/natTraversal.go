@@ -0,0 +1,146 @@
+package libp2p
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/event"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// createNATTraversalOptions returns the libp2p host options that control how the node is reachable when it runs
+// behind a NAT: the announced addresses, the UPnP/NAT-PMP port mapping, the AutoNAT service offered to the other peers
+// and the circuit-relay fallback
+func createNATTraversalOptions(natConfig config.NATTraversalConfig) ([]libp2p.Option, error) {
+	opts := make([]libp2p.Option, 0)
+
+	announcedAddresses, err := parseAnnouncedAddresses(natConfig.AnnouncedAddresses)
+	if err != nil {
+		return nil, err
+	}
+	if len(announcedAddresses) > 0 {
+		opts = append(opts, libp2p.AddrsFactory(func(_ []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+			return announcedAddresses
+		}))
+	}
+
+	if natConfig.EnablePortMapping {
+		opts = append(opts, libp2p.NATPortMap())
+	}
+
+	if natConfig.EnableNATService {
+		opts = append(opts, libp2p.EnableNATService())
+	}
+
+	if !natConfig.EnableRelayFallback {
+		//we need the disable relay option in order to save the node's bandwidth as much as possible
+		opts = append(opts, libp2p.DisableRelay())
+		return opts, nil
+	}
+
+	relayPeers, err := parseRelayPeers(natConfig.RelayPeers)
+	if err != nil {
+		return nil, err
+	}
+
+	//the node will only use the relays, it will not act as a relay (hop) for other peers
+	opts = append(opts,
+		libp2p.EnableRelay(),
+		libp2p.EnableAutoRelay(),
+		libp2p.StaticRelays(relayPeers),
+	)
+
+	return opts, nil
+}
+
+func parseAnnouncedAddresses(addresses []string) ([]multiaddr.Multiaddr, error) {
+	announcedAddresses := make([]multiaddr.Multiaddr, 0, len(addresses))
+	for _, address := range addresses {
+		ma, err := multiaddr.NewMultiaddr(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", p2p.ErrInvalidAnnouncedAddress, address, err.Error())
+		}
+
+		announcedAddresses = append(announcedAddresses, ma)
+	}
+
+	return announcedAddresses, nil
+}
+
+func parseRelayPeers(addresses []string) ([]peer.AddrInfo, error) {
+	if len(addresses) == 0 {
+		return nil, p2p.ErrEmptyRelayPeersList
+	}
+
+	relayPeers := make([]peer.AddrInfo, 0, len(addresses))
+	for _, address := range addresses {
+		ma, err := multiaddr.NewMultiaddr(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", p2p.ErrInvalidRelayPeerAddress, address, err.Error())
+		}
+
+		addrInfo, err := peer.AddrInfoFromP2pAddr(ma)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", p2p.ErrInvalidRelayPeerAddress, address, err.Error())
+		}
+
+		relayPeers = append(relayPeers, *addrInfo)
+	}
+
+	return relayPeers, nil
+}
+
+func (netMes *networkMessenger) monitorReachability() error {
+	netMes.setReachability(network.ReachabilityUnknown)
+
+	sub, err := netMes.p2pHost.EventBus().Subscribe(new(event.EvtLocalReachabilityChanged))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer func() {
+			log.LogIfError(sub.Close())
+		}()
+
+		for {
+			select {
+			case <-netMes.ctx.Done():
+				return
+			case evt, ok := <-sub.Out():
+				if !ok {
+					return
+				}
+
+				reachabilityEvent, isReachabilityEvent := evt.(event.EvtLocalReachabilityChanged)
+				if !isReachabilityEvent {
+					continue
+				}
+
+				log.Debug("network messenger reachability changed", "reachability", reachabilityEvent.Reachability.String())
+				netMes.setReachability(reachabilityEvent.Reachability)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (netMes *networkMessenger) setReachability(reachability network.Reachability) {
+	netMes.mutReachability.Lock()
+	netMes.reachability = reachability
+	netMes.mutReachability.Unlock()
+}
+
+// Reachability returns the reachability of the current node as detected by the AutoNAT subsystem
+// (Unknown, Public or Private)
+func (netMes *networkMessenger) Reachability() string {
+	netMes.mutReachability.RLock()
+	defer netMes.mutReachability.RUnlock()
+
+	return netMes.reachability.String()
+}
@@ -0,0 +1,89 @@
+package libp2p
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateNATTraversalOptions_InvalidAnnouncedAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	opts, err := createNATTraversalOptions(config.NATTraversalConfig{
+		AnnouncedAddresses: []string{"invalid address"},
+	})
+
+	assert.Nil(t, opts)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidAnnouncedAddress))
+}
+
+func TestCreateNATTraversalOptions_RelayFallbackWithoutRelayPeersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	opts, err := createNATTraversalOptions(config.NATTraversalConfig{
+		EnableRelayFallback: true,
+	})
+
+	assert.Nil(t, opts)
+	assert.True(t, errors.Is(err, p2p.ErrEmptyRelayPeersList))
+}
+
+func TestCreateNATTraversalOptions_InvalidRelayPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	opts, err := createNATTraversalOptions(config.NATTraversalConfig{
+		EnableRelayFallback: true,
+		RelayPeers:          []string{"/ip4/10.0.0.2/tcp/37373"},
+	})
+
+	assert.Nil(t, opts)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidRelayPeerAddress))
+}
+
+func TestCreateNATTraversalOptions_DisabledShouldOnlyDisableRelay(t *testing.T) {
+	t.Parallel()
+
+	opts, err := createNATTraversalOptions(config.NATTraversalConfig{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(opts))
+}
+
+func TestCreateNATTraversalOptions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	opts, err := createNATTraversalOptions(config.NATTraversalConfig{
+		AnnouncedAddresses:  []string{"/ip4/203.0.113.10/tcp/37373"},
+		EnablePortMapping:   true,
+		EnableNATService:    true,
+		EnableRelayFallback: true,
+		RelayPeers:          []string{"/ip4/10.0.0.2/tcp/37373/p2p/" + trustedPidString},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 6, len(opts))
+}
+
+func TestCreateNATTraversalOptions_NATServiceShouldBeEnabledByConfig(t *testing.T) {
+	t.Parallel()
+
+	opts, err := createNATTraversalOptions(config.NATTraversalConfig{EnableNATService: true})
+	assert.Nil(t, err)
+
+	cfg := &libp2p.Config{}
+	err = cfg.Apply(opts...)
+	assert.Nil(t, err)
+	assert.True(t, cfg.AutoNATConfig.EnableService)
+
+	opts, err = createNATTraversalOptions(config.NATTraversalConfig{})
+	assert.Nil(t, err)
+
+	cfg = &libp2p.Config{}
+	err = cfg.Apply(opts...)
+	assert.Nil(t, err)
+	assert.False(t, cfg.AutoNATConfig.EnableService)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
 	"github.com/libp2p/go-libp2p-core/protocol"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p-pubsub/pb"
@@ -55,6 +56,7 @@ const acceptMessagesInAdvanceDuration = 5 * time.Second //we are accepting the m
 const broadcastGoRoutines = 1000
 const timeBetweenPeerPrints = time.Second * 20
 const timeBetweenExternalLoggersCheck = time.Second * 20
+const durationCheckTrustedPeers = time.Second * 10
 const minRangePortValue = 1025
 const noSignPolicy = pubsub.MessageSignaturePolicy(0) //should be used only in tests
 
@@ -87,6 +89,9 @@ type networkMessenger struct {
 	peerDiscoverer      p2p.PeerDiscoverer
 	sharder             p2p.CommonSharder
 	peerShardResolver   p2p.PeerShardResolver
+	trustedPeersHolder  *trustedPeersHolder
+	mutReachability     sync.RWMutex
+	reachability        network.Reachability
 	mutTopics           sync.RWMutex
 	processors          map[string]p2p.MessageProcessor
 	topics              map[string]*pubsub.Topic
@@ -96,6 +101,7 @@ type networkMessenger struct {
 	goRoutinesThrottler *throttler.NumGoRoutinesThrottler
 	ip                  *identityProvider
 	connectionsMetric   *metrics.Connections
+	topicsBandwidth     *metrics.TopicsBandwidth
 	debugger            p2p.Debugger
 	marshalizer         p2p.Marshalizer
 	syncTimer           p2p.SyncTimer
@@ -135,11 +141,14 @@ func NewNetworkMessenger(args ArgsNetworkMessenger) (*networkMessenger, error) {
 		libp2p.DefaultMuxers,
 		libp2p.DefaultSecurity,
 		libp2p.DefaultTransports,
-		//we need the disable relay option in order to save the node's bandwidth as much as possible
-		libp2p.DisableRelay(),
-		libp2p.NATPortMap(),
 	}
 
+	natTraversalOpts, err := createNATTraversalOptions(args.P2pConfig.NATTraversal)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, natTraversalOpts...)
+
 	setupExternalP2PLoggers()
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
@@ -195,13 +204,28 @@ func createMessenger(
 		processors:        make(map[string]p2p.MessageProcessor),
 		topics:            make(map[string]*pubsub.Topic),
 		subscriptions:     make(map[string]*pubsub.Subscription),
-		outgoingPLB:       loadBalancer.NewOutgoingChannelLoadBalancer(),
+		topicsBandwidth:   metrics.NewTopicsBandwidth(),
 		peerShardResolver: &unknownPeerShardResolver{},
 		marshalizer:       args.Marshalizer,
 		syncTimer:         args.SyncTimer,
 	}
 	netMes.debugger = p2pDebug.NewP2PDebugger(core.PeerID(p2pHost.ID()))
 
+	netMes.outgoingPLB, err = createOutgoingLoadBalancer(args.P2pConfig.OutgoingQoS)
+	if err != nil {
+		return nil, err
+	}
+
+	netMes.trustedPeersHolder, err = NewTrustedPeersHolder(args.P2pConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = netMes.monitorReachability()
+	if err != nil {
+		return nil, err
+	}
+
 	err = netMes.createPubSub(withMessageSigning)
 	if err != nil {
 		return nil, err
@@ -235,6 +259,7 @@ func createMessenger(
 	}
 
 	netMes.printLogs()
+	netMes.keepTrustedPeersConnected()
 
 	return &netMes, nil
 }
@@ -275,6 +300,11 @@ func (netMes *networkMessenger) createPubSub(withMessageSigning bool) error {
 				continue
 			}
 
+			if len(sendableData.ID) > 0 {
+				netMes.sendDirect(sendableData)
+				continue
+			}
+
 			netMes.mutTopics.RLock()
 			topic := netMes.topics[sendableData.Topic]
 			netMes.mutTopics.RUnlock()
@@ -295,13 +325,32 @@ func (netMes *networkMessenger) createPubSub(withMessageSigning bool) error {
 			errPublish := topic.Publish(netMes.ctx, buffToSend)
 			if errPublish != nil {
 				log.Trace("error sending data", "error", errPublish)
+				continue
 			}
+
+			netMes.topicsBandwidth.AddSent(sendableData.Topic, uint64(len(buffToSend)))
 		}
 	}(netMes.outgoingPLB)
 
 	return nil
 }
 
+func createOutgoingLoadBalancer(qosConfig config.OutgoingQoSConfig) (p2p.ChannelLoadBalancer, error) {
+	if !qosConfig.Enabled {
+		return loadBalancer.NewOutgoingChannelLoadBalancer(), nil
+	}
+
+	arg := loadBalancer.ArgsOutgoingChannelLoadBalancer{
+		HighPriorityChannels: qosConfig.HighPriorityTopics,
+		LowPriorityChannels:  qosConfig.LowPriorityTopics,
+		HighPriorityWeight:   qosConfig.HighPriorityWeight,
+		NormalPriorityWeight: qosConfig.NormalPriorityWeight,
+		LowPriorityWeight:    qosConfig.LowPriorityWeight,
+	}
+
+	return loadBalancer.NewOutgoingChannelLoadBalancerWithPriorities(arg)
+}
+
 func (netMes *networkMessenger) createMessageBytes(buff []byte) []byte {
 	message := &data.TopicMessage{
 		Version:   currentTopicMessageVersion,
@@ -359,6 +408,7 @@ func (netMes *networkMessenger) createConnectionMonitor(p2pConfig config.P2PConf
 		Sharder:                    netMes.sharder,
 		ThresholdMinConnectedPeers: p2pConfig.Node.ThresholdMinConnectedPeers,
 		TargetCount:                p2pConfig.Sharding.TargetPeerCount,
+		TrustedPeersHolder:         netMes.trustedPeersHolder,
 	}
 	var err error
 	netMes.connMonitor, err = connMonitorFactory.NewConnectionMonitor(args)
@@ -370,6 +420,7 @@ func (netMes *networkMessenger) createConnectionMonitor(p2pConfig config.P2PConf
 		netMes.p2pHost.Network(),
 		netMes.connMonitor,
 		&disabled.NilPeerDenialEvaluator{},
+		netMes.trustedPeersHolder,
 	)
 	netMes.p2pHost.Network().Notify(cmw)
 	netMes.connMonitorWrapper = cmw
@@ -384,6 +435,44 @@ func (netMes *networkMessenger) createConnectionMonitor(p2pConfig config.P2PConf
 	return nil
 }
 
+func (netMes *networkMessenger) keepTrustedPeersConnected() {
+	if len(netMes.trustedPeersHolder.addrInfos) == 0 {
+		return
+	}
+
+	for _, addrInfo := range netMes.trustedPeersHolder.addrInfos {
+		netMes.p2pHost.Peerstore().AddAddrs(addrInfo.ID, addrInfo.Addrs, peerstore.PermanentAddrTTL)
+	}
+
+	go func() {
+		for {
+			netMes.connectToTrustedPeers()
+
+			select {
+			case <-netMes.ctx.Done():
+				return
+			case <-time.After(durationCheckTrustedPeers):
+			}
+		}
+	}()
+}
+
+func (netMes *networkMessenger) connectToTrustedPeers() {
+	for _, addrInfo := range netMes.trustedPeersHolder.addrInfos {
+		if netMes.p2pHost.Network().Connectedness(addrInfo.ID) == network.Connected {
+			continue
+		}
+
+		err := netMes.p2pHost.Connect(netMes.ctx, addrInfo)
+		if err != nil {
+			log.Debug("error connecting to trusted peer",
+				"pid", addrInfo.ID.Pretty(),
+				"error", err.Error(),
+			)
+		}
+	}
+}
+
 func (netMes *networkMessenger) createConnectionsMetric() {
 	netMes.connectionsMetric = metrics.NewConnections()
 	netMes.p2pHost.Network().Notify(netMes.connectionsMetric)
@@ -532,6 +621,11 @@ func (netMes *networkMessenger) ID() core.PeerID {
 	return core.PeerID(h.ID())
 }
 
+// TrustedPeerIDs returns the IDs of the configured trusted peers
+func (netMes *networkMessenger) TrustedPeerIDs() []core.PeerID {
+	return netMes.trustedPeersHolder.TrustedPeerIDs()
+}
+
 // Peers returns the list of all known peers ID (including self)
 func (netMes *networkMessenger) Peers() []core.PeerID {
 	peers := make([]core.PeerID, 0)
@@ -553,6 +647,11 @@ func (netMes *networkMessenger) Addresses() []string {
 	return addrs
 }
 
+// GetTopicsBandwidth returns the number of bytes sent and received on each topic
+func (netMes *networkMessenger) GetTopicsBandwidth() map[string]p2p.TopicBandwidth {
+	return netMes.topicsBandwidth.Get()
+}
+
 // ConnectToPeer tries to open a new connection to a peer
 func (netMes *networkMessenger) ConnectToPeer(address string) error {
 	return netMes.p2pHost.ConnectToPeer(netMes.ctx, address)
@@ -777,8 +876,10 @@ func (netMes *networkMessenger) pubsubCallback(handler p2p.MessageProcessor, top
 		msg, err := netMes.transformAndCheckMessage(message, fromConnectedPeer, topic)
 		if err != nil {
 			log.Trace("p2p validator - new message", "error", err.Error(), "topics", message.TopicIDs)
+			netMes.topicsBandwidth.AddReceived(metrics.UnknownTopic, uint64(len(message.Data)))
 			return false
 		}
+		netMes.topicsBandwidth.AddReceived(topic, uint64(len(message.Data)))
 
 		err = handler.ProcessReceivedMessage(msg, fromConnectedPeer)
 		if err != nil {
@@ -944,26 +1045,65 @@ func (netMes *networkMessenger) UnregisterMessageProcessor(topic string) error {
 	return nil
 }
 
-// SendToConnectedPeer sends a direct message to a connected peer
+// SendToConnectedPeer sends a direct message to a connected peer. The message is scheduled on the outgoing channel
+// of the topic, so it is prioritized together with the broadcast messages. It is a blocking method that returns after
+// the message was taken from the outgoing queue, the send errors being only logged
 func (netMes *networkMessenger) SendToConnectedPeer(topic string, buff []byte, peerID core.PeerID) error {
 	err := netMes.checkSendableData(buff)
 	if err != nil {
 		return err
 	}
 
-	buffToSend := netMes.createMessageBytes(buff)
-	if len(buffToSend) == 0 {
+	if peerID == netMes.ID() {
+		buffToSend := netMes.createMessageBytes(buff)
+		if len(buffToSend) == 0 {
+			return nil
+		}
+
+		return netMes.sendDirectToSelf(topic, buffToSend)
+	}
+
+	if !netMes.IsConnected(peerID) {
+		return p2p.ErrPeerNotDirectlyConnected
+	}
+
+	// the topic might not have an outgoing channel yet, as the direct messages do not require joining the topic
+	err = netMes.outgoingPLB.AddChannel(topic)
+	if err != nil {
+		return err
+	}
+
+	sendable := &p2p.SendableData{
+		Buff:  buff,
+		Topic: topic,
+		ID:    peerID,
+	}
+	select {
+	case netMes.outgoingPLB.GetChannelOrDefault(topic) <- sendable:
 		return nil
+	case <-netMes.ctx.Done():
+		return p2p.ErrMessengerClosed
 	}
+}
 
-	if peerID == netMes.ID() {
-		return netMes.sendDirectToSelf(topic, buffToSend)
+func (netMes *networkMessenger) sendDirect(sendableData *p2p.SendableData) {
+	buffToSend := netMes.createMessageBytes(sendableData.Buff)
+	if len(buffToSend) == 0 {
+		return
 	}
 
-	err = netMes.ds.Send(topic, buffToSend, peerID)
-	netMes.debugger.AddOutgoingMessage(topic, uint64(len(buffToSend)), err != nil)
+	err := netMes.ds.Send(sendableData.Topic, buffToSend, sendableData.ID)
+	netMes.debugger.AddOutgoingMessage(sendableData.Topic, uint64(len(buffToSend)), err != nil)
+	if err != nil {
+		log.Trace("error sending direct data",
+			"topic", sendableData.Topic,
+			"peer", sendableData.ID.Pretty(),
+			"error", err,
+		)
+		return
+	}
 
-	return err
+	netMes.topicsBandwidth.AddSent(sendableData.Topic, uint64(len(buffToSend)))
 }
 
 func (netMes *networkMessenger) sendDirectToSelf(topic string, buff []byte) error {
@@ -986,6 +1126,7 @@ func (netMes *networkMessenger) directMessageHandler(message *pubsub.Message, fr
 	topic := message.TopicIDs[0]
 	msg, err := netMes.transformAndCheckMessage(message, fromConnectedPeer, topic)
 	if err != nil {
+		netMes.topicsBandwidth.AddReceived(metrics.UnknownTopic, uint64(len(message.Data)))
 		return err
 	}
 
@@ -994,8 +1135,10 @@ func (netMes *networkMessenger) directMessageHandler(message *pubsub.Message, fr
 	netMes.mutTopics.RUnlock()
 
 	if processor == nil {
+		netMes.topicsBandwidth.AddReceived(metrics.UnknownTopic, uint64(len(message.Data)))
 		return fmt.Errorf("%w on directMessageHandler for topic %s", p2p.ErrNilValidator, topic)
 	}
+	netMes.topicsBandwidth.AddReceived(topic, uint64(len(message.Data)))
 
 	go func(msg p2p.MessageP2P) {
 		if check.IfNil(msg) {
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/metrics"
 	"github.com/ElrondNetwork/elrond-go/p2p/message"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -26,6 +27,7 @@ import (
 	"github.com/libp2p/go-libp2p-pubsub/pb"
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var timeoutWaitResponses = time.Second * 2
@@ -204,6 +206,47 @@ func TestNewNetworkMessenger_WithKadDiscovererListSharderShouldWork(t *testing.T
 	_ = mes.Close()
 }
 
+func TestNewNetworkMessenger_InvalidNATTraversalConfigShouldErr(t *testing.T) {
+	arg := createMockNetworkArgs()
+	arg.P2pConfig.NATTraversal = config.NATTraversalConfig{
+		EnableRelayFallback: true,
+	}
+	mes, err := libp2p.NewNetworkMessenger(arg)
+
+	assert.True(t, check.IfNil(mes))
+	assert.True(t, errors.Is(err, p2p.ErrEmptyRelayPeersList))
+}
+
+func TestNewNetworkMessenger_WithAnnouncedAddressesShouldAdvertiseThem(t *testing.T) {
+	announcedAddress := "/ip4/203.0.113.10/tcp/37373"
+	arg := createMockNetworkArgs()
+	arg.P2pConfig.NATTraversal = config.NATTraversalConfig{
+		AnnouncedAddresses: []string{announcedAddress},
+	}
+	mes, err := libp2p.NewNetworkMessenger(arg)
+	require.Nil(t, err)
+
+	addresses := mes.Addresses()
+	require.Equal(t, 1, len(addresses))
+	assert.Equal(t, announcedAddress+"/p2p/"+mes.ID().Pretty(), addresses[0])
+	assert.Equal(t, "Unknown", mes.Reachability())
+
+	_ = mes.Close()
+}
+
+func TestNewNetworkMessenger_TrustedPeerIDsShouldReturnTheConfiguredPeers(t *testing.T) {
+	trustedPid := "16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"
+	arg := createMockNetworkArgs()
+	arg.P2pConfig.TrustedPeers.PeerList = []string{"/ip4/127.0.0.1/tcp/9999/p2p/" + trustedPid}
+	mes, err := libp2p.NewMockMessenger(arg, mocknet.New(context.Background()))
+	require.Nil(t, err)
+
+	pid, _ := peer.Decode(trustedPid)
+	assert.Equal(t, []core.PeerID{core.PeerID(pid)}, mes.TrustedPeerIDs())
+
+	_ = mes.Close()
+}
+
 //------- Messenger functionality
 
 func TestLibp2pMessenger_ConnectToPeerShouldCallUpgradedHost(t *testing.T) {
@@ -463,6 +506,146 @@ func TestLibp2pMessenger_BroadcastDataBetween2PeersShouldWork(t *testing.T) {
 	_ = mes2.Close()
 }
 
+func TestLibp2pMessenger_BroadcastDataShouldAccountTopicsBandwidth(t *testing.T) {
+	msg := []byte("test message")
+
+	_, mes1, mes2 := createMockNetworkOf2()
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(2)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	prepareMessengerForMatchDataReceive(mes1, msg, wg)
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	time.Sleep(time.Second)
+
+	mes1.Broadcast("test", msg)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	assert.True(t, mes1.GetTopicsBandwidth()["test"].BytesSent > uint64(len(msg)))
+	assert.True(t, mes2.GetTopicsBandwidth()["test"].BytesReceived > uint64(len(msg)))
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestLibp2pMessenger_DirectDataOnUnknownTopicShouldAccountTheUnknownBandwidth(t *testing.T) {
+	msg := []byte("test message")
+
+	_, mes1, mes2 := createMockNetworkOf2()
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	wg := &sync.WaitGroup{}
+	chanDone := make(chan bool)
+	wg.Add(1)
+
+	go func() {
+		wg.Wait()
+		chanDone <- true
+	}()
+
+	prepareMessengerForMatchDataReceive(mes2, msg, wg)
+
+	err := mes1.SendToConnectedPeer("topic chosen by the sender", msg, mes2.ID())
+	assert.Nil(t, err)
+	err = mes1.SendToConnectedPeer("test", msg, mes2.ID())
+	assert.Nil(t, err)
+
+	waitDoneWithTimeout(t, chanDone, timeoutWaitResponses)
+
+	topicsBandwidth := mes2.GetTopicsBandwidth()
+	_, found := topicsBandwidth["topic chosen by the sender"]
+	assert.False(t, found)
+	assert.True(t, topicsBandwidth[metrics.UnknownTopic].BytesReceived > uint64(len(msg)))
+	assert.True(t, topicsBandwidth["test"].BytesReceived > uint64(len(msg)))
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestLibp2pMessenger_SendToConnectedPeerHighPriorityShouldOvertakeLowPriority(t *testing.T) {
+	numLowPriorityMessages := 1000
+	netw := mocknet.New(context.Background())
+	args := createMockNetworkArgs()
+	args.P2pConfig.OutgoingQoS = config.OutgoingQoSConfig{
+		Enabled:              true,
+		HighPriorityTopics:   []string{"high"},
+		LowPriorityTopics:    []string{"low"},
+		HighPriorityWeight:   8,
+		NormalPriorityWeight: 4,
+		LowPriorityWeight:    1,
+	}
+	mes1, _ := libp2p.NewMockMessenger(args, netw)
+	mes2, _ := libp2p.NewMockMessenger(createMockNetworkArgs(), netw)
+	_ = netw.LinkAll()
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	mutReceived := sync.Mutex{}
+	received := make([]string, 0, numLowPriorityMessages+1)
+	chDone := make(chan bool)
+	for _, topic := range []string{"low", "high"} {
+		_ = mes2.CreateTopic(topic, false)
+		_ = mes2.RegisterMessageProcessor(topic, &mock.MessageProcessorStub{
+			ProcessMessageCalled: func(message p2p.MessageP2P, _ core.PeerID) error {
+				mutReceived.Lock()
+				received = append(received, message.Topics()[0])
+				if len(received) == numLowPriorityMessages+1 {
+					close(chDone)
+				}
+				mutReceived.Unlock()
+
+				return nil
+			},
+		})
+	}
+
+	for i := 0; i < numLowPriorityMessages; i++ {
+		go func() {
+			err := mes1.SendToConnectedPeer("low", []byte("low priority"), mes2.ID())
+			assert.Nil(t, err)
+		}()
+	}
+	time.Sleep(time.Millisecond * 10)
+
+	err := mes1.SendToConnectedPeer("high", []byte("high priority"), mes2.ID())
+	assert.Nil(t, err)
+
+	waitDoneWithTimeout(t, chDone, timeoutWaitResponses)
+
+	mutReceived.Lock()
+	highPriorityIndex := 0
+	for index, topic := range received {
+		if topic == "high" {
+			highPriorityIndex = index
+		}
+	}
+	mutReceived.Unlock()
+	assert.True(t, highPriorityIndex < numLowPriorityMessages/2,
+		"high priority message received at index %d", highPriorityIndex)
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
+func TestNewNetworkMessenger_InvalidOutgoingQoSConfigShouldErr(t *testing.T) {
+	arg := createMockNetworkArgs()
+	arg.P2pConfig.OutgoingQoS = config.OutgoingQoSConfig{
+		Enabled: true,
+	}
+	mes, err := libp2p.NewNetworkMessenger(arg)
+
+	assert.True(t, check.IfNil(mes))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidChannelPriorityWeight))
+}
+
 func TestLibp2pMessenger_BroadcastOnChannelBlockingShouldLimitNumberOfGoRoutines(t *testing.T) {
 	if testing.Short() {
 		t.Skip("this test does not perform well in TC with race detector on")
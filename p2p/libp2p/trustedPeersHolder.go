@@ -0,0 +1,103 @@
+package libp2p
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+var _ p2p.TrustedPeersHolder = (*trustedPeersHolder)(nil)
+
+type trustedPeersHolder struct {
+	addrInfos               []peer.AddrInfo
+	trustedPeers            map[core.PeerID]struct{}
+	allowedPeers            map[core.PeerID]struct{}
+	isPrivateNetworkEnabled bool
+}
+
+// NewTrustedPeersHolder creates a component able to tell if a peer is trusted or allowed to connect to self,
+// based on the trusted peers and private network settings
+func NewTrustedPeersHolder(p2pConfig config.P2PConfig) (*trustedPeersHolder, error) {
+	tph := &trustedPeersHolder{
+		addrInfos:               make([]peer.AddrInfo, 0, len(p2pConfig.TrustedPeers.PeerList)),
+		trustedPeers:            make(map[core.PeerID]struct{}),
+		allowedPeers:            make(map[core.PeerID]struct{}),
+		isPrivateNetworkEnabled: p2pConfig.PrivateNetwork.Enabled,
+	}
+
+	for _, address := range p2pConfig.TrustedPeers.PeerList {
+		addrInfo, err := parseTrustedPeerAddress(address)
+		if err != nil {
+			return nil, err
+		}
+
+		tph.addrInfos = append(tph.addrInfos, *addrInfo)
+		tph.trustedPeers[core.PeerID(addrInfo.ID)] = struct{}{}
+	}
+
+	for _, pidString := range p2pConfig.PrivateNetwork.AllowedPeerIDs {
+		pid, err := peer.Decode(pidString)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s: %s", p2p.ErrInvalidAllowedPeerID, pidString, err.Error())
+		}
+
+		tph.allowedPeers[core.PeerID(pid)] = struct{}{}
+	}
+
+	return tph, nil
+}
+
+func parseTrustedPeerAddress(address string) (*peer.AddrInfo, error) {
+	ma, err := multiaddr.NewMultiaddr(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s: %s", p2p.ErrInvalidTrustedPeerAddress, address, err.Error())
+	}
+
+	addrInfo, err := peer.AddrInfoFromP2pAddr(ma)
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s: %s", p2p.ErrInvalidTrustedPeerAddress, address, err.Error())
+	}
+
+	return addrInfo, nil
+}
+
+// IsTrusted returns true if the provided peer ID was configured as a trusted peer
+func (tph *trustedPeersHolder) IsTrusted(pid core.PeerID) bool {
+	_, found := tph.trustedPeers[pid]
+
+	return found
+}
+
+// IsAllowed returns true if the provided peer ID can connect to self. When the private network mode is
+// disabled, all peers are allowed
+func (tph *trustedPeersHolder) IsAllowed(pid core.PeerID) bool {
+	if !tph.isPrivateNetworkEnabled {
+		return true
+	}
+	if tph.IsTrusted(pid) {
+		return true
+	}
+
+	_, found := tph.allowedPeers[pid]
+
+	return found
+}
+
+// TrustedPeerIDs returns the configured trusted peer IDs
+func (tph *trustedPeersHolder) TrustedPeerIDs() []core.PeerID {
+	pids := make([]core.PeerID, 0, len(tph.addrInfos))
+	for _, addrInfo := range tph.addrInfos {
+		pids = append(pids, core.PeerID(addrInfo.ID))
+	}
+
+	return pids
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tph *trustedPeersHolder) IsInterfaceNil() bool {
+	return tph == nil
+}
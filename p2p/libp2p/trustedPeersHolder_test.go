@@ -0,0 +1,87 @@
+package libp2p
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+const trustedPidString = "16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"
+const allowedPidString = "16Uiu2HAm6yvbp1oZ6zjnWsn9FdRqBSaQkbhELyaThuq48ybdorrr"
+
+func decodePid(pidString string) core.PeerID {
+	pid, _ := peer.Decode(pidString)
+
+	return core.PeerID(pid)
+}
+
+func TestNewTrustedPeersHolder_InvalidTrustedAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.P2PConfig{}
+	cfg.TrustedPeers.PeerList = []string{"invalid address"}
+	tph, err := NewTrustedPeersHolder(cfg)
+
+	assert.True(t, check.IfNil(tph))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidTrustedPeerAddress))
+}
+
+func TestNewTrustedPeersHolder_TrustedAddressWithoutPeerIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.P2PConfig{}
+	cfg.TrustedPeers.PeerList = []string{"/ip4/127.0.0.1/tcp/9999"}
+	tph, err := NewTrustedPeersHolder(cfg)
+
+	assert.True(t, check.IfNil(tph))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidTrustedPeerAddress))
+}
+
+func TestNewTrustedPeersHolder_InvalidAllowedPeerIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.P2PConfig{}
+	cfg.PrivateNetwork.AllowedPeerIDs = []string{"invalid pid"}
+	tph, err := NewTrustedPeersHolder(cfg)
+
+	assert.True(t, check.IfNil(tph))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidAllowedPeerID))
+}
+
+func TestTrustedPeersHolder_PublicNetworkShouldAllowAll(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.P2PConfig{}
+	cfg.TrustedPeers.PeerList = []string{"/ip4/127.0.0.1/tcp/9999/p2p/" + trustedPidString}
+	tph, err := NewTrustedPeersHolder(cfg)
+	assert.Nil(t, err)
+
+	trustedPid := decodePid(trustedPidString)
+	assert.True(t, tph.IsTrusted(trustedPid))
+	assert.True(t, tph.IsAllowed(trustedPid))
+	assert.False(t, tph.IsTrusted("other pid"))
+	assert.True(t, tph.IsAllowed("other pid"))
+	assert.Equal(t, []core.PeerID{trustedPid}, tph.TrustedPeerIDs())
+}
+
+func TestTrustedPeersHolder_PrivateNetworkShouldAllowOnlyTrustedAndAllowListed(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.P2PConfig{}
+	cfg.TrustedPeers.PeerList = []string{"/ip4/127.0.0.1/tcp/9999/p2p/" + trustedPidString}
+	cfg.PrivateNetwork.Enabled = true
+	cfg.PrivateNetwork.AllowedPeerIDs = []string{allowedPidString}
+	tph, err := NewTrustedPeersHolder(cfg)
+	assert.Nil(t, err)
+
+	assert.True(t, tph.IsAllowed(decodePid(trustedPidString)))
+	assert.True(t, tph.IsAllowed(decodePid(allowedPidString)))
+	assert.False(t, tph.IsTrusted(decodePid(allowedPidString)))
+	assert.False(t, tph.IsAllowed("other pid"))
+}
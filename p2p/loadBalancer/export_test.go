@@ -19,3 +19,11 @@ func (oplb *OutgoingChannelLoadBalancer) NamesChans() map[string]chan *p2p.Senda
 func DefaultSendChannel() string {
 	return defaultSendChannel
 }
+
+func (oplb *OutgoingChannelLoadBalancer) ChannelPriority(channel string) int {
+	return oplb.channelPriority(channel)
+}
+
+const HighPriority = highPriority
+const NormalPriority = normalPriority
+const LowPriority = lowPriority
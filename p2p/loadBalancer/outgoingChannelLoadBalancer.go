@@ -2,6 +2,8 @@ package loadBalancer
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -11,34 +13,97 @@ var _ p2p.ChannelLoadBalancer = (*OutgoingChannelLoadBalancer)(nil)
 
 const defaultSendChannel = "default send channel"
 
+const (
+	highPriority = iota
+	normalPriority
+	lowPriority
+	numPriorities
+)
+
+// ArgsOutgoingChannelLoadBalancer is the argument DTO used to create a priority aware outgoing channel load balancer
+type ArgsOutgoingChannelLoadBalancer struct {
+	HighPriorityChannels []string
+	LowPriorityChannels  []string
+	HighPriorityWeight   uint32
+	NormalPriorityWeight uint32
+	LowPriorityWeight    uint32
+}
+
 // OutgoingChannelLoadBalancer is a component that evenly balances requests to be sent
 type OutgoingChannelLoadBalancer struct {
-	mut      sync.RWMutex
-	chans    []chan *p2p.SendableData
-	mainChan chan *p2p.SendableData
-	names    []string
+	mut       sync.RWMutex
+	chans     []chan *p2p.SendableData
+	mainChans [numPriorities]chan *p2p.SendableData
+	names     []string
 	//namesChans is defined only for performance purposes as to fast search by name
 	//iteration is done directly on slices as that is used very often and is about 50x
 	//faster then an iteration over a map
-	namesChans map[string]chan *p2p.SendableData
-	cancelFunc context.CancelFunc
-	ctx        context.Context //we need the context saved here in order to call appendChannel from exported func AddChannel
+	namesChans           map[string]chan *p2p.SendableData
+	cancelFunc           context.CancelFunc
+	ctx                  context.Context //we need the context saved here in order to call appendChannel from exported func AddChannel
+	highPriorityChannels []string
+	lowPriorityChannels  []string
+	mutCredits           sync.Mutex
+	weights              [numPriorities]uint32
+	credits              [numPriorities]uint32
 }
 
 // NewOutgoingChannelLoadBalancer creates a new instance of a ChannelLoadBalancer instance
+// All channels will have the same priority
 func NewOutgoingChannelLoadBalancer() *OutgoingChannelLoadBalancer {
+	oclb := newOutgoingChannelLoadBalancer()
+	oclb.appendChannel(defaultSendChannel)
+
+	return oclb
+}
+
+// NewOutgoingChannelLoadBalancerWithPriorities creates a new instance of a ChannelLoadBalancer instance that
+// schedules the channels based on their priority. A channel gets the high (or low) priority if its name starts with
+// one of the provided high (or low) priority channel names, otherwise it gets the normal priority.
+// Under load, in each scheduling round, each priority will be able to send at most the number of messages defined
+// by its weight, the higher priorities being served first
+func NewOutgoingChannelLoadBalancerWithPriorities(arg ArgsOutgoingChannelLoadBalancer) (*OutgoingChannelLoadBalancer, error) {
+	if arg.HighPriorityWeight == 0 {
+		return nil, fmt.Errorf("%w for the high priority", p2p.ErrInvalidChannelPriorityWeight)
+	}
+	if arg.NormalPriorityWeight == 0 {
+		return nil, fmt.Errorf("%w for the normal priority", p2p.ErrInvalidChannelPriorityWeight)
+	}
+	if arg.LowPriorityWeight == 0 {
+		return nil, fmt.Errorf("%w for the low priority", p2p.ErrInvalidChannelPriorityWeight)
+	}
+
+	oclb := newOutgoingChannelLoadBalancer()
+	oclb.highPriorityChannels = arg.HighPriorityChannels
+	oclb.lowPriorityChannels = arg.LowPriorityChannels
+	oclb.weights[highPriority] = arg.HighPriorityWeight
+	oclb.weights[normalPriority] = arg.NormalPriorityWeight
+	oclb.weights[lowPriority] = arg.LowPriorityWeight
+	oclb.resetCredits()
+
+	oclb.appendChannel(defaultSendChannel)
+
+	return oclb, nil
+}
+
+func newOutgoingChannelLoadBalancer() *OutgoingChannelLoadBalancer {
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
 	oclb := &OutgoingChannelLoadBalancer{
-		chans:      make([]chan *p2p.SendableData, 0),
-		names:      make([]string, 0),
-		namesChans: make(map[string]chan *p2p.SendableData),
-		mainChan:   make(chan *p2p.SendableData),
-		cancelFunc: cancelFunc,
-		ctx:        ctx,
+		chans:                make([]chan *p2p.SendableData, 0),
+		names:                make([]string, 0),
+		namesChans:           make(map[string]chan *p2p.SendableData),
+		cancelFunc:           cancelFunc,
+		ctx:                  ctx,
+		highPriorityChannels: make([]string, 0),
+		lowPriorityChannels:  make([]string, 0),
 	}
 
-	oclb.appendChannel(defaultSendChannel)
+	for i := 0; i < numPriorities; i++ {
+		oclb.mainChans[i] = make(chan *p2p.SendableData)
+		oclb.weights[i] = 1
+	}
+	oclb.resetCredits()
 
 	return oclb
 }
@@ -48,6 +113,7 @@ func (oplb *OutgoingChannelLoadBalancer) appendChannel(channel string) {
 	ch := make(chan *p2p.SendableData)
 	oplb.chans = append(oplb.chans, ch)
 	oplb.namesChans[channel] = ch
+	mainChan := oplb.mainChans[oplb.channelPriority(channel)]
 
 	go func() {
 		for {
@@ -59,11 +125,36 @@ func (oplb *OutgoingChannelLoadBalancer) appendChannel(channel string) {
 				return
 			}
 
-			oplb.mainChan <- obj
+			select {
+			case mainChan <- obj:
+			case <-oplb.ctx.Done():
+				return
+			}
 		}
 	}()
 }
 
+func (oplb *OutgoingChannelLoadBalancer) channelPriority(channel string) int {
+	if hasPrefixInList(channel, oplb.highPriorityChannels) {
+		return highPriority
+	}
+	if hasPrefixInList(channel, oplb.lowPriorityChannels) {
+		return lowPriority
+	}
+
+	return normalPriority
+}
+
+func hasPrefixInList(channel string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(channel, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // AddChannel adds a new channel to the throttler, if it does not exists
 func (oplb *OutgoingChannelLoadBalancer) AddChannel(channel string) error {
 	if channel == defaultSendChannel {
@@ -137,12 +228,74 @@ func (oplb *OutgoingChannelLoadBalancer) GetChannelOrDefault(channel string) cha
 	return oplb.chans[0]
 }
 
-// CollectOneElementFromChannels gets the waiting object from mainChan. It is a blocking call.
+// CollectOneElementFromChannels gets the waiting object from the main channels, taking into account the priority
+// and the weight of each channel. It is a blocking call.
 func (oplb *OutgoingChannelLoadBalancer) CollectOneElementFromChannels() *p2p.SendableData {
-	obj := <-oplb.mainChan
+	obj, found := oplb.collectWithCredits()
+	if found {
+		return obj
+	}
+
+	//either there are no pending objects or the priorities that have pending objects ran out of credits
+	oplb.resetCredits()
+	obj, found = oplb.collectWithCredits()
+	if found {
+		return obj
+	}
+
+	priority := normalPriority
+	select {
+	case obj = <-oplb.mainChans[highPriority]:
+		priority = highPriority
+	case obj = <-oplb.mainChans[normalPriority]:
+	case obj = <-oplb.mainChans[lowPriority]:
+		priority = lowPriority
+	}
+	oplb.consumeCredit(priority)
+
 	return obj
 }
 
+func (oplb *OutgoingChannelLoadBalancer) collectWithCredits() (*p2p.SendableData, bool) {
+	for priority := 0; priority < numPriorities; priority++ {
+		if !oplb.hasCredits(priority) {
+			continue
+		}
+
+		select {
+		case obj := <-oplb.mainChans[priority]:
+			oplb.consumeCredit(priority)
+			return obj, true
+		default:
+		}
+	}
+
+	return nil, false
+}
+
+func (oplb *OutgoingChannelLoadBalancer) hasCredits(priority int) bool {
+	oplb.mutCredits.Lock()
+	defer oplb.mutCredits.Unlock()
+
+	return oplb.credits[priority] > 0
+}
+
+func (oplb *OutgoingChannelLoadBalancer) consumeCredit(priority int) {
+	oplb.mutCredits.Lock()
+	defer oplb.mutCredits.Unlock()
+
+	if oplb.credits[priority] > 0 {
+		oplb.credits[priority]--
+	}
+}
+
+func (oplb *OutgoingChannelLoadBalancer) resetCredits() {
+	oplb.mutCredits.Lock()
+	defer oplb.mutCredits.Unlock()
+
+	oplb.credits = oplb.weights
+}
+
 // Close finishes all started go routines in this instance
 func (oplb *OutgoingChannelLoadBalancer) Close() error {
 	oplb.cancelFunc()
@@ -45,6 +45,16 @@ func checkIntegrity(oclb *loadBalancer.OutgoingChannelLoadBalancer, name string)
 	return nil
 }
 
+func createMockArgsOutgoingChannelLoadBalancer() loadBalancer.ArgsOutgoingChannelLoadBalancer {
+	return loadBalancer.ArgsOutgoingChannelLoadBalancer{
+		HighPriorityChannels: []string{"consensus"},
+		LowPriorityChannels:  []string{"accountTrieNodes"},
+		HighPriorityWeight:   2,
+		NormalPriorityWeight: 1,
+		LowPriorityWeight:    1,
+	}
+}
+
 //------- NewOutgoingChannelLoadBalancer
 
 func TestNewOutgoingChannelLoadBalancer_ShouldNotProduceNil(t *testing.T) {
@@ -64,6 +74,42 @@ func TestNewOutgoingChannelLoadBalancer_ShouldAddDefaultChannel(t *testing.T) {
 	assert.Nil(t, checkIntegrity(oclb, loadBalancer.DefaultSendChannel()))
 }
 
+func TestNewOutgoingChannelLoadBalancerWithPriorities_InvalidWeightsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgsOutgoingChannelLoadBalancer()
+	arg.HighPriorityWeight = 0
+	oclb, err := loadBalancer.NewOutgoingChannelLoadBalancerWithPriorities(arg)
+	assert.Nil(t, oclb)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidChannelPriorityWeight))
+
+	arg = createMockArgsOutgoingChannelLoadBalancer()
+	arg.NormalPriorityWeight = 0
+	oclb, err = loadBalancer.NewOutgoingChannelLoadBalancerWithPriorities(arg)
+	assert.Nil(t, oclb)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidChannelPriorityWeight))
+
+	arg = createMockArgsOutgoingChannelLoadBalancer()
+	arg.LowPriorityWeight = 0
+	oclb, err = loadBalancer.NewOutgoingChannelLoadBalancerWithPriorities(arg)
+	assert.Nil(t, oclb)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidChannelPriorityWeight))
+}
+
+func TestNewOutgoingChannelLoadBalancerWithPriorities_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	oclb, err := loadBalancer.NewOutgoingChannelLoadBalancerWithPriorities(createMockArgsOutgoingChannelLoadBalancer())
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oclb.Names()))
+	assert.Nil(t, checkIntegrity(oclb, loadBalancer.DefaultSendChannel()))
+	assert.Equal(t, loadBalancer.HighPriority, oclb.ChannelPriority("consensus_0"))
+	assert.Equal(t, loadBalancer.LowPriority, oclb.ChannelPriority("accountTrieNodes_0_REQUEST"))
+	assert.Equal(t, loadBalancer.NormalPriority, oclb.ChannelPriority("transactions_0"))
+	assert.Equal(t, loadBalancer.NormalPriority, oclb.ChannelPriority(loadBalancer.DefaultSendChannel()))
+}
+
 //------- AddChannel
 
 func TestOutgoingChannelLoadBalancer_AddChannelNewChannelShouldNotErrAndAddNewChannel(t *testing.T) {
@@ -299,3 +345,38 @@ func TestOutgoingChannelLoadBalancer_CollectOneElementFromChannelsShouldWork(t *
 		return
 	}
 }
+
+func TestOutgoingChannelLoadBalancer_CollectOneElementFromChannelsShouldRespectPrioritiesAndWeights(t *testing.T) {
+	t.Parallel()
+
+	oclb, _ := loadBalancer.NewOutgoingChannelLoadBalancerWithPriorities(createMockArgsOutgoingChannelLoadBalancer())
+	_ = oclb.AddChannel("consensus_0")
+	_ = oclb.AddChannel("accountTrieNodes_0")
+
+	numObjects := 3
+	sendObjects := func(channel string) {
+		for i := 0; i < numObjects; i++ {
+			oclb.GetChannelOrDefault(channel) <- &p2p.SendableData{Topic: channel}
+		}
+	}
+	go sendObjects("accountTrieNodes_0")
+	go sendObjects("consensus_0")
+
+	expectedTopics := []string{
+		"consensus_0",
+		"consensus_0",
+		"accountTrieNodes_0",
+		"consensus_0",
+		"accountTrieNodes_0",
+		"accountTrieNodes_0",
+	}
+	for _, expectedTopic := range expectedTopics {
+		//wait for the internal go routines to move the pending objects on the main channels
+		time.Sleep(time.Millisecond * 100)
+
+		obj := oclb.CollectOneElementFromChannels()
+		assert.Equal(t, expectedTopic, obj.Topic)
+	}
+
+	_ = oclb.Close()
+}
@@ -143,6 +143,11 @@ func (messenger *Messenger) ConnectedAddresses() []string {
 	return messenger.network.ListAddressesExceptOne(messenger.ID())
 }
 
+// Reachability returns Public as all the messengers from the in-memory network can reach each other
+func (messenger *Messenger) Reachability() string {
+	return "Public"
+}
+
 // PeerAddresses creates the address string from a given peer ID.
 func (messenger *Messenger) PeerAddresses(pid core.PeerID) []string {
 	return []string{fmt.Sprintf("/memp2p/%s", string(pid))}
@@ -398,6 +403,11 @@ func (messenger *Messenger) SetPeerDenialEvaluator(_ p2p.PeerDenialEvaluator) er
 	return nil
 }
 
+// GetTopicsBandwidth returns an empty map. Not implemented.
+func (messenger *Messenger) GetTopicsBandwidth() map[string]p2p.TopicBandwidth {
+	return make(map[string]p2p.TopicBandwidth)
+}
+
 // GetConnectedPeersInfo returns a nil object. Not implemented.
 func (messenger *Messenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
 	return nil
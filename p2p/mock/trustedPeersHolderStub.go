@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// TrustedPeersHolderStub -
+type TrustedPeersHolderStub struct {
+	IsTrustedCalled func(pid core.PeerID) bool
+	IsAllowedCalled func(pid core.PeerID) bool
+}
+
+// IsTrusted -
+func (tphs *TrustedPeersHolderStub) IsTrusted(pid core.PeerID) bool {
+	if tphs.IsTrustedCalled != nil {
+		return tphs.IsTrustedCalled(pid)
+	}
+
+	return false
+}
+
+// IsAllowed -
+func (tphs *TrustedPeersHolderStub) IsAllowed(pid core.PeerID) bool {
+	if tphs.IsAllowedCalled != nil {
+		return tphs.IsAllowedCalled(pid)
+	}
+
+	return true
+}
+
+// IsInterfaceNil -
+func (tphs *TrustedPeersHolderStub) IsInterfaceNil() bool {
+	return tphs == nil
+}
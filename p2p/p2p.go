@@ -27,10 +27,12 @@ type MessageProcessor interface {
 	IsInterfaceNil() bool
 }
 
-// SendableData represents the struct used in data throttler implementation
+// SendableData represents the struct used in data throttler implementation. The ID is set only for the data sent
+// directly to a connected peer
 type SendableData struct {
 	Buff  []byte
 	Topic string
+	ID    core.PeerID
 }
 
 // PeerDiscoverer defines the behaviour of a peer discovery mechanism
@@ -78,6 +80,13 @@ type Messenger interface {
 	// Messenger is currently connected.
 	ConnectedAddresses() []string
 
+	// Reachability returns the reachability of the Messenger as seen by the other peers
+	// (Unknown, Public or Private)
+	Reachability() string
+
+	// GetTopicsBandwidth returns the number of bytes sent and received by the Messenger on each topic
+	GetTopicsBandwidth() map[string]TopicBandwidth
+
 	// PeerAddresses returns the known addresses for the provided peer ID
 	PeerAddresses(pid core.PeerID) []string
 
@@ -214,6 +223,12 @@ type PeerShardResolver interface {
 	IsInterfaceNil() bool
 }
 
+// TopicBandwidth represents the DTO structure used to output the number of bytes sent and received on a topic
+type TopicBandwidth struct {
+	BytesSent     uint64
+	BytesReceived uint64
+}
+
 // ConnectedPeersInfo represents the DTO structure used to output the metrics for connected peers
 type ConnectedPeersInfo struct {
 	SelfShardID             uint32
@@ -274,6 +289,14 @@ type PeerDenialEvaluator interface {
 	IsInterfaceNil() bool
 }
 
+// TrustedPeersHolder defines the behavior of a component able to tell if a peer is trusted (always connected and
+// exempted from connection trimming) or if it is allowed to connect to self
+type TrustedPeersHolder interface {
+	IsTrusted(pid core.PeerID) bool
+	IsAllowed(pid core.PeerID) bool
+	IsInterfaceNil() bool
+}
+
 // ConnectionMonitorWrapper uses a connection monitor but checks if the peer is blacklisted or not
 //TODO this should be removed after merging of the PeerShardResolver and BlacklistHandler
 type ConnectionMonitorWrapper interface {
@@ -50,6 +50,7 @@ type ArgBaseProcessor struct {
 // new instances of shard processor
 type ArgShardProcessor struct {
 	ArgBaseProcessor
+	MinGasPriceAdjuster process.MinGasPriceAdjuster
 }
 
 // ArgMetaProcessor holds all dependencies required by the process data factory in order to create
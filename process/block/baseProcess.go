@@ -1239,6 +1239,22 @@ func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeade
 	if err != nil {
 		log.Error("historyRepo.RecordBlock()", "blockHeaderHash", blockHeaderHash, "error", err.Error())
 	}
+
+	if !bp.historyRepo.IsEnabled() {
+		return
+	}
+
+	txsFromPool := make(map[string]data.TransactionHandler)
+	for _, blockType := range []block.Type{block.TxBlock, block.InvalidBlock, block.RewardsBlock} {
+		for txHash, tx := range bp.txCoordinator.GetAllCurrentUsedTxs(blockType) {
+			txsFromPool[txHash] = tx
+		}
+	}
+	for scrHash, scr := range scrResultsFromPool {
+		txsFromPool[scrHash] = scr
+	}
+
+	bp.historyRepo.RecordBalanceChanges(blockHeaderHash, blockHeader, txsFromPool)
 }
 
 func (bp *baseProcessor) addHeaderIntoTrackerPool(nonce uint64, shardID uint32) {
@@ -318,6 +318,7 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 			HistoryRepository:       &testscommon.HistoryRepositoryStub{},
 			EpochNotifier:           &mock.EpochNotifierStub{},
 		},
+		MinGasPriceAdjuster: &mock.MinGasPriceAdjusterStub{},
 	}
 
 	return arguments
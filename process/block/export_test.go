@@ -109,6 +109,7 @@ func NewShardProcessorEmptyWith3shards(
 			HistoryRepository:       &testscommon.HistoryRepositoryStub{},
 			EpochNotifier:           &mock.EpochNotifierStub{},
 		},
+		MinGasPriceAdjuster: &mock.MinGasPriceAdjusterStub{},
 	}
 	shardProc, err := NewShardProcessor(arguments)
 	return shardProc, err
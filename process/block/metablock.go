@@ -664,6 +664,8 @@ func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler,
 
 	mp.blockTracker.RemoveLastNotarizedHeaders()
 
+	mp.historyRepo.RevertBalanceChanges(metaBlock)
+
 	return nil
 }
 
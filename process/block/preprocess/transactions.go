@@ -587,7 +587,10 @@ func (txs *transactions) processAndRemoveBadTransaction(
 ) error {
 
 	_, err := txs.txProcessor.ProcessTransaction(tx)
-	isTxTargetedForDeletion := errors.Is(err, process.ErrLowerNonceInTransaction) || errors.Is(err, process.ErrInsufficientFee)
+	// a transaction below the min gas price of the block is removed as it would no longer pass the interceptors either
+	isTxTargetedForDeletion := errors.Is(err, process.ErrLowerNonceInTransaction) ||
+		errors.Is(err, process.ErrInsufficientFee) ||
+		errors.Is(err, process.ErrInsufficientGasPriceInTx)
 	if isTxTargetedForDeletion {
 		strCache := process.ShardCacherIdentifier(sndShardId, dstShardId)
 		txs.txPool.RemoveData(txHash, strCache)
@@ -920,7 +923,9 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 		txs.mutAccountsInfo.Unlock()
 
 		if err != nil && !errors.Is(err, process.ErrFailedTransaction) {
-			if errors.Is(err, process.ErrHigherNonceInTransaction) {
+			isSenderBlocked := errors.Is(err, process.ErrHigherNonceInTransaction) ||
+				errors.Is(err, process.ErrInsufficientGasPriceInTx)
+			if isSenderBlocked {
 				senderAddressToSkip = tx.GetSndAddr()
 			}
 
@@ -25,6 +25,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const MaxGasLimitPerBlock = uint64(100000)
@@ -966,6 +967,74 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddOnly5asSCCal
 	assert.Equal(t, numTxsToAdd, txHashes)
 }
 
+func TestTransactions_CreateAndProcessMiniBlocksFromMeBelowBlockMinGasPriceShouldRemoveTxAndSkipSender(t *testing.T) {
+	t.Parallel()
+
+	txPool, _ := testscommon.CreateTxPool(2, 0)
+	hasher := &mock.HasherMock{}
+	marshalizer := &mock.MarshalizerMock{}
+	blockMinGasPrice := uint64(20)
+	processedNonces := make(map[string][]uint64)
+	txs, _ := NewTransactionPreprocessor(
+		txPool,
+		&mock.ChainStorerMock{},
+		hasher,
+		marshalizer,
+		&mock.TxProcessorMock{ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			processedNonces[string(tx.SndAddr)] = append(processedNonces[string(tx.SndAddr)], tx.Nonce)
+			if tx.GasPrice < blockMinGasPrice {
+				return 0, process.ErrInsufficientGasPriceInTx
+			}
+			return 0, nil
+		}},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.AccountsStub{},
+		func(shardID uint32, txHashes [][]byte) {},
+		feeHandlerMock(),
+		&mock.GasHandlerMock{
+			ComputeGasConsumedByTxCalled: func(txSenderShardId uint32, txReceiverShardId uint32, txHandler data.TransactionHandler) (uint64, uint64, error) {
+				return 0, 0, nil
+			},
+			RemoveGasConsumedCalled: func(hashes [][]byte) {},
+			RemoveGasRefundedCalled: func(hashes [][]byte) {},
+		},
+		&mock.BlockTrackerMock{},
+		block.TxBlock,
+		createMockPubkeyConverter(),
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+	)
+
+	strCache := process.ShardCacherIdentifier(0, 1)
+	lowPriceSender := bytes.Repeat([]byte{1}, 32)
+	highPriceSender := bytes.Repeat([]byte{2}, 32)
+	newTxs := []*transaction.Transaction{
+		{SndAddr: lowPriceSender, Nonce: 0, GasPrice: 10},
+		{SndAddr: lowPriceSender, Nonce: 1, GasPrice: 30},
+		{SndAddr: highPriceSender, Nonce: 0, GasPrice: 30},
+	}
+	txHashes := make([][]byte, 0, len(newTxs))
+	for _, newTx := range newTxs {
+		txHash, _ := core.CalculateHash(marshalizer, hasher, newTx)
+		txPool.AddData(txHash, newTx, newTx.Size(), strCache)
+		txHashes = append(txHashes, txHash)
+	}
+
+	sortedTxsAndHashes, _ := txs.computeSortedTxs(0, 1)
+	miniBlocks, err := txs.createAndProcessMiniBlocksFromMe(haveTimeTrue, isShardStuckFalse, isMaxBlockSizeReachedFalse, sortedTxsAndHashes)
+	assert.Nil(t, err)
+
+	require.Equal(t, 1, len(miniBlocks))
+	assert.Equal(t, [][]byte{txHashes[2]}, miniBlocks[0].TxHashes)
+	// the next nonce of the sender can not be executed in this block, so it is not processed
+	assert.Equal(t, []uint64{0}, processedNonces[string(lowPriceSender)])
+
+	_, found := txPool.ShardDataStore(strCache).Peek(txHashes[0])
+	assert.False(t, found)
+	_, found = txPool.ShardDataStore(strCache).Peek(txHashes[1])
+	assert.True(t, found)
+}
+
 func TestTransactions_IsDataPrepared_NumMissingTxsZeroShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -31,6 +31,7 @@ type shardProcessor struct {
 	chRcvAllMetaHdrs  chan bool
 
 	processedMiniBlocks *processedMb.ProcessedMiniBlockTracker
+	minGasPriceAdjuster process.MinGasPriceAdjuster
 }
 
 // NewShardProcessor creates a new shardProcessor object
@@ -49,6 +50,9 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 	if check.IfNil(arguments.DataPool.Transactions()) {
 		return nil, process.ErrNilTransactionPool
 	}
+	if check.IfNil(arguments.MinGasPriceAdjuster) {
+		return nil, process.ErrNilMinGasPriceAdjuster
+	}
 
 	genesisHdr := arguments.BlockChain.GetGenesisHeader()
 	base := &baseProcessor{
@@ -83,7 +87,8 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 	}
 
 	sp := shardProcessor{
-		baseProcessor: base,
+		baseProcessor:       base,
+		minGasPriceAdjuster: arguments.MinGasPriceAdjuster,
 	}
 
 	sp.txCounter = NewTransactionCounter()
@@ -131,6 +136,11 @@ func (sp *shardProcessor) ProcessBlock(
 	sp.epochNotifier.CheckEpoch(headerHandler.GetEpoch())
 	sp.requestHandler.SetEpoch(headerHandler.GetEpoch())
 
+	err = sp.minGasPriceAdjuster.AdjustMinGasPrice(headerHandler)
+	if err != nil {
+		return err
+	}
+
 	log.Debug("started processing block",
 		"epoch", headerHandler.GetEpoch(),
 		"round", headerHandler.GetRound(),
@@ -620,6 +630,8 @@ func (sp *shardProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler
 
 	sp.blockTracker.RemoveLastNotarizedHeaders()
 
+	sp.historyRepo.RevertBalanceChanges(header)
+
 	return nil
 }
 
@@ -699,6 +711,11 @@ func (sp *shardProcessor) CreateBlock(
 
 	shardHdr.SetEpoch(sp.epochStartTrigger.MetaEpoch())
 	sp.epochNotifier.CheckEpoch(shardHdr.GetEpoch())
+	err := sp.minGasPriceAdjuster.AdjustMinGasPrice(shardHdr)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	sp.blockChainHook.SetCurrentHeader(shardHdr)
 	shardHdr.SoftwareVersion = []byte(sp.headerIntegrityVerifier.GetVersion(shardHdr.Epoch))
 	body, err := sp.createBlockBody(shardHdr, haveTime)
@@ -875,6 +892,11 @@ func (sp *shardProcessor) CommitBlock(
 	sp.indexBlockIfNeeded(bodyHandler, headerHash, headerHandler, lastBlockHeader)
 	sp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
 
+	errNotCritical = sp.minGasPriceAdjuster.PublishMinGasPrice(header)
+	if errNotCritical != nil {
+		log.Debug("minGasPriceAdjuster.PublishMinGasPrice", "error", errNotCritical.Error())
+	}
+
 	lastCrossNotarizedHeader, _, err := sp.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
 	if err != nil {
 		return err
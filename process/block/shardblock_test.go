@@ -214,6 +214,17 @@ func TestNewShardProcessor_NilTransactionPoolShouldErr(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilMinGasPriceAdjusterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.MinGasPriceAdjuster = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilMinGasPriceAdjuster, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_NilTxCoordinatorShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -369,6 +380,37 @@ func TestShardProcessor_ProcessBlockHeaderBodyMismatchShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrHeaderBodyMismatch, err)
 }
 
+func TestShardProcessor_ProcessBlockMinGasPriceAdjustmentFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.Header{
+		Nonce:         1,
+		PrevHash:      []byte(""),
+		PrevRandSeed:  []byte("rand seed"),
+		Signature:     []byte("signature"),
+		PubKeysBitmap: []byte("00110"),
+		ShardID:       0,
+		RootHash:      []byte("rootHash"),
+	}
+	expectedErr := errors.New("expected error")
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.AccountsDB[state.UserAccountsState] = &mock.AccountsStub{
+		JournalLenCalled: func() int {
+			return 0
+		},
+	}
+	arguments.MinGasPriceAdjuster = &mock.MinGasPriceAdjusterStub{
+		AdjustMinGasPriceCalled: func(header data.HeaderHandler) error {
+			assert.Equal(t, hdr, header)
+			return expectedErr
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	err := sp.ProcessBlock(hdr, &block.Body{}, haveTime)
+	assert.Equal(t, expectedErr, err)
+}
+
 func TestShardProcessor_ProcessBlockWithInvalidTransactionShouldErr(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool([]byte("tx_hash1"))
@@ -1769,14 +1811,23 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 		return hdrHash
 	}
 	arguments.BlockChain = blkc
+	var publishedHeader data.HeaderHandler
+	arguments.MinGasPriceAdjuster = &mock.MinGasPriceAdjusterStub{
+		PublishMinGasPriceCalled: func(header data.HeaderHandler) error {
+			publishedHeader = header
+			return nil
+		},
+	}
 	sp, _ := blproc.NewShardProcessor(arguments)
 
 	err := sp.ProcessBlock(hdr, body, haveTime)
 	assert.Nil(t, err)
+	assert.Nil(t, publishedHeader)
 	err = sp.CommitBlock(hdr, body)
 	assert.Nil(t, err)
 	assert.True(t, forkDetectorAddCalled)
 	assert.Equal(t, hdrHash, blkc.GetCurrentBlockHeaderHash())
+	assert.Equal(t, hdr, publishedHeader)
 	//this should sleep as there is an async call to display current hdr and block in CommitBlock
 	time.Sleep(time.Second)
 }
@@ -2987,6 +3038,12 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 	arguments.Hasher = hasherMock
 	arguments.Marshalizer = marshalizerMock
 	arguments.TxCoordinator = tc
+	var revertedHeader data.HeaderHandler
+	arguments.HistoryRepository = &testscommon.HistoryRepositoryStub{
+		RevertBalanceChangesCalled: func(blockHeader data.HeaderHandler) {
+			revertedHeader = blockHeader
+		},
+	}
 	sp, _ := blproc.NewShardProcessor(arguments)
 
 	txHashes := make([][]byte, 0)
@@ -3024,8 +3081,10 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 		ReceiverShardID: miniblock.ReceiverShardID,
 	}
 
-	err = sp.RestoreBlockIntoPools(&block.Header{MetaBlockHashes: [][]byte{metablockHash}, MiniBlockHeaders: []block.MiniBlockHeader{miniBlockHeader}}, body)
+	header := &block.Header{MetaBlockHashes: [][]byte{metablockHash}, MiniBlockHeaders: []block.MiniBlockHeader{miniBlockHeader}}
+	err = sp.RestoreBlockIntoPools(header, body)
 	assert.Nil(t, err)
+	assert.True(t, header == revertedHeader)
 
 	miniblockFromPool, _ := datapool.MiniBlocks().Get(miniblockHash)
 	txFromPool, _ := datapool.Transactions().SearchFirstData(txHash)
@@ -0,0 +1,36 @@
+package economics
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.MinGasPriceAdjuster = (*disabledMinGasPriceAdjuster)(nil)
+
+type disabledMinGasPriceAdjuster struct {
+}
+
+// NewDisabledMinGasPriceAdjuster returns a min gas price adjuster which keeps the configured min gas price
+func NewDisabledMinGasPriceAdjuster() *disabledMinGasPriceAdjuster {
+	return &disabledMinGasPriceAdjuster{}
+}
+
+// AdjustMinGasPrice does nothing
+func (dmgpa *disabledMinGasPriceAdjuster) AdjustMinGasPrice(_ data.HeaderHandler) error {
+	return nil
+}
+
+// BlockMinGasPrice returns 0 as the configured min gas price is the only one required
+func (dmgpa *disabledMinGasPriceAdjuster) BlockMinGasPrice() uint64 {
+	return 0
+}
+
+// PublishMinGasPrice does nothing
+func (dmgpa *disabledMinGasPriceAdjuster) PublishMinGasPrice(_ data.HeaderHandler) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dmgpa *disabledMinGasPriceAdjuster) IsInterfaceNil() bool {
+	return dmgpa == nil
+}
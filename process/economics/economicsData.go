@@ -32,6 +32,7 @@ type economicsData struct {
 	maxGasLimitPerMetaBlock          uint64
 	gasPerDataByte                   uint64
 	minGasPrice                      uint64
+	currentMinGasPrice               atomic.Uint64
 	gasPriceModifier                 float64
 	minGasLimit                      uint64
 	developerPercentage              float64
@@ -100,6 +101,8 @@ func NewEconomicsData(args ArgsNewEconomicsData) (*economicsData, error) {
 		statusHandler:                    statusHandler.NewNilStatusHandler(),
 	}
 
+	ed.currentMinGasPrice.Set(ed.minGasPrice)
+
 	ed.yearSettings = make(map[uint32]*config.YearSetting)
 	for _, yearSetting := range args.Economics.GlobalSettings.YearSettings {
 		ed.yearSettings[yearSetting.Year] = &config.YearSetting{
@@ -236,6 +239,30 @@ func (ed *economicsData) MinGasPrice() uint64 {
 	return ed.minGasPrice
 }
 
+// CurrentMinGasPrice returns the minimum gas price a transaction sent from this shard must pay in order to be accepted
+// by the interceptors. It is equal to the configured minimum gas price, unless it was raised by the congestion based
+// minimum gas price adjustment of the last committed block
+func (ed *economicsData) CurrentMinGasPrice() uint64 {
+	return ed.currentMinGasPrice.Get()
+}
+
+// SetCurrentMinGasPrice sets the minimum gas price a transaction sent from this shard must pay in order to be accepted
+// by the interceptors. Values below the configured minimum gas price are replaced by the configured one
+func (ed *economicsData) SetCurrentMinGasPrice(minGasPrice uint64) {
+	if minGasPrice < ed.minGasPrice {
+		minGasPrice = ed.minGasPrice
+	}
+
+	oldMinGasPrice := ed.currentMinGasPrice.Get()
+	if oldMinGasPrice == minGasPrice {
+		return
+	}
+
+	ed.currentMinGasPrice.Set(minGasPrice)
+	ed.statusHandler.SetUInt64Value(core.MetricCurrentMinGasPrice, minGasPrice)
+	log.Debug("economics: current min gas price changed", "old", oldMinGasPrice, "new", minGasPrice)
+}
+
 // MinGasPriceForProcessing returns the minimum allowed gas price for processing
 func (ed *economicsData) MinGasPriceForProcessing() uint64 {
 	priceModifier := ed.GasPriceModifier()
@@ -332,7 +359,8 @@ func (ed *economicsData) SplitTxGasInCategories(tx process.TransactionWithFeeHan
 	return
 }
 
-// CheckValidityTxValues checks if the provided transaction is economically correct
+// CheckValidityTxValues checks if the provided transaction is economically correct. The gas price is checked against the
+// configured min gas price, as the congestion based one only applies to the transactions of the shard senders
 func (ed *economicsData) CheckValidityTxValues(tx process.TransactionWithFeeHandler) error {
 	if ed.minGasPrice > tx.GetGasPrice() {
 		return process.ErrInsufficientGasPriceInTx
@@ -294,6 +294,47 @@ func TestEconomicsData_TxWithLowerGasPriceShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrInsufficientGasPriceInTx, err)
 }
 
+func TestEconomicsData_CheckValidityTxValuesShouldUseTheConfiguredMinGasPrice(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsData(1)
+	minGasPrice := uint64(500)
+	minGasLimit := uint64(12)
+	args.Economics.FeeSettings.MinGasPrice = fmt.Sprintf("%d", minGasPrice)
+	args.Economics.FeeSettings.MinGasLimit = fmt.Sprintf("%d", minGasLimit)
+	economicsData, _ := economics.NewEconomicsData(args)
+	economicsData.SetCurrentMinGasPrice(2 * minGasPrice)
+	tx := &transaction.Transaction{
+		GasPrice: minGasPrice - 1,
+		GasLimit: minGasLimit,
+		Value:    big.NewInt(0),
+	}
+
+	err := economicsData.CheckValidityTxValues(tx)
+	assert.Equal(t, process.ErrInsufficientGasPriceInTx, err)
+
+	// the current min gas price is required only from the shard senders, by the interceptors
+	tx.GasPrice = minGasPrice
+	err = economicsData.CheckValidityTxValues(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*minGasPrice, economicsData.CurrentMinGasPrice())
+}
+
+func TestEconomicsData_SetCurrentMinGasPriceShouldNotGoBelowTheConfiguredValue(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsData(1)
+	args.Economics.FeeSettings.MinGasPrice = "500"
+	economicsData, _ := economics.NewEconomicsData(args)
+	assert.Equal(t, uint64(500), economicsData.CurrentMinGasPrice())
+
+	economicsData.SetCurrentMinGasPrice(1200)
+	assert.Equal(t, uint64(1200), economicsData.CurrentMinGasPrice())
+
+	economicsData.SetCurrentMinGasPrice(100)
+	assert.Equal(t, uint64(500), economicsData.CurrentMinGasPrice())
+}
+
 func TestEconomicsData_TxWithLowerGasLimitShouldErr(t *testing.T) {
 	t.Parallel()
 
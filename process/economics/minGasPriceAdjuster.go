@@ -0,0 +1,472 @@
+package economics
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.MinGasPriceAdjuster = (*minGasPriceAdjuster)(nil)
+
+// minGasPriceScale is the fixed point scale of the fractions used in the min gas price computation, so the result does
+// not depend on the floating point arithmetic of the node
+const minGasPriceScale = 10000
+
+// MinGasPriceHandler defines the economics component whose accepted min gas price is adjusted
+type MinGasPriceHandler interface {
+	MinGasPrice() uint64
+	MaxGasLimitPerBlock(shardID uint32) uint64
+	SetCurrentMinGasPrice(minGasPrice uint64)
+	IsInterfaceNil() bool
+}
+
+// ArgsMinGasPriceAdjuster holds the arguments needed for creating a new min gas price adjuster
+type ArgsMinGasPriceAdjuster struct {
+	Settings       config.DynamicMinGasPriceSettings
+	EnableEpoch    uint32
+	Economics      MinGasPriceHandler
+	ShardID        uint32
+	BlockChain     data.ChainHandler
+	DataPool       dataRetriever.PoolsHolder
+	Store          dataRetriever.StorageService
+	Marshalizer    marshal.Marshalizer
+	RequestHandler process.RequestHandler
+	GasHandler     process.GasHandler
+}
+
+type headerGas struct {
+	round    uint64
+	nonce    uint64
+	prevHash []byte
+	gasUsed  uint64
+}
+
+type minGasPriceAdjuster struct {
+	roundsPerAdjustment uint64
+	scaledTarget        *big.Int
+	scaledMaxMultiplier *big.Int
+	enableEpoch         uint32
+	economics           MinGasPriceHandler
+	shardID             uint32
+	blockChain          data.ChainHandler
+	dataPool            dataRetriever.PoolsHolder
+	store               dataRetriever.StorageService
+	marshalizer         marshal.Marshalizer
+	requestHandler      process.RequestHandler
+	gasHandler          process.GasHandler
+
+	blockMinGasPrice atomic.Uint64
+
+	mutAdjust       sync.Mutex
+	headers         map[string]*headerGas
+	lastWindowHash  []byte
+	lastMinGasPrice uint64
+}
+
+// NewMinGasPriceAdjuster creates the component which adjusts the min gas price of the shard based on the fullness of
+// its recent blocks, as resulting from the gas consumed by their miniblocks
+func NewMinGasPriceAdjuster(args ArgsMinGasPriceAdjuster) (*minGasPriceAdjuster, error) {
+	if check.IfNil(args.Economics) {
+		return nil, process.ErrNilEconomicsData
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(args.DataPool) {
+		return nil, process.ErrNilDataPoolHolder
+	}
+	if check.IfNil(args.DataPool.Headers()) {
+		return nil, process.ErrNilHeadersDataPool
+	}
+	if check.IfNil(args.DataPool.MiniBlocks()) {
+		return nil, process.ErrNilMiniBlockPool
+	}
+	if check.IfNil(args.DataPool.Transactions()) {
+		return nil, process.ErrNilTransactionPool
+	}
+	if check.IfNil(args.DataPool.UnsignedTransactions()) {
+		return nil, process.ErrNilUnsignedTxDataPool
+	}
+	if check.IfNil(args.Store) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.RequestHandler) {
+		return nil, process.ErrNilRequestHandler
+	}
+	if check.IfNil(args.GasHandler) {
+		return nil, process.ErrNilGasHandler
+	}
+	err := checkDynamicMinGasPriceSettings(args.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	mgpa := &minGasPriceAdjuster{
+		roundsPerAdjustment: args.Settings.RoundsPerAdjustment,
+		scaledTarget:        big.NewInt(int64(args.Settings.TargetBlockFullness * minGasPriceScale)),
+		scaledMaxMultiplier: big.NewInt(int64(args.Settings.MaxMinGasPriceMultiplier * minGasPriceScale)),
+		enableEpoch:         args.EnableEpoch,
+		economics:           args.Economics,
+		shardID:             args.ShardID,
+		blockChain:          args.BlockChain,
+		dataPool:            args.DataPool,
+		store:               args.Store,
+		marshalizer:         args.Marshalizer,
+		requestHandler:      args.RequestHandler,
+		gasHandler:          args.GasHandler,
+		headers:             make(map[string]*headerGas),
+	}
+	mgpa.blockMinGasPrice.Set(args.Economics.MinGasPrice())
+
+	return mgpa, nil
+}
+
+func checkDynamicMinGasPriceSettings(settings config.DynamicMinGasPriceSettings) error {
+	if settings.RoundsPerAdjustment == 0 {
+		return fmt.Errorf("%w: RoundsPerAdjustment should be greater than 0", process.ErrInvalidDynamicMinGasPriceSettings)
+	}
+	if settings.TargetBlockFullness < 0 || settings.TargetBlockFullness >= 1 {
+		return fmt.Errorf("%w: TargetBlockFullness should be in the [0, 1) interval", process.ErrInvalidDynamicMinGasPriceSettings)
+	}
+	if settings.MaxMinGasPriceMultiplier < 1 {
+		return fmt.Errorf("%w: MaxMinGasPriceMultiplier should be at least 1", process.ErrInvalidDynamicMinGasPriceSettings)
+	}
+
+	return nil
+}
+
+// AdjustMinGasPrice sets the min gas price to be used while creating or processing the provided header. The price is
+// computed from the last completed adjustment window before the header round, so all the nodes which process the
+// header on top of the same parent use the same value. The price accepted by the interceptors is not changed
+func (mgpa *minGasPriceAdjuster) AdjustMinGasPrice(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+
+	mgpa.mutAdjust.Lock()
+	defer mgpa.mutAdjust.Unlock()
+
+	minGasPrice, err := mgpa.computeMinGasPrice(header)
+	if err != nil {
+		return err
+	}
+
+	mgpa.blockMinGasPrice.Set(minGasPrice)
+
+	return nil
+}
+
+// BlockMinGasPrice returns the min gas price of the header last provided to AdjustMinGasPrice
+func (mgpa *minGasPriceAdjuster) BlockMinGasPrice() uint64 {
+	return mgpa.blockMinGasPrice.Get()
+}
+
+// PublishMinGasPrice sets the min gas price of the provided committed header as the price accepted by the interceptors
+// from the transactions of the shard senders
+func (mgpa *minGasPriceAdjuster) PublishMinGasPrice(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return process.ErrNilBlockHeader
+	}
+
+	mgpa.mutAdjust.Lock()
+	defer mgpa.mutAdjust.Unlock()
+
+	minGasPrice, err := mgpa.computeMinGasPrice(header)
+	if err != nil {
+		return err
+	}
+
+	mgpa.economics.SetCurrentMinGasPrice(minGasPrice)
+
+	return nil
+}
+
+func (mgpa *minGasPriceAdjuster) computeMinGasPrice(header data.HeaderHandler) (uint64, error) {
+	baseMinGasPrice := mgpa.economics.MinGasPrice()
+	if header.GetEpoch() < mgpa.enableEpoch || header.GetRound() <= mgpa.roundsPerAdjustment {
+		return baseMinGasPrice, nil
+	}
+
+	windowEnd := (header.GetRound() - 1) / mgpa.roundsPerAdjustment * mgpa.roundsPerAdjustment
+	windowStart := windowEnd - mgpa.roundsPerAdjustment
+
+	// the window is identified by its last header, found by walking back from the parent of the provided header
+	windowHash := header.GetPrevHash()
+	info, err := mgpa.getHeaderGas(windowHash, header.GetNonce())
+	if err != nil {
+		return 0, err
+	}
+	for info.round > windowEnd {
+		windowHash = info.prevHash
+		info, err = mgpa.getHeaderGas(windowHash, info.nonce)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if bytes.Equal(windowHash, mgpa.lastWindowHash) {
+		return mgpa.lastMinGasPrice, nil
+	}
+
+	totalGasUsed := big.NewInt(0)
+	for info.round > windowStart && info.nonce > 0 {
+		totalGasUsed.Add(totalGasUsed, big.NewInt(0).SetUint64(info.gasUsed))
+		info, err = mgpa.getHeaderGas(info.prevHash, info.nonce)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	minGasPrice := mgpa.computeMinGasPriceFromGasUsed(baseMinGasPrice, totalGasUsed)
+	mgpa.lastWindowHash = windowHash
+	mgpa.lastMinGasPrice = minGasPrice
+	mgpa.pruneHeaders(windowStart)
+
+	log.Debug("min gas price adjusted",
+		"window start round", windowStart+1,
+		"window end round", windowEnd,
+		"gas used", totalGasUsed.String(),
+		"min gas price", minGasPrice,
+	)
+
+	return minGasPrice, nil
+}
+
+// computeMinGasPriceFromGasUsed returns the min gas price x * base for the block fullness y of the window, computed as
+// the gas used divided by the max gas of the window: x = 1 + (maxMultiplier - 1) * (y - target) / (1 - target). The
+// fullness does not depend on the gas price paid by the transactions, so a high price does not sustain itself once the
+// blocks are no longer full
+func (mgpa *minGasPriceAdjuster) computeMinGasPriceFromGasUsed(baseMinGasPrice uint64, totalGasUsed *big.Int) uint64 {
+	scale := big.NewInt(minGasPriceScale)
+	maxGasInWindow := big.NewInt(0).SetUint64(mgpa.economics.MaxGasLimitPerBlock(mgpa.shardID))
+	maxGasInWindow.Mul(maxGasInWindow, big.NewInt(0).SetUint64(mgpa.roundsPerAdjustment))
+	if maxGasInWindow.Sign() == 0 {
+		return baseMinGasPrice
+	}
+
+	scaledFullness := big.NewInt(0).Mul(totalGasUsed, scale)
+	scaledFullness.Div(scaledFullness, maxGasInWindow)
+	if scaledFullness.Cmp(mgpa.scaledTarget) <= 0 {
+		return baseMinGasPrice
+	}
+
+	scaledMultiplier := big.NewInt(0).Sub(mgpa.scaledMaxMultiplier, scale)
+	scaledMultiplier.Mul(scaledMultiplier, big.NewInt(0).Sub(scaledFullness, mgpa.scaledTarget))
+	scaledMultiplier.Div(scaledMultiplier, big.NewInt(0).Sub(scale, mgpa.scaledTarget))
+	scaledMultiplier.Add(scaledMultiplier, scale)
+	if scaledMultiplier.Cmp(mgpa.scaledMaxMultiplier) > 0 {
+		scaledMultiplier.Set(mgpa.scaledMaxMultiplier)
+	}
+
+	minGasPrice := big.NewInt(0).SetUint64(baseMinGasPrice)
+	minGasPrice.Mul(minGasPrice, scaledMultiplier)
+	minGasPrice.Div(minGasPrice, scale)
+
+	return minGasPrice.Uint64()
+}
+
+// getHeaderGas returns the gas used by the header with the provided hash. When the header is not found, it is requested
+// by nonce, so a node that did not sync it can retry the block once it arrives
+func (mgpa *minGasPriceAdjuster) getHeaderGas(hash []byte, childNonce uint64) (*headerGas, error) {
+	info, ok := mgpa.headers[string(hash)]
+	if ok {
+		return info, nil
+	}
+
+	var header data.HeaderHandler
+	if bytes.Equal(hash, mgpa.blockChain.GetGenesisHeaderHash()) {
+		header = mgpa.blockChain.GetGenesisHeader()
+	}
+	if check.IfNil(header) {
+		shardHeader, err := process.GetShardHeader(hash, mgpa.dataPool.Headers(), mgpa.marshalizer, mgpa.store)
+		if err != nil {
+			mgpa.requestMissingHeader(childNonce)
+			return nil, fmt.Errorf("%w for min gas price adjustment: %v", process.ErrMissingHeader, err)
+		}
+		header = shardHeader
+	}
+
+	gasUsed, err := mgpa.computeGasUsedByHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	info = &headerGas{
+		round:    header.GetRound(),
+		nonce:    header.GetNonce(),
+		prevHash: header.GetPrevHash(),
+		gasUsed:  gasUsed,
+	}
+	mgpa.headers[string(hash)] = info
+
+	return info, nil
+}
+
+// computeGasUsedByHeader sums the gas consumed in this shard by the miniblocks of the provided header, the same way it
+// is counted against the max gas limit per block when the block is created: the transactions sent from this shard and
+// the transactions and smart contract results received from other shards. The smart contract results generated in this
+// shard are not counted, as their gas is part of the gas of the transactions which generated them
+func (mgpa *minGasPriceAdjuster) computeGasUsedByHeader(header data.HeaderHandler) (uint64, error) {
+	shardHeader, ok := header.(*block.Header)
+	if !ok {
+		return 0, nil
+	}
+
+	gasUsed := uint64(0)
+	for _, miniBlockHeader := range shardHeader.MiniBlockHeaders {
+		if !mgpa.isMiniBlockFillingTheBlock(miniBlockHeader) {
+			continue
+		}
+
+		miniBlock, err := mgpa.getMiniBlock(miniBlockHeader.Hash, miniBlockHeader.SenderShardID)
+		if err != nil {
+			return 0, err
+		}
+
+		mapHashTx, err := mgpa.getMiniBlockTransactions(miniBlock)
+		if err != nil {
+			return 0, err
+		}
+
+		gasInSenderShard, gasInReceiverShard, err := mgpa.gasHandler.ComputeGasConsumedByMiniBlock(miniBlock, mapHashTx)
+		if err != nil {
+			return 0, err
+		}
+
+		if miniBlockHeader.SenderShardID == mgpa.shardID {
+			gasUsed += gasInSenderShard
+			continue
+		}
+		gasUsed += gasInReceiverShard
+	}
+
+	return gasUsed, nil
+}
+
+func (mgpa *minGasPriceAdjuster) isMiniBlockFillingTheBlock(miniBlockHeader block.MiniBlockHeader) bool {
+	switch miniBlockHeader.Type {
+	case block.TxBlock, block.InvalidBlock:
+		return miniBlockHeader.SenderShardID == mgpa.shardID || miniBlockHeader.ReceiverShardID == mgpa.shardID
+	case block.SmartContractResultBlock:
+		return miniBlockHeader.SenderShardID != mgpa.shardID && miniBlockHeader.ReceiverShardID == mgpa.shardID
+	default:
+		return false
+	}
+}
+
+// getMiniBlock returns the miniblock with the provided hash from the pool or from the storage. When the miniblock is
+// not found, it is requested, so the block can be retried once it arrives
+func (mgpa *minGasPriceAdjuster) getMiniBlock(hash []byte, senderShardID uint32) (*block.MiniBlock, error) {
+	value, ok := mgpa.dataPool.MiniBlocks().Peek(hash)
+	if ok {
+		miniBlock, isMiniBlock := value.(*block.MiniBlock)
+		if isMiniBlock {
+			return miniBlock, nil
+		}
+	}
+
+	buff, err := mgpa.store.Get(dataRetriever.MiniBlockUnit, hash)
+	if err == nil {
+		miniBlock := &block.MiniBlock{}
+		err = mgpa.marshalizer.Unmarshal(miniBlock, buff)
+		if err == nil {
+			return miniBlock, nil
+		}
+	}
+
+	go mgpa.requestHandler.RequestMiniBlock(senderShardID, hash)
+
+	return nil, fmt.Errorf("%w for min gas price adjustment: miniblock %x", process.ErrMissingBody, hash)
+}
+
+// getMiniBlockTransactions returns the transactions of the provided miniblock from the pool or from the storage. The
+// missing transactions are requested, so the block can be retried once they arrive
+func (mgpa *minGasPriceAdjuster) getMiniBlockTransactions(miniBlock *block.MiniBlock) (map[string]data.TransactionHandler, error) {
+	var pool dataRetriever.ShardedDataCacherNotifier
+	var unit dataRetriever.UnitType
+	var newTransaction func() data.TransactionHandler
+	var requestTransactions func(shardID uint32, txHashes [][]byte)
+
+	switch miniBlock.Type {
+	case block.SmartContractResultBlock:
+		pool = mgpa.dataPool.UnsignedTransactions()
+		unit = dataRetriever.UnsignedTransactionUnit
+		newTransaction = func() data.TransactionHandler { return &smartContractResult.SmartContractResult{} }
+		requestTransactions = mgpa.requestHandler.RequestUnsignedTransactions
+	default:
+		pool = mgpa.dataPool.Transactions()
+		unit = dataRetriever.TransactionUnit
+		newTransaction = func() data.TransactionHandler { return &transaction.Transaction{} }
+		requestTransactions = mgpa.requestHandler.RequestTransaction
+	}
+
+	mapHashTx := make(map[string]data.TransactionHandler, len(miniBlock.TxHashes))
+	missingTxHashes := make([][]byte, 0)
+	for _, txHash := range miniBlock.TxHashes {
+		value, ok := pool.SearchFirstData(txHash)
+		if ok {
+			tx, isTransaction := value.(data.TransactionHandler)
+			if isTransaction {
+				mapHashTx[string(txHash)] = tx
+				continue
+			}
+		}
+
+		buff, err := mgpa.store.Get(unit, txHash)
+		if err == nil {
+			tx := newTransaction()
+			err = mgpa.marshalizer.Unmarshal(tx, buff)
+			if err == nil {
+				mapHashTx[string(txHash)] = tx
+				continue
+			}
+		}
+
+		missingTxHashes = append(missingTxHashes, txHash)
+	}
+
+	if len(missingTxHashes) > 0 {
+		go requestTransactions(miniBlock.SenderShardID, missingTxHashes)
+		return nil, fmt.Errorf("%w for min gas price adjustment: %d missing transactions",
+			process.ErrMissingTransaction, len(missingTxHashes))
+	}
+
+	return mapHashTx, nil
+}
+
+func (mgpa *minGasPriceAdjuster) requestMissingHeader(childNonce uint64) {
+	if childNonce == 0 {
+		return
+	}
+
+	go mgpa.requestHandler.RequestShardHeaderByNonce(mgpa.shardID, childNonce-1)
+}
+
+// pruneHeaders removes the headers which can not be part of the next adjustment windows
+func (mgpa *minGasPriceAdjuster) pruneHeaders(windowStart uint64) {
+	for hash, info := range mgpa.headers {
+		if info.round <= windowStart {
+			delete(mgpa.headers, hash)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mgpa *minGasPriceAdjuster) IsInterfaceNil() bool {
+	return mgpa == nil
+}
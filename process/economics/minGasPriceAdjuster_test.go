@@ -0,0 +1,586 @@
+package economics_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/blockchain"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBaseMinGasPrice     = uint64(1000)
+	testMaxGasLimitPerBlock = uint64(100000)
+	testRoundsPerAdjustment = uint64(10)
+	testGenesisHash         = "genesis"
+	testEnableEpoch         = uint32(1)
+)
+
+func headerHash(round uint64) []byte {
+	if round == 0 {
+		return []byte(testGenesisHash)
+	}
+
+	return []byte(fmt.Sprintf("hash%d", round))
+}
+
+// testChain holds the headers of a shard chain, one for each round, together with their miniblocks and transactions
+type testChain struct {
+	headers    map[string]data.HeaderHandler
+	miniBlocks map[string]*block.MiniBlock
+	txs        map[string]data.TransactionHandler
+}
+
+func newTestChain() *testChain {
+	return &testChain{
+		headers:    make(map[string]data.HeaderHandler),
+		miniBlocks: make(map[string]*block.MiniBlock),
+		txs:        make(map[string]data.TransactionHandler),
+	}
+}
+
+// addHeader adds the header of the provided round, built on top of the previous round and holding the provided miniblocks
+func (tc *testChain) addHeader(round uint64, miniBlocks ...*block.MiniBlock) *block.Header {
+	header := &block.Header{
+		Round:           round,
+		Nonce:           round,
+		Epoch:           testEnableEpoch,
+		PrevHash:        headerHash(round - 1),
+		AccumulatedFees: big.NewInt(0),
+	}
+	for i, miniBlock := range miniBlocks {
+		miniBlockHash := []byte(fmt.Sprintf("miniBlock%d_%d", round, i))
+		tc.miniBlocks[string(miniBlockHash)] = miniBlock
+		header.MiniBlockHeaders = append(header.MiniBlockHeaders, block.MiniBlockHeader{
+			Hash:            miniBlockHash,
+			SenderShardID:   miniBlock.SenderShardID,
+			ReceiverShardID: miniBlock.ReceiverShardID,
+			TxCount:         uint32(len(miniBlock.TxHashes)),
+			Type:            miniBlock.Type,
+		})
+	}
+	tc.headers[string(headerHash(round))] = header
+
+	return header
+}
+
+// newMiniBlock creates a miniblock holding the provided transactions
+func (tc *testChain) newMiniBlock(blockType block.Type, senderShardID uint32, receiverShardID uint32, txs ...data.TransactionHandler) *block.MiniBlock {
+	miniBlock := &block.MiniBlock{
+		Type:            blockType,
+		SenderShardID:   senderShardID,
+		ReceiverShardID: receiverShardID,
+	}
+	for _, tx := range txs {
+		txHash := []byte(fmt.Sprintf("tx%d", len(tc.txs)))
+		tc.txs[string(txHash)] = tx
+		miniBlock.TxHashes = append(miniBlock.TxHashes, txHash)
+	}
+
+	return miniBlock
+}
+
+// createChain returns a chain of shard headers, one for each round, each one using the provided gas
+func createChain(gasUsed []uint64) *testChain {
+	chain := newTestChain()
+	for i, gas := range gasUsed {
+		miniBlock := chain.newMiniBlock(block.TxBlock, 0, 0, &transaction.Transaction{GasLimit: gas})
+		chain.addHeader(uint64(i+1), miniBlock)
+	}
+
+	return chain
+}
+
+func createEconomicsDataForAdjuster(t *testing.T, gasPriceModifier float64) process.EconomicsDataHandler {
+	args := createArgsForEconomicsData(gasPriceModifier)
+	args.Economics.FeeSettings.MinGasPrice = fmt.Sprintf("%d", testBaseMinGasPrice)
+	args.Economics.FeeSettings.MaxGasLimitPerBlock = fmt.Sprintf("%d", testMaxGasLimitPerBlock)
+	economicsData, err := economics.NewEconomicsData(args)
+	require.Nil(t, err)
+
+	return economicsData
+}
+
+func createMockArgsMinGasPriceAdjuster(t *testing.T, chain *testChain) economics.ArgsMinGasPriceAdjuster {
+	if chain == nil {
+		chain = newTestChain()
+	}
+
+	blkc := blockchain.NewBlockChain()
+	_ = blkc.SetGenesisHeader(&block.Header{Nonce: 0, Round: 0})
+	blkc.SetGenesisHeaderHash([]byte(testGenesisHash))
+
+	return economics.ArgsMinGasPriceAdjuster{
+		Settings: config.DynamicMinGasPriceSettings{
+			RoundsPerAdjustment:      testRoundsPerAdjustment,
+			TargetBlockFullness:      0.5,
+			MaxMinGasPriceMultiplier: 4,
+		},
+		EnableEpoch: testEnableEpoch,
+		Economics:   createEconomicsDataForAdjuster(t, 1).(economics.MinGasPriceHandler),
+		ShardID:     0,
+		BlockChain:  blkc,
+		DataPool: &testscommon.PoolsHolderStub{
+			HeadersCalled: func() dataRetriever.HeadersPool {
+				return &mock.HeadersCacherStub{
+					GetHeaderByHashCalled: func(hash []byte) (data.HeaderHandler, error) {
+						header, ok := chain.headers[string(hash)]
+						if !ok {
+							return nil, errors.New("header not found")
+						}
+
+						return header, nil
+					},
+				}
+			},
+			MiniBlocksCalled: func() storage.Cacher {
+				return &testscommon.CacherStub{
+					PeekCalled: func(key []byte) (interface{}, bool) {
+						miniBlock, ok := chain.miniBlocks[string(key)]
+						return miniBlock, ok
+					},
+				}
+			},
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &testscommon.ShardedDataStub{
+					SearchFirstDataCalled: func(key []byte) (interface{}, bool) {
+						tx, ok := chain.txs[string(key)]
+						return tx, ok
+					},
+				}
+			},
+			UnsignedTransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &testscommon.ShardedDataStub{
+					SearchFirstDataCalled: func(key []byte) (interface{}, bool) {
+						tx, ok := chain.txs[string(key)]
+						return tx, ok
+					},
+				}
+			},
+		},
+		Store: &mock.ChainStorerMock{
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				return nil, errors.New("key not found")
+			},
+		},
+		Marshalizer:    &mock.MarshalizerMock{},
+		RequestHandler: &mock.RequestHandlerStub{},
+		GasHandler: &mock.GasHandlerMock{
+			ComputeGasConsumedByMiniBlockCalled: func(miniBlock *block.MiniBlock, mapHashTx map[string]data.TransactionHandler) (uint64, uint64, error) {
+				gas := uint64(0)
+				for _, txHash := range miniBlock.TxHashes {
+					gas += mapHashTx[string(txHash)].GetGasLimit()
+				}
+
+				return gas, gas, nil
+			},
+		},
+	}
+}
+
+func repeatGas(gas uint64, numRounds int) []uint64 {
+	gasUsed := make([]uint64, numRounds)
+	for i := range gasUsed {
+		gasUsed[i] = gas
+	}
+
+	return gasUsed
+}
+
+func currentMinGasPrice(args economics.ArgsMinGasPriceAdjuster) uint64 {
+	return args.Economics.(interface{ CurrentMinGasPrice() uint64 }).CurrentMinGasPrice()
+}
+
+func TestNewMinGasPriceAdjuster(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMinGasPriceAdjuster(t, nil)
+	args.Economics = nil
+	mgpa, err := economics.NewMinGasPriceAdjuster(args)
+	assert.True(t, check.IfNil(mgpa))
+	assert.Equal(t, process.ErrNilEconomicsData, err)
+
+	args = createMockArgsMinGasPriceAdjuster(t, nil)
+	args.BlockChain = nil
+	mgpa, err = economics.NewMinGasPriceAdjuster(args)
+	assert.True(t, check.IfNil(mgpa))
+	assert.Equal(t, process.ErrNilBlockChain, err)
+
+	args = createMockArgsMinGasPriceAdjuster(t, nil)
+	args.DataPool = nil
+	mgpa, err = economics.NewMinGasPriceAdjuster(args)
+	assert.True(t, check.IfNil(mgpa))
+	assert.Equal(t, process.ErrNilDataPoolHolder, err)
+
+	args = createMockArgsMinGasPriceAdjuster(t, nil)
+	args.DataPool.(*testscommon.PoolsHolderStub).HeadersCalled = func() dataRetriever.HeadersPool {
+		return nil
+	}
+	mgpa, err = economics.NewMinGasPriceAdjuster(args)
+	assert.True(t, check.IfNil(mgpa))
+	assert.Equal(t, process.ErrNilHeadersDataPool, err)
+
+	args = createMockArgsMinGasPriceAdjuster(t, nil)
+	args.DataPool.(*testscommon.PoolsHolderStub).MiniBlocksCalled = func() storage.Cacher {
+		return nil
+	}
+	mgpa, err = economics.NewMinGasPriceAdjuster(args)
+	assert.True(t, check.IfNil(mgpa))
+	assert.Equal(t, process.ErrNilMiniBlockPool, err)
+
+	args = createMockArgsMinGasPriceAdjuster(t, nil)
+	args.DataPool.(*testscommon.PoolsHolderStub).TransactionsCalled = func() dataRetriever.ShardedDataCacherNotifier {
+		return nil
+	}
+	mgpa, err = economics.NewMinGasPriceAdjuster(args)
+	assert.True(t, check.IfNil(mgpa))
+	assert.Equal(t, process.ErrNilTransactionPool, err)
+
+	args = createMockArgsMinGasPriceAdjuster(t, nil)
+	args.DataPool.(*testscommon.PoolsHolderStub).UnsignedTransactionsCalled = func() dataRetriever.ShardedDataCacherNotifier {
+		return nil
+	}
+	mgpa, err = economics.NewMinGasPriceAdjuster(args)
+	assert.True(t, check.IfNil(mgpa))
+	assert.Equal(t, process.ErrNilUnsignedTxDataPool, err)
+
+	args = createMockArgsMinGasPriceAdjuster(t, nil)
+	args.Store = nil
+	mgpa, err = economics.NewMinGasPriceAdjuster(args)
+	assert.True(t, check.IfNil(mgpa))
+	assert.Equal(t, process.ErrNilStorage, err)
+
+	args = createMockArgsMinGasPriceAdjuster(t, nil)
+	args.Marshalizer = nil
+	mgpa, err = economics.NewMinGasPriceAdjuster(args)
+	assert.True(t, check.IfNil(mgpa))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	args = createMockArgsMinGasPriceAdjuster(t, nil)
+	args.RequestHandler = nil
+	mgpa, err = economics.NewMinGasPriceAdjuster(args)
+	assert.True(t, check.IfNil(mgpa))
+	assert.Equal(t, process.ErrNilRequestHandler, err)
+
+	args = createMockArgsMinGasPriceAdjuster(t, nil)
+	args.GasHandler = nil
+	mgpa, err = economics.NewMinGasPriceAdjuster(args)
+	assert.True(t, check.IfNil(mgpa))
+	assert.Equal(t, process.ErrNilGasHandler, err)
+
+	invalidSettings := []config.DynamicMinGasPriceSettings{
+		{RoundsPerAdjustment: 0, TargetBlockFullness: 0.5, MaxMinGasPriceMultiplier: 2},
+		{RoundsPerAdjustment: 10, TargetBlockFullness: -0.1, MaxMinGasPriceMultiplier: 2},
+		{RoundsPerAdjustment: 10, TargetBlockFullness: 1, MaxMinGasPriceMultiplier: 2},
+		{RoundsPerAdjustment: 10, TargetBlockFullness: 0.5, MaxMinGasPriceMultiplier: 0.9},
+	}
+	for _, settings := range invalidSettings {
+		args = createMockArgsMinGasPriceAdjuster(t, nil)
+		args.Settings = settings
+		mgpa, err = economics.NewMinGasPriceAdjuster(args)
+		assert.True(t, check.IfNil(mgpa))
+		assert.True(t, errors.Is(err, process.ErrInvalidDynamicMinGasPriceSettings))
+	}
+
+	mgpa, err = economics.NewMinGasPriceAdjuster(createMockArgsMinGasPriceAdjuster(t, nil))
+	assert.False(t, check.IfNil(mgpa))
+	assert.Nil(t, err)
+}
+
+func TestMinGasPriceAdjuster_AdjustMinGasPriceNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mgpa, _ := economics.NewMinGasPriceAdjuster(createMockArgsMinGasPriceAdjuster(t, nil))
+
+	err := mgpa.AdjustMinGasPrice(nil)
+	assert.Equal(t, process.ErrNilBlockHeader, err)
+}
+
+func TestMinGasPriceAdjuster_AdjustMinGasPriceBeforeEnableEpochShouldKeepTheBasePrice(t *testing.T) {
+	t.Parallel()
+
+	chain := createChain(repeatGas(testMaxGasLimitPerBlock, 25))
+	args := createMockArgsMinGasPriceAdjuster(t, chain)
+	mgpa, _ := economics.NewMinGasPriceAdjuster(args)
+
+	err := mgpa.AdjustMinGasPrice(&block.Header{Round: 26, Nonce: 26, Epoch: 0, PrevHash: headerHash(25)})
+	assert.Nil(t, err)
+	assert.Equal(t, testBaseMinGasPrice, mgpa.BlockMinGasPrice())
+}
+
+func TestMinGasPriceAdjuster_AdjustMinGasPriceInFirstWindowShouldKeepTheBasePrice(t *testing.T) {
+	t.Parallel()
+
+	chain := createChain(repeatGas(testMaxGasLimitPerBlock, 9))
+	args := createMockArgsMinGasPriceAdjuster(t, chain)
+	mgpa, _ := economics.NewMinGasPriceAdjuster(args)
+
+	err := mgpa.AdjustMinGasPrice(&block.Header{Round: 10, Nonce: 10, Epoch: 1, PrevHash: headerHash(9)})
+	assert.Nil(t, err)
+	assert.Equal(t, testBaseMinGasPrice, mgpa.BlockMinGasPrice())
+}
+
+func TestMinGasPriceAdjuster_AdjustMinGasPriceBelowTargetShouldKeepTheBasePrice(t *testing.T) {
+	t.Parallel()
+
+	chain := createChain(repeatGas(testMaxGasLimitPerBlock/2, 25))
+	args := createMockArgsMinGasPriceAdjuster(t, chain)
+	mgpa, _ := economics.NewMinGasPriceAdjuster(args)
+
+	err := mgpa.AdjustMinGasPrice(&block.Header{Round: 26, Nonce: 26, Epoch: 1, PrevHash: headerHash(25)})
+	assert.Nil(t, err)
+	assert.Equal(t, testBaseMinGasPrice, mgpa.BlockMinGasPrice())
+}
+
+func TestMinGasPriceAdjuster_AdjustMinGasPriceFullBlocksShouldIncreaseThePrice(t *testing.T) {
+	t.Parallel()
+
+	// only the rounds 11..20 are used above the target
+	gasUsed := append(repeatGas(0, 10), repeatGas(testMaxGasLimitPerBlock*3/4, 10)...)
+	gasUsed = append(gasUsed, repeatGas(0, 10)...)
+	chain := createChain(gasUsed)
+	args := createMockArgsMinGasPriceAdjuster(t, chain)
+	mgpa, _ := economics.NewMinGasPriceAdjuster(args)
+
+	err := mgpa.AdjustMinGasPrice(&block.Header{Round: 26, Nonce: 26, Epoch: 1, PrevHash: headerHash(25)})
+	assert.Nil(t, err)
+	// x = 1 + 3 * (0.75 - 0.5) / 0.5
+	assert.Equal(t, uint64(2500), mgpa.BlockMinGasPrice())
+	assert.Equal(t, testBaseMinGasPrice, currentMinGasPrice(args))
+
+	err = mgpa.AdjustMinGasPrice(&block.Header{Round: 31, Nonce: 31, Epoch: 1, PrevHash: headerHash(30)})
+	assert.Nil(t, err)
+	assert.Equal(t, testBaseMinGasPrice, mgpa.BlockMinGasPrice())
+}
+
+func TestMinGasPriceAdjuster_AdjustMinGasPriceShouldBeCappedByTheMaxMultiplier(t *testing.T) {
+	t.Parallel()
+
+	chain := createChain(repeatGas(100*testMaxGasLimitPerBlock, 20))
+	args := createMockArgsMinGasPriceAdjuster(t, chain)
+	mgpa, _ := economics.NewMinGasPriceAdjuster(args)
+
+	err := mgpa.AdjustMinGasPrice(&block.Header{Round: 21, Nonce: 21, Epoch: 1, PrevHash: headerHash(20)})
+	assert.Nil(t, err)
+	assert.Equal(t, 4*testBaseMinGasPrice, mgpa.BlockMinGasPrice())
+}
+
+func TestMinGasPriceAdjuster_AdjustMinGasPriceShouldSkipMissingRounds(t *testing.T) {
+	t.Parallel()
+
+	chain := createChain(repeatGas(testMaxGasLimitPerBlock, 20))
+	// round 15 had no block, so round 16 is built on top of round 14 and the window has a single full block less
+	delete(chain.headers, string(headerHash(15)))
+	for round := uint64(16); round <= 20; round++ {
+		hdr := chain.headers[string(headerHash(round))].(*block.Header)
+		hdr.Nonce--
+	}
+	chain.headers[string(headerHash(16))].(*block.Header).PrevHash = headerHash(14)
+
+	args := createMockArgsMinGasPriceAdjuster(t, chain)
+	mgpa, _ := economics.NewMinGasPriceAdjuster(args)
+
+	err := mgpa.AdjustMinGasPrice(&block.Header{Round: 21, Nonce: 20, Epoch: 1, PrevHash: headerHash(20)})
+	assert.Nil(t, err)
+	// x = 1 + 3 * (0.9 - 0.5) / 0.5
+	assert.Equal(t, uint64(3400), mgpa.BlockMinGasPrice())
+}
+
+func TestMinGasPriceAdjuster_AdjustMinGasPriceSmartContractCallsShouldIncreaseThePrice(t *testing.T) {
+	t.Parallel()
+
+	economicsData := createEconomicsDataForAdjuster(t, 0.01)
+	gasHandler, _ := preprocess.NewGasComputation(
+		economicsData,
+		&mock.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+				return process.SCInvoking, process.SCInvoking
+			},
+		},
+		&mock.EpochNotifierStub{},
+		0,
+	)
+
+	// each block is 80% full with smart contract calls which pay the base price, while their fees are below 3% of the
+	// fees of a block full of move balance transactions
+	chain := newTestChain()
+	for round := uint64(1); round <= 20; round++ {
+		scCalls := make([]data.TransactionHandler, 0)
+		for i := 0; i < 4; i++ {
+			scCalls = append(scCalls, &transaction.Transaction{
+				GasLimit: testMaxGasLimitPerBlock / 5,
+				GasPrice: testBaseMinGasPrice,
+				Data:     []byte("callMe"),
+			})
+		}
+		// the gas of the results generated in the shard is already part of the gas of the smart contract calls
+		scrs := []data.TransactionHandler{&smartContractResult.SmartContractResult{GasLimit: testMaxGasLimitPerBlock}}
+
+		header := chain.addHeader(
+			round,
+			chain.newMiniBlock(block.TxBlock, 0, 0, scCalls...),
+			chain.newMiniBlock(block.SmartContractResultBlock, 0, 0, scrs...),
+		)
+		for _, scCall := range scCalls {
+			header.AccumulatedFees.Add(header.AccumulatedFees, economicsData.ComputeTxFee(scCall.(*transaction.Transaction)))
+		}
+
+		fullBlockFees := big.NewInt(0).SetUint64(testMaxGasLimitPerBlock * testBaseMinGasPrice)
+		require.True(t, big.NewInt(0).Mul(header.AccumulatedFees, big.NewInt(100)).Cmp(big.NewInt(0).Mul(fullBlockFees, big.NewInt(3))) < 0)
+	}
+
+	args := createMockArgsMinGasPriceAdjuster(t, chain)
+	args.Economics = economicsData.(economics.MinGasPriceHandler)
+	args.GasHandler = gasHandler
+	mgpa, _ := economics.NewMinGasPriceAdjuster(args)
+
+	err := mgpa.AdjustMinGasPrice(&block.Header{Round: 21, Nonce: 21, Epoch: 1, PrevHash: headerHash(20)})
+	assert.Nil(t, err)
+	// x = 1 + 3 * (0.8 - 0.5) / 0.5
+	assert.Equal(t, uint64(2800), mgpa.BlockMinGasPrice())
+}
+
+func TestMinGasPriceAdjuster_AdjustMinGasPriceShouldCountTheMiniBlocksReceivedFromOtherShards(t *testing.T) {
+	t.Parallel()
+
+	chain := newTestChain()
+	for round := uint64(1); round <= 20; round++ {
+		chain.addHeader(
+			round,
+			chain.newMiniBlock(block.TxBlock, 0, 1, &transaction.Transaction{GasLimit: testMaxGasLimitPerBlock / 4}),
+			chain.newMiniBlock(block.TxBlock, 1, 0, &transaction.Transaction{GasLimit: testMaxGasLimitPerBlock / 4}),
+			chain.newMiniBlock(block.SmartContractResultBlock, 2, 0, &smartContractResult.SmartContractResult{GasLimit: testMaxGasLimitPerBlock / 4}),
+		)
+	}
+
+	args := createMockArgsMinGasPriceAdjuster(t, chain)
+	mgpa, _ := economics.NewMinGasPriceAdjuster(args)
+
+	err := mgpa.AdjustMinGasPrice(&block.Header{Round: 21, Nonce: 21, Epoch: 1, PrevHash: headerHash(20)})
+	assert.Nil(t, err)
+	// x = 1 + 3 * (0.75 - 0.5) / 0.5
+	assert.Equal(t, uint64(2500), mgpa.BlockMinGasPrice())
+}
+
+func TestMinGasPriceAdjuster_AdjustMinGasPriceMissingTransactionShouldRequestItAndErr(t *testing.T) {
+	t.Parallel()
+
+	chain := createChain(repeatGas(testMaxGasLimitPerBlock, 25))
+	missingMiniBlock := chain.miniBlocks[string(chain.headers[string(headerHash(17))].(*block.Header).MiniBlockHeaders[0].Hash)]
+	delete(chain.txs, string(missingMiniBlock.TxHashes[0]))
+	chRequested := make(chan [][]byte, 1)
+	args := createMockArgsMinGasPriceAdjuster(t, chain)
+	args.RequestHandler = &mock.RequestHandlerStub{
+		RequestTransactionHandlerCalled: func(destShardID uint32, txHashes [][]byte) {
+			chRequested <- txHashes
+		},
+	}
+	mgpa, _ := economics.NewMinGasPriceAdjuster(args)
+
+	err := mgpa.AdjustMinGasPrice(&block.Header{Round: 26, Nonce: 26, Epoch: 1, PrevHash: headerHash(25)})
+	assert.True(t, errors.Is(err, process.ErrMissingTransaction))
+	assert.Equal(t, missingMiniBlock.TxHashes, <-chRequested)
+	assert.Equal(t, testBaseMinGasPrice, mgpa.BlockMinGasPrice())
+}
+
+func TestMinGasPriceAdjuster_AdjustMinGasPriceSameWindowShouldNotReadTheHeadersAgain(t *testing.T) {
+	t.Parallel()
+
+	chain := createChain(repeatGas(testMaxGasLimitPerBlock, 30))
+	numReads := uint32(0)
+	args := createMockArgsMinGasPriceAdjuster(t, chain)
+	args.DataPool.(*testscommon.PoolsHolderStub).HeadersCalled = func() dataRetriever.HeadersPool {
+		return &mock.HeadersCacherStub{
+			GetHeaderByHashCalled: func(hash []byte) (data.HeaderHandler, error) {
+				atomic.AddUint32(&numReads, 1)
+				return chain.headers[string(hash)], nil
+			},
+		}
+	}
+	mgpa, _ := economics.NewMinGasPriceAdjuster(args)
+
+	err := mgpa.AdjustMinGasPrice(&block.Header{Round: 22, Nonce: 22, Epoch: 1, PrevHash: headerHash(21)})
+	require.Nil(t, err)
+	numReadsFirstAdjustment := atomic.LoadUint32(&numReads)
+
+	for round := uint64(23); round <= 30; round++ {
+		err = mgpa.AdjustMinGasPrice(&block.Header{Round: round, Nonce: round, Epoch: 1, PrevHash: headerHash(round - 1)})
+		require.Nil(t, err)
+	}
+	assert.Equal(t, numReadsFirstAdjustment+8, atomic.LoadUint32(&numReads))
+	assert.Equal(t, 4*testBaseMinGasPrice, mgpa.BlockMinGasPrice())
+}
+
+func TestMinGasPriceAdjuster_AdjustMinGasPriceMissingHeaderShouldRequestItAndErr(t *testing.T) {
+	t.Parallel()
+
+	chain := createChain(repeatGas(testMaxGasLimitPerBlock, 25))
+	delete(chain.headers, string(headerHash(17)))
+	chRequested := make(chan uint64, 1)
+	args := createMockArgsMinGasPriceAdjuster(t, chain)
+	args.RequestHandler = &mock.RequestHandlerStub{
+		RequestShardHeaderByNonceCalled: func(shardID uint32, nonce uint64) {
+			chRequested <- nonce
+		},
+	}
+	mgpa, _ := economics.NewMinGasPriceAdjuster(args)
+
+	err := mgpa.AdjustMinGasPrice(&block.Header{Round: 26, Nonce: 26, Epoch: 1, PrevHash: headerHash(25)})
+	assert.True(t, errors.Is(err, process.ErrMissingHeader))
+	assert.Equal(t, uint64(17), <-chRequested)
+	assert.Equal(t, testBaseMinGasPrice, mgpa.BlockMinGasPrice())
+}
+
+func TestMinGasPriceAdjuster_PublishMinGasPriceNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mgpa, _ := economics.NewMinGasPriceAdjuster(createMockArgsMinGasPriceAdjuster(t, nil))
+
+	err := mgpa.PublishMinGasPrice(nil)
+	assert.Equal(t, process.ErrNilBlockHeader, err)
+}
+
+func TestMinGasPriceAdjuster_PublishMinGasPriceShouldSetThePriceOfTheCommittedHeader(t *testing.T) {
+	t.Parallel()
+
+	chain := createChain(repeatGas(testMaxGasLimitPerBlock, 30))
+	args := createMockArgsMinGasPriceAdjuster(t, chain)
+	mgpa, _ := economics.NewMinGasPriceAdjuster(args)
+
+	// a rejected candidate header does not change the published price
+	err := mgpa.AdjustMinGasPrice(&block.Header{Round: 26, Nonce: 26, Epoch: 1, PrevHash: headerHash(25)})
+	require.Nil(t, err)
+	assert.Equal(t, 4*testBaseMinGasPrice, mgpa.BlockMinGasPrice())
+	assert.Equal(t, testBaseMinGasPrice, currentMinGasPrice(args))
+
+	err = mgpa.PublishMinGasPrice(&block.Header{Round: 26, Nonce: 26, Epoch: 1, PrevHash: headerHash(25)})
+	assert.Nil(t, err)
+	assert.Equal(t, 4*testBaseMinGasPrice, currentMinGasPrice(args))
+}
+
+func TestDisabledMinGasPriceAdjuster(t *testing.T) {
+	t.Parallel()
+
+	dmgpa := economics.NewDisabledMinGasPriceAdjuster()
+	assert.False(t, check.IfNil(dmgpa))
+	assert.Nil(t, dmgpa.AdjustMinGasPrice(&block.Header{}))
+	assert.Nil(t, dmgpa.PublishMinGasPrice(&block.Header{}))
+	assert.Equal(t, uint64(0), dmgpa.BlockMinGasPrice())
+}
@@ -941,8 +941,26 @@ var ErrNilOrEmptyList = errors.New("nil or empty provided list")
 // ErrNilScQueryElement signals that a nil sc query service element was provided
 var ErrNilScQueryElement = errors.New("nil SC query service element")
 
+// ErrNilScQuery signals that a nil smart contract query has been provided
+var ErrNilScQuery = errors.New("nil SC query")
+
+// ErrStateChangedDuringQueries signals that new blocks kept being committed while a batch of queries was executed
+var ErrStateChangedDuringQueries = errors.New("the state changed while executing the queries")
+
 // ErrMaxAccumulatedFeesExceeded signals that max accumulated fees has been exceeded
 var ErrMaxAccumulatedFeesExceeded = errors.New("max accumulated fees has been exceeded")
 
 // ErrMaxDeveloperFeesExceeded signals that max developer fees has been exceeded
 var ErrMaxDeveloperFeesExceeded = errors.New("max developer fees has been exceeded")
+
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler was provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
+// ErrInvalidMaxBanMultiplier signals that an invalid max ban multiplier was provided
+var ErrInvalidMaxBanMultiplier = errors.New("invalid max ban multiplier")
+
+// ErrNilMinGasPriceAdjuster signals that a nil min gas price adjuster was provided
+var ErrNilMinGasPriceAdjuster = errors.New("nil min gas price adjuster")
+
+// ErrInvalidDynamicMinGasPriceSettings signals that invalid dynamic min gas price settings were provided
+var ErrInvalidDynamicMinGasPriceSettings = errors.New("invalid dynamic min gas price settings")
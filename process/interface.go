@@ -259,6 +259,25 @@ type TransactionLogProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// SCExecutionTracer defines the component notified about each step of a smart contract execution. The smart contract
+// processor reports the top-level executions and built-in function calls while the blockchain hook reports what the
+// virtual machines request during an execution
+type SCExecutionTracer interface {
+	OnExecutionStart(contractAddress []byte, function string, input *vmcommon.VMInput)
+	OnExecutionEnd(vmOutput *vmcommon.VMOutput, err error)
+	OnCodeLoad(address []byte)
+	OnStorageRead(address []byte, key []byte, value []byte)
+	OnBuiltInFunctionCall(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error)
+	IsInterfaceNil() bool
+}
+
+// SCExecutionMetricsHandler defines the component collecting the execution metrics of the smart contracts run by
+// the smart contract processor
+type SCExecutionMetricsHandler interface {
+	AddExecution(contractAddress []byte, gasUsed uint64, duration time.Duration, failed bool)
+	IsInterfaceNil() bool
+}
+
 // TransactionLogProcessorDatabase is interface the  for saving logs also in RAM
 type TransactionLogProcessorDatabase interface {
 	GetLogFromCache(txHash []byte) (data.LogHandler, bool)
@@ -564,6 +583,7 @@ type FeeHandler interface {
 	CheckValidityTxValues(tx TransactionWithFeeHandler) error
 	ComputeFeeForProcessing(tx TransactionWithFeeHandler, gasToUse uint64) *big.Int
 	MinGasPrice() uint64
+	CurrentMinGasPrice() uint64
 	GasPriceModifier() float64
 	GenesisTotalSupply() *big.Int
 	IsInterfaceNil() bool
@@ -589,6 +609,7 @@ type EconomicsDataHandler interface {
 	ComputeTxFee(tx TransactionWithFeeHandler) *big.Int
 	CheckValidityTxValues(tx TransactionWithFeeHandler) error
 	MinGasPrice() uint64
+	CurrentMinGasPrice() uint64
 	GasPriceModifier() float64
 	LeaderPercentage() float64
 	ProtocolSustainabilityPercentage() float64
@@ -656,6 +677,17 @@ type PeerBlackListCacher interface {
 	IsInterfaceNil() bool
 }
 
+// PeerReputationHandler can persist and retrieve the reputation of peer IDs and validator public keys
+type PeerReputationHandler interface {
+	RecordPeerIDOffence(pid core.PeerID, span time.Duration) time.Duration
+	RecordPublicKeyOffence(pk string, span time.Duration) time.Duration
+	PeerIDReputation(pid core.PeerID) core.P2PPeerReputation
+	PublicKeyReputation(pk string) core.P2PPeerReputation
+	RestoreBans(peerBlackListCacher PeerBlackListCacher, publicKeysCacher TimeCacher) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // PeerShardMapper can return the public key of a provided peer ID
 type PeerShardMapper interface {
 	GetPeerInfo(pid core.PeerID) core.P2PPeerInfo
@@ -803,6 +835,7 @@ type PeerValidatorMapper interface {
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueries(queries []*SCQuery) ([]*vmcommon.VMOutput, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	IsInterfaceNil() bool
 }
@@ -1005,6 +1038,21 @@ type EpochNotifier interface {
 	IsInterfaceNil() bool
 }
 
+// MinGasPriceAdjuster defines the component which computes the min gas price required from the transactions of the
+// shard senders. The price of a block is set before the block is created or processed and it is published to the
+// transactions interceptors only after the block is committed
+type MinGasPriceAdjuster interface {
+	BlockMinGasPriceHandler
+	AdjustMinGasPrice(header data.HeaderHandler) error
+	PublishMinGasPrice(header data.HeaderHandler) error
+}
+
+// BlockMinGasPriceHandler defines the component providing the min gas price of the block being created or processed
+type BlockMinGasPriceHandler interface {
+	BlockMinGasPrice() uint64
+	IsInterfaceNil() bool
+}
+
 // ESDTPauseHandler provides IsPaused function for an ESDT token
 type ESDTPauseHandler interface {
 	IsPaused(token []byte) bool
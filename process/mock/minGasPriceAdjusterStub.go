@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// MinGasPriceAdjusterStub -
+type MinGasPriceAdjusterStub struct {
+	AdjustMinGasPriceCalled  func(header data.HeaderHandler) error
+	BlockMinGasPriceCalled   func() uint64
+	PublishMinGasPriceCalled func(header data.HeaderHandler) error
+}
+
+// AdjustMinGasPrice -
+func (mgpas *MinGasPriceAdjusterStub) AdjustMinGasPrice(header data.HeaderHandler) error {
+	if mgpas.AdjustMinGasPriceCalled != nil {
+		return mgpas.AdjustMinGasPriceCalled(header)
+	}
+
+	return nil
+}
+
+// BlockMinGasPrice -
+func (mgpas *MinGasPriceAdjusterStub) BlockMinGasPrice() uint64 {
+	if mgpas.BlockMinGasPriceCalled != nil {
+		return mgpas.BlockMinGasPriceCalled()
+	}
+
+	return 0
+}
+
+// PublishMinGasPrice -
+func (mgpas *MinGasPriceAdjusterStub) PublishMinGasPrice(header data.HeaderHandler) error {
+	if mgpas.PublishMinGasPriceCalled != nil {
+		return mgpas.PublishMinGasPriceCalled(header)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (mgpas *MinGasPriceAdjusterStub) IsInterfaceNil() bool {
+	return mgpas == nil
+}
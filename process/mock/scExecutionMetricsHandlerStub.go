@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"time"
+)
+
+// SCExecutionMetricsHandlerStub -
+type SCExecutionMetricsHandlerStub struct {
+	AddExecutionCalled func(contractAddress []byte, gasUsed uint64, duration time.Duration, failed bool)
+}
+
+// AddExecution -
+func (semhs *SCExecutionMetricsHandlerStub) AddExecution(contractAddress []byte, gasUsed uint64, duration time.Duration, failed bool) {
+	if semhs.AddExecutionCalled != nil {
+		semhs.AddExecutionCalled(contractAddress, gasUsed, duration, failed)
+	}
+}
+
+// IsInterfaceNil -
+func (semhs *SCExecutionMetricsHandlerStub) IsInterfaceNil() bool {
+	return semhs == nil
+}
@@ -0,0 +1,54 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+)
+
+// SCExecutionTracerStub -
+type SCExecutionTracerStub struct {
+	OnExecutionStartCalled      func(contractAddress []byte, function string, input *vmcommon.VMInput)
+	OnExecutionEndCalled        func(vmOutput *vmcommon.VMOutput, err error)
+	OnCodeLoadCalled            func(address []byte)
+	OnStorageReadCalled         func(address []byte, key []byte, value []byte)
+	OnBuiltInFunctionCallCalled func(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error)
+}
+
+// OnExecutionStart -
+func (sets *SCExecutionTracerStub) OnExecutionStart(contractAddress []byte, function string, input *vmcommon.VMInput) {
+	if sets.OnExecutionStartCalled != nil {
+		sets.OnExecutionStartCalled(contractAddress, function, input)
+	}
+}
+
+// OnExecutionEnd -
+func (sets *SCExecutionTracerStub) OnExecutionEnd(vmOutput *vmcommon.VMOutput, err error) {
+	if sets.OnExecutionEndCalled != nil {
+		sets.OnExecutionEndCalled(vmOutput, err)
+	}
+}
+
+// OnCodeLoad -
+func (sets *SCExecutionTracerStub) OnCodeLoad(address []byte) {
+	if sets.OnCodeLoadCalled != nil {
+		sets.OnCodeLoadCalled(address)
+	}
+}
+
+// OnStorageRead -
+func (sets *SCExecutionTracerStub) OnStorageRead(address []byte, key []byte, value []byte) {
+	if sets.OnStorageReadCalled != nil {
+		sets.OnStorageReadCalled(address, key, value)
+	}
+}
+
+// OnBuiltInFunctionCall -
+func (sets *SCExecutionTracerStub) OnBuiltInFunctionCall(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput, err error) {
+	if sets.OnBuiltInFunctionCallCalled != nil {
+		sets.OnBuiltInFunctionCallCalled(input, vmOutput, err)
+	}
+}
+
+// IsInterfaceNil -
+func (sets *SCExecutionTracerStub) IsInterfaceNil() bool {
+	return sets == nil
+}
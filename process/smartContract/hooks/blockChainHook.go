@@ -48,6 +48,7 @@ type ArgBlockChainHook struct {
 	ConfigSCStorage    config.StorageConfig
 	WorkingDir         string
 	NilCompiledSCStore bool
+	ExecutionTracer    process.SCExecutionTracer
 }
 
 // BlockChainHookImpl is a wrapper over AccountsAdapter that satisfy vmcommon.BlockchainHook interface
@@ -69,6 +70,7 @@ type BlockChainHookImpl struct {
 	configSCStorage    config.StorageConfig
 	workingDir         string
 	nilCompiledSCStore bool
+	executionTracer    process.SCExecutionTracer
 }
 
 // NewBlockChainHookImpl creates a new BlockChainHookImpl instance
@@ -93,6 +95,7 @@ func NewBlockChainHookImpl(
 		configSCStorage:    args.ConfigSCStorage,
 		workingDir:         args.WorkingDir,
 		nilCompiledSCStore: args.NilCompiledSCStore,
+		executionTracer:    args.ExecutionTracer,
 	}
 
 	err = blockChainHookImpl.makeCompiledSCStorage()
@@ -140,6 +143,10 @@ func checkForNil(args ArgBlockChainHook) error {
 
 // GetCode returns the code for the given account
 func (bh *BlockChainHookImpl) GetCode(account vmcommon.UserAccountHandler) []byte {
+	if !check.IfNil(bh.executionTracer) {
+		bh.executionTracer.OnCodeLoad(account.AddressBytes())
+	}
+
 	return bh.accounts.GetCode(account.GetCodeHash())
 }
 
@@ -194,6 +201,10 @@ func (bh *BlockChainHookImpl) GetStorageData(accountAddress []byte, index []byte
 		messages = append(messages, err)
 	}
 	log.Trace("GetStorageData ", messages...)
+	if !check.IfNil(bh.executionTracer) {
+		bh.executionTracer.OnStorageRead(accountAddress, index, value)
+	}
+
 	return value, err
 }
 
@@ -358,6 +369,9 @@ func (bh *BlockChainHookImpl) ProcessBuiltInFunction(input *vmcommon.ContractCal
 	}
 
 	vmOutput, err := function.ProcessBuiltinFunction(sndAccount, dstAccount, input)
+	if !check.IfNil(bh.executionTracer) {
+		bh.executionTracer.OnBuiltInFunctionCall(input, vmOutput, err)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +219,40 @@ func TestBlockChainHookImpl_GetStorageDataShouldWork(t *testing.T) {
 	assert.Equal(t, variableValue, value)
 }
 
+func TestBlockChainHookImpl_GetStorageDataShouldNotifyTheExecutionTracer(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	variableIdentifier := []byte("variable")
+	variableValue := []byte("value")
+	accnt := mock.NewAccountWrapMock(address)
+	_ = accnt.DataTrieTracker().SaveKeyValue(variableIdentifier, variableValue)
+
+	var tracedAddress, tracedKey, tracedValue []byte
+	args := createMockVMAccountsArguments()
+	args.Accounts = &mock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (handler state.AccountHandler, e error) {
+			return accnt, nil
+		},
+	}
+	args.ExecutionTracer = &mock.SCExecutionTracerStub{
+		OnStorageReadCalled: func(address []byte, key []byte, value []byte) {
+			tracedAddress = address
+			tracedKey = key
+			tracedValue = value
+		},
+	}
+	bh, _ := hooks.NewBlockChainHookImpl(args)
+
+	value, err := bh.GetStorageData(address, variableIdentifier)
+
+	assert.Nil(t, err)
+	assert.Equal(t, variableValue, value)
+	assert.Equal(t, address, tracedAddress)
+	assert.Equal(t, variableIdentifier, tracedKey)
+	assert.Equal(t, variableValue, tracedValue)
+}
+
 func TestBlockChainHookImpl_NewAddressLengthNoGood(t *testing.T) {
 	t.Parallel()
 
@@ -71,7 +71,9 @@ type scProcessor struct {
 	esdtTransferCost     uint64
 	mutGasLock           sync.RWMutex
 
-	txLogsProcessor process.TransactionLogProcessor
+	txLogsProcessor  process.TransactionLogProcessor
+	executionTracer  process.SCExecutionTracer
+	executionMetrics process.SCExecutionMetricsHandler
 }
 
 // ArgsNewSmartContractProcessor defines the arguments needed for new smart contract processor
@@ -99,6 +101,8 @@ type ArgsNewSmartContractProcessor struct {
 	RepairCallbackEnableEpoch      uint32
 	StakingV2EnableEpoch           uint32
 	EpochNotifier                  process.EpochNotifier
+	ExecutionTracer                process.SCExecutionTracer
+	ExecutionMetrics               process.SCExecutionMetricsHandler
 	IsGenesisProcessing            bool
 }
 
@@ -187,6 +191,8 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 		penalizedTooMuchGasEnableEpoch: args.PenalizedTooMuchGasEnableEpoch,
 		isGenesisProcessing:            args.IsGenesisProcessing,
 		stakingV2EnableEpoch:           args.StakingV2EnableEpoch,
+		executionTracer:                args.ExecutionTracer,
+		executionMetrics:               args.ExecutionMetrics,
 	}
 
 	args.EpochNotifier.RegisterNotifyHandler(sc)
@@ -367,7 +373,11 @@ func (sc *scProcessor) executeSmartContractCall(
 	}
 
 	var vmOutput *vmcommon.VMOutput
+	sc.traceExecutionStart(vmInput.RecipientAddr, vmInput.Function, &vmInput.VMInput)
+	startTime := time.Now()
 	vmOutput, err = vmExec.RunSmartContractCall(vmInput)
+	sc.addExecutionMetrics(vmInput.RecipientAddr, vmInput.GasProvided, vmOutput, err, time.Since(startTime))
+	sc.traceExecutionEnd(vmOutput, err)
 	if err != nil {
 		log.Debug("run smart contract call error", "error", err.Error())
 		return userErrorVmOutput, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
@@ -397,6 +407,62 @@ func (sc *scProcessor) executeSmartContractCall(
 	return vmOutput, nil
 }
 
+func (sc *scProcessor) traceExecutionStart(contractAddress []byte, function string, input *vmcommon.VMInput) {
+	if check.IfNil(sc.executionTracer) {
+		return
+	}
+
+	sc.executionTracer.OnExecutionStart(contractAddress, function, input)
+}
+
+func (sc *scProcessor) traceExecutionEnd(vmOutput *vmcommon.VMOutput, err error) {
+	if check.IfNil(sc.executionTracer) {
+		return
+	}
+
+	sc.executionTracer.OnExecutionEnd(vmOutput, err)
+}
+
+func (sc *scProcessor) addExecutionMetrics(
+	contractAddress []byte,
+	gasProvided uint64,
+	vmOutput *vmcommon.VMOutput,
+	err error,
+	duration time.Duration,
+) {
+	if check.IfNil(sc.executionMetrics) {
+		return
+	}
+
+	failed := err != nil || vmOutput == nil || vmOutput.ReturnCode != vmcommon.Ok
+	gasUsed := gasProvided
+	if vmOutput != nil && vmOutput.GasRemaining <= gasProvided {
+		gasUsed = gasProvided - vmOutput.GasRemaining
+	}
+
+	sc.executionMetrics.AddExecution(contractAddress, gasUsed, duration, failed)
+}
+
+// addDeployExecutionMetrics attributes the deploy to the created contract. A failed deploy does not create any
+// contract, so it is not counted
+func (sc *scProcessor) addDeployExecutionMetrics(
+	vmInput *vmcommon.ContractCreateInput,
+	vmOutput *vmcommon.VMOutput,
+	err error,
+	duration time.Duration,
+) {
+	if check.IfNil(sc.executionMetrics) || err != nil || vmOutput == nil {
+		return
+	}
+
+	for _, account := range process.SortVMOutputInsideData(vmOutput) {
+		if account != nil && core.IsSmartContractAddress(account.Address) && len(account.Code) > 0 {
+			sc.addExecutionMetrics(account.Address, vmInput.GasProvided, vmOutput, nil, duration)
+			return
+		}
+	}
+}
+
 func (sc *scProcessor) finishSCExecution(
 	results []data.TransactionHandler,
 	txHash []byte,
@@ -911,7 +977,15 @@ func (sc *scProcessor) resolveBuiltInFunctions(
 		return vmOutput, nil
 	}
 
+	startTime := time.Now()
 	vmOutput, err = builtIn.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	// the built-in functions ran on user accounts (e.g. the transfers between users) are not contract executions
+	if core.IsSmartContractAddress(vmInput.RecipientAddr) {
+		sc.addExecutionMetrics(vmInput.RecipientAddr, vmInput.GasProvided, vmOutput, err, time.Since(startTime))
+	}
+	if !check.IfNil(sc.executionTracer) {
+		sc.executionTracer.OnBuiltInFunctionCall(vmInput, vmOutput, err)
+	}
 	if err != nil {
 		vmOutput = &vmcommon.VMOutput{
 			ReturnCode:    vmcommon.UserError,
@@ -1299,7 +1373,11 @@ func (sc *scProcessor) DeploySmartContract(tx data.TransactionHandler, acntSnd s
 		return vmcommon.UserError, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
 	}
 
+	sc.traceExecutionStart(nil, core.SCDeployInitFunctionName, &vmInput.VMInput)
+	startTime := time.Now()
 	vmOutput, err = vmExec.RunSmartContractCreate(vmInput)
+	sc.addDeployExecutionMetrics(vmInput, vmOutput, err, time.Since(startTime))
+	sc.traceExecutionEnd(vmOutput, err)
 	if err != nil {
 		log.Debug("VM error", "error", err.Error())
 		return vmcommon.UserError, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
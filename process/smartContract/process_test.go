@@ -7,6 +7,7 @@ import (
 	"math"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -1701,6 +1702,190 @@ func TestScProcessor_ExecuteSmartContractTransactionSaveLogCalled(t *testing.T)
 	require.True(t, slCalled)
 }
 
+func TestScProcessor_ExecuteSmartContractTransactionShouldAddExecutionMetrics(t *testing.T) {
+	t.Parallel()
+
+	gasRemaining := uint64(100)
+	gasProvided := uint64(0)
+	vm := &mock.VMContainerMock{}
+	vmExecutor := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			gasProvided = input.GasProvided
+			return &vmcommon.VMOutput{
+				GasRemaining: gasRemaining,
+				ReturnCode:   vmcommon.Ok,
+			}, nil
+		},
+	}
+	vm.GetCalled = func(key []byte) (vmcommon.VMExecutionHandler, error) {
+		return vmExecutor, nil
+	}
+	accntState := &mock.AccountsStub{}
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.VmContainer = vm
+	arguments.ArgsParser = &mock.ArgumentParserMock{}
+	arguments.AccountsDB = accntState
+	numCalls := 0
+	arguments.ExecutionMetrics = &mock.SCExecutionMetricsHandlerStub{
+		AddExecutionCalled: func(contractAddress []byte, gasUsed uint64, duration time.Duration, failed bool) {
+			numCalls++
+			assert.Equal(t, []byte("DST0000000"), contractAddress)
+			assert.Equal(t, gasProvided-gasRemaining, gasUsed)
+			assert.False(t, failed)
+		},
+	}
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	tx := &transaction.Transaction{}
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST0000000")
+	tx.Data = []byte("data")
+	tx.Value = big.NewInt(0)
+	tx.GasLimit = 1000
+	acntSrc, acntDst := createAccounts(tx)
+
+	accntState.LoadAccountCalled = func(address []byte) (handler state.AccountHandler, e error) {
+		return acntSrc, nil
+	}
+
+	acntDst.SetCode([]byte("code"))
+	_, err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst)
+	require.Nil(t, err)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestScProcessor_ExecuteSmartContractTransactionVMRunErrorShouldAddFailedExecutionMetrics(t *testing.T) {
+	t.Parallel()
+
+	gasProvided := uint64(0)
+	vm := &mock.VMContainerMock{}
+	vmExecutor := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			gasProvided = input.GasProvided
+			return nil, errors.New("error")
+		},
+	}
+	vm.GetCalled = func(key []byte) (vmcommon.VMExecutionHandler, error) {
+		return vmExecutor, nil
+	}
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.VmContainer = vm
+	arguments.ArgsParser = &mock.ArgumentParserMock{}
+	numCalls := 0
+	arguments.ExecutionMetrics = &mock.SCExecutionMetricsHandlerStub{
+		AddExecutionCalled: func(contractAddress []byte, gasUsed uint64, duration time.Duration, failed bool) {
+			numCalls++
+			assert.Equal(t, gasProvided, gasUsed)
+			assert.True(t, failed)
+		},
+	}
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	tx := &transaction.Transaction{}
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST0000000")
+	tx.Data = []byte("data")
+	tx.Value = big.NewInt(0)
+	tx.GasLimit = 1000
+	acntSrc, acntDst := createAccounts(tx)
+
+	acntDst.SetCode([]byte("code"))
+	_, err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst)
+	require.Nil(t, err)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestScProcessor_DeploySmartContractShouldAddExecutionMetricsForTheCreatedContract(t *testing.T) {
+	t.Parallel()
+
+	contractAddress := append(make([]byte, 10), []byte("new contract address 0")...)
+	vm := &mock.VMContainerMock{}
+	vmExecutor := &mock.VMExecutionHandlerStub{
+		RunSmartContractCreateCalled: func(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{
+				GasRemaining: 100,
+				ReturnCode:   vmcommon.Ok,
+				OutputAccounts: map[string]*vmcommon.OutputAccount{
+					string(contractAddress): {Address: contractAddress, Code: []byte("code")},
+				},
+			}, nil
+		},
+	}
+	vm.GetCalled = func(key []byte) (vmcommon.VMExecutionHandler, error) {
+		return vmExecutor, nil
+	}
+	accntState := &mock.AccountsStub{}
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.VmContainer = vm
+	arguments.ArgsParser = NewArgumentParser()
+	arguments.AccountsDB = accntState
+	numCalls := 0
+	arguments.ExecutionMetrics = &mock.SCExecutionMetricsHandlerStub{
+		AddExecutionCalled: func(address []byte, gasUsed uint64, duration time.Duration, failed bool) {
+			numCalls++
+			assert.Equal(t, contractAddress, address)
+			assert.False(t, failed)
+		},
+	}
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	tx := &transaction.Transaction{}
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = generateEmptyByteSlice(createMockPubkeyConverter().Len())
+	tx.Data = []byte("abba@0500@0000")
+	tx.Value = big.NewInt(0)
+	tx.GasLimit = 1000
+	acntSrc, _ := createAccounts(tx)
+	accntState.LoadAccountCalled = func(address []byte) (handler state.AccountHandler, e error) {
+		return acntSrc, nil
+	}
+
+	_, _ = sc.DeploySmartContract(tx, acntSrc)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestScProcessor_ExecuteBuiltInFunctionShouldAddExecutionMetricsOnlyForContracts(t *testing.T) {
+	t.Parallel()
+
+	contractAddress := append(make([]byte, 10), []byte("contract address 00000")...)
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.AccountsDB = &mock.AccountsStub{
+		RevertToSnapshotCalled: func(snapshot int) error {
+			return nil
+		},
+	}
+	arguments.VmContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			return &mock.VMExecutionHandlerStub{}, nil
+		},
+	}
+	arguments.ArgsParser = NewArgumentParser()
+	arguments.BuiltinEnableEpoch = maxEpoch
+	funcName := "builtIn"
+	_ = arguments.BuiltInFunctions.Add(funcName, &mock.BuiltInFunctionStub{})
+	recordedAddresses := make([][]byte, 0)
+	arguments.ExecutionMetrics = &mock.SCExecutionMetricsHandlerStub{
+		AddExecutionCalled: func(address []byte, gasUsed uint64, duration time.Duration, failed bool) {
+			recordedAddresses = append(recordedAddresses, address)
+		},
+	}
+	sc, _ := NewSmartContractProcessor(arguments)
+	sc.flagBuiltin.Set()
+
+	for _, rcvAddr := range [][]byte{[]byte("DST"), contractAddress} {
+		tx := &transaction.Transaction{}
+		tx.SndAddr = []byte("SRC")
+		tx.RcvAddr = rcvAddr
+		tx.Data = []byte(funcName + "@0500@0000")
+		tx.Value = big.NewInt(0)
+		acntSrc, _ := createAccounts(tx)
+
+		_, _ = sc.ExecuteBuiltInFunction(tx, acntSrc, nil)
+	}
+
+	assert.Equal(t, [][]byte{contractAddress}, recordedAddresses)
+}
+
 func TestScProcessor_CreateVMCallInputWrongCode(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,7 @@
 package smartContract
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"math/big"
@@ -17,6 +18,8 @@ import (
 
 var _ process.SCQueryService = (*SCQueryService)(nil)
 
+const maxQueriesBatchAttempts = 2
+
 // SCQueryService can execute Get functions over SC to fetch stored values
 type SCQueryService struct {
 	vmContainer    process.VirtualMachinesContainer
@@ -57,11 +60,9 @@ func NewSCQueryService(
 
 // ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract
 func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
-	if query.ScAddress == nil {
-		return nil, process.ErrNilScAddress
-	}
-	if len(query.FuncName) == 0 {
-		return nil, process.ErrEmptyFunctionName
+	err := checkScQuery(query)
+	if err != nil {
+		return nil, err
 	}
 
 	service.mutRunSc.Lock()
@@ -70,12 +71,77 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 	return service.executeScCall(query, 0)
 }
 
+// ExecuteQueries runs all the provided queries against the state committed by the same block. If a new block is
+// committed while the queries are running, they are executed once more on the new state. A failed query is reported
+// through the return code and message of its own VMOutput, without affecting the other queries
+func (service *SCQueryService) ExecuteQueries(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error) {
+	if len(queries) == 0 {
+		return nil, process.ErrNilOrEmptyList
+	}
+
+	service.mutRunSc.Lock()
+	defer service.mutRunSc.Unlock()
+
+	for i := 0; i < maxQueriesBatchAttempts; i++ {
+		headerHash := service.blockChain.GetCurrentBlockHeaderHash()
+		vmOutputs := service.executeScCalls(queries)
+		if bytes.Equal(headerHash, service.blockChain.GetCurrentBlockHeaderHash()) {
+			return vmOutputs, nil
+		}
+
+		log.Debug("SCQueryService.ExecuteQueries: the current block changed, executing the queries again",
+			"num queries", len(queries))
+	}
+
+	return nil, process.ErrStateChangedDuringQueries
+}
+
+func (service *SCQueryService) executeScCalls(queries []*process.SCQuery) []*vmcommon.VMOutput {
+	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
+
+	vmOutputs := make([]*vmcommon.VMOutput, 0, len(queries))
+	for _, query := range queries {
+		err := checkScQuery(query)
+		if err != nil {
+			vmOutputs = append(vmOutputs, createFailedQueryOutput(err))
+			continue
+		}
+
+		log.Debug("executeScCalls", "function", query.FuncName, "numQueries", service.numQueries)
+		service.numQueries++
+
+		vmOutput, err := service.runScCall(query, 0)
+		if err != nil {
+			vmOutputs = append(vmOutputs, createFailedQueryOutput(err))
+			continue
+		}
+
+		vmOutputs = append(vmOutputs, vmOutput)
+	}
+
+	return vmOutputs
+}
+
 func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
 	log.Debug("executeScCall", "function", query.FuncName, "numQueries", service.numQueries)
 	service.numQueries++
 
 	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
 
+	vmOutput, err := service.runScCall(query, gasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.checkVMOutput(vmOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+func (service *SCQueryService) runScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
 	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
 	if err != nil {
 		return nil, err
@@ -97,12 +163,28 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 		}
 	}
 
-	err = service.checkVMOutput(vmOutput)
-	if err != nil {
-		return nil, err
+	return vmOutput, nil
+}
+
+func checkScQuery(query *process.SCQuery) error {
+	if query == nil {
+		return process.ErrNilScQuery
+	}
+	if query.ScAddress == nil {
+		return process.ErrNilScAddress
+	}
+	if len(query.FuncName) == 0 {
+		return process.ErrEmptyFunctionName
 	}
 
-	return vmOutput, nil
+	return nil
+}
+
+func createFailedQueryOutput(err error) *vmcommon.VMOutput {
+	return &vmcommon.VMOutput{
+		ReturnCode:    vmcommon.UserError,
+		ReturnMessage: err.Error(),
+	}
 }
 
 func prepareScQuery(query *process.SCQuery) *process.SCQuery {
@@ -0,0 +1,200 @@
+package smartContract
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ process.SCQueryService = (*scQueryServiceCache)(nil)
+
+type scQueryServiceCache struct {
+	service      process.SCQueryService
+	blockChain   data.ChainHandler
+	cacher       storage.Cacher
+	mutBlockHash sync.Mutex
+	blockHash    []byte
+}
+
+// NewScQueryServiceCache returns a smart contract query service which stores the successful query results, keyed by
+// the hash of the current block, the contract, the function, the caller, the call value and the arguments.
+// The stored results are dropped as soon as a new block is committed
+func NewScQueryServiceCache(
+	service process.SCQueryService,
+	blockChain data.ChainHandler,
+	cacher storage.Cacher,
+) (*scQueryServiceCache, error) {
+	if check.IfNil(service) {
+		return nil, process.ErrNilScQueryElement
+	}
+	if check.IfNil(blockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(cacher) {
+		return nil, process.ErrNilCacher
+	}
+
+	return &scQueryServiceCache{
+		service:    service,
+		blockChain: blockChain,
+		cacher:     cacher,
+	}, nil
+}
+
+// ExecuteQuery returns the cached result of the query, if there is one for the current block, or executes it
+func (sqsc *scQueryServiceCache) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	if query == nil {
+		return nil, process.ErrNilScQuery
+	}
+
+	blockHash := sqsc.getCurrentBlockHash()
+	key := createQueryCacheKey(blockHash, query)
+	vmOutput, found := sqsc.getCachedOutput(key)
+	if found {
+		return vmOutput, nil
+	}
+
+	vmOutput, err := sqsc.service.ExecuteQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	sqsc.cacheOutput(blockHash, key, vmOutput)
+
+	return vmOutput, nil
+}
+
+// ExecuteQueries returns the cached results of the queries and executes, in a single batch, the ones not yet cached
+func (sqsc *scQueryServiceCache) ExecuteQueries(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error) {
+	if len(queries) == 0 {
+		return nil, process.ErrNilOrEmptyList
+	}
+
+	blockHash := sqsc.getCurrentBlockHash()
+	vmOutputs := make([]*vmcommon.VMOutput, len(queries))
+	keys := make([][]byte, len(queries))
+	missingQueries := make([]*process.SCQuery, 0, len(queries))
+	missingIndexes := make([]int, 0, len(queries))
+	for i, query := range queries {
+		if query != nil {
+			keys[i] = createQueryCacheKey(blockHash, query)
+			vmOutput, found := sqsc.getCachedOutput(keys[i])
+			if found {
+				vmOutputs[i] = vmOutput
+				continue
+			}
+		}
+
+		missingQueries = append(missingQueries, query)
+		missingIndexes = append(missingIndexes, i)
+	}
+
+	if len(missingQueries) == 0 {
+		return vmOutputs, nil
+	}
+
+	executedOutputs, err := sqsc.service.ExecuteQueries(missingQueries)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(executedOutputs) && i < len(missingIndexes); i++ {
+		vmOutput := executedOutputs[i]
+		index := missingIndexes[i]
+		vmOutputs[index] = vmOutput
+		if keys[index] != nil && vmOutput != nil && vmOutput.ReturnCode == vmcommon.Ok {
+			sqsc.cacheOutput(blockHash, keys[index], vmOutput)
+		}
+	}
+
+	return vmOutputs, nil
+}
+
+// ComputeScCallGasLimit forwards the call to the wrapped service, as the gas estimation is never cached
+func (sqsc *scQueryServiceCache) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
+	return sqsc.service.ComputeScCallGasLimit(tx)
+}
+
+// getCurrentBlockHash returns the hash of the current block, removing all the cached results if it changed since the
+// last call. The block hash is used instead of the state root hash, which stays the same over empty blocks although
+// the results might depend on the block itself (e.g. its nonce, round or epoch)
+func (sqsc *scQueryServiceCache) getCurrentBlockHash() []byte {
+	blockHash := sqsc.blockChain.GetCurrentBlockHeaderHash()
+
+	sqsc.mutBlockHash.Lock()
+	defer sqsc.mutBlockHash.Unlock()
+
+	if !bytes.Equal(sqsc.blockHash, blockHash) {
+		sqsc.cacher.Clear()
+		sqsc.blockHash = blockHash
+	}
+
+	return blockHash
+}
+
+func (sqsc *scQueryServiceCache) getCachedOutput(key []byte) (*vmcommon.VMOutput, bool) {
+	value, found := sqsc.cacher.Get(key)
+	if !found {
+		return nil, false
+	}
+
+	vmOutput, ok := value.(*vmcommon.VMOutput)
+	if !ok {
+		return nil, false
+	}
+
+	return vmOutput, true
+}
+
+// cacheOutput stores the result only if no other block was committed since the query started, as the result might
+// have been computed on the newer state
+func (sqsc *scQueryServiceCache) cacheOutput(blockHash []byte, key []byte, vmOutput *vmcommon.VMOutput) {
+	if !bytes.Equal(blockHash, sqsc.getCurrentBlockHash()) {
+		return
+	}
+
+	_ = sqsc.cacher.Put(key, vmOutput, estimateVMOutputSize(vmOutput))
+}
+
+// createQueryCacheKey concatenates the length prefixed query fields, so that different queries can not produce the
+// same key
+func createQueryCacheKey(blockHash []byte, query *process.SCQuery) []byte {
+	var callValue []byte
+	if query.CallValue != nil {
+		callValue = query.CallValue.Bytes()
+	}
+
+	fields := [][]byte{blockHash, query.ScAddress, []byte(query.FuncName), query.CallerAddr, callValue}
+	fields = append(fields, query.Arguments...)
+
+	key := make([]byte, 0)
+	lenBuff := make([]byte, 4)
+	for _, field := range fields {
+		binary.BigEndian.PutUint32(lenBuff, uint32(len(field)))
+		key = append(key, lenBuff...)
+		key = append(key, field...)
+	}
+
+	return key
+}
+
+func estimateVMOutputSize(vmOutput *vmcommon.VMOutput) int {
+	size := len(vmOutput.ReturnMessage)
+	for _, returnData := range vmOutput.ReturnData {
+		size += len(returnData)
+	}
+
+	return size
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sqsc *scQueryServiceCache) IsInterfaceNil() bool {
+	return sqsc == nil
+}
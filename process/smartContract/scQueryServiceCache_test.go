@@ -0,0 +1,180 @@
+package smartContract
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBlockChainWithHeaderHash(headerHash *[]byte) *mock.BlockChainMock {
+	return &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{RootHash: []byte("same root hash for all blocks")}
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return *headerHash
+		},
+	}
+}
+
+func TestNewScQueryServiceCache(t *testing.T) {
+	t.Parallel()
+
+	sqsc, err := NewScQueryServiceCache(nil, &mock.BlockChainMock{}, testscommon.NewCacherMock())
+	assert.Nil(t, sqsc)
+	assert.Equal(t, process.ErrNilScQueryElement, err)
+
+	sqsc, err = NewScQueryServiceCache(&mock.ScQueryStub{}, nil, testscommon.NewCacherMock())
+	assert.Nil(t, sqsc)
+	assert.Equal(t, process.ErrNilBlockChain, err)
+
+	sqsc, err = NewScQueryServiceCache(&mock.ScQueryStub{}, &mock.BlockChainMock{}, nil)
+	assert.Nil(t, sqsc)
+	assert.Equal(t, process.ErrNilCacher, err)
+
+	sqsc, err = NewScQueryServiceCache(&mock.ScQueryStub{}, &mock.BlockChainMock{}, testscommon.NewCacherMock())
+	assert.Nil(t, err)
+	assert.False(t, sqsc.IsInterfaceNil())
+}
+
+func TestScQueryServiceCache_ExecuteQueryShouldCacheUntilTheBlockChanges(t *testing.T) {
+	t.Parallel()
+
+	numExecutions := 0
+	service := &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			numExecutions++
+			return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte(query.FuncName)}}, nil
+		},
+	}
+	headerHash := []byte("header hash 1")
+	sqsc, _ := NewScQueryServiceCache(service, createBlockChainWithHeaderHash(&headerHash), testscommon.NewCacherMock())
+
+	query := func() *process.SCQuery {
+		return &process.SCQuery{ScAddress: []byte("contract"), FuncName: "function", Arguments: [][]byte{{1}}}
+	}
+
+	first, err := sqsc.ExecuteQuery(query())
+	require.Nil(t, err)
+	second, err := sqsc.ExecuteQuery(query())
+	require.Nil(t, err)
+	assert.Equal(t, 1, numExecutions)
+	assert.True(t, first == second)
+
+	differentArgs := query()
+	differentArgs.Arguments = [][]byte{{2}}
+	_, _ = sqsc.ExecuteQuery(differentArgs)
+	differentCaller := query()
+	differentCaller.CallerAddr = []byte("caller")
+	_, _ = sqsc.ExecuteQuery(differentCaller)
+	differentValue := query()
+	differentValue.CallValue = big.NewInt(1)
+	_, _ = sqsc.ExecuteQuery(differentValue)
+	assert.Equal(t, 4, numExecutions)
+
+	// an empty block keeps the root hash but still drops the cached results
+	headerHash = []byte("header hash 2")
+	_, _ = sqsc.ExecuteQuery(query())
+	assert.Equal(t, 5, numExecutions)
+}
+
+func TestScQueryServiceCache_ExecuteQueryErrorsShouldNotBeCached(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numExecutions := 0
+	service := &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			numExecutions++
+			return nil, expectedErr
+		},
+	}
+	headerHash := []byte("header hash")
+	sqsc, _ := NewScQueryServiceCache(service, createBlockChainWithHeaderHash(&headerHash), testscommon.NewCacherMock())
+
+	query := &process.SCQuery{ScAddress: []byte("contract"), FuncName: "function"}
+	_, err := sqsc.ExecuteQuery(query)
+	assert.Equal(t, expectedErr, err)
+	_, err = sqsc.ExecuteQuery(query)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, numExecutions)
+
+	vmOutput, err := sqsc.ExecuteQuery(nil)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, process.ErrNilScQuery, err)
+}
+
+func TestScQueryServiceCache_ExecuteQueriesShouldExecuteOnlyTheMissingQueries(t *testing.T) {
+	t.Parallel()
+
+	executedFunctions := make([]string, 0)
+	service := &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			executedFunctions = append(executedFunctions, query.FuncName)
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, ReturnData: [][]byte{[]byte(query.FuncName)}}, nil
+		},
+		ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error) {
+			vmOutputs := make([]*vmcommon.VMOutput, 0, len(queries))
+			for _, query := range queries {
+				if query == nil {
+					vmOutputs = append(vmOutputs, &vmcommon.VMOutput{ReturnCode: vmcommon.UserError})
+					continue
+				}
+
+				executedFunctions = append(executedFunctions, query.FuncName)
+				returnCode := vmcommon.Ok
+				if query.FuncName == "failing" {
+					returnCode = vmcommon.UserError
+				}
+				vmOutputs = append(vmOutputs, &vmcommon.VMOutput{ReturnCode: returnCode, ReturnData: [][]byte{[]byte(query.FuncName)}})
+			}
+
+			return vmOutputs, nil
+		},
+	}
+	headerHash := []byte("header hash")
+	sqsc, _ := NewScQueryServiceCache(service, createBlockChainWithHeaderHash(&headerHash), testscommon.NewCacherMock())
+
+	_, _ = sqsc.ExecuteQuery(&process.SCQuery{ScAddress: []byte("contract"), FuncName: "cached"})
+
+	queries := []*process.SCQuery{
+		{ScAddress: []byte("contract"), FuncName: "cached"},
+		{ScAddress: []byte("contract"), FuncName: "missing"},
+		nil,
+		{ScAddress: []byte("contract"), FuncName: "failing"},
+	}
+	vmOutputs, err := sqsc.ExecuteQueries(queries)
+	require.Nil(t, err)
+	require.Len(t, vmOutputs, len(queries))
+	assert.Equal(t, []string{"cached", "missing", "failing"}, executedFunctions)
+	assert.Equal(t, []byte("cached"), vmOutputs[0].ReturnData[0])
+	assert.Equal(t, []byte("missing"), vmOutputs[1].ReturnData[0])
+	assert.Equal(t, vmcommon.UserError, vmOutputs[2].ReturnCode)
+	assert.Equal(t, vmcommon.UserError, vmOutputs[3].ReturnCode)
+
+	_, err = sqsc.ExecuteQueries(queries)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"cached", "missing", "failing", "failing"}, executedFunctions)
+
+	vmOutputs, err = sqsc.ExecuteQueries(nil)
+	assert.Nil(t, vmOutputs)
+	assert.Equal(t, process.ErrNilOrEmptyList, err)
+}
+
+func TestCreateQueryCacheKey_DifferentFieldsSplitShouldNotCollide(t *testing.T) {
+	t.Parallel()
+
+	first := createQueryCacheKey([]byte("root"), &process.SCQuery{ScAddress: []byte("ab"), FuncName: "c"})
+	second := createQueryCacheKey([]byte("root"), &process.SCQuery{ScAddress: []byte("a"), FuncName: "bc"})
+
+	assert.NotEqual(t, first, second)
+}
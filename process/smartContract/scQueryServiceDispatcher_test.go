@@ -80,6 +80,35 @@ func TestScQueryServiceDispatcher_ExecuteQueryShouldCallInRoundRobinFashion(t *t
 	assert.Equal(t, 1, calledElement2)
 }
 
+func TestScQueryServiceDispatcher_ExecuteQueriesShouldSendTheWholeBatchToOneElement(t *testing.T) {
+	t.Parallel()
+
+	numQueriesElement1 := 0
+	numQueriesElement2 := 0
+	sqsd, _ := NewScQueryServiceDispatcher([]process.SCQueryService{
+		&mock.ScQueryStub{
+			ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error) {
+				numQueriesElement1 += len(queries)
+
+				return nil, nil
+			},
+		},
+		&mock.ScQueryStub{
+			ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*vmcommon.VMOutput, error) {
+				numQueriesElement2 += len(queries)
+
+				return nil, nil
+			},
+		},
+	})
+
+	_, _ = sqsd.ExecuteQueries(make([]*process.SCQuery, 3))
+	_, _ = sqsd.ExecuteQueries(make([]*process.SCQuery, 2))
+
+	assert.Equal(t, 3, numQueriesElement1)
+	assert.Equal(t, 2, numQueriesElement2)
+}
+
 func TestScQueryServiceDispatcher_ComputeScCallGasLimitShouldCallInRoundRobinFashion(t *testing.T) {
 	t.Parallel()
 
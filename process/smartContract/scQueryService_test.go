@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -393,3 +394,139 @@ func TestSCQueryService_ComputeTxCostScCall(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, consumedGas, cost)
 }
+
+func TestSCQueryService_ExecuteQueriesEmptyListShouldErr(t *testing.T) {
+	t.Parallel()
+
+	target, _ := NewSCQueryService(&mock.VMContainerMock{}, &mock.FeeHandlerStub{}, &mock.BlockChainHookHandlerMock{}, &mock.BlockChainMock{})
+
+	outputs, err := target.ExecuteQueries(nil)
+
+	assert.Nil(t, outputs)
+	assert.Equal(t, process.ErrNilOrEmptyList, err)
+}
+
+func TestSCQueryService_ExecuteQueriesShouldReportEachQueryResult(t *testing.T) {
+	t.Parallel()
+
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			if input.Function == "failing" {
+				return &vmcommon.VMOutput{
+					ReturnCode:    vmcommon.UserError,
+					ReturnMessage: "function failed",
+				}, nil
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: [][]byte{[]byte(input.Function)},
+			}, nil
+		},
+	}
+	numSetHeaderCalls := 0
+	target, _ := NewSCQueryService(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{},
+		&mock.BlockChainHookHandlerMock{
+			SetCurrentHeaderCalled: func(_ data.HeaderHandler) {
+				numSetHeaderCalls++
+			},
+		},
+		&mock.BlockChainMock{},
+	)
+
+	queries := []*process.SCQuery{
+		{ScAddress: []byte(DummyScAddress), FuncName: "first"},
+		nil,
+		{ScAddress: []byte(DummyScAddress), FuncName: "failing"},
+		{ScAddress: []byte(DummyScAddress), FuncName: ""},
+		{ScAddress: []byte(DummyScAddress), FuncName: "second"},
+	}
+	outputs, err := target.ExecuteQueries(queries)
+
+	require.Nil(t, err)
+	require.Len(t, outputs, len(queries))
+	require.Equal(t, 1, numSetHeaderCalls)
+	require.Equal(t, vmcommon.Ok, outputs[0].ReturnCode)
+	require.Equal(t, []byte("first"), outputs[0].ReturnData[0])
+	require.Equal(t, vmcommon.UserError, outputs[1].ReturnCode)
+	require.Equal(t, process.ErrNilScQuery.Error(), outputs[1].ReturnMessage)
+	require.Equal(t, vmcommon.UserError, outputs[2].ReturnCode)
+	require.Equal(t, "function failed", outputs[2].ReturnMessage)
+	require.Equal(t, vmcommon.UserError, outputs[3].ReturnCode)
+	require.Equal(t, process.ErrEmptyFunctionName.Error(), outputs[3].ReturnMessage)
+	require.Equal(t, []byte("second"), outputs[4].ReturnData[0])
+}
+
+func TestSCQueryService_ExecuteQueriesBlockCommittedShouldRetry(t *testing.T) {
+	t.Parallel()
+
+	numRuns := 0
+	mockVM := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+			numRuns++
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+	}
+	numHeaderHashCalls := 0
+	blockChain := &mock.BlockChainMock{
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			numHeaderHashCalls++
+			if numHeaderHashCalls == 1 {
+				return []byte("old header")
+			}
+
+			return []byte("new header")
+		},
+	}
+	target, _ := NewSCQueryService(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return mockVM, nil
+			},
+		},
+		&mock.FeeHandlerStub{},
+		&mock.BlockChainHookHandlerMock{},
+		blockChain,
+	)
+
+	queries := []*process.SCQuery{{ScAddress: []byte(DummyScAddress), FuncName: "function"}}
+	outputs, err := target.ExecuteQueries(queries)
+
+	require.Nil(t, err)
+	require.Len(t, outputs, 1)
+	require.Equal(t, 2, numRuns)
+}
+
+func TestSCQueryService_ExecuteQueriesStateKeepsChangingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	numHeaderHashCalls := 0
+	blockChain := &mock.BlockChainMock{
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			numHeaderHashCalls++
+			return big.NewInt(int64(numHeaderHashCalls)).Bytes()
+		},
+	}
+	target, _ := NewSCQueryService(
+		&mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{}, nil
+			},
+		},
+		&mock.FeeHandlerStub{},
+		&mock.BlockChainHookHandlerMock{},
+		blockChain,
+	)
+
+	queries := []*process.SCQuery{{ScAddress: []byte(DummyScAddress), FuncName: "function"}}
+	outputs, err := target.ExecuteQueries(queries)
+
+	require.Nil(t, outputs)
+	require.Equal(t, process.ErrStateChangedDuringQueries, err)
+}
@@ -0,0 +1,235 @@
+package blackList
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const pidKeyPrefix = "pid_"
+const pkKeyPrefix = "pk_"
+const minDecayInterval = time.Second
+const minMaxBanMultiplier = uint32(1)
+
+// ArgPeerReputationStore represents the arguments structure used when creating a peer reputation store
+type ArgPeerReputationStore struct {
+	Storer           storage.Storer
+	Marshalizer      marshal.Marshalizer
+	DecayCoefficient float64
+	DecayInterval    time.Duration
+	MaxBanMultiplier uint32
+}
+
+// peerReputation is the persisted form of a peer ID or public key reputation
+type peerReputation struct {
+	Score       float64 `json:"score"`
+	NumOffences uint32  `json:"numOffences"`
+	BannedUntil int64   `json:"bannedUntil"`
+	LastUpdate  int64   `json:"lastUpdate"`
+}
+
+type peerReputationStore struct {
+	mut              sync.Mutex
+	storer           storage.Storer
+	marshalizer      marshal.Marshalizer
+	decayCoefficient float64
+	decayInterval    time.Duration
+	maxBanMultiplier uint32
+	getTimeHandler   func() time.Time
+}
+
+// NewPeerReputationStore creates a store able to persist the offences of peer IDs and validator public keys.
+// The offence score decays in time so that a reformed peer will eventually get the usual ban durations
+func NewPeerReputationStore(arg ArgPeerReputationStore) (*peerReputationStore, error) {
+	if check.IfNil(arg.Storer) {
+		return nil, fmt.Errorf("%w in NewPeerReputationStore", process.ErrNilStorage)
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, fmt.Errorf("%w in NewPeerReputationStore", process.ErrNilMarshalizer)
+	}
+	isDecayCoefficientOk := arg.DecayCoefficient > 0 && arg.DecayCoefficient < 1
+	if !isDecayCoefficientOk {
+		return nil, fmt.Errorf("%w, decay coefficient should be in interval (0, 1)", process.ErrInvalidDecayCoefficient)
+	}
+	if arg.DecayInterval < minDecayInterval {
+		return nil, fmt.Errorf("%w, decay interval should be greater or equal to %v",
+			process.ErrInvalidDecayIntervalInSeconds,
+			minDecayInterval,
+		)
+	}
+	if arg.MaxBanMultiplier < minMaxBanMultiplier {
+		return nil, fmt.Errorf("%w, should be greater or equal to %d", process.ErrInvalidMaxBanMultiplier, minMaxBanMultiplier)
+	}
+
+	return &peerReputationStore{
+		storer:           arg.Storer,
+		marshalizer:      arg.Marshalizer,
+		decayCoefficient: arg.DecayCoefficient,
+		decayInterval:    arg.DecayInterval,
+		maxBanMultiplier: arg.MaxBanMultiplier,
+		getTimeHandler:   time.Now,
+	}, nil
+}
+
+// RecordPeerIDOffence increases the offence score of the provided peer ID and returns the ban duration
+// that should be applied, given the offence history of the peer
+func (prs *peerReputationStore) RecordPeerIDOffence(pid core.PeerID, span time.Duration) time.Duration {
+	return prs.recordOffence(pidKeyPrefix+string(pid), span)
+}
+
+// RecordPublicKeyOffence increases the offence score of the provided public key and returns the ban duration
+// that should be applied, given the offence history of the public key
+func (prs *peerReputationStore) RecordPublicKeyOffence(pk string, span time.Duration) time.Duration {
+	return prs.recordOffence(pkKeyPrefix+pk, span)
+}
+
+func (prs *peerReputationStore) recordOffence(key string, span time.Duration) time.Duration {
+	prs.mut.Lock()
+	defer prs.mut.Unlock()
+
+	now := prs.getTimeHandler()
+	rep := prs.getDecayedReputation(key, now)
+	rep.Score++
+	rep.NumOffences++
+	rep.LastUpdate = now.UnixNano()
+
+	multiplier := uint32(math.Ceil(rep.Score))
+	if multiplier > prs.maxBanMultiplier {
+		multiplier = prs.maxBanMultiplier
+	}
+	banDuration := span * time.Duration(multiplier)
+	bannedUntil := now.Add(banDuration).UnixNano()
+	if bannedUntil > rep.BannedUntil {
+		rep.BannedUntil = bannedUntil
+	}
+
+	err := prs.saveReputation(key, rep)
+	if err != nil {
+		log.Warn("peerReputationStore.recordOffence", "error", err)
+	}
+
+	return banDuration
+}
+
+// PeerIDReputation returns the current reputation of the provided peer ID
+func (prs *peerReputationStore) PeerIDReputation(pid core.PeerID) core.P2PPeerReputation {
+	return prs.getReputation(pidKeyPrefix + string(pid))
+}
+
+// PublicKeyReputation returns the current reputation of the provided public key
+func (prs *peerReputationStore) PublicKeyReputation(pk string) core.P2PPeerReputation {
+	return prs.getReputation(pkKeyPrefix + pk)
+}
+
+func (prs *peerReputationStore) getReputation(key string) core.P2PPeerReputation {
+	prs.mut.Lock()
+	defer prs.mut.Unlock()
+
+	rep := prs.getDecayedReputation(key, prs.getTimeHandler())
+
+	return core.P2PPeerReputation{
+		Score:       rep.Score,
+		NumOffences: rep.NumOffences,
+		BannedUntil: rep.BannedUntil,
+	}
+}
+
+func (prs *peerReputationStore) getDecayedReputation(key string, now time.Time) *peerReputation {
+	rep := &peerReputation{}
+	buff, err := prs.storer.Get([]byte(key))
+	if err != nil {
+		return rep
+	}
+
+	err = prs.marshalizer.Unmarshal(rep, buff)
+	if err != nil {
+		return &peerReputation{}
+	}
+
+	elapsed := now.UnixNano() - rep.LastUpdate
+	if elapsed > 0 {
+		numIntervals := float64(elapsed) / float64(prs.decayInterval)
+		rep.Score *= math.Pow(prs.decayCoefficient, numIntervals)
+	}
+
+	return rep
+}
+
+func (prs *peerReputationStore) saveReputation(key string, rep *peerReputation) error {
+	buff, err := prs.marshalizer.Marshal(rep)
+	if err != nil {
+		return err
+	}
+
+	return prs.storer.Put([]byte(key), buff)
+}
+
+// RestoreBans will re-apply, on the provided caches, the bans that did not expire before the node was restarted
+func (prs *peerReputationStore) RestoreBans(
+	peerBlackListCacher process.PeerBlackListCacher,
+	publicKeysCacher process.TimeCacher,
+) error {
+	if check.IfNil(peerBlackListCacher) {
+		return fmt.Errorf("%w for peer IDs cacher", process.ErrNilBlackListCacher)
+	}
+	if check.IfNil(publicKeysCacher) {
+		return fmt.Errorf("%w for public keys cacher", process.ErrNilBlackListCacher)
+	}
+
+	prs.mut.Lock()
+	defer prs.mut.Unlock()
+
+	now := prs.getTimeHandler().UnixNano()
+	numRestored := 0
+	prs.storer.RangeKeys(func(key []byte, val []byte) bool {
+		rep := &peerReputation{}
+		err := prs.marshalizer.Unmarshal(rep, val)
+		if err != nil {
+			return true
+		}
+
+		remaining := time.Duration(rep.BannedUntil - now)
+		if remaining <= 0 {
+			return true
+		}
+
+		keyString := string(key)
+		switch {
+		case strings.HasPrefix(keyString, pidKeyPrefix):
+			err = peerBlackListCacher.Upsert(core.PeerID(keyString[len(pidKeyPrefix):]), remaining)
+		case strings.HasPrefix(keyString, pkKeyPrefix):
+			err = publicKeysCacher.Upsert(keyString[len(pkKeyPrefix):], remaining)
+		default:
+			return true
+		}
+		if err != nil {
+			log.Warn("peerReputationStore.RestoreBans", "error", err)
+			return true
+		}
+
+		numRestored++
+		return true
+	})
+
+	log.Debug("peerReputationStore.RestoreBans", "num restored bans", numRestored)
+
+	return nil
+}
+
+// Close will close the inner storer
+func (prs *peerReputationStore) Close() error {
+	return prs.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prs *peerReputationStore) IsInterfaceNil() bool {
+	return prs == nil
+}
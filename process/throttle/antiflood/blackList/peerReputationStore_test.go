@@ -0,0 +1,181 @@
+package blackList
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgPeerReputationStore() ArgPeerReputationStore {
+	return ArgPeerReputationStore{
+		Storer:           genericmocks.NewStorerMock("reputation", 0),
+		Marshalizer:      &mock.MarshalizerMock{},
+		DecayCoefficient: 0.5,
+		DecayInterval:    time.Hour,
+		MaxBanMultiplier: 3,
+	}
+}
+
+func TestNewPeerReputationStore_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerReputationStore()
+	arg.Storer = nil
+	prs, err := NewPeerReputationStore(arg)
+
+	assert.True(t, check.IfNil(prs))
+	assert.True(t, errors.Is(err, process.ErrNilStorage))
+}
+
+func TestNewPeerReputationStore_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerReputationStore()
+	arg.Marshalizer = nil
+	prs, err := NewPeerReputationStore(arg)
+
+	assert.True(t, check.IfNil(prs))
+	assert.True(t, errors.Is(err, process.ErrNilMarshalizer))
+}
+
+func TestNewPeerReputationStore_InvalidDecayCoefficientShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerReputationStore()
+	arg.DecayCoefficient = 1
+	prs, err := NewPeerReputationStore(arg)
+
+	assert.True(t, check.IfNil(prs))
+	assert.True(t, errors.Is(err, process.ErrInvalidDecayCoefficient))
+}
+
+func TestNewPeerReputationStore_InvalidDecayIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerReputationStore()
+	arg.DecayInterval = time.Millisecond
+	prs, err := NewPeerReputationStore(arg)
+
+	assert.True(t, check.IfNil(prs))
+	assert.True(t, errors.Is(err, process.ErrInvalidDecayIntervalInSeconds))
+}
+
+func TestNewPeerReputationStore_InvalidMaxBanMultiplierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerReputationStore()
+	arg.MaxBanMultiplier = 0
+	prs, err := NewPeerReputationStore(arg)
+
+	assert.True(t, check.IfNil(prs))
+	assert.True(t, errors.Is(err, process.ErrInvalidMaxBanMultiplier))
+}
+
+func TestNewPeerReputationStore_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	prs, err := NewPeerReputationStore(createMockArgPeerReputationStore())
+
+	assert.False(t, check.IfNil(prs))
+	assert.Nil(t, err)
+}
+
+func TestPeerReputationStore_RecordPeerIDOffenceShouldIncreaseBanDuration(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	prs, _ := NewPeerReputationStore(createMockArgPeerReputationStore())
+	prs.getTimeHandler = func() time.Time {
+		return now
+	}
+
+	pid := core.PeerID("pid")
+	assert.Equal(t, time.Second, prs.RecordPeerIDOffence(pid, time.Second))
+	assert.Equal(t, 2*time.Second, prs.RecordPeerIDOffence(pid, time.Second))
+	assert.Equal(t, 3*time.Second, prs.RecordPeerIDOffence(pid, time.Second))
+	assert.Equal(t, 3*time.Second, prs.RecordPeerIDOffence(pid, time.Second)) //max ban multiplier reached
+
+	rep := prs.PeerIDReputation(pid)
+	assert.Equal(t, float64(4), rep.Score)
+	assert.Equal(t, uint32(4), rep.NumOffences)
+	assert.Equal(t, now.Add(3*time.Second).UnixNano(), rep.BannedUntil)
+
+	assert.Equal(t, core.P2PPeerReputation{}, prs.PublicKeyReputation(string(pid)))
+}
+
+func TestPeerReputationStore_ScoreShouldDecay(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	prs, _ := NewPeerReputationStore(createMockArgPeerReputationStore())
+	prs.getTimeHandler = func() time.Time {
+		return now
+	}
+
+	pk := "pk"
+	_ = prs.RecordPublicKeyOffence(pk, time.Second)
+	_ = prs.RecordPublicKeyOffence(pk, time.Second)
+
+	now = now.Add(2 * time.Hour)
+	rep := prs.PublicKeyReputation(pk)
+	assert.Equal(t, 0.5, rep.Score)
+	assert.Equal(t, uint32(2), rep.NumOffences)
+
+	assert.Equal(t, time.Second*2, prs.RecordPublicKeyOffence(pk, time.Second))
+}
+
+func TestPeerReputationStore_RestoreBansNilCachersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	prs, _ := NewPeerReputationStore(createMockArgPeerReputationStore())
+
+	err := prs.RestoreBans(nil, &mock.TimeCacheStub{})
+	assert.True(t, errors.Is(err, process.ErrNilBlackListCacher))
+
+	err = prs.RestoreBans(&mock.PeerBlackListHandlerStub{}, nil)
+	assert.True(t, errors.Is(err, process.ErrNilBlackListCacher))
+}
+
+func TestPeerReputationStore_RestoreBansShouldRestoreOnlyActiveBans(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerReputationStore()
+	now := time.Unix(1000, 0)
+	prs, _ := NewPeerReputationStore(arg)
+	prs.getTimeHandler = func() time.Time {
+		return now
+	}
+
+	_ = prs.RecordPeerIDOffence("expired pid", time.Second)
+	_ = prs.RecordPeerIDOffence("banned pid", time.Minute)
+	_ = prs.RecordPublicKeyOffence("banned pk", time.Minute)
+	now = now.Add(time.Second * 10)
+
+	restoredPids := make(map[core.PeerID]time.Duration)
+	restoredPks := make(map[string]time.Duration)
+	err := prs.RestoreBans(
+		&mock.PeerBlackListHandlerStub{
+			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+				restoredPids[pid] = span
+				return nil
+			},
+		},
+		&mock.TimeCacheStub{
+			UpsertCalled: func(key string, span time.Duration) error {
+				restoredPks[key] = span
+				return nil
+			},
+		},
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[core.PeerID]time.Duration{"banned pid": time.Second * 50}, restoredPids)
+	assert.Equal(t, map[string]time.Duration{"banned pk": time.Second * 50}, restoredPks)
+}
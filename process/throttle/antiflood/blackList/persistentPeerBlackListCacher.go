@@ -0,0 +1,45 @@
+package blackList
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type persistentPeerBlackListCacher struct {
+	process.PeerBlackListCacher
+	reputationHandler process.PeerReputationHandler
+}
+
+// NewPersistentPeerBlackListCacher creates a peer black list cacher that records each ban in the provided
+// reputation handler. Repeated offenders will receive longer bans
+func NewPersistentPeerBlackListCacher(
+	cacher process.PeerBlackListCacher,
+	reputationHandler process.PeerReputationHandler,
+) (*persistentPeerBlackListCacher, error) {
+	if check.IfNil(cacher) {
+		return nil, process.ErrNilBlackListCacher
+	}
+	if check.IfNil(reputationHandler) {
+		return nil, process.ErrNilPeerReputationHandler
+	}
+
+	return &persistentPeerBlackListCacher{
+		PeerBlackListCacher: cacher,
+		reputationHandler:   reputationHandler,
+	}, nil
+}
+
+// Upsert will record the offence and will black list the peer for the duration computed by the reputation handler
+func (ppbc *persistentPeerBlackListCacher) Upsert(pid core.PeerID, span time.Duration) error {
+	span = ppbc.reputationHandler.RecordPeerIDOffence(pid, span)
+
+	return ppbc.PeerBlackListCacher.Upsert(pid, span)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ppbc *persistentPeerBlackListCacher) IsInterfaceNil() bool {
+	return ppbc == nil
+}
@@ -0,0 +1,60 @@
+package blackList
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPersistentPeerBlackListCacher_NilCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ppbc, err := NewPersistentPeerBlackListCacher(nil, &mock.PeerReputationHandlerStub{})
+
+	assert.True(t, check.IfNil(ppbc))
+	assert.Equal(t, process.ErrNilBlackListCacher, err)
+}
+
+func TestNewPersistentPeerBlackListCacher_NilReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ppbc, err := NewPersistentPeerBlackListCacher(&mock.PeerBlackListHandlerStub{}, nil)
+
+	assert.True(t, check.IfNil(ppbc))
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+}
+
+func TestPersistentPeerBlackListCacher_UpsertShouldUseTheRecordedDuration(t *testing.T) {
+	t.Parallel()
+
+	providedPid := core.PeerID("pid")
+	var upsertedSpan time.Duration
+	ppbc, _ := NewPersistentPeerBlackListCacher(
+		&mock.PeerBlackListHandlerStub{
+			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+				assert.Equal(t, providedPid, pid)
+				upsertedSpan = span
+				return nil
+			},
+			HasCalled: func(pid core.PeerID) bool {
+				return true
+			},
+		},
+		&mock.PeerReputationHandlerStub{
+			RecordPeerIDOffenceCalled: func(pid core.PeerID, span time.Duration) time.Duration {
+				return span * 2
+			},
+		},
+	)
+
+	err := ppbc.Upsert(providedPid, time.Second)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2*time.Second, upsertedSpan)
+	assert.True(t, ppbc.Has(providedPid))
+}
@@ -0,0 +1,44 @@
+package blackList
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type persistentPublicKeysCacher struct {
+	process.TimeCacher
+	reputationHandler process.PeerReputationHandler
+}
+
+// NewPersistentPublicKeysCacher creates a public keys time cacher that records each ban in the provided
+// reputation handler. Repeated offenders will receive longer bans
+func NewPersistentPublicKeysCacher(
+	cacher process.TimeCacher,
+	reputationHandler process.PeerReputationHandler,
+) (*persistentPublicKeysCacher, error) {
+	if check.IfNil(cacher) {
+		return nil, process.ErrNilBlackListCacher
+	}
+	if check.IfNil(reputationHandler) {
+		return nil, process.ErrNilPeerReputationHandler
+	}
+
+	return &persistentPublicKeysCacher{
+		TimeCacher:        cacher,
+		reputationHandler: reputationHandler,
+	}, nil
+}
+
+// Upsert will record the offence and will black list the public key for the duration computed by the reputation handler
+func (ppkc *persistentPublicKeysCacher) Upsert(pk string, span time.Duration) error {
+	span = ppkc.reputationHandler.RecordPublicKeyOffence(pk, span)
+
+	return ppkc.TimeCacher.Upsert(pk, span)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ppkc *persistentPublicKeysCacher) IsInterfaceNil() bool {
+	return ppkc == nil
+}
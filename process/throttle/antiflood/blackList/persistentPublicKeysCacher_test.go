@@ -0,0 +1,55 @@
+package blackList
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPersistentPublicKeysCacher_NilCacherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ppkc, err := NewPersistentPublicKeysCacher(nil, &mock.PeerReputationHandlerStub{})
+
+	assert.True(t, check.IfNil(ppkc))
+	assert.Equal(t, process.ErrNilBlackListCacher, err)
+}
+
+func TestNewPersistentPublicKeysCacher_NilReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ppkc, err := NewPersistentPublicKeysCacher(&mock.TimeCacheStub{}, nil)
+
+	assert.True(t, check.IfNil(ppkc))
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+}
+
+func TestPersistentPublicKeysCacher_UpsertShouldUseTheRecordedDuration(t *testing.T) {
+	t.Parallel()
+
+	providedPk := "pk"
+	var upsertedSpan time.Duration
+	ppkc, _ := NewPersistentPublicKeysCacher(
+		&mock.TimeCacheStub{
+			UpsertCalled: func(key string, span time.Duration) error {
+				assert.Equal(t, providedPk, key)
+				upsertedSpan = span
+				return nil
+			},
+		},
+		&mock.PeerReputationHandlerStub{
+			RecordPublicKeyOffenceCalled: func(pk string, span time.Duration) time.Duration {
+				return span * 3
+			},
+		},
+	)
+
+	err := ppkc.Upsert(providedPk, time.Second)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3*time.Second, upsertedSpan)
+}
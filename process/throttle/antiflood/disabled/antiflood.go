@@ -37,6 +37,10 @@ func (af *AntiFlood) IsOriginatorEligibleForTopic(_ core.PeerID, _ string) error
 func (af *AntiFlood) SetTopicsForAll(_ ...string) {
 }
 
+// SetTrustedPeers does nothing
+func (af *AntiFlood) SetTrustedPeers(_ ...core.PeerID) {
+}
+
 // SetPeerValidatorMapper does nothing
 func (af *AntiFlood) SetPeerValidatorMapper(_ process.PeerValidatorMapper) error {
 	return nil
@@ -0,0 +1,49 @@
+package disabled
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.PeerReputationHandler = (*PeerReputationHandler)(nil)
+
+// PeerReputationHandler is a disabled implementation of PeerReputationHandler that does not persist anything
+type PeerReputationHandler struct {
+}
+
+// RecordPeerIDOffence returns the provided span
+func (prh *PeerReputationHandler) RecordPeerIDOffence(_ core.PeerID, span time.Duration) time.Duration {
+	return span
+}
+
+// RecordPublicKeyOffence returns the provided span
+func (prh *PeerReputationHandler) RecordPublicKeyOffence(_ string, span time.Duration) time.Duration {
+	return span
+}
+
+// PeerIDReputation returns an empty reputation
+func (prh *PeerReputationHandler) PeerIDReputation(_ core.PeerID) core.P2PPeerReputation {
+	return core.P2PPeerReputation{}
+}
+
+// PublicKeyReputation returns an empty reputation
+func (prh *PeerReputationHandler) PublicKeyReputation(_ string) core.P2PPeerReputation {
+	return core.P2PPeerReputation{}
+}
+
+// RestoreBans does nothing
+func (prh *PeerReputationHandler) RestoreBans(_ process.PeerBlackListCacher, _ process.TimeCacher) error {
+	return nil
+}
+
+// Close does nothing
+func (prh *PeerReputationHandler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prh *PeerReputationHandler) IsInterfaceNil() bool {
+	return prh == nil
+}
@@ -34,12 +34,16 @@ func NewP2PAntiFloodAndBlackList(
 	config config.Config,
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
+	peerReputationHandler process.PeerReputationHandler,
 ) (process.P2PAntifloodHandler, process.PeerBlackListCacher, process.TimeCacher, error) {
 	if check.IfNil(statusHandler) {
 		return nil, nil, nil, p2p.ErrNilStatusHandler
 	}
+	if check.IfNil(peerReputationHandler) {
+		return nil, nil, nil, process.ErrNilPeerReputationHandler
+	}
 	if config.Antiflood.Enabled {
-		return initP2PAntiFloodAndBlackList(config, statusHandler, currentPid)
+		return initP2PAntiFloodAndBlackList(config, statusHandler, currentPid, peerReputationHandler)
 	}
 
 	return &disabled.AntiFlood{}, &disabled.PeerBlacklistCacher{}, &disabled.TimeCache{}, nil
@@ -49,14 +53,29 @@ func initP2PAntiFloodAndBlackList(
 	mainConfig config.Config,
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
+	peerReputationHandler process.PeerReputationHandler,
 ) (process.P2PAntifloodHandler, process.PeerBlackListCacher, process.TimeCacher, error) {
 	cache := timecache.NewTimeCache(defaultSpan)
-	p2pPeerBlackList, err := timecache.NewPeerTimeCache(cache)
+	peerTimeCache, err := timecache.NewPeerTimeCache(cache)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	publicKeysTimeCache := timecache.NewTimeCache(defaultSpan)
+	err = peerReputationHandler.RestoreBans(peerTimeCache, publicKeysTimeCache)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	p2pPeerBlackList, err := blackList.NewPersistentPeerBlackListCacher(peerTimeCache, peerReputationHandler)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	publicKeysCache := timecache.NewTimeCache(defaultSpan)
+	publicKeysCache, err := blackList.NewPersistentPublicKeysCacher(publicKeysTimeCache, peerReputationHandler)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	fastReactingFloodPreventer, err := createFloodPreventer(
 		mainConfig.Antiflood.FastReacting,
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/stretchr/testify/assert"
 )
@@ -17,13 +18,25 @@ func TestNewP2PAntiFloodAndBlackList_NilStatusHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	cfg := config.Config{}
-	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, nil, currentPid)
+	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, nil, currentPid, &disabled.PeerReputationHandler{})
 	assert.Nil(t, af)
 	assert.Nil(t, pids)
 	assert.Nil(t, pks)
 	assert.Equal(t, p2p.ErrNilStatusHandler, err)
 }
 
+func TestNewP2PAntiFloodAndBlackList_NilPeerReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{}
+	ash := &mock.AppStatusHandlerMock{}
+	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid, nil)
+	assert.Nil(t, af)
+	assert.Nil(t, pids)
+	assert.Nil(t, pks)
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+}
+
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(t *testing.T) {
 	t.Parallel()
 
@@ -33,7 +46,7 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(
 		},
 	}
 	ash := &mock.AppStatusHandlerMock{}
-	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid)
+	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid, &disabled.PeerReputationHandler{})
 	assert.NotNil(t, af)
 	assert.NotNil(t, pids)
 	assert.NotNil(t, pks)
@@ -68,7 +81,7 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 	}
 
 	ash := &mock.AppStatusHandlerMock{}
-	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid)
+	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid, &disabled.PeerReputationHandler{})
 	assert.Nil(t, err)
 	assert.NotNil(t, af)
 	assert.NotNil(t, pids)
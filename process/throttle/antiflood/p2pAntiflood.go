@@ -27,6 +27,8 @@ type p2pAntiflood struct {
 	peerValidatorMapper process.PeerValidatorMapper
 	mapTopicsFromAll    map[string]struct{}
 	mutTopicCheck       sync.RWMutex
+	mapTrustedPeers     map[core.PeerID]struct{}
+	mutTrustedPeers     sync.RWMutex
 }
 
 // NewP2PAntiflood creates a new p2p anti flood protection mechanism built on top of a flood preventer implementation.
@@ -53,6 +55,7 @@ func NewP2PAntiflood(
 		topicPreventer:      topicFloodPreventer,
 		debugger:            &disabled.AntifloodDebugger{},
 		mapTopicsFromAll:    make(map[string]struct{}),
+		mapTrustedPeers:     make(map[core.PeerID]struct{}),
 		peerValidatorMapper: &disabled.PeerValidatorMapper{},
 	}, nil
 }
@@ -62,6 +65,9 @@ func (af *p2pAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedP
 	if message == nil {
 		return p2p.ErrNilMessage
 	}
+	if af.isTrusted(fromConnectedPeer) {
+		return nil
+	}
 
 	var lastErrFound error
 	for _, fp := range af.floodPreventers {
@@ -121,6 +127,25 @@ func (af *p2pAntiflood) SetTopicsForAll(topics ...string) {
 	}
 }
 
+// SetTrustedPeers sets the peers that will bypass the antiflood checks and that can not be blacklisted
+func (af *p2pAntiflood) SetTrustedPeers(pids ...core.PeerID) {
+	af.mutTrustedPeers.Lock()
+	defer af.mutTrustedPeers.Unlock()
+
+	for _, pid := range pids {
+		af.mapTrustedPeers[pid] = struct{}{}
+	}
+}
+
+func (af *p2pAntiflood) isTrusted(pid core.PeerID) bool {
+	af.mutTrustedPeers.RLock()
+	defer af.mutTrustedPeers.RUnlock()
+
+	_, ok := af.mapTrustedPeers[pid]
+
+	return ok
+}
+
 // SetPeerValidatorMapper sets the peer validator mapper
 func (af *p2pAntiflood) SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error {
 	if check.IfNil(validatorMapper) {
@@ -181,6 +206,10 @@ func (af *p2pAntiflood) canProcessMessage(fp process.FloodPreventer, message p2p
 
 // CanProcessMessagesOnTopic signals if a p2p message can be processed or not for a given topic
 func (af *p2pAntiflood) CanProcessMessagesOnTopic(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error {
+	if af.isTrusted(peer) {
+		return nil
+	}
+
 	err := af.topicPreventer.IncreaseLoad(peer, topic, numMessages)
 	if err != nil {
 		log.Trace("topicFloodPreventer.Accumulate peer",
@@ -232,6 +261,14 @@ func (af *p2pAntiflood) SetDebugger(debugger process.AntifloodDebugger) error {
 
 // BlacklistPeer will add a peer to the black list
 func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration time.Duration) {
+	if af.isTrusted(peer) {
+		log.Debug("trusted peer will not be blacklisted",
+			"pid", peer.Pretty(),
+			"reason", reason,
+		)
+		return
+	}
+
 	peerIsBlacklisted := af.blacklistHandler.Has(peer)
 
 	err := af.blacklistHandler.Upsert(peer, duration)
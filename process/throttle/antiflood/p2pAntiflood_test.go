@@ -463,3 +463,44 @@ func TestP2pAntiflood_IsOriginatorEligibleForTopic(t *testing.T) {
 	err = afm.IsOriginatorEligibleForTopic(core.PeerID(validatorPID), "topic")
 	assert.Nil(t, err)
 }
+
+func TestP2pAntiflood_TrustedPeerShouldBypassChecks(t *testing.T) {
+	t.Parallel()
+
+	trustedPeer := core.PeerID("trusted peer")
+	message := &mock.P2PMessageMock{
+		DataField: []byte("data"),
+		FromField: []byte("originator"),
+	}
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{
+			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+				assert.Fail(t, "should have not blacklisted a trusted peer")
+
+				return nil
+			},
+		},
+		&mock.TopicAntiFloodStub{
+			IncreaseLoadCalled: func(pid core.PeerID, topic string, numMessages uint32) error {
+				return process.ErrSystemBusy
+			},
+		},
+		&mock.FloodPreventerStub{
+			IncreaseLoadCalled: func(pid core.PeerID, size uint64) error {
+				return process.ErrSystemBusy
+			},
+		},
+	)
+	afm.SetTrustedPeers(trustedPeer)
+
+	err := afm.CanProcessMessage(message, trustedPeer)
+	assert.Nil(t, err)
+
+	err = afm.CanProcessMessagesOnTopic(trustedPeer, "topic", 1, 0, nil)
+	assert.Nil(t, err)
+
+	afm.BlacklistPeer(trustedPeer, "reason", time.Second)
+
+	err = afm.CanProcessMessage(message, "other peer")
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+}
@@ -24,6 +24,7 @@ type baseTxProcessor struct {
 	hasher                  hashing.Hasher
 	marshalizer             marshal.Marshalizer
 	scProcessor             process.SmartContractProcessor
+	blockMinGasPrice        process.BlockMinGasPriceHandler
 	flagPenalizedTooMuchGas atomic.Flag
 }
 
@@ -134,6 +135,10 @@ func (txProc *baseTxProcessor) checkTxValues(
 	if err != nil {
 		return err
 	}
+	// the congestion based min gas price is required only from the senders of this shard
+	if !check.IfNil(txProc.blockMinGasPrice) && txProc.blockMinGasPrice.BlockMinGasPrice() > tx.GasPrice {
+		return process.ErrInsufficientGasPriceInTx
+	}
 
 	stAcc, ok := acntSnd.(state.UserAccountHandler)
 	if !ok {
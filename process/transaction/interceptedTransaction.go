@@ -153,6 +153,11 @@ func (inTx *InterceptedTransaction) CheckValidity() error {
 		return err
 	}
 
+	err = inTx.checkCurrentMinGasPrice()
+	if err != nil {
+		return err
+	}
+
 	whiteListedVerified := inTx.whiteListerVerifiedTxs.IsWhiteListed(inTx)
 	if !whiteListedVerified {
 		err = inTx.verifySig(inTx.tx)
@@ -171,6 +176,19 @@ func (inTx *InterceptedTransaction) CheckValidity() error {
 	return nil
 }
 
+// checkCurrentMinGasPrice requires the congestion based min gas price only from the transactions sent from this shard,
+// as the cross shard transactions were already accepted by their sender shard
+func (inTx *InterceptedTransaction) checkCurrentMinGasPrice() error {
+	if inTx.sndShard != inTx.coordinator.SelfId() {
+		return nil
+	}
+	if inTx.feeHandler.CurrentMinGasPrice() > inTx.tx.GasPrice {
+		return process.ErrInsufficientGasPriceInTx
+	}
+
+	return nil
+}
+
 func (inTx *InterceptedTransaction) verifyIfRelayedTx(tx *transaction.Transaction) error {
 	funcName, userTxArgs, err := inTx.argsParser.ParseCallData(string(tx.Data))
 	if err != nil {
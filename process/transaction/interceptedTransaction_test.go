@@ -896,6 +896,83 @@ func TestInterceptedTransaction_CheckValidityOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func createInterceptedTxOnShard(tx *dataTransaction.Transaction, selfShard uint32, currentMinGasPrice uint64) (*transaction.InterceptedTransaction, error) {
+	marshalizer := &mock.MarshalizerMock{}
+	txBuff, err := marshalizer.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.CurrentShard = selfShard
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, senderAddress) {
+			return senderShard
+		}
+
+		return recvShard
+	}
+	feeHandler := createFreeTxFeeHandler()
+	feeHandler.CurrentMinGasPriceCalled = func() uint64 {
+		return currentMinGasPrice
+	}
+
+	return transaction.NewInterceptedTransaction(
+		txBuff,
+		marshalizer,
+		marshalizer,
+		mock.HasherMock{},
+		createKeyGenMock(),
+		createDummySigner(),
+		&mock.PubkeyConverterStub{
+			LenCalled: func() int {
+				return 32
+			},
+		},
+		shardCoordinator,
+		feeHandler,
+		&mock.WhiteListHandlerStub{},
+		&mock.ArgumentParserMock{},
+		tx.ChainID,
+		false,
+		mock.HasherMock{},
+		versioning.NewTxVersionChecker(tx.Version),
+	)
+}
+
+func TestInterceptedTransaction_CheckValidityCurrentMinGasPriceShouldApplyOnlyToTheSenderShard(t *testing.T) {
+	t.Parallel()
+
+	senderShardMinGasPrice := uint64(200)
+	receiverShardMinGasPrice := uint64(300)
+	tx := &dataTransaction.Transaction{
+		Nonce:     1,
+		Value:     big.NewInt(2),
+		GasLimit:  3,
+		GasPrice:  250,
+		RcvAddr:   recvAddress,
+		SndAddr:   senderAddress,
+		Signature: sigOk,
+		ChainID:   []byte("chain"),
+		Version:   1,
+	}
+
+	txi, _ := createInterceptedTxOnShard(tx, senderShard, senderShardMinGasPrice)
+	assert.Nil(t, txi.CheckValidity())
+	txi, _ = createInterceptedTxOnShard(tx, recvShard, receiverShardMinGasPrice)
+	assert.Nil(t, txi.CheckValidity())
+
+	tx.GasPrice = 150
+	txi, _ = createInterceptedTxOnShard(tx, senderShard, senderShardMinGasPrice)
+	assert.Equal(t, process.ErrInsufficientGasPriceInTx, txi.CheckValidity())
+	txi, _ = createInterceptedTxOnShard(tx, recvShard, receiverShardMinGasPrice)
+	assert.Nil(t, txi.CheckValidity())
+
+	tx.SndAddr, tx.RcvAddr = recvAddress, senderAddress
+	txi, _ = createInterceptedTxOnShard(tx, recvShard, receiverShardMinGasPrice)
+	assert.Equal(t, process.ErrInsufficientGasPriceInTx, txi.CheckValidity())
+}
+
 func TestInterceptedTransaction_CheckValiditySignedWithHashButNotEnabled(t *testing.T) {
 	t.Parallel()
 
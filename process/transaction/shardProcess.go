@@ -66,6 +66,7 @@ type ArgsNewTxProcessor struct {
 	PenalizedTooMuchGasEnableEpoch uint32
 	MetaProtectionEnableEpoch      uint32
 	EpochNotifier                  process.EpochNotifier
+	BlockMinGasPrice               process.BlockMinGasPriceHandler
 }
 
 // NewTxProcessor creates a new txProcessor engine
@@ -124,6 +125,7 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 		hasher:           args.Hasher,
 		marshalizer:      args.Marshalizer,
 		scProcessor:      args.ScProcessor,
+		blockMinGasPrice: args.BlockMinGasPrice,
 	}
 
 	txProc := &txProcessor{
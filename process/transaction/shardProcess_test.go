@@ -483,6 +483,35 @@ func TestTxProcessor_CheckTxValuesInsufficientFundsShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrInsufficientFunds, err)
 }
 
+func TestTxProcessor_CheckTxValuesLowerGasPriceThanBlockMinGasPriceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	senderAcc, err := state.NewUserAccount([]byte{65})
+	assert.Nil(t, err)
+	senderAcc.Balance = big.NewInt(1000)
+
+	args := createArgsForTxProcessor()
+	args.BlockMinGasPrice = &mock.MinGasPriceAdjusterStub{
+		BlockMinGasPriceCalled: func() uint64 {
+			return 10
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	tx := &transaction.Transaction{Value: big.NewInt(10), GasPrice: 9}
+	err = execTx.CheckTxValues(tx, senderAcc, nil, false)
+	assert.Equal(t, process.ErrInsufficientGasPriceInTx, err)
+
+	tx.GasPrice = 10
+	err = execTx.CheckTxValues(tx, senderAcc, nil, false)
+	assert.Nil(t, err)
+
+	// the transactions of the senders from other shards are not checked against the block min gas price
+	tx.GasPrice = 9
+	err = execTx.CheckTxValues(tx, nil, senderAcc, false)
+	assert.Nil(t, err)
+}
+
 func TestTxProcessor_CheckTxValuesMismatchedSenderUsernamesShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -31,3 +31,12 @@ var ErrNilStorage = errors.New("nil storage")
 
 // ErrNilTermUIStartChannel signals that a nil TermUI start channel has been provided
 var ErrNilTermUIStartChannel = errors.New("nil TermUI start channel")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrInvalidMaxNumContracts signals that an invalid maximum number of tracked contracts has been provided
+var ErrInvalidMaxNumContracts = errors.New("invalid maximum number of contracts")
+
+// ErrInvalidPublishInterval signals that an invalid metrics publish interval has been provided
+var ErrInvalidPublishInterval = errors.New("invalid publish interval")
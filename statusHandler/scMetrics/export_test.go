@@ -0,0 +1,5 @@
+package scMetrics
+
+func (sem *scExecutionMetrics) Publish() {
+	sem.publish()
+}
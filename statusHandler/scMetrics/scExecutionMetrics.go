@@ -0,0 +1,182 @@
+package scMetrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+)
+
+var log = logger.GetOrCreate("statushandler/scmetrics")
+
+const minPublishInterval = time.Second
+
+type contractMetrics struct {
+	address       []byte
+	rankingGas    uint64
+	gasUsed       uint64
+	numCalls      uint64
+	numFailures   uint64
+	executionTime time.Duration
+}
+
+// ArgsSCExecutionMetrics is the argument used in the smart contract execution metrics constructor
+type ArgsSCExecutionMetrics struct {
+	StatusHandler   core.AppStatusHandler
+	PubkeyConverter core.PubkeyConverter
+	MaxNumContracts int
+	PublishInterval time.Duration
+}
+
+// scExecutionMetrics implements process.SCExecutionMetricsHandler and periodically sends to a statusHandler the
+// execution metrics of the contracts consuming the most gas. Each tracked contract occupies a slot, the metrics of a
+// slot being published under keys suffixed with the slot index
+type scExecutionMetrics struct {
+	mutContracts      sync.Mutex
+	slots             []*contractMetrics
+	slotsByAddress    map[string]int
+	hasChanges        bool
+	maxNumContracts   int
+	publishInterval   time.Duration
+	statusHandler     core.AppStatusHandler
+	pubkeyConverter   core.PubkeyConverter
+	cancelPublishFunc func()
+}
+
+// NewSCExecutionMetrics creates a new scExecutionMetrics instance which starts publishing the collected metrics
+func NewSCExecutionMetrics(args ArgsSCExecutionMetrics) (*scExecutionMetrics, error) {
+	if check.IfNil(args.StatusHandler) {
+		return nil, statusHandler.ErrNilAppStatusHandler
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, statusHandler.ErrNilPubkeyConverter
+	}
+	if args.MaxNumContracts < 1 {
+		return nil, statusHandler.ErrInvalidMaxNumContracts
+	}
+	if args.PublishInterval < minPublishInterval {
+		return nil, statusHandler.ErrInvalidPublishInterval
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	sem := &scExecutionMetrics{
+		slots:             make([]*contractMetrics, 0, args.MaxNumContracts),
+		slotsByAddress:    make(map[string]int),
+		maxNumContracts:   args.MaxNumContracts,
+		publishInterval:   args.PublishInterval,
+		statusHandler:     args.StatusHandler,
+		pubkeyConverter:   args.PubkeyConverter,
+		cancelPublishFunc: cancelFunc,
+	}
+
+	go sem.publishContinuously(ctx)
+
+	return sem, nil
+}
+
+// AddExecution records a contract execution. When all the slots are used, a contract which is not tracked takes the
+// slot of the tracked contract having the lowest ranking gas and inherits it, so the contracts consuming the most gas
+// end up being tracked even if all their calls are small. The reported counters start from the moment a contract
+// is tracked
+func (sem *scExecutionMetrics) AddExecution(contractAddress []byte, gasUsed uint64, duration time.Duration, failed bool) {
+	sem.mutContracts.Lock()
+	defer sem.mutContracts.Unlock()
+
+	cm := sem.getOrCreateContractMetrics(contractAddress)
+	cm.rankingGas += gasUsed
+	cm.gasUsed += gasUsed
+	cm.numCalls++
+	cm.executionTime += duration
+	if failed {
+		cm.numFailures++
+	}
+	sem.hasChanges = true
+}
+
+func (sem *scExecutionMetrics) getOrCreateContractMetrics(contractAddress []byte) *contractMetrics {
+	slot, ok := sem.slotsByAddress[string(contractAddress)]
+	if ok {
+		return sem.slots[slot]
+	}
+
+	cm := &contractMetrics{
+		address: contractAddress,
+	}
+	if len(sem.slots) < sem.maxNumContracts {
+		sem.slotsByAddress[string(contractAddress)] = len(sem.slots)
+		sem.slots = append(sem.slots, cm)
+		return cm
+	}
+
+	slot = 0
+	for i, tracked := range sem.slots {
+		if tracked.rankingGas < sem.slots[slot].rankingGas {
+			slot = i
+		}
+	}
+
+	evicted := sem.slots[slot]
+	delete(sem.slotsByAddress, string(evicted.address))
+	cm.rankingGas = evicted.rankingGas
+	sem.slotsByAddress[string(contractAddress)] = slot
+	sem.slots[slot] = cm
+
+	return cm
+}
+
+func (sem *scExecutionMetrics) publishContinuously(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("scExecutionMetrics's go routine is stopping...")
+			return
+		case <-time.After(sem.publishInterval):
+		}
+
+		sem.publish()
+	}
+}
+
+func (sem *scExecutionMetrics) publish() {
+	sem.mutContracts.Lock()
+	if !sem.hasChanges {
+		sem.mutContracts.Unlock()
+		return
+	}
+	snapshot := make([]contractMetrics, 0, len(sem.slots))
+	for _, cm := range sem.slots {
+		snapshot = append(snapshot, *cm)
+	}
+	sem.hasChanges = false
+	sem.mutContracts.Unlock()
+
+	for slot, cm := range snapshot {
+		sem.statusHandler.SetStringValue(
+			statusHandler.SCExecutionMetricKey(core.MetricSCExecutionAddress, slot),
+			sem.pubkeyConverter.Encode(cm.address),
+		)
+		sem.statusHandler.SetUInt64Value(statusHandler.SCExecutionMetricKey(core.MetricSCExecutionGasUsed, slot), cm.gasUsed)
+		sem.statusHandler.SetUInt64Value(statusHandler.SCExecutionMetricKey(core.MetricSCExecutionNumCalls, slot), cm.numCalls)
+		sem.statusHandler.SetUInt64Value(statusHandler.SCExecutionMetricKey(core.MetricSCExecutionNumFailures, slot), cm.numFailures)
+		sem.statusHandler.SetUInt64Value(
+			statusHandler.SCExecutionMetricKey(core.MetricSCExecutionTimeMs, slot),
+			uint64(cm.executionTime.Milliseconds()),
+		)
+	}
+}
+
+// Close stops publishing the metrics
+func (sem *scExecutionMetrics) Close() error {
+	sem.cancelPublishFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sem *scExecutionMetrics) IsInterfaceNil() bool {
+	return sem == nil
+}
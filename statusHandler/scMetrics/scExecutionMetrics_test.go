@@ -0,0 +1,168 @@
+package scMetrics_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/scMetrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddressLen = 4
+
+func createMockArgsSCExecutionMetrics() scMetrics.ArgsSCExecutionMetrics {
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(testAddressLen)
+
+	return scMetrics.ArgsSCExecutionMetrics{
+		StatusHandler:   statusHandler.NewStatusMetrics(),
+		PubkeyConverter: converter,
+		MaxNumContracts: 2,
+		PublishInterval: time.Hour,
+	}
+}
+
+func loadMetric(statusMetrics map[string]interface{}, metric string, slot int) interface{} {
+	return statusMetrics[statusHandler.SCExecutionMetricKey(metric, slot)]
+}
+
+func TestNewSCExecutionMetrics_NilStatusHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSCExecutionMetrics()
+	args.StatusHandler = nil
+	sem, err := scMetrics.NewSCExecutionMetrics(args)
+
+	assert.True(t, check.IfNil(sem))
+	assert.Equal(t, statusHandler.ErrNilAppStatusHandler, err)
+}
+
+func TestNewSCExecutionMetrics_NilPubkeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSCExecutionMetrics()
+	args.PubkeyConverter = nil
+	sem, err := scMetrics.NewSCExecutionMetrics(args)
+
+	assert.True(t, check.IfNil(sem))
+	assert.Equal(t, statusHandler.ErrNilPubkeyConverter, err)
+}
+
+func TestNewSCExecutionMetrics_InvalidMaxNumContractsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSCExecutionMetrics()
+	args.MaxNumContracts = 0
+	sem, err := scMetrics.NewSCExecutionMetrics(args)
+
+	assert.True(t, check.IfNil(sem))
+	assert.Equal(t, statusHandler.ErrInvalidMaxNumContracts, err)
+}
+
+func TestNewSCExecutionMetrics_InvalidPublishIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSCExecutionMetrics()
+	args.PublishInterval = time.Millisecond
+	sem, err := scMetrics.NewSCExecutionMetrics(args)
+
+	assert.True(t, check.IfNil(sem))
+	assert.Equal(t, statusHandler.ErrInvalidPublishInterval, err)
+}
+
+func TestNewSCExecutionMetrics_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sem, err := scMetrics.NewSCExecutionMetrics(createMockArgsSCExecutionMetrics())
+
+	assert.False(t, check.IfNil(sem))
+	assert.Nil(t, err)
+	assert.Nil(t, sem.Close())
+}
+
+func TestScExecutionMetrics_AddExecutionShouldAccumulate(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSCExecutionMetrics()
+	sm := statusHandler.NewStatusMetrics()
+	args.StatusHandler = sm
+	sem, _ := scMetrics.NewSCExecutionMetrics(args)
+	defer func() {
+		_ = sem.Close()
+	}()
+
+	sem.AddExecution([]byte("sc01"), 100, time.Millisecond, false)
+	sem.AddExecution([]byte("sc01"), 50, 2*time.Millisecond, true)
+	sem.AddExecution([]byte("sc02"), 10, time.Millisecond, false)
+	sem.Publish()
+
+	metrics := sm.StatusMetricsMapWithoutP2P()
+	assert.Equal(t, "73633031", loadMetric(metrics, core.MetricSCExecutionAddress, 0))
+	assert.Equal(t, uint64(150), loadMetric(metrics, core.MetricSCExecutionGasUsed, 0))
+	assert.Equal(t, uint64(2), loadMetric(metrics, core.MetricSCExecutionNumCalls, 0))
+	assert.Equal(t, uint64(1), loadMetric(metrics, core.MetricSCExecutionNumFailures, 0))
+	assert.Equal(t, uint64(3), loadMetric(metrics, core.MetricSCExecutionTimeMs, 0))
+	assert.Equal(t, "73633032", loadMetric(metrics, core.MetricSCExecutionAddress, 1))
+	assert.Equal(t, uint64(10), loadMetric(metrics, core.MetricSCExecutionGasUsed, 1))
+	assert.Equal(t, uint64(0), loadMetric(metrics, core.MetricSCExecutionNumFailures, 1))
+}
+
+func TestScExecutionMetrics_AddExecutionShouldReplaceTheContractWithTheLowestGas(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSCExecutionMetrics()
+	sm := statusHandler.NewStatusMetrics()
+	args.StatusHandler = sm
+	sem, _ := scMetrics.NewSCExecutionMetrics(args)
+	defer func() {
+		_ = sem.Close()
+	}()
+
+	sem.AddExecution([]byte("sc01"), 10, time.Millisecond, false)
+	sem.AddExecution([]byte("sc02"), 100, time.Millisecond, false)
+	sem.AddExecution([]byte("sc03"), 5, time.Millisecond, false)
+	sem.Publish()
+
+	metrics := sm.StatusMetricsMapWithoutP2P()
+	assert.Equal(t, "73633033", loadMetric(metrics, core.MetricSCExecutionAddress, 0))
+	assert.Equal(t, uint64(5), loadMetric(metrics, core.MetricSCExecutionGasUsed, 0))
+	assert.Equal(t, uint64(1), loadMetric(metrics, core.MetricSCExecutionNumCalls, 0))
+	assert.Equal(t, "73633032", loadMetric(metrics, core.MetricSCExecutionAddress, 1))
+	_, found := metrics[statusHandler.SCExecutionMetricKey(core.MetricSCExecutionAddress, 2)]
+	assert.False(t, found)
+
+	// sc03 inherited the ranking gas of sc01, so the new contract replaces sc03 even if it consumed more gas
+	sem.AddExecution([]byte("sc04"), 12, time.Millisecond, false)
+	sem.Publish()
+
+	metrics = sm.StatusMetricsMapWithoutP2P()
+	assert.Equal(t, "73633034", loadMetric(metrics, core.MetricSCExecutionAddress, 0))
+	assert.Equal(t, uint64(12), loadMetric(metrics, core.MetricSCExecutionGasUsed, 0))
+	assert.Equal(t, "73633032", loadMetric(metrics, core.MetricSCExecutionAddress, 1))
+}
+
+func TestScExecutionMetrics_ShouldPublishPeriodically(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSCExecutionMetrics()
+	sm := statusHandler.NewStatusMetrics()
+	args.StatusHandler = sm
+	args.PublishInterval = time.Second
+	sem, _ := scMetrics.NewSCExecutionMetrics(args)
+	defer func() {
+		_ = sem.Close()
+	}()
+
+	sem.AddExecution([]byte("sc01"), 10, time.Millisecond, false)
+	time.Sleep(time.Second + 500*time.Millisecond)
+
+	prometheusMetrics := sm.StatusMetricsWithoutP2PPrometheusString()
+	expectedLine := fmt.Sprintf("%s{%s=\"0\",erd_sc_address=\"73633031\"} 10", core.MetricSCExecutionGasUsed, core.MetricShardId)
+	require.True(t, strings.Contains(prometheusMetrics, expectedLine), prometheusMetrics)
+}
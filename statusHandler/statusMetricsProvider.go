@@ -8,6 +8,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 )
 
+const scAddressPrometheusLabel = "erd_sc_address"
+
+var scExecutionCounters = []string{
+	core.MetricSCExecutionGasUsed,
+	core.MetricSCExecutionNumCalls,
+	core.MetricSCExecutionNumFailures,
+	core.MetricSCExecutionTimeMs,
+}
+
 // statusMetrics will handle displaying at /node/details all metrics already collected for other status handlers
 type statusMetrics struct {
 	nodeMetrics *sync.Map
@@ -133,6 +142,10 @@ func (sm *statusMetrics) StatusMetricsWithoutP2PPrometheusString() string {
 	metrics := sm.StatusMetricsMapWithoutP2P()
 	stringBuilder := strings.Builder{}
 	for key, value := range metrics {
+		if strings.HasPrefix(key, core.MetricSCExecutionPrefix) {
+			continue
+		}
+
 		_, isUint64 := value.(uint64)
 		_, isInt64 := value.(int64)
 		isNumericValue := isUint64 || isInt64
@@ -140,10 +153,38 @@ func (sm *statusMetrics) StatusMetricsWithoutP2PPrometheusString() string {
 			stringBuilder.WriteString(fmt.Sprintf("%s{%s=\"%d\"} %v\n", key, core.MetricShardId, shardID, value))
 		}
 	}
+	sm.writeSCExecutionPrometheusMetrics(&stringBuilder, shardID)
 
 	return stringBuilder.String()
 }
 
+// writeSCExecutionPrometheusMetrics writes the per contract execution metrics, labeled with the contract's address
+// instead of being suffixed with the slot index
+func (sm *statusMetrics) writeSCExecutionPrometheusMetrics(stringBuilder *strings.Builder, shardID uint64) {
+	for slot := 0; ; slot++ {
+		address := sm.loadStringMetric(SCExecutionMetricKey(core.MetricSCExecutionAddress, slot))
+		if len(address) == 0 {
+			return
+		}
+
+		for _, metric := range scExecutionCounters {
+			stringBuilder.WriteString(fmt.Sprintf("%s{%s=\"%d\",%s=\"%s\"} %d\n",
+				metric,
+				core.MetricShardId,
+				shardID,
+				scAddressPrometheusLabel,
+				address,
+				sm.loadUint64Metric(SCExecutionMetricKey(metric, slot)),
+			))
+		}
+	}
+}
+
+// SCExecutionMetricKey returns the key under which a contract execution metric is stored for the provided slot
+func SCExecutionMetricKey(metric string, slot int) string {
+	return fmt.Sprintf("%s_%d", metric, slot)
+}
+
 // EconomicsMetrics returns the economics related metrics
 func (sm *statusMetrics) EconomicsMetrics() map[string]interface{} {
 	economicsMetrics := make(map[string]interface{})
@@ -167,6 +208,7 @@ func (sm *statusMetrics) ConfigMetrics() map[string]interface{} {
 	configMetrics[core.MetricShardConsensusGroupSize] = sm.loadUint64Metric(core.MetricShardConsensusGroupSize)
 	configMetrics[core.MetricMetaConsensusGroupSize] = sm.loadUint64Metric(core.MetricMetaConsensusGroupSize)
 	configMetrics[core.MetricMinGasPrice] = sm.loadUint64Metric(core.MetricMinGasPrice)
+	configMetrics[core.MetricCurrentMinGasPrice] = sm.loadUint64Metric(core.MetricCurrentMinGasPrice)
 	configMetrics[core.MetricMinGasLimit] = sm.loadUint64Metric(core.MetricMinGasLimit)
 	configMetrics[core.MetricRewardsTopUpGradientPoint] = sm.loadStringMetric(core.MetricRewardsTopUpGradientPoint)
 	configMetrics[core.MetricGasPerDataByte] = sm.loadUint64Metric(core.MetricGasPerDataByte)
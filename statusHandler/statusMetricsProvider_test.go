@@ -144,6 +144,32 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldPutCorrectSh
 	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
 }
 
+func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldLabelSCExecutionMetrics(t *testing.T) {
+	t.Parallel()
+
+	shardID := uint64(2)
+	sm := statusHandler.NewStatusMetrics()
+	sm.SetUInt64Value(core.MetricShardId, shardID)
+	sm.SetStringValue(statusHandler.SCExecutionMetricKey(core.MetricSCExecutionAddress, 0), "erd1first")
+	sm.SetUInt64Value(statusHandler.SCExecutionMetricKey(core.MetricSCExecutionGasUsed, 0), 100)
+	sm.SetUInt64Value(statusHandler.SCExecutionMetricKey(core.MetricSCExecutionNumCalls, 0), 3)
+	sm.SetStringValue(statusHandler.SCExecutionMetricKey(core.MetricSCExecutionAddress, 1), "erd1second")
+	sm.SetUInt64Value(statusHandler.SCExecutionMetricKey(core.MetricSCExecutionNumFailures, 1), 7)
+
+	strRes := sm.StatusMetricsWithoutP2PPrometheusString()
+
+	expectedLines := []string{
+		fmt.Sprintf("%s{%s=\"%d\",erd_sc_address=\"erd1first\"} 100", core.MetricSCExecutionGasUsed, core.MetricShardId, shardID),
+		fmt.Sprintf("%s{%s=\"%d\",erd_sc_address=\"erd1first\"} 3", core.MetricSCExecutionNumCalls, core.MetricShardId, shardID),
+		fmt.Sprintf("%s{%s=\"%d\",erd_sc_address=\"erd1first\"} 0", core.MetricSCExecutionTimeMs, core.MetricShardId, shardID),
+		fmt.Sprintf("%s{%s=\"%d\",erd_sc_address=\"erd1second\"} 7", core.MetricSCExecutionNumFailures, core.MetricShardId, shardID),
+	}
+	for _, line := range expectedLines {
+		assert.True(t, strings.Contains(strRes, line), "line %s not contained", line)
+	}
+	assert.False(t, strings.Contains(strRes, statusHandler.SCExecutionMetricKey(core.MetricSCExecutionGasUsed, 0)))
+}
+
 func TestStatusMetrics_NetworkConfig(t *testing.T) {
 	t.Parallel()
 
@@ -155,6 +181,7 @@ func TestStatusMetrics_NetworkConfig(t *testing.T) {
 	sm.SetUInt64Value(core.MetricShardConsensusGroupSize, 20)
 	sm.SetUInt64Value(core.MetricMetaConsensusGroupSize, 25)
 	sm.SetUInt64Value(core.MetricMinGasPrice, 1000)
+	sm.SetUInt64Value(core.MetricCurrentMinGasPrice, 2000)
 	sm.SetUInt64Value(core.MetricMinGasLimit, 50000)
 	sm.SetStringValue(core.MetricRewardsTopUpGradientPoint, "12345")
 	sm.SetUInt64Value(core.MetricGasPerDataByte, 1500)
@@ -169,6 +196,7 @@ func TestStatusMetrics_NetworkConfig(t *testing.T) {
 
 	expectedConfig := map[string]interface{}{
 		"erd_chain_id":                      "local-id",
+		"erd_current_min_gas_price":         uint64(2000),
 		"erd_denomination":                  uint64(18),
 		"erd_gas_per_data_byte":             uint64(1500),
 		"erd_latest_tag_software_version":   "version1.0",
@@ -467,6 +467,24 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	*createdStorers = append(*createdStorers, epochByHashUnit)
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	if !psf.generalConfig.DbLookupExtensions.BalanceHistoryEnabled {
+		return nil
+	}
+
+	// Create the balanceHistory (STATIC) storer
+	balanceHistoryConfig := psf.generalConfig.DbLookupExtensions.BalanceHistoryStorageConfig
+	balanceHistoryDbConfig := GetDBFromConfig(balanceHistoryConfig.DB)
+	balanceHistoryDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, balanceHistoryConfig.DB.FilePath)
+	balanceHistoryCacherConfig := GetCacherFromConfig(balanceHistoryConfig.Cache)
+	balanceHistoryBloomFilter := GetBloomFromConfig(balanceHistoryConfig.Bloom)
+	balanceHistoryUnit, err := storageUnit.NewStorageUnitFromConf(balanceHistoryCacherConfig, balanceHistoryDbConfig, balanceHistoryBloomFilter)
+	if err != nil {
+		return err
+	}
+
+	*createdStorers = append(*createdStorers, balanceHistoryUnit)
+	chainStorer.AddStorer(dataRetriever.BalanceHistoryUnit, balanceHistoryUnit)
+
 	return nil
 }
 
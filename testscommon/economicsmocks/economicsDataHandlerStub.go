@@ -15,6 +15,7 @@ type EconomicsHandlerStub struct {
 	CheckValidityTxValuesCalled                  func(tx process.TransactionWithFeeHandler) error
 	DeveloperPercentageCalled                    func() float64
 	MinGasPriceCalled                            func() uint64
+	CurrentMinGasPriceCalled                     func() uint64
 	GasPriceModifierCalled                       func() float64
 	LeaderPercentageCalled                       func() float64
 	ProtocolSustainabilityPercentageCalled       func() float64
@@ -115,6 +116,14 @@ func (e *EconomicsHandlerStub) GasPriceModifier() float64 {
 	return 1.0
 }
 
+// CurrentMinGasPrice -
+func (e *EconomicsHandlerStub) CurrentMinGasPrice() uint64 {
+	if e.CurrentMinGasPriceCalled != nil {
+		return e.CurrentMinGasPriceCalled()
+	}
+	return 0
+}
+
 // MinGasPrice -
 func (e *EconomicsHandlerStub) MinGasPrice() uint64 {
 	if e.MinGasPriceCalled != nil {
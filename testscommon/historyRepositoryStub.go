@@ -15,6 +15,9 @@ type HistoryRepositoryStub struct {
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	IsEnabledCalled                    func() bool
+	RecordBalanceChangesCalled         func(blockHeaderHash []byte, blockHeader data.HeaderHandler, txsFromPool map[string]data.TransactionHandler)
+	RevertBalanceChangesCalled         func(blockHeader data.HeaderHandler)
+	GetBalanceHistoryCalled            func(address []byte, from uint64, size uint64) (*dblookupext.BalanceHistory, error)
 }
 
 // RecordBlock -
@@ -67,6 +70,28 @@ func (hp *HistoryRepositoryStub) GetResultsHashesByTxHash(hash []byte, epoch uin
 	return nil, nil
 }
 
+// RecordBalanceChanges -
+func (hp *HistoryRepositoryStub) RecordBalanceChanges(blockHeaderHash []byte, blockHeader data.HeaderHandler, txsFromPool map[string]data.TransactionHandler) {
+	if hp.RecordBalanceChangesCalled != nil {
+		hp.RecordBalanceChangesCalled(blockHeaderHash, blockHeader, txsFromPool)
+	}
+}
+
+// RevertBalanceChanges -
+func (hp *HistoryRepositoryStub) RevertBalanceChanges(blockHeader data.HeaderHandler) {
+	if hp.RevertBalanceChangesCalled != nil {
+		hp.RevertBalanceChangesCalled(blockHeader)
+	}
+}
+
+// GetBalanceHistory -
+func (hp *HistoryRepositoryStub) GetBalanceHistory(address []byte, from uint64, size uint64) (*dblookupext.BalanceHistory, error) {
+	if hp.GetBalanceHistoryCalled != nil {
+		return hp.GetBalanceHistoryCalled(address, from, size)
+	}
+	return nil, dblookupext.ErrBalanceHistoryNotEnabled
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil